@@ -26,88 +26,149 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
-// Resource is a concrete type of recyclables brought by the pupils to the events
-type Resource int32
+// EventStatus is a stage of the event's lifecycle. The resources can be brought to the open event only
+type EventStatus int32
 
 const (
-	Resource_RESOURCE_UNKNOWN Resource = 0
-	Resource_RESOURCE_GADGETS Resource = 1
-	Resource_RESOURCE_PAPER   Resource = 2
-	Resource_RESOURCE_PLASTIC Resource = 3
+	EventStatus_EVENT_STATUS_UNKNOWN  EventStatus = 0
+	EventStatus_EVENT_STATUS_PLANNED  EventStatus = 1
+	EventStatus_EVENT_STATUS_OPEN     EventStatus = 2
+	EventStatus_EVENT_STATUS_CLOSED   EventStatus = 3
+	EventStatus_EVENT_STATUS_ARCHIVED EventStatus = 4
 )
 
-// Enum value maps for Resource.
+// Enum value maps for EventStatus.
 var (
-	Resource_name = map[int32]string{
-		0: "RESOURCE_UNKNOWN",
-		1: "RESOURCE_GADGETS",
-		2: "RESOURCE_PAPER",
-		3: "RESOURCE_PLASTIC",
-	}
-	Resource_value = map[string]int32{
-		"RESOURCE_UNKNOWN": 0,
-		"RESOURCE_GADGETS": 1,
-		"RESOURCE_PAPER":   2,
-		"RESOURCE_PLASTIC": 3,
+	EventStatus_name = map[int32]string{
+		0: "EVENT_STATUS_UNKNOWN",
+		1: "EVENT_STATUS_PLANNED",
+		2: "EVENT_STATUS_OPEN",
+		3: "EVENT_STATUS_CLOSED",
+		4: "EVENT_STATUS_ARCHIVED",
+	}
+	EventStatus_value = map[string]int32{
+		"EVENT_STATUS_UNKNOWN":  0,
+		"EVENT_STATUS_PLANNED":  1,
+		"EVENT_STATUS_OPEN":     2,
+		"EVENT_STATUS_CLOSED":   3,
+		"EVENT_STATUS_ARCHIVED": 4,
 	}
 )
 
-func (x Resource) Enum() *Resource {
-	p := new(Resource)
+func (x EventStatus) Enum() *EventStatus {
+	p := new(EventStatus)
 	*p = x
 	return p
 }
 
-func (x Resource) String() string {
+func (x EventStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (Resource) Descriptor() protoreflect.EnumDescriptor {
+func (EventStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_events_proto_enumTypes[0].Descriptor()
 }
 
-func (Resource) Type() protoreflect.EnumType {
+func (EventStatus) Type() protoreflect.EnumType {
 	return &file_events_proto_enumTypes[0]
 }
 
-func (x Resource) Number() protoreflect.EnumNumber {
+func (x EventStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Resource.Descriptor instead.
-func (Resource) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use EventStatus.Descriptor instead.
+func (EventStatus) EnumDescriptor() ([]byte, []int) {
 	return file_events_proto_rawDescGZIP(), []int{0}
 }
 
-// PupilSorting shows how pupils can be sorted
+// ResourceUnit is a unit the amounts of a resource are measured in. The resource types are measured in kilograms or
+// pieces, the amounts given in grams or pounds are converted to kilograms
+type ResourceUnit int32
+
+const (
+	ResourceUnit_RESOURCE_UNIT_UNKNOWN   ResourceUnit = 0
+	ResourceUnit_RESOURCE_UNIT_KILOGRAMS ResourceUnit = 1
+	ResourceUnit_RESOURCE_UNIT_PIECES    ResourceUnit = 2
+	ResourceUnit_RESOURCE_UNIT_GRAMS     ResourceUnit = 3
+	ResourceUnit_RESOURCE_UNIT_POUNDS    ResourceUnit = 4
+)
+
+// Enum value maps for ResourceUnit.
+var (
+	ResourceUnit_name = map[int32]string{
+		0: "RESOURCE_UNIT_UNKNOWN",
+		1: "RESOURCE_UNIT_KILOGRAMS",
+		2: "RESOURCE_UNIT_PIECES",
+		3: "RESOURCE_UNIT_GRAMS",
+		4: "RESOURCE_UNIT_POUNDS",
+	}
+	ResourceUnit_value = map[string]int32{
+		"RESOURCE_UNIT_UNKNOWN":   0,
+		"RESOURCE_UNIT_KILOGRAMS": 1,
+		"RESOURCE_UNIT_PIECES":    2,
+		"RESOURCE_UNIT_GRAMS":     3,
+		"RESOURCE_UNIT_POUNDS":    4,
+	}
+)
+
+func (x ResourceUnit) Enum() *ResourceUnit {
+	p := new(ResourceUnit)
+	*p = x
+	return p
+}
+
+func (x ResourceUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_proto_enumTypes[1].Descriptor()
+}
+
+func (ResourceUnit) Type() protoreflect.EnumType {
+	return &file_events_proto_enumTypes[1]
+}
+
+func (x ResourceUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceUnit.Descriptor instead.
+func (ResourceUnit) EnumDescriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{1}
+}
+
+// PupilSorting shows how pupils can be sorted. The sorting by a resource needs the name of the resource. The sorting by
+// the total is made by the points scored for all the resources brought
 type PupilSorting int32
 
 const (
-	PupilSorting_PUPIL_SORTING_UNKNOWN   PupilSorting = 0
-	PupilSorting_PUPIL_SORTING_GADGETS   PupilSorting = 1
-	PupilSorting_PUPIL_SORTING_NAME_ASC  PupilSorting = 2
-	PupilSorting_PUPIL_SORTING_NAME_DESC PupilSorting = 3
-	PupilSorting_PUPIL_SORTING_PAPER     PupilSorting = 4
-	PupilSorting_PUPIL_SORTING_PLASTIC   PupilSorting = 5
+	PupilSorting_PUPIL_SORTING_UNKNOWN    PupilSorting = 0
+	PupilSorting_PUPIL_SORTING_NAME_ASC   PupilSorting = 2
+	PupilSorting_PUPIL_SORTING_NAME_DESC  PupilSorting = 3
+	PupilSorting_PUPIL_SORTING_RESOURCE   PupilSorting = 6
+	PupilSorting_PUPIL_SORTING_TOTAL_ASC  PupilSorting = 7
+	PupilSorting_PUPIL_SORTING_TOTAL_DESC PupilSorting = 8
 )
 
 // Enum value maps for PupilSorting.
 var (
 	PupilSorting_name = map[int32]string{
 		0: "PUPIL_SORTING_UNKNOWN",
-		1: "PUPIL_SORTING_GADGETS",
 		2: "PUPIL_SORTING_NAME_ASC",
 		3: "PUPIL_SORTING_NAME_DESC",
-		4: "PUPIL_SORTING_PAPER",
-		5: "PUPIL_SORTING_PLASTIC",
+		6: "PUPIL_SORTING_RESOURCE",
+		7: "PUPIL_SORTING_TOTAL_ASC",
+		8: "PUPIL_SORTING_TOTAL_DESC",
 	}
 	PupilSorting_value = map[string]int32{
-		"PUPIL_SORTING_UNKNOWN":   0,
-		"PUPIL_SORTING_GADGETS":   1,
-		"PUPIL_SORTING_NAME_ASC":  2,
-		"PUPIL_SORTING_NAME_DESC": 3,
-		"PUPIL_SORTING_PAPER":     4,
-		"PUPIL_SORTING_PLASTIC":   5,
+		"PUPIL_SORTING_UNKNOWN":    0,
+		"PUPIL_SORTING_NAME_ASC":   2,
+		"PUPIL_SORTING_NAME_DESC":  3,
+		"PUPIL_SORTING_RESOURCE":   6,
+		"PUPIL_SORTING_TOTAL_ASC":  7,
+		"PUPIL_SORTING_TOTAL_DESC": 8,
 	}
 )
 
@@ -122,11 +183,11 @@ func (x PupilSorting) String() string {
 }
 
 func (PupilSorting) Descriptor() protoreflect.EnumDescriptor {
-	return file_events_proto_enumTypes[1].Descriptor()
+	return file_events_proto_enumTypes[2].Descriptor()
 }
 
 func (PupilSorting) Type() protoreflect.EnumType {
-	return &file_events_proto_enumTypes[1]
+	return &file_events_proto_enumTypes[2]
 }
 
 func (x PupilSorting) Number() protoreflect.EnumNumber {
@@ -135,38 +196,59 @@ func (x PupilSorting) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PupilSorting.Descriptor instead.
 func (PupilSorting) EnumDescriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{1}
+	return file_events_proto_rawDescGZIP(), []int{2}
 }
 
-// ClassSorting shows how classes can be sorted
+// ClassSorting shows how classes can be sorted. The sorting by a resource needs the name of the resource. The sorting by
+// the total is made by the points scored for all the resources brought, the sorting per pupil divides them by the
+// number of the pupils in the class. The sorting by the participation is made by the share of the pupils who brought
+// anything, the sorting by the median is made by the median of the points scored by the pupils
 type ClassSorting int32
 
 const (
-	ClassSorting_CLASS_SORTING_UNKNOWN   ClassSorting = 0
-	ClassSorting_CLASS_SORTING_GADGETS   ClassSorting = 1
-	ClassSorting_CLASS_SORTING_NAME_ASC  ClassSorting = 2
-	ClassSorting_CLASS_SORTING_NAME_DESC ClassSorting = 3
-	ClassSorting_CLASS_SORTING_PAPER     ClassSorting = 4
-	ClassSorting_CLASS_SORTING_PLASTIC   ClassSorting = 5
+	ClassSorting_CLASS_SORTING_UNKNOWN            ClassSorting = 0
+	ClassSorting_CLASS_SORTING_NAME_ASC           ClassSorting = 2
+	ClassSorting_CLASS_SORTING_NAME_DESC          ClassSorting = 3
+	ClassSorting_CLASS_SORTING_RESOURCE           ClassSorting = 6
+	ClassSorting_CLASS_SORTING_TOTAL_ASC          ClassSorting = 7
+	ClassSorting_CLASS_SORTING_TOTAL_DESC         ClassSorting = 8
+	ClassSorting_CLASS_SORTING_PER_PUPIL_ASC      ClassSorting = 9
+	ClassSorting_CLASS_SORTING_PER_PUPIL_DESC     ClassSorting = 10
+	ClassSorting_CLASS_SORTING_PARTICIPATION_ASC  ClassSorting = 11
+	ClassSorting_CLASS_SORTING_PARTICIPATION_DESC ClassSorting = 12
+	ClassSorting_CLASS_SORTING_MEDIAN_ASC         ClassSorting = 13
+	ClassSorting_CLASS_SORTING_MEDIAN_DESC        ClassSorting = 14
 )
 
 // Enum value maps for ClassSorting.
 var (
 	ClassSorting_name = map[int32]string{
-		0: "CLASS_SORTING_UNKNOWN",
-		1: "CLASS_SORTING_GADGETS",
-		2: "CLASS_SORTING_NAME_ASC",
-		3: "CLASS_SORTING_NAME_DESC",
-		4: "CLASS_SORTING_PAPER",
-		5: "CLASS_SORTING_PLASTIC",
+		0:  "CLASS_SORTING_UNKNOWN",
+		2:  "CLASS_SORTING_NAME_ASC",
+		3:  "CLASS_SORTING_NAME_DESC",
+		6:  "CLASS_SORTING_RESOURCE",
+		7:  "CLASS_SORTING_TOTAL_ASC",
+		8:  "CLASS_SORTING_TOTAL_DESC",
+		9:  "CLASS_SORTING_PER_PUPIL_ASC",
+		10: "CLASS_SORTING_PER_PUPIL_DESC",
+		11: "CLASS_SORTING_PARTICIPATION_ASC",
+		12: "CLASS_SORTING_PARTICIPATION_DESC",
+		13: "CLASS_SORTING_MEDIAN_ASC",
+		14: "CLASS_SORTING_MEDIAN_DESC",
 	}
 	ClassSorting_value = map[string]int32{
-		"CLASS_SORTING_UNKNOWN":   0,
-		"CLASS_SORTING_GADGETS":   1,
-		"CLASS_SORTING_NAME_ASC":  2,
-		"CLASS_SORTING_NAME_DESC": 3,
-		"CLASS_SORTING_PAPER":     4,
-		"CLASS_SORTING_PLASTIC":   5,
+		"CLASS_SORTING_UNKNOWN":            0,
+		"CLASS_SORTING_NAME_ASC":           2,
+		"CLASS_SORTING_NAME_DESC":          3,
+		"CLASS_SORTING_RESOURCE":           6,
+		"CLASS_SORTING_TOTAL_ASC":          7,
+		"CLASS_SORTING_TOTAL_DESC":         8,
+		"CLASS_SORTING_PER_PUPIL_ASC":      9,
+		"CLASS_SORTING_PER_PUPIL_DESC":     10,
+		"CLASS_SORTING_PARTICIPATION_ASC":  11,
+		"CLASS_SORTING_PARTICIPATION_DESC": 12,
+		"CLASS_SORTING_MEDIAN_ASC":         13,
+		"CLASS_SORTING_MEDIAN_DESC":        14,
 	}
 )
 
@@ -181,11 +263,11 @@ func (x ClassSorting) String() string {
 }
 
 func (ClassSorting) Descriptor() protoreflect.EnumDescriptor {
-	return file_events_proto_enumTypes[2].Descriptor()
+	return file_events_proto_enumTypes[3].Descriptor()
 }
 
 func (ClassSorting) Type() protoreflect.EnumType {
-	return &file_events_proto_enumTypes[2]
+	return &file_events_proto_enumTypes[3]
 }
 
 func (x ClassSorting) Number() protoreflect.EnumNumber {
@@ -194,44 +276,45 @@ func (x ClassSorting) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ClassSorting.Descriptor instead.
 func (ClassSorting) EnumDescriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{2}
+	return file_events_proto_rawDescGZIP(), []int{3}
 }
 
-// EventSorting shows how events can be sorted
+// EventSorting shows how events can be sorted. The sorting by a resource needs the name of the resource. The sorting by
+// the total is made by the points scored for all the resources brought
 type EventSorting int32
 
 const (
-	EventSorting_EVENT_SORTING_UNKNOWN   EventSorting = 0
-	EventSorting_EVENT_SORTING_DATE_ASC  EventSorting = 1
-	EventSorting_EVENT_SORTING_DATE_DESC EventSorting = 2
-	EventSorting_EVENT_SORTING_GADGETS   EventSorting = 3
-	EventSorting_EVENT_SORTING_NAME_ASC  EventSorting = 4
-	EventSorting_EVENT_SORTING_NAME_DESC EventSorting = 5
-	EventSorting_EVENT_SORTING_PAPER     EventSorting = 6
-	EventSorting_EVENT_SORTING_PLASTIC   EventSorting = 7
+	EventSorting_EVENT_SORTING_UNKNOWN    EventSorting = 0
+	EventSorting_EVENT_SORTING_DATE_ASC   EventSorting = 1
+	EventSorting_EVENT_SORTING_DATE_DESC  EventSorting = 2
+	EventSorting_EVENT_SORTING_NAME_ASC   EventSorting = 4
+	EventSorting_EVENT_SORTING_NAME_DESC  EventSorting = 5
+	EventSorting_EVENT_SORTING_RESOURCE   EventSorting = 8
+	EventSorting_EVENT_SORTING_TOTAL_ASC  EventSorting = 9
+	EventSorting_EVENT_SORTING_TOTAL_DESC EventSorting = 10
 )
 
 // Enum value maps for EventSorting.
 var (
 	EventSorting_name = map[int32]string{
-		0: "EVENT_SORTING_UNKNOWN",
-		1: "EVENT_SORTING_DATE_ASC",
-		2: "EVENT_SORTING_DATE_DESC",
-		3: "EVENT_SORTING_GADGETS",
-		4: "EVENT_SORTING_NAME_ASC",
-		5: "EVENT_SORTING_NAME_DESC",
-		6: "EVENT_SORTING_PAPER",
-		7: "EVENT_SORTING_PLASTIC",
+		0:  "EVENT_SORTING_UNKNOWN",
+		1:  "EVENT_SORTING_DATE_ASC",
+		2:  "EVENT_SORTING_DATE_DESC",
+		4:  "EVENT_SORTING_NAME_ASC",
+		5:  "EVENT_SORTING_NAME_DESC",
+		8:  "EVENT_SORTING_RESOURCE",
+		9:  "EVENT_SORTING_TOTAL_ASC",
+		10: "EVENT_SORTING_TOTAL_DESC",
 	}
 	EventSorting_value = map[string]int32{
-		"EVENT_SORTING_UNKNOWN":   0,
-		"EVENT_SORTING_DATE_ASC":  1,
-		"EVENT_SORTING_DATE_DESC": 2,
-		"EVENT_SORTING_GADGETS":   3,
-		"EVENT_SORTING_NAME_ASC":  4,
-		"EVENT_SORTING_NAME_DESC": 5,
-		"EVENT_SORTING_PAPER":     6,
-		"EVENT_SORTING_PLASTIC":   7,
+		"EVENT_SORTING_UNKNOWN":    0,
+		"EVENT_SORTING_DATE_ASC":   1,
+		"EVENT_SORTING_DATE_DESC":  2,
+		"EVENT_SORTING_NAME_ASC":   4,
+		"EVENT_SORTING_NAME_DESC":  5,
+		"EVENT_SORTING_RESOURCE":   8,
+		"EVENT_SORTING_TOTAL_ASC":  9,
+		"EVENT_SORTING_TOTAL_DESC": 10,
 	}
 )
 
@@ -246,11 +329,11 @@ func (x EventSorting) String() string {
 }
 
 func (EventSorting) Descriptor() protoreflect.EnumDescriptor {
-	return file_events_proto_enumTypes[3].Descriptor()
+	return file_events_proto_enumTypes[4].Descriptor()
 }
 
 func (EventSorting) Type() protoreflect.EnumType {
-	return &file_events_proto_enumTypes[3]
+	return &file_events_proto_enumTypes[4]
 }
 
 func (x EventSorting) Number() protoreflect.EnumNumber {
@@ -259,7 +342,116 @@ func (x EventSorting) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EventSorting.Descriptor instead.
 func (EventSorting) EnumDescriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{3}
+	return file_events_proto_rawDescGZIP(), []int{4}
+}
+
+// AuditEntry is a record of a change made by calling a mutating RPC
+type AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// id of the user who has made the change. It's empty if the RPC doesn't require authentication
+	ActorId  string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// full method name of the RPC
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	// ids of the changed entities
+	TargetIds []string `protobuf:"bytes,5,rep,name=target_ids,json=targetIds,proto3" json:"target_ids,omitempty"`
+	// json encoded states of the targets before and after the change with the secrets redacted. "before" is empty if
+	// the change has created the targets or their previous state is unknown, "after" is empty if it has deleted them
+	Before     string               `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After      string               `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	OccurredAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTargetIds() []string {
+	if x != nil {
+		return x.TargetIds
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOccurredAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
 }
 
 // Class is a school class consisting of pupils. This message is used in the context of a single event
@@ -272,12 +464,14 @@ type Class struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// amount of the resources the class brought to the event
 	ResourcesBrought *ResourcesBrought `protobuf:"bytes,2,opt,name=resources_brought,json=resourcesBrought,proto3" json:"resources_brought,omitempty"`
+	// resources the class brought to the event per pupil
+	Stats *ClassStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
 func (x *Class) Reset() {
 	*x = Class{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_proto_msgTypes[0]
+		mi := &file_events_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -290,7 +484,7 @@ func (x *Class) String() string {
 func (*Class) ProtoMessage() {}
 
 func (x *Class) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[0]
+	mi := &file_events_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -303,7 +497,7 @@ func (x *Class) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Class.ProtoReflect.Descriptor instead.
 func (*Class) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{0}
+	return file_events_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Class) GetName() string {
@@ -320,6 +514,13 @@ func (x *Class) GetResourcesBrought() *ResourcesBrought {
 	return nil
 }
 
+func (x *Class) GetStats() *ClassStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
 // ClassAggr is used in the context of many events the class has participated in.
 // Note, that the name of the class changes depending on the date of a particular event. Hence, we send its
 // letter and the date it was formed on instead of its name
@@ -336,12 +537,14 @@ type ClassAggr struct {
 	ResourcesBrought *ResourcesBrought `protobuf:"bytes,3,opt,name=resources_brought,json=resourcesBrought,proto3" json:"resources_brought,omitempty"`
 	// array of events with their names and the amount of resources brought by the class
 	Events []*Event `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	// resources the class has brought to all the events that passed the filters per pupil
+	Stats *ClassStats `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
 func (x *ClassAggr) Reset() {
 	*x = ClassAggr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_proto_msgTypes[1]
+		mi := &file_events_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -354,7 +557,7 @@ func (x *ClassAggr) String() string {
 func (*ClassAggr) ProtoMessage() {}
 
 func (x *ClassAggr) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[1]
+	mi := &file_events_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,7 +570,7 @@ func (x *ClassAggr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClassAggr.ProtoReflect.Descriptor instead.
 func (*ClassAggr) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{1}
+	return file_events_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ClassAggr) GetLetter() string {
@@ -398,40 +601,51 @@ func (x *ClassAggr) GetEvents() []*Event {
 	return nil
 }
 
-// Event is a meeting of pupils who bring in recyclables
-// The goal of the event is to gather as many recyclable materials (resources) as possible
-type Event struct {
+func (x *ClassAggr) GetStats() *ClassStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// ClassStats describes the resources brought by the class relative to the number of its pupils, so that the classes of
+// different sizes can be compared
+type ClassStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// date on which the event will take place
-	Date *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	Name string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// types of resources allowed to be brought to this event
-	ResourcesAllowed []Resource `protobuf:"varint,4,rep,packed,name=resources_allowed,json=resourcesAllowed,proto3,enum=shanvl.garbage.events.v1.Resource" json:"resources_allowed,omitempty"`
-	// amount of the resources gathered at the event
-	ResourcesBrought *ResourcesBrought `protobuf:"bytes,5,opt,name=resources_brought,json=resourcesBrought,proto3" json:"resources_brought,omitempty"`
-}
-
-func (x *Event) Reset() {
-	*x = Event{}
+	// number of the pupils the class had on the date of the event
+	Pupils uint32 `protobuf:"varint,1,opt,name=pupils,proto3" json:"pupils,omitempty"`
+	// number of the pupils who brought any of the resources
+	Participants uint32 `protobuf:"varint,2,opt,name=participants,proto3" json:"participants,omitempty"`
+	// share of the pupils who brought any of the resources, from 0 to 1
+	ParticipationRate float32 `protobuf:"fixed32,3,opt,name=participation_rate,json=participationRate,proto3" json:"participation_rate,omitempty"`
+	// average amounts of the resources brought per pupil. The pupils who haven't brought anything are counted too
+	Average *ResourcesBrought `protobuf:"bytes,4,opt,name=average,proto3" json:"average,omitempty"`
+	// median amounts of the resources brought per pupil
+	Median *ResourcesBrought `protobuf:"bytes,5,opt,name=median,proto3" json:"median,omitempty"`
+	// median of the points scored by the pupils
+	MedianPoints float32 `protobuf:"fixed32,6,opt,name=median_points,json=medianPoints,proto3" json:"median_points,omitempty"`
+}
+
+func (x *ClassStats) Reset() {
+	*x = ClassStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_proto_msgTypes[2]
+		mi := &file_events_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event) String() string {
+func (x *ClassStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*ClassStats) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[2]
+func (x *ClassStats) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,79 +656,82 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ClassStats.ProtoReflect.Descriptor instead.
+func (*ClassStats) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Event) GetId() string {
+func (x *ClassStats) GetPupils() uint32 {
 	if x != nil {
-		return x.Id
+		return x.Pupils
 	}
-	return ""
+	return 0
 }
 
-func (x *Event) GetDate() *timestamp.Timestamp {
+func (x *ClassStats) GetParticipants() uint32 {
 	if x != nil {
-		return x.Date
+		return x.Participants
 	}
-	return nil
+	return 0
 }
 
-func (x *Event) GetName() string {
+func (x *ClassStats) GetParticipationRate() float32 {
 	if x != nil {
-		return x.Name
+		return x.ParticipationRate
 	}
-	return ""
+	return 0
 }
 
-func (x *Event) GetResourcesAllowed() []Resource {
+func (x *ClassStats) GetAverage() *ResourcesBrought {
 	if x != nil {
-		return x.ResourcesAllowed
+		return x.Average
 	}
 	return nil
 }
 
-func (x *Event) GetResourcesBrought() *ResourcesBrought {
+func (x *ClassStats) GetMedian() *ResourcesBrought {
 	if x != nil {
-		return x.ResourcesBrought
+		return x.Median
 	}
 	return nil
 }
 
-// EventFilters is used to filter the events
-type EventFilters struct {
+func (x *ClassStats) GetMedianPoints() float32 {
+	if x != nil {
+		return x.MedianPoints
+	}
+	return 0
+}
+
+// ClassStanding is the place of the class on the leaderboard. Like in ClassAggr, the class is identified by its
+// letter and the date it was formed on
+type ClassStanding struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// include events occurred since this date
-	From *timestamp.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	// include events occurred up to this date
-	To *timestamp.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	// name of the event
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// resources permitted to be brought to this event
-	ResourcesAllowed []Resource `protobuf:"varint,4,rep,packed,name=resources_allowed,json=resourcesAllowed,proto3,enum=shanvl.garbage.events.v1.Resource" json:"resources_allowed,omitempty"`
+	Letter     string               `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	DateFormed *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date_formed,json=dateFormed,proto3" json:"date_formed,omitempty"`
+	Standing   *Standing            `protobuf:"bytes,3,opt,name=standing,proto3" json:"standing,omitempty"`
 }
 
-func (x *EventFilters) Reset() {
-	*x = EventFilters{}
+func (x *ClassStanding) Reset() {
+	*x = ClassStanding{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_proto_msgTypes[3]
+		mi := &file_events_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventFilters) String() string {
+func (x *ClassStanding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventFilters) ProtoMessage() {}
+func (*ClassStanding) ProtoMessage() {}
 
-func (x *EventFilters) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[3]
+func (x *ClassStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,72 +742,72 @@ func (x *EventFilters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventFilters.ProtoReflect.Descriptor instead.
-func (*EventFilters) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ClassStanding.ProtoReflect.Descriptor instead.
+func (*ClassStanding) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *EventFilters) GetFrom() *timestamp.Timestamp {
+func (x *ClassStanding) GetLetter() string {
 	if x != nil {
-		return x.From
+		return x.Letter
 	}
-	return nil
+	return ""
 }
 
-func (x *EventFilters) GetTo() *timestamp.Timestamp {
+func (x *ClassStanding) GetDateFormed() *timestamp.Timestamp {
 	if x != nil {
-		return x.To
+		return x.DateFormed
 	}
 	return nil
 }
 
-func (x *EventFilters) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *EventFilters) GetResourcesAllowed() []Resource {
+func (x *ClassStanding) GetStanding() *Standing {
 	if x != nil {
-		return x.ResourcesAllowed
+		return x.Standing
 	}
 	return nil
 }
 
-// Pupils bring recyclable materials (resources) to the events.
-// This message is used in the context of a single event
-type Pupil struct {
+// Event is a meeting of pupils who bring in recyclables
+// The goal of the event is to gather as many recyclable materials (resources) as possible
+type Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName string `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName  string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	// name of the class as it was on the date of the event
-	Class string `protobuf:"bytes,4,opt,name=class,proto3" json:"class,omitempty"`
-	// amount of the resources the pupil brought to the event
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// date on which the event will take place
+	Date *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Name string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// names of the resources allowed to be brought to this event
+	ResourcesAllowed []string `protobuf:"bytes,8,rep,name=resources_allowed,json=resourcesAllowed,proto3" json:"resources_allowed,omitempty"`
+	// amount of the resources gathered at the event
 	ResourcesBrought *ResourcesBrought `protobuf:"bytes,5,opt,name=resources_brought,json=resourcesBrought,proto3" json:"resources_brought,omitempty"`
+	// stage of the event's lifecycle
+	Status EventStatus `protobuf:"varint,6,opt,name=status,proto3,enum=shanvl.garbage.events.v1.EventStatus" json:"status,omitempty"`
+	// version of the event, incremented on every change. It's set by FindEventByID only
+	Version uint32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// the points scored at the event are multiplied by it. It's set by FindEventByID only
+	Multiplier float32 `protobuf:"fixed32,9,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
 }
 
-func (x *Pupil) Reset() {
-	*x = Pupil{}
+func (x *Event) Reset() {
+	*x = Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_proto_msgTypes[4]
+		mi := &file_events_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pupil) String() string {
+func (x *Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pupil) ProtoMessage() {}
+func (*Event) ProtoMessage() {}
 
-func (x *Pupil) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[4]
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,11 +818,194 @@ func (x *Pupil) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pupil.ProtoReflect.Descriptor instead.
-func (*Pupil) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{4}
-}
-
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event) GetResourcesAllowed() []string {
+	if x != nil {
+		return x.ResourcesAllowed
+	}
+	return nil
+}
+
+func (x *Event) GetResourcesBrought() *ResourcesBrought {
+	if x != nil {
+		return x.ResourcesBrought
+	}
+	return nil
+}
+
+func (x *Event) GetStatus() EventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EventStatus_EVENT_STATUS_UNKNOWN
+}
+
+func (x *Event) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Event) GetMultiplier() float32 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+// EventFilters is used to filter the events
+type EventFilters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// include events occurred since this date
+	From *timestamp.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// include events occurred up to this date
+	To *timestamp.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// name of the event
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// names of the resources permitted to be brought to this event
+	ResourcesAllowed []string `protobuf:"bytes,5,rep,name=resources_allowed,json=resourcesAllowed,proto3" json:"resources_allowed,omitempty"`
+}
+
+func (x *EventFilters) Reset() {
+	*x = EventFilters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventFilters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFilters) ProtoMessage() {}
+
+func (x *EventFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventFilters.ProtoReflect.Descriptor instead.
+func (*EventFilters) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventFilters) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *EventFilters) GetTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *EventFilters) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventFilters) GetResourcesAllowed() []string {
+	if x != nil {
+		return x.ResourcesAllowed
+	}
+	return nil
+}
+
+// Pupils bring recyclable materials (resources) to the events.
+// This message is used in the context of a single event
+type Pupil struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName string `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// name of the class as it was on the date of the event
+	Class string `protobuf:"bytes,4,opt,name=class,proto3" json:"class,omitempty"`
+	// amount of the resources the pupil brought to the event
+	ResourcesBrought *ResourcesBrought `protobuf:"bytes,5,opt,name=resources_brought,json=resourcesBrought,proto3" json:"resources_brought,omitempty"`
+	// version of the resources brought by the pupil to the event, incremented on every change.
+	// It's set by FindEventPupilByID only
+	ResourcesVersion uint32 `protobuf:"varint,6,opt,name=resources_version,json=resourcesVersion,proto3" json:"resources_version,omitempty"`
+}
+
+func (x *Pupil) Reset() {
+	*x = Pupil{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pupil) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pupil) ProtoMessage() {}
+
+func (x *Pupil) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pupil.ProtoReflect.Descriptor instead.
+func (*Pupil) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{7}
+}
+
 func (x *Pupil) GetId() string {
 	if x != nil {
 		return x.Id
@@ -641,6 +1041,13 @@ func (x *Pupil) GetResourcesBrought() *ResourcesBrought {
 	return nil
 }
 
+func (x *Pupil) GetResourcesVersion() uint32 {
+	if x != nil {
+		return x.ResourcesVersion
+	}
+	return 0
+}
+
 // PupilAggr is used in the context of many events the pupil has participated in.
 // Note, that the name of the class changes depending on the date of a particular event. Hence, we send its
 // letter and the date it was formed on instead of its name
@@ -658,12 +1065,14 @@ type PupilAggr struct {
 	ResourcesBrought *ResourcesBrought `protobuf:"bytes,6,opt,name=resources_brought,json=resourcesBrought,proto3" json:"resources_brought,omitempty"`
 	// array of events with their names and the amount of resources brought by the pupil
 	Events []*Event `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
+	// version of the pupil, incremented on every change of the pupil
+	Version uint32 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *PupilAggr) Reset() {
 	*x = PupilAggr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_proto_msgTypes[5]
+		mi := &file_events_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -676,7 +1085,7 @@ func (x *PupilAggr) String() string {
 func (*PupilAggr) ProtoMessage() {}
 
 func (x *PupilAggr) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[5]
+	mi := &file_events_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,7 +1098,7 @@ func (x *PupilAggr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PupilAggr.ProtoReflect.Descriptor instead.
 func (*PupilAggr) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{5}
+	return file_events_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PupilAggr) GetId() string {
@@ -741,6 +1150,270 @@ func (x *PupilAggr) GetEvents() []*Event {
 	return nil
 }
 
+func (x *PupilAggr) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// PupilStanding is the place of the pupil on the leaderboard
+type PupilStanding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName       string               `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName        string               `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	ClassLetter     string               `protobuf:"bytes,4,opt,name=class_letter,json=classLetter,proto3" json:"class_letter,omitempty"`
+	ClassDateFormed *timestamp.Timestamp `protobuf:"bytes,5,opt,name=class_date_formed,json=classDateFormed,proto3" json:"class_date_formed,omitempty"`
+	Standing        *Standing            `protobuf:"bytes,6,opt,name=standing,proto3" json:"standing,omitempty"`
+}
+
+func (x *PupilStanding) Reset() {
+	*x = PupilStanding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PupilStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PupilStanding) ProtoMessage() {}
+
+func (x *PupilStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PupilStanding.ProtoReflect.Descriptor instead.
+func (*PupilStanding) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PupilStanding) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PupilStanding) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *PupilStanding) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *PupilStanding) GetClassLetter() string {
+	if x != nil {
+		return x.ClassLetter
+	}
+	return ""
+}
+
+func (x *PupilStanding) GetClassDateFormed() *timestamp.Timestamp {
+	if x != nil {
+		return x.ClassDateFormed
+	}
+	return nil
+}
+
+func (x *PupilStanding) GetStanding() *Standing {
+	if x != nil {
+		return x.Standing
+	}
+	return nil
+}
+
+// ResourceType is an entry of the catalogue of the resources, i.e. the types of recyclables brought by the pupils to
+// the events
+type ResourceType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the resource, which consists of lowercase latin letters, digits and underscores
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// unit the amounts of the resource are measured in
+	Unit ResourceUnit `protobuf:"varint,2,opt,name=unit,proto3,enum=shanvl.garbage.events.v1.ResourceUnit" json:"unit,omitempty"`
+	// points given for a unit of the resource brought. 0 means the resource isn't scored
+	Points float32 `protobuf:"fixed32,3,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *ResourceType) Reset() {
+	*x = ResourceType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceType) ProtoMessage() {}
+
+func (x *ResourceType) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceType.ProtoReflect.Descriptor instead.
+func (*ResourceType) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ResourceType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceType) GetUnit() ResourceUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return ResourceUnit_RESOURCE_UNIT_UNKNOWN
+}
+
+func (x *ResourceType) GetPoints() float32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+// ResourceEntry is a single weigh-in of the resources brought by a pupil to an event
+type ResourceEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// signed amounts the weigh-in adds to the resources brought by the pupil
+	Resources *ResourcesBrought `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	// id of the user who has recorded the weigh-in
+	RecordedBy string               `protobuf:"bytes,3,opt,name=recorded_by,json=recordedBy,proto3" json:"recorded_by,omitempty"`
+	RecordedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	// id of the weigh-in corrected by this one, if any
+	CorrectionOf string `protobuf:"bytes,5,opt,name=correction_of,json=correctionOf,proto3" json:"correction_of,omitempty"`
+	// id of the user who has voided the weigh-in. The voided weigh-ins don't count towards the resources
+	VoidedBy string `protobuf:"bytes,6,opt,name=voided_by,json=voidedBy,proto3" json:"voided_by,omitempty"`
+	// empty unless the weigh-in has been voided
+	VoidedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=voided_at,json=voidedAt,proto3" json:"voided_at,omitempty"`
+}
+
+func (x *ResourceEntry) Reset() {
+	*x = ResourceEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceEntry) ProtoMessage() {}
+
+func (x *ResourceEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceEntry.ProtoReflect.Descriptor instead.
+func (*ResourceEntry) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResourceEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResourceEntry) GetResources() *ResourcesBrought {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ResourceEntry) GetRecordedBy() string {
+	if x != nil {
+		return x.RecordedBy
+	}
+	return ""
+}
+
+func (x *ResourceEntry) GetRecordedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+func (x *ResourceEntry) GetCorrectionOf() string {
+	if x != nil {
+		return x.CorrectionOf
+	}
+	return ""
+}
+
+func (x *ResourceEntry) GetVoidedBy() string {
+	if x != nil {
+		return x.VoidedBy
+	}
+	return ""
+}
+
+func (x *ResourceEntry) GetVoidedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.VoidedAt
+	}
+	return nil
+}
+
 // ResourceBrought message shows how many resources a pupil/class has brought to an event or how many resources were
 // collected on an event
 type ResourcesBrought struct {
@@ -748,15 +1421,18 @@ type ResourcesBrought struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Gadgets float32 `protobuf:"fixed32,1,opt,name=gadgets,proto3" json:"gadgets,omitempty"`
-	Paper   float32 `protobuf:"fixed32,2,opt,name=paper,proto3" json:"paper,omitempty"`
-	Plastic float32 `protobuf:"fixed32,3,opt,name=plastic,proto3" json:"plastic,omitempty"`
+	// amounts of the resources keyed by their names, each in the unit of its type unless it's given in units. The
+	// amounts of the resources measured in pieces must be whole
+	Amounts map[string]float32 `protobuf:"bytes,4,rep,name=amounts,proto3" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	// units of the amounts keyed by the names of the resources. In the requests, it's needed only for the amounts
+	// given in grams or pounds. In the aggregated reports, it holds the units of the types of all the resources
+	Units map[string]ResourceUnit `protobuf:"bytes,5,rep,name=units,proto3" json:"units,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=shanvl.garbage.events.v1.ResourceUnit"`
 }
 
 func (x *ResourcesBrought) Reset() {
 	*x = ResourcesBrought{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_proto_msgTypes[6]
+		mi := &file_events_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -769,7 +1445,7 @@ func (x *ResourcesBrought) String() string {
 func (*ResourcesBrought) ProtoMessage() {}
 
 func (x *ResourcesBrought) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[6]
+	mi := &file_events_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,26 +1458,97 @@ func (x *ResourcesBrought) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourcesBrought.ProtoReflect.Descriptor instead.
 func (*ResourcesBrought) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{6}
+	return file_events_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ResourcesBrought) GetGadgets() float32 {
+func (x *ResourcesBrought) GetAmounts() map[string]float32 {
 	if x != nil {
-		return x.Gadgets
+		return x.Amounts
+	}
+	return nil
+}
+
+func (x *ResourcesBrought) GetUnits() map[string]ResourceUnit {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+// Standing is the place on the leaderboard made of the points scored at the events of a period. A resource brought
+// scores the points of its type per unit, multiplied by the multiplier of the event
+type Standing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sum of the points scored in the period
+	Score float32 `protobuf:"fixed32,1,opt,name=score,proto3" json:"score,omitempty"`
+	// place by the score, starting from 1. The equal scores share the rank and the next rank skips the shared places,
+	// e.g. 1, 2, 2, 4
+	Rank uint32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// rank in the previous period of the same length. 0 if nothing was scored in that period
+	PreviousRank uint32 `protobuf:"varint,3,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
+	// places gained since the previous period, negative if the places were lost. 0 if there's no previous rank
+	RankDelta int32 `protobuf:"varint,4,opt,name=rank_delta,json=rankDelta,proto3" json:"rank_delta,omitempty"`
+}
+
+func (x *Standing) Reset() {
+	*x = Standing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Standing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Standing) ProtoMessage() {}
+
+func (x *Standing) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Standing.ProtoReflect.Descriptor instead.
+func (*Standing) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Standing) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Standing) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
 	}
 	return 0
 }
 
-func (x *ResourcesBrought) GetPaper() float32 {
+func (x *Standing) GetPreviousRank() uint32 {
 	if x != nil {
-		return x.Paper
+		return x.PreviousRank
 	}
 	return 0
 }
 
-func (x *ResourcesBrought) GetPlastic() float32 {
+func (x *Standing) GetRankDelta() int32 {
 	if x != nil {
-		return x.Plastic
+		return x.RankDelta
 	}
 	return 0
 }
@@ -813,144 +1560,316 @@ var file_events_proto_rawDesc = []byte{
 	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
 	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x74, 0x0a, 0x05, 0x43, 0x6c, 0x61,
-	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x5f, 0x62, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x52, 0x10, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x22,
-	0xf2, 0x01, 0x0a, 0x09, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x41, 0x67, 0x67, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
-	0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x6f,
-	0x72, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf6, 0x01, 0x0a, 0x0a, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d,
-	0x65, 0x64, 0x12, 0x57, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f,
-	0x62, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
-	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x68,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x57, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x62, 0x72,
+	0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x68,
 	0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x22, 0x2e,
-	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x5f, 0x62, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
-	0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x22, 0xcf, 0x01, 0x0a,
-	0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a,
-	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a,
-	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4f, 0x0a,
-	0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76,
 	0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x10, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0xc2,
-	0x01, 0x0a, 0x05, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69,
-	0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x57, 0x0a, 0x11, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x62, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67,
-	0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68,
-	0x74, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75,
-	0x67, 0x68, 0x74, 0x22, 0xd4, 0x02, 0x0a, 0x09, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x41, 0x67, 0x67,
-	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
-	0x12, 0x46, 0x0a, 0x11, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66,
-	0x6f, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x09, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x41,
+	0x67, 0x67, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x61,
 	0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x62, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x62, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72,
 	0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
 	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x52,
 	0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68,
-	0x74, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x74, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61,
 	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x5c, 0x0a, 0x10, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x67, 0x61, 0x64, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x07, 0x67, 0x61, 0x64, 0x67, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x70, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x61, 0x70, 0x65, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x07, 0x70, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x2a, 0x60, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
-	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45,
-	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x41, 0x44, 0x47, 0x45, 0x54, 0x53, 0x10, 0x01,
-	0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x50, 0x41, 0x50,
-	0x45, 0x52, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
-	0x5f, 0x50, 0x4c, 0x41, 0x53, 0x54, 0x49, 0x43, 0x10, 0x03, 0x2a, 0xb1, 0x01, 0x0a, 0x0c, 0x50,
-	0x75, 0x70, 0x69, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x15, 0x50,
-	0x55, 0x50, 0x49, 0x4c, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x55, 0x50, 0x49, 0x4c, 0x5f,
-	0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x47, 0x41, 0x44, 0x47, 0x45, 0x54, 0x53, 0x10,
-	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x55, 0x50, 0x49, 0x4c, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49,
-	0x4e, 0x47, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x02, 0x12, 0x1b, 0x0a,
-	0x17, 0x50, 0x55, 0x50, 0x49, 0x4c, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4e,
-	0x41, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x55,
+	0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xa6, 0x02, 0x0a, 0x0a, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x44, 0x0a, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x52, 0x07, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e,
+	0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67,
+	0x68, 0x74, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
+	0xa4, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76,
+	0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xe0, 0x02, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x12, 0x57, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x62,
+	0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73,
+	0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x73, 0x68, 0x61,
+	0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0xb1, 0x01, 0x0a, 0x0c, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0xef, 0x01,
+	0x0a, 0x05, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x57, 0x0a, 0x11, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x62, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74,
+	0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67,
+	0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xee, 0x02, 0x0a, 0x09, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x11,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x61, 0x74, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x5f, 0x62, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x52, 0x10, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x86, 0x02, 0x0a, 0x0d, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x12, 0x46, 0x0a, 0x11, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x44,
+	0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x08, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x68,
+	0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x76, 0x0a, 0x0c, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a,
+	0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68,
+	0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55,
+	0x6e, 0x69, 0x74, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x22, 0xc2, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x48, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e,
+	0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67,
+	0x68, 0x74, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3b,
+	0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x66,
+	0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x37, 0x0a,
+	0x09, 0x76, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x76, 0x6f,
+	0x69, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22, 0xef, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x73,
+	0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x4b,
+	0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x60, 0x0a, 0x0a, 0x55, 0x6e, 0x69, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e,
+	0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x04, 0x52,
+	0x07, 0x67, 0x61, 0x64, 0x67, 0x65, 0x74, 0x73, 0x52, 0x05, 0x70, 0x61, 0x70, 0x65, 0x72, 0x52,
+	0x07, 0x70, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x22, 0x78, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52,
+	0x61, 0x6e, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x2a, 0x8c, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x4c, 0x41,
+	0x4e, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x17, 0x0a,
+	0x13, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4c,
+	0x4f, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10,
+	0x04, 0x2a, 0x93, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55,
+	0x4e, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1b, 0x0a,
+	0x17, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x4b,
+	0x49, 0x4c, 0x4f, 0x47, 0x52, 0x41, 0x4d, 0x53, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x50, 0x49, 0x45, 0x43,
+	0x45, 0x53, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x47, 0x52, 0x41, 0x4d, 0x53, 0x10, 0x03, 0x12, 0x18, 0x0a,
+	0x14, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x50,
+	0x4f, 0x55, 0x4e, 0x44, 0x53, 0x10, 0x04, 0x2a, 0x8e, 0x02, 0x0a, 0x0c, 0x50, 0x75, 0x70, 0x69,
+	0x6c, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x55, 0x50, 0x49,
+	0x4c, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x55, 0x50, 0x49, 0x4c, 0x5f, 0x53, 0x4f, 0x52,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x02, 0x12,
+	0x1b, 0x0a, 0x17, 0x50, 0x55, 0x50, 0x49, 0x4c, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16,
+	0x50, 0x55, 0x50, 0x49, 0x4c, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x55, 0x50, 0x49,
+	0x4c, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f,
+	0x41, 0x53, 0x43, 0x10, 0x07, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x55, 0x50, 0x49, 0x4c, 0x5f, 0x53,
+	0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x53,
+	0x43, 0x10, 0x08, 0x22, 0x04, 0x08, 0x01, 0x10, 0x01, 0x22, 0x04, 0x08, 0x04, 0x10, 0x04, 0x22,
+	0x04, 0x08, 0x05, 0x10, 0x05, 0x2a, 0x15, 0x50, 0x55, 0x50, 0x49, 0x4c, 0x5f, 0x53, 0x4f, 0x52,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x47, 0x41, 0x44, 0x47, 0x45, 0x54, 0x53, 0x2a, 0x13, 0x50, 0x55,
 	0x50, 0x49, 0x4c, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x50, 0x45,
-	0x52, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x55, 0x50, 0x49, 0x4c, 0x5f, 0x53, 0x4f, 0x52,
-	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4c, 0x41, 0x53, 0x54, 0x49, 0x43, 0x10, 0x05, 0x2a, 0xb1,
-	0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x19, 0x0a, 0x15, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47,
-	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4c,
-	0x41, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x47, 0x41, 0x44, 0x47,
-	0x45, 0x54, 0x53, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53,
-	0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10,
-	0x02, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49,
-	0x4e, 0x47, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x03, 0x12, 0x17,
-	0x0a, 0x13, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f,
-	0x50, 0x41, 0x50, 0x45, 0x52, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4c, 0x41, 0x53, 0x53,
-	0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4c, 0x41, 0x53, 0x54, 0x49, 0x43,
-	0x10, 0x05, 0x2a, 0xea, 0x01, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74,
-	0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52,
-	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a,
-	0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f,
-	0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x41, 0x54, 0x45,
-	0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x47, 0x41, 0x44, 0x47, 0x45, 0x54, 0x53,
-	0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54,
-	0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x04, 0x12, 0x1b,
+	0x52, 0x2a, 0x15, 0x50, 0x55, 0x50, 0x49, 0x4c, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x50, 0x4c, 0x41, 0x53, 0x54, 0x49, 0x43, 0x2a, 0xd9, 0x03, 0x0a, 0x0c, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4c, 0x41,
+	0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x4f,
+	0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x02,
+	0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x03, 0x12, 0x1a, 0x0a,
+	0x16, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52,
+	0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x41,
+	0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c,
+	0x5f, 0x41, 0x53, 0x43, 0x10, 0x07, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f,
+	0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x44, 0x45,
+	0x53, 0x43, 0x10, 0x08, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x4f,
+	0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x50, 0x55, 0x50, 0x49, 0x4c, 0x5f,
+	0x41, 0x53, 0x43, 0x10, 0x09, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53,
+	0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x50, 0x55, 0x50, 0x49, 0x4c,
+	0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x0a, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4c, 0x41, 0x53, 0x53,
+	0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49,
+	0x50, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x0b, 0x12, 0x24, 0x0a, 0x20,
+	0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x41,
+	0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x53, 0x43,
+	0x10, 0x0c, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54,
+	0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x0d,
+	0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x0e, 0x22,
+	0x04, 0x08, 0x01, 0x10, 0x01, 0x22, 0x04, 0x08, 0x04, 0x10, 0x04, 0x22, 0x04, 0x08, 0x05, 0x10,
+	0x05, 0x2a, 0x15, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x47, 0x41, 0x44, 0x47, 0x45, 0x54, 0x53, 0x2a, 0x13, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f,
+	0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x50, 0x45, 0x52, 0x2a, 0x15, 0x43,
+	0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4c, 0x41,
+	0x53, 0x54, 0x49, 0x43, 0x2a, 0xc7, 0x02, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x41,
+	0x54, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x41, 0x53, 0x43, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43,
+	0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54,
+	0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x08, 0x12, 0x1b,
 	0x0a, 0x17, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f,
-	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x50,
-	0x45, 0x52, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f,
-	0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4c, 0x41, 0x53, 0x54, 0x49, 0x43, 0x10, 0x07, 0x42,
-	0x0e, 0x5a, 0x0c, 0x2e, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x76, 0x31, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x09, 0x12, 0x1c, 0x0a, 0x18, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x54,
+	0x41, 0x4c, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x0a, 0x22, 0x04, 0x08, 0x03, 0x10, 0x03, 0x22,
+	0x04, 0x08, 0x06, 0x10, 0x06, 0x22, 0x04, 0x08, 0x07, 0x10, 0x07, 0x2a, 0x15, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x47, 0x41, 0x44, 0x47, 0x45,
+	0x54, 0x53, 0x2a, 0x13, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x50, 0x41, 0x50, 0x45, 0x52, 0x2a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4c, 0x41, 0x53, 0x54, 0x49, 0x43, 0x42, 0x0e,
+	0x5a, 0x0c, 0x2e, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x76, 0x31, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -965,42 +1884,67 @@ func file_events_proto_rawDescGZIP() []byte {
 	return file_events_proto_rawDescData
 }
 
-var file_events_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_events_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_events_proto_goTypes = []interface{}{
-	(Resource)(0),               // 0: shanvl.garbage.events.v1.Resource
-	(PupilSorting)(0),           // 1: shanvl.garbage.events.v1.PupilSorting
-	(ClassSorting)(0),           // 2: shanvl.garbage.events.v1.ClassSorting
-	(EventSorting)(0),           // 3: shanvl.garbage.events.v1.EventSorting
-	(*Class)(nil),               // 4: shanvl.garbage.events.v1.Class
-	(*ClassAggr)(nil),           // 5: shanvl.garbage.events.v1.ClassAggr
-	(*Event)(nil),               // 6: shanvl.garbage.events.v1.Event
-	(*EventFilters)(nil),        // 7: shanvl.garbage.events.v1.EventFilters
-	(*Pupil)(nil),               // 8: shanvl.garbage.events.v1.Pupil
-	(*PupilAggr)(nil),           // 9: shanvl.garbage.events.v1.PupilAggr
-	(*ResourcesBrought)(nil),    // 10: shanvl.garbage.events.v1.ResourcesBrought
-	(*timestamp.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(EventStatus)(0),            // 0: shanvl.garbage.events.v1.EventStatus
+	(ResourceUnit)(0),           // 1: shanvl.garbage.events.v1.ResourceUnit
+	(PupilSorting)(0),           // 2: shanvl.garbage.events.v1.PupilSorting
+	(ClassSorting)(0),           // 3: shanvl.garbage.events.v1.ClassSorting
+	(EventSorting)(0),           // 4: shanvl.garbage.events.v1.EventSorting
+	(*AuditEntry)(nil),          // 5: shanvl.garbage.events.v1.AuditEntry
+	(*Class)(nil),               // 6: shanvl.garbage.events.v1.Class
+	(*ClassAggr)(nil),           // 7: shanvl.garbage.events.v1.ClassAggr
+	(*ClassStats)(nil),          // 8: shanvl.garbage.events.v1.ClassStats
+	(*ClassStanding)(nil),       // 9: shanvl.garbage.events.v1.ClassStanding
+	(*Event)(nil),               // 10: shanvl.garbage.events.v1.Event
+	(*EventFilters)(nil),        // 11: shanvl.garbage.events.v1.EventFilters
+	(*Pupil)(nil),               // 12: shanvl.garbage.events.v1.Pupil
+	(*PupilAggr)(nil),           // 13: shanvl.garbage.events.v1.PupilAggr
+	(*PupilStanding)(nil),       // 14: shanvl.garbage.events.v1.PupilStanding
+	(*ResourceType)(nil),        // 15: shanvl.garbage.events.v1.ResourceType
+	(*ResourceEntry)(nil),       // 16: shanvl.garbage.events.v1.ResourceEntry
+	(*ResourcesBrought)(nil),    // 17: shanvl.garbage.events.v1.ResourcesBrought
+	(*Standing)(nil),            // 18: shanvl.garbage.events.v1.Standing
+	nil,                         // 19: shanvl.garbage.events.v1.ResourcesBrought.AmountsEntry
+	nil,                         // 20: shanvl.garbage.events.v1.ResourcesBrought.UnitsEntry
+	(*timestamp.Timestamp)(nil), // 21: google.protobuf.Timestamp
 }
 var file_events_proto_depIdxs = []int32{
-	10, // 0: shanvl.garbage.events.v1.Class.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
-	11, // 1: shanvl.garbage.events.v1.ClassAggr.date_formed:type_name -> google.protobuf.Timestamp
-	10, // 2: shanvl.garbage.events.v1.ClassAggr.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
-	6,  // 3: shanvl.garbage.events.v1.ClassAggr.events:type_name -> shanvl.garbage.events.v1.Event
-	11, // 4: shanvl.garbage.events.v1.Event.date:type_name -> google.protobuf.Timestamp
-	0,  // 5: shanvl.garbage.events.v1.Event.resources_allowed:type_name -> shanvl.garbage.events.v1.Resource
-	10, // 6: shanvl.garbage.events.v1.Event.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
-	11, // 7: shanvl.garbage.events.v1.EventFilters.from:type_name -> google.protobuf.Timestamp
-	11, // 8: shanvl.garbage.events.v1.EventFilters.to:type_name -> google.protobuf.Timestamp
-	0,  // 9: shanvl.garbage.events.v1.EventFilters.resources_allowed:type_name -> shanvl.garbage.events.v1.Resource
-	10, // 10: shanvl.garbage.events.v1.Pupil.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
-	11, // 11: shanvl.garbage.events.v1.PupilAggr.class_date_formed:type_name -> google.protobuf.Timestamp
-	10, // 12: shanvl.garbage.events.v1.PupilAggr.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
-	6,  // 13: shanvl.garbage.events.v1.PupilAggr.events:type_name -> shanvl.garbage.events.v1.Event
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	21, // 0: shanvl.garbage.events.v1.AuditEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	17, // 1: shanvl.garbage.events.v1.Class.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
+	8,  // 2: shanvl.garbage.events.v1.Class.stats:type_name -> shanvl.garbage.events.v1.ClassStats
+	21, // 3: shanvl.garbage.events.v1.ClassAggr.date_formed:type_name -> google.protobuf.Timestamp
+	17, // 4: shanvl.garbage.events.v1.ClassAggr.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
+	10, // 5: shanvl.garbage.events.v1.ClassAggr.events:type_name -> shanvl.garbage.events.v1.Event
+	8,  // 6: shanvl.garbage.events.v1.ClassAggr.stats:type_name -> shanvl.garbage.events.v1.ClassStats
+	17, // 7: shanvl.garbage.events.v1.ClassStats.average:type_name -> shanvl.garbage.events.v1.ResourcesBrought
+	17, // 8: shanvl.garbage.events.v1.ClassStats.median:type_name -> shanvl.garbage.events.v1.ResourcesBrought
+	21, // 9: shanvl.garbage.events.v1.ClassStanding.date_formed:type_name -> google.protobuf.Timestamp
+	18, // 10: shanvl.garbage.events.v1.ClassStanding.standing:type_name -> shanvl.garbage.events.v1.Standing
+	21, // 11: shanvl.garbage.events.v1.Event.date:type_name -> google.protobuf.Timestamp
+	17, // 12: shanvl.garbage.events.v1.Event.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
+	0,  // 13: shanvl.garbage.events.v1.Event.status:type_name -> shanvl.garbage.events.v1.EventStatus
+	21, // 14: shanvl.garbage.events.v1.EventFilters.from:type_name -> google.protobuf.Timestamp
+	21, // 15: shanvl.garbage.events.v1.EventFilters.to:type_name -> google.protobuf.Timestamp
+	17, // 16: shanvl.garbage.events.v1.Pupil.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
+	21, // 17: shanvl.garbage.events.v1.PupilAggr.class_date_formed:type_name -> google.protobuf.Timestamp
+	17, // 18: shanvl.garbage.events.v1.PupilAggr.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
+	10, // 19: shanvl.garbage.events.v1.PupilAggr.events:type_name -> shanvl.garbage.events.v1.Event
+	21, // 20: shanvl.garbage.events.v1.PupilStanding.class_date_formed:type_name -> google.protobuf.Timestamp
+	18, // 21: shanvl.garbage.events.v1.PupilStanding.standing:type_name -> shanvl.garbage.events.v1.Standing
+	1,  // 22: shanvl.garbage.events.v1.ResourceType.unit:type_name -> shanvl.garbage.events.v1.ResourceUnit
+	17, // 23: shanvl.garbage.events.v1.ResourceEntry.resources:type_name -> shanvl.garbage.events.v1.ResourcesBrought
+	21, // 24: shanvl.garbage.events.v1.ResourceEntry.recorded_at:type_name -> google.protobuf.Timestamp
+	21, // 25: shanvl.garbage.events.v1.ResourceEntry.voided_at:type_name -> google.protobuf.Timestamp
+	19, // 26: shanvl.garbage.events.v1.ResourcesBrought.amounts:type_name -> shanvl.garbage.events.v1.ResourcesBrought.AmountsEntry
+	20, // 27: shanvl.garbage.events.v1.ResourcesBrought.units:type_name -> shanvl.garbage.events.v1.ResourcesBrought.UnitsEntry
+	1,  // 28: shanvl.garbage.events.v1.ResourcesBrought.UnitsEntry.value:type_name -> shanvl.garbage.events.v1.ResourceUnit
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_events_proto_init() }
@@ -1010,7 +1954,7 @@ func file_events_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Class); i {
+			switch v := v.(*AuditEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1022,7 +1966,7 @@ func file_events_proto_init() {
 			}
 		}
 		file_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClassAggr); i {
+			switch v := v.(*Class); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1034,7 +1978,7 @@ func file_events_proto_init() {
 			}
 		}
 		file_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+			switch v := v.(*ClassAggr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1046,7 +1990,7 @@ func file_events_proto_init() {
 			}
 		}
 		file_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventFilters); i {
+			switch v := v.(*ClassStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1058,7 +2002,7 @@ func file_events_proto_init() {
 			}
 		}
 		file_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pupil); i {
+			switch v := v.(*ClassStanding); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1070,7 +2014,7 @@ func file_events_proto_init() {
 			}
 		}
 		file_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PupilAggr); i {
+			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1082,6 +2026,78 @@ func file_events_proto_init() {
 			}
 		}
 		file_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventFilters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pupil); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PupilAggr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PupilStanding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResourcesBrought); i {
 			case 0:
 				return &v.state
@@ -1093,14 +2109,26 @@ func file_events_proto_init() {
 				return nil
 			}
 		}
+		file_events_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Standing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_events_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   7,
+			NumEnums:      5,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
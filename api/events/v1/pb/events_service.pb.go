@@ -33,6 +33,70 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type AddPupilResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PupilId string `protobuf:"bytes,2,opt,name=pupil_id,json=pupilId,proto3" json:"pupil_id,omitempty"`
+	// signed amounts added to the resources brought by the pupil to the event
+	ResourcesBrought *ResourcesBrought `protobuf:"bytes,3,opt,name=resources_brought,json=resourcesBrought,proto3" json:"resources_brought,omitempty"`
+}
+
+func (x *AddPupilResourcesRequest) Reset() {
+	*x = AddPupilResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPupilResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPupilResourcesRequest) ProtoMessage() {}
+
+func (x *AddPupilResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPupilResourcesRequest.ProtoReflect.Descriptor instead.
+func (*AddPupilResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddPupilResourcesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AddPupilResourcesRequest) GetPupilId() string {
+	if x != nil {
+		return x.PupilId
+	}
+	return ""
+}
+
+func (x *AddPupilResourcesRequest) GetResourcesBrought() *ResourcesBrought {
+	if x != nil {
+		return x.ResourcesBrought
+	}
+	return nil
+}
+
 type AddPupilsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -45,7 +109,7 @@ type AddPupilsRequest struct {
 func (x *AddPupilsRequest) Reset() {
 	*x = AddPupilsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[0]
+		mi := &file_events_service_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -58,7 +122,7 @@ func (x *AddPupilsRequest) String() string {
 func (*AddPupilsRequest) ProtoMessage() {}
 
 func (x *AddPupilsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[0]
+	mi := &file_events_service_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -71,7 +135,7 @@ func (x *AddPupilsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPupilsRequest.ProtoReflect.Descriptor instead.
 func (*AddPupilsRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{0}
+	return file_events_service_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *AddPupilsRequest) GetPupils() []*AddPupilsRequest_Pupil {
@@ -93,7 +157,7 @@ type AddPupilsResponse struct {
 func (x *AddPupilsResponse) Reset() {
 	*x = AddPupilsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[1]
+		mi := &file_events_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -106,7 +170,7 @@ func (x *AddPupilsResponse) String() string {
 func (*AddPupilsResponse) ProtoMessage() {}
 
 func (x *AddPupilsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[1]
+	mi := &file_events_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -119,7 +183,7 @@ func (x *AddPupilsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPupilsResponse.ProtoReflect.Descriptor instead.
 func (*AddPupilsResponse) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{1}
+	return file_events_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *AddPupilsResponse) GetPupilIds() []string {
@@ -129,6 +193,53 @@ func (x *AddPupilsResponse) GetPupilIds() []string {
 	return nil
 }
 
+type ArchiveEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ArchiveEventRequest) Reset() {
+	*x = ArchiveEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveEventRequest) ProtoMessage() {}
+
+func (x *ArchiveEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveEventRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveEventRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ArchiveEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 type ChangePupilClassRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -137,12 +248,14 @@ type ChangePupilClassRequest struct {
 	PupilId string `protobuf:"bytes,1,opt,name=pupil_id,json=pupilId,proto3" json:"pupil_id,omitempty"`
 	// class name as it is now (class name changes depending on the date)
 	Class string `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
+	// if set, the class is changed only if the pupil is still of this version. The If-Match header can be used instead
+	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *ChangePupilClassRequest) Reset() {
 	*x = ChangePupilClassRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[2]
+		mi := &file_events_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -155,7 +268,7 @@ func (x *ChangePupilClassRequest) String() string {
 func (*ChangePupilClassRequest) ProtoMessage() {}
 
 func (x *ChangePupilClassRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[2]
+	mi := &file_events_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -168,7 +281,7 @@ func (x *ChangePupilClassRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePupilClassRequest.ProtoReflect.Descriptor instead.
 func (*ChangePupilClassRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{2}
+	return file_events_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ChangePupilClassRequest) GetPupilId() string {
@@ -185,6 +298,13 @@ func (x *ChangePupilClassRequest) GetClass() string {
 	return ""
 }
 
+func (x *ChangePupilClassRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type ChangePupilResourcesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -194,12 +314,15 @@ type ChangePupilResourcesRequest struct {
 	PupilId string `protobuf:"bytes,2,opt,name=pupil_id,json=pupilId,proto3" json:"pupil_id,omitempty"`
 	// amount of resources brought by the pupil to the event
 	ResourcesBrought *ResourcesBrought `protobuf:"bytes,3,opt,name=resources_brought,json=resourcesBrought,proto3" json:"resources_brought,omitempty"`
+	// if set, the resources are changed only if they are still of this version. The If-Match header can be used
+	// instead
+	ResourcesVersion uint32 `protobuf:"varint,4,opt,name=resources_version,json=resourcesVersion,proto3" json:"resources_version,omitempty"`
 }
 
 func (x *ChangePupilResourcesRequest) Reset() {
 	*x = ChangePupilResourcesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[3]
+		mi := &file_events_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -212,7 +335,7 @@ func (x *ChangePupilResourcesRequest) String() string {
 func (*ChangePupilResourcesRequest) ProtoMessage() {}
 
 func (x *ChangePupilResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[3]
+	mi := &file_events_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -225,7 +348,7 @@ func (x *ChangePupilResourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePupilResourcesRequest.ProtoReflect.Descriptor instead.
 func (*ChangePupilResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{3}
+	return file_events_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ChangePupilResourcesRequest) GetEventId() string {
@@ -249,35 +372,38 @@ func (x *ChangePupilResourcesRequest) GetResourcesBrought() *ResourcesBrought {
 	return nil
 }
 
-type CreateEventRequest struct {
+func (x *ChangePupilResourcesRequest) GetResourcesVersion() uint32 {
+	if x != nil {
+		return x.ResourcesVersion
+	}
+	return 0
+}
+
+type CloseEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// event date
-	Date *timestamp.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Name string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// resources permitted on the event
-	ResourcesAllowed []Resource `protobuf:"varint,3,rep,packed,name=resources_allowed,json=resourcesAllowed,proto3,enum=shanvl.garbage.events.v1.Resource" json:"resources_allowed,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreateEventRequest) Reset() {
-	*x = CreateEventRequest{}
+func (x *CloseEventRequest) Reset() {
+	*x = CloseEventRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[4]
+		mi := &file_events_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateEventRequest) String() string {
+func (x *CloseEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEventRequest) ProtoMessage() {}
+func (*CloseEventRequest) ProtoMessage() {}
 
-func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[4]
+func (x *CloseEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,57 +414,48 @@ func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
-func (*CreateEventRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *CreateEventRequest) GetDate() *timestamp.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
+// Deprecated: Use CloseEventRequest.ProtoReflect.Descriptor instead.
+func (*CloseEventRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateEventRequest) GetName() string {
+func (x *CloseEventRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateEventRequest) GetResourcesAllowed() []Resource {
-	if x != nil {
-		return x.ResourcesAllowed
-	}
-	return nil
-}
-
-type CreateEventResponse struct {
+type CorrectResourceEntryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PupilId string `protobuf:"bytes,2,opt,name=pupil_id,json=pupilId,proto3" json:"pupil_id,omitempty"`
+	// id of the corrected entry
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// corrected signed amounts of the weigh-in
+	ResourcesBrought *ResourcesBrought `protobuf:"bytes,4,opt,name=resources_brought,json=resourcesBrought,proto3" json:"resources_brought,omitempty"`
 }
 
-func (x *CreateEventResponse) Reset() {
-	*x = CreateEventResponse{}
+func (x *CorrectResourceEntryRequest) Reset() {
+	*x = CorrectResourceEntryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[5]
+		mi := &file_events_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateEventResponse) String() string {
+func (x *CorrectResourceEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEventResponse) ProtoMessage() {}
+func (*CorrectResourceEntryRequest) ProtoMessage() {}
 
-func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[5]
+func (x *CorrectResourceEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,43 +466,65 @@ func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventResponse.ProtoReflect.Descriptor instead.
-func (*CreateEventResponse) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CorrectResourceEntryRequest.ProtoReflect.Descriptor instead.
+func (*CorrectResourceEntryRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateEventResponse) GetId() string {
+func (x *CorrectResourceEntryRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CorrectResourceEntryRequest) GetPupilId() string {
+	if x != nil {
+		return x.PupilId
+	}
+	return ""
+}
+
+func (x *CorrectResourceEntryRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteEventRequest struct {
+func (x *CorrectResourceEntryRequest) GetResourcesBrought() *ResourcesBrought {
+	if x != nil {
+		return x.ResourcesBrought
+	}
+	return nil
+}
+
+type CorrectResourceEntryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// id of the entry recorded instead of the corrected one
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteEventRequest) Reset() {
-	*x = DeleteEventRequest{}
+func (x *CorrectResourceEntryResponse) Reset() {
+	*x = CorrectResourceEntryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[6]
+		mi := &file_events_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteEventRequest) String() string {
+func (x *CorrectResourceEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEventRequest) ProtoMessage() {}
+func (*CorrectResourceEntryResponse) ProtoMessage() {}
 
-func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[6]
+func (x *CorrectResourceEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,53 +535,47 @@ func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CorrectResourceEntryResponse.ProtoReflect.Descriptor instead.
+func (*CorrectResourceEntryResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteEventRequest) GetId() string {
+func (x *CorrectResourceEntryResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// Note, that we can't use the class name here because it changes depending on the event's date. So the class' letter
-// the date it was formed on used instead
-type FindClassesRequest struct {
+type CreateEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// letter of the class
-	Letter string `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
-	// date the class was formed on
-	DateFormed   *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date_formed,json=dateFormed,proto3" json:"date_formed,omitempty"`
-	EventFilters *EventFilters        `protobuf:"bytes,3,opt,name=event_filters,json=eventFilters,proto3" json:"event_filters,omitempty"`
-	Sorting      ClassSorting         `protobuf:"varint,4,opt,name=sorting,proto3,enum=shanvl.garbage.events.v1.ClassSorting" json:"sorting,omitempty"`
-	EventSorting EventSorting         `protobuf:"varint,5,opt,name=event_sorting,json=eventSorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"event_sorting,omitempty"`
-	Amount       uint32               `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	Skip         uint32               `protobuf:"varint,7,opt,name=skip,proto3" json:"skip,omitempty"`
+	// event date
+	Date *timestamp.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Name string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// names of the resources permitted on the event
+	ResourcesAllowed []string `protobuf:"bytes,4,rep,name=resources_allowed,json=resourcesAllowed,proto3" json:"resources_allowed,omitempty"`
 }
 
-func (x *FindClassesRequest) Reset() {
-	*x = FindClassesRequest{}
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[7]
+		mi := &file_events_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindClassesRequest) String() string {
+func (x *CreateEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindClassesRequest) ProtoMessage() {}
+func (*CreateEventRequest) ProtoMessage() {}
 
-func (x *FindClassesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[7]
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,89 +586,104 @@ func (x *FindClassesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindClassesRequest.ProtoReflect.Descriptor instead.
-func (*FindClassesRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *FindClassesRequest) GetLetter() string {
+func (x *CreateEventRequest) GetDate() *timestamp.Timestamp {
 	if x != nil {
-		return x.Letter
+		return x.Date
 	}
-	return ""
+	return nil
 }
 
-func (x *FindClassesRequest) GetDateFormed() *timestamp.Timestamp {
+func (x *CreateEventRequest) GetName() string {
 	if x != nil {
-		return x.DateFormed
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *FindClassesRequest) GetEventFilters() *EventFilters {
+func (x *CreateEventRequest) GetResourcesAllowed() []string {
 	if x != nil {
-		return x.EventFilters
+		return x.ResourcesAllowed
 	}
 	return nil
 }
 
-func (x *FindClassesRequest) GetSorting() ClassSorting {
-	if x != nil {
-		return x.Sorting
-	}
-	return ClassSorting_CLASS_SORTING_UNKNOWN
+type CreateEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *FindClassesRequest) GetEventSorting() EventSorting {
-	if x != nil {
-		return x.EventSorting
+func (x *CreateEventResponse) Reset() {
+	*x = CreateEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return EventSorting_EVENT_SORTING_UNKNOWN
 }
 
-func (x *FindClassesRequest) GetAmount() uint32 {
-	if x != nil {
-		return x.Amount
+func (x *CreateEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventResponse) ProtoMessage() {}
+
+func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *FindClassesRequest) GetSkip() uint32 {
+// Deprecated: Use CreateEventResponse.ProtoReflect.Descriptor instead.
+func (*CreateEventResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateEventResponse) GetId() string {
 	if x != nil {
-		return x.Skip
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type FindClassesResponse struct {
+type CreateResourceTypeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// list of classes with aggregated info about the resources a class has brought to every event that
-	// passed the filters and a list of those events for each class
-	Classes []*ClassAggr `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
-	// total classes found
-	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	ResourceType *ResourceType `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
 }
 
-func (x *FindClassesResponse) Reset() {
-	*x = FindClassesResponse{}
+func (x *CreateResourceTypeRequest) Reset() {
+	*x = CreateResourceTypeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[8]
+		mi := &file_events_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindClassesResponse) String() string {
+func (x *CreateResourceTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindClassesResponse) ProtoMessage() {}
+func (*CreateResourceTypeRequest) ProtoMessage() {}
 
-func (x *FindClassesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[8]
+func (x *CreateResourceTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,53 +694,43 @@ func (x *FindClassesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindClassesResponse.ProtoReflect.Descriptor instead.
-func (*FindClassesResponse) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CreateResourceTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateResourceTypeRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *FindClassesResponse) GetClasses() []*ClassAggr {
+func (x *CreateResourceTypeRequest) GetResourceType() *ResourceType {
 	if x != nil {
-		return x.Classes
+		return x.ResourceType
 	}
 	return nil
 }
 
-func (x *FindClassesResponse) GetTotal() uint32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-type FindEventsRequest struct {
+type DeleteEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filters *EventFilters `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty"`
-	Sorting EventSorting  `protobuf:"varint,2,opt,name=sorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"sorting,omitempty"`
-	Amount  uint32        `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Skip    uint32        `protobuf:"varint,4,opt,name=skip,proto3" json:"skip,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *FindEventsRequest) Reset() {
-	*x = FindEventsRequest{}
+func (x *DeleteEventRequest) Reset() {
+	*x = DeleteEventRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[9]
+		mi := &file_events_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindEventsRequest) String() string {
+func (x *DeleteEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindEventsRequest) ProtoMessage() {}
+func (*DeleteEventRequest) ProtoMessage() {}
 
-func (x *FindEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[9]
+func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,67 +741,43 @@ func (x *FindEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindEventsRequest.ProtoReflect.Descriptor instead.
-func (*FindEventsRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *FindEventsRequest) GetFilters() *EventFilters {
-	if x != nil {
-		return x.Filters
-	}
-	return nil
-}
-
-func (x *FindEventsRequest) GetSorting() EventSorting {
-	if x != nil {
-		return x.Sorting
-	}
-	return EventSorting_EVENT_SORTING_UNKNOWN
-}
-
-func (x *FindEventsRequest) GetAmount() uint32 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *FindEventsRequest) GetSkip() uint32 {
+func (x *DeleteEventRequest) GetId() string {
 	if x != nil {
-		return x.Skip
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type FindEventsResponse struct {
+type DeleteResourceTypeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// list of the events that passed the provided filters
-	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	// total events found
-	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *FindEventsResponse) Reset() {
-	*x = FindEventsResponse{}
+func (x *DeleteResourceTypeRequest) Reset() {
+	*x = DeleteResourceTypeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[10]
+		mi := &file_events_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindEventsResponse) String() string {
+func (x *DeleteResourceTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindEventsResponse) ProtoMessage() {}
+func (*DeleteResourceTypeRequest) ProtoMessage() {}
 
-func (x *FindEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[10]
+func (x *DeleteResourceTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,52 +788,55 @@ func (x *FindEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindEventsResponse.ProtoReflect.Descriptor instead.
-func (*FindEventsResponse) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *FindEventsResponse) GetEvents() []*Event {
-	if x != nil {
-		return x.Events
-	}
-	return nil
+// Deprecated: Use DeleteResourceTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourceTypeRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *FindEventsResponse) GetTotal() uint32 {
+func (x *DeleteResourceTypeRequest) GetName() string {
 	if x != nil {
-		return x.Total
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type FindPupilByIDRequest struct {
+// Note, that we can't use the class name here because it changes depending on the event's date. So the class' letter
+// the date it was formed on used instead
+type FindAuditEntriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	EventFilters *EventFilters `protobuf:"bytes,2,opt,name=event_filters,json=eventFilters,proto3" json:"event_filters,omitempty"`
-	EventSorting EventSorting  `protobuf:"varint,3,opt,name=event_sorting,json=eventSorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"event_sorting,omitempty"`
-}
-
-func (x *FindPupilByIDRequest) Reset() {
-	*x = FindPupilByIDRequest{}
+	// return only the entries of the changes made by the user with the given id
+	ActorId string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// return only the entries of the calls of the RPC with the given full method name
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// return only the entries of the changes of the entity with the given id
+	TargetId string `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// return only the entries of the changes made in the given period. Both are optional
+	From   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To     *timestamp.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Amount uint32               `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Skip   uint32               `protobuf:"varint,7,opt,name=skip,proto3" json:"skip,omitempty"`
+}
+
+func (x *FindAuditEntriesRequest) Reset() {
+	*x = FindAuditEntriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[11]
+		mi := &file_events_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindPupilByIDRequest) String() string {
+func (x *FindAuditEntriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindPupilByIDRequest) ProtoMessage() {}
+func (*FindAuditEntriesRequest) ProtoMessage() {}
 
-func (x *FindPupilByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[11]
+func (x *FindAuditEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,59 +847,87 @@ func (x *FindPupilByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindPupilByIDRequest.ProtoReflect.Descriptor instead.
-func (*FindPupilByIDRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use FindAuditEntriesRequest.ProtoReflect.Descriptor instead.
+func (*FindAuditEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *FindPupilByIDRequest) GetId() string {
+func (x *FindAuditEntriesRequest) GetActorId() string {
 	if x != nil {
-		return x.Id
+		return x.ActorId
 	}
 	return ""
 }
 
-func (x *FindPupilByIDRequest) GetEventFilters() *EventFilters {
+func (x *FindAuditEntriesRequest) GetMethod() string {
 	if x != nil {
-		return x.EventFilters
+		return x.Method
+	}
+	return ""
+}
+
+func (x *FindAuditEntriesRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *FindAuditEntriesRequest) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.From
 	}
 	return nil
 }
 
-func (x *FindPupilByIDRequest) GetEventSorting() EventSorting {
+func (x *FindAuditEntriesRequest) GetTo() *timestamp.Timestamp {
 	if x != nil {
-		return x.EventSorting
+		return x.To
 	}
-	return EventSorting_EVENT_SORTING_UNKNOWN
+	return nil
 }
 
-type FindPupilByIDResponse struct {
+func (x *FindAuditEntriesRequest) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FindAuditEntriesRequest) GetSkip() uint32 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+type FindAuditEntriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// pupil with aggregated info about the resources the pupil brought to every event that
-	// passed the filters and a list of those events
-	Pupil *PupilAggr `protobuf:"bytes,1,opt,name=pupil,proto3" json:"pupil,omitempty"`
+	// entries found, the latest first
+	Entries []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total   uint32        `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *FindPupilByIDResponse) Reset() {
-	*x = FindPupilByIDResponse{}
+func (x *FindAuditEntriesResponse) Reset() {
+	*x = FindAuditEntriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[12]
+		mi := &file_events_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindPupilByIDResponse) String() string {
+func (x *FindAuditEntriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindPupilByIDResponse) ProtoMessage() {}
+func (*FindAuditEntriesResponse) ProtoMessage() {}
 
-func (x *FindPupilByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[12]
+func (x *FindAuditEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,49 +938,55 @@ func (x *FindPupilByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindPupilByIDResponse.ProtoReflect.Descriptor instead.
-func (*FindPupilByIDResponse) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use FindAuditEntriesResponse.ProtoReflect.Descriptor instead.
+func (*FindAuditEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *FindPupilByIDResponse) GetPupil() *PupilAggr {
+func (x *FindAuditEntriesResponse) GetEntries() []*AuditEntry {
 	if x != nil {
-		return x.Pupil
+		return x.Entries
 	}
 	return nil
 }
 
-type FindPupilsRequest struct {
+func (x *FindAuditEntriesResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type FindClassLeaderboardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// text search field that can be a combination of the name of a pupil and the name of their class
-	NameAndClass string        `protobuf:"bytes,1,opt,name=name_and_class,json=nameAndClass,proto3" json:"name_and_class,omitempty"`
-	EventFilters *EventFilters `protobuf:"bytes,2,opt,name=event_filters,json=eventFilters,proto3" json:"event_filters,omitempty"`
-	Sorting      PupilSorting  `protobuf:"varint,3,opt,name=sorting,proto3,enum=shanvl.garbage.events.v1.PupilSorting" json:"sorting,omitempty"`
-	EventSorting EventSorting  `protobuf:"varint,4,opt,name=event_sorting,json=eventSorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"event_sorting,omitempty"`
-	Amount       uint32        `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Skip         uint32        `protobuf:"varint,6,opt,name=skip,proto3" json:"skip,omitempty"`
+	// the period starts on this date
+	From *timestamp.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// the period ends on this date, which is included
+	To     *timestamp.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount uint32               `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Skip   uint32               `protobuf:"varint,4,opt,name=skip,proto3" json:"skip,omitempty"`
 }
 
-func (x *FindPupilsRequest) Reset() {
-	*x = FindPupilsRequest{}
+func (x *FindClassLeaderboardRequest) Reset() {
+	*x = FindClassLeaderboardRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[13]
+		mi := &file_events_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindPupilsRequest) String() string {
+func (x *FindClassLeaderboardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindPupilsRequest) ProtoMessage() {}
+func (*FindClassLeaderboardRequest) ProtoMessage() {}
 
-func (x *FindPupilsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[13]
+func (x *FindClassLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,82 +997,67 @@ func (x *FindPupilsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindPupilsRequest.ProtoReflect.Descriptor instead.
-func (*FindPupilsRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *FindPupilsRequest) GetNameAndClass() string {
-	if x != nil {
-		return x.NameAndClass
-	}
-	return ""
+// Deprecated: Use FindClassLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*FindClassLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *FindPupilsRequest) GetEventFilters() *EventFilters {
+func (x *FindClassLeaderboardRequest) GetFrom() *timestamp.Timestamp {
 	if x != nil {
-		return x.EventFilters
+		return x.From
 	}
 	return nil
 }
 
-func (x *FindPupilsRequest) GetSorting() PupilSorting {
-	if x != nil {
-		return x.Sorting
-	}
-	return PupilSorting_PUPIL_SORTING_UNKNOWN
-}
-
-func (x *FindPupilsRequest) GetEventSorting() EventSorting {
+func (x *FindClassLeaderboardRequest) GetTo() *timestamp.Timestamp {
 	if x != nil {
-		return x.EventSorting
+		return x.To
 	}
-	return EventSorting_EVENT_SORTING_UNKNOWN
+	return nil
 }
 
-func (x *FindPupilsRequest) GetAmount() uint32 {
+func (x *FindClassLeaderboardRequest) GetAmount() uint32 {
 	if x != nil {
 		return x.Amount
 	}
 	return 0
 }
 
-func (x *FindPupilsRequest) GetSkip() uint32 {
+func (x *FindClassLeaderboardRequest) GetSkip() uint32 {
 	if x != nil {
 		return x.Skip
 	}
 	return 0
 }
 
-type FindPupilsResponse struct {
+type FindClassLeaderboardResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// list of pupils with aggregated info about the resources a pupil has brought to every event that
-	// passed the filters and a list of those events for each pupil
-	Pupils []*PupilAggr `protobuf:"bytes,1,rep,name=pupils,proto3" json:"pupils,omitempty"`
-	// total pupils found
+	// classes which scored in the period, ranked by their scores
+	Classes []*ClassStanding `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	// total classes ranked
 	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *FindPupilsResponse) Reset() {
-	*x = FindPupilsResponse{}
+func (x *FindClassLeaderboardResponse) Reset() {
+	*x = FindClassLeaderboardResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[14]
+		mi := &file_events_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindPupilsResponse) String() string {
+func (x *FindClassLeaderboardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindPupilsResponse) ProtoMessage() {}
+func (*FindClassLeaderboardResponse) ProtoMessage() {}
 
-func (x *FindPupilsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[14]
+func (x *FindClassLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -932,50 +1068,75 @@ func (x *FindPupilsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindPupilsResponse.ProtoReflect.Descriptor instead.
-func (*FindPupilsResponse) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use FindClassLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*FindClassLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *FindPupilsResponse) GetPupils() []*PupilAggr {
+func (x *FindClassLeaderboardResponse) GetClasses() []*ClassStanding {
 	if x != nil {
-		return x.Pupils
+		return x.Classes
 	}
 	return nil
 }
 
-func (x *FindPupilsResponse) GetTotal() uint32 {
+func (x *FindClassLeaderboardResponse) GetTotal() uint32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-type FindEventByIDRequest struct {
+type FindClassesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// letter of the class
+	Letter string `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	// date the class was formed on
+	DateFormed   *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date_formed,json=dateFormed,proto3" json:"date_formed,omitempty"`
+	EventFilters *EventFilters        `protobuf:"bytes,3,opt,name=event_filters,json=eventFilters,proto3" json:"event_filters,omitempty"`
+	Sorting      ClassSorting         `protobuf:"varint,4,opt,name=sorting,proto3,enum=shanvl.garbage.events.v1.ClassSorting" json:"sorting,omitempty"`
+	EventSorting EventSorting         `protobuf:"varint,5,opt,name=event_sorting,json=eventSorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"event_sorting,omitempty"`
+	Amount       uint32               `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Skip         uint32               `protobuf:"varint,7,opt,name=skip,proto3" json:"skip,omitempty"`
+	// name of the resource the classes are sorted by if the sorting is CLASS_SORTING_RESOURCE
+	SortingResource string `protobuf:"bytes,8,opt,name=sorting_resource,json=sortingResource,proto3" json:"sorting_resource,omitempty"`
+	// name of the resource the events are sorted by if the event sorting is EVENT_SORTING_RESOURCE
+	EventSortingResource string `protobuf:"bytes,9,opt,name=event_sorting_resource,json=eventSortingResource,proto3" json:"event_sorting_resource,omitempty"`
+	// sortings applied in turn to the classes which are equal by the previous ones. The sorting by a resource uses
+	// sorting_resource
+	ThenSorting []ClassSorting `protobuf:"varint,10,rep,packed,name=then_sorting,json=thenSorting,proto3,enum=shanvl.garbage.events.v1.ClassSorting" json:"then_sorting,omitempty"`
+	// sortings applied in turn to the events which are equal by the previous ones. The sorting by a resource uses
+	// event_sorting_resource
+	ThenEventSorting []EventSorting `protobuf:"varint,11,rep,packed,name=then_event_sorting,json=thenEventSorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"then_event_sorting,omitempty"`
+	// max number of the classes returned. It's used instead of amount if it's set
+	PageSize uint32 `protobuf:"varint,12,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// token of the page, which is next_page_token of the previous response. The request must have the same sortings
+	// and filters as the previous one. skip is ignored if the token is set
+	PageToken string `protobuf:"bytes,13,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// doesn't count the total number of the classes found, which is 0 then. It makes the request cheaper
+	SkipTotal bool `protobuf:"varint,14,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"`
 }
 
-func (x *FindEventByIDRequest) Reset() {
-	*x = FindEventByIDRequest{}
+func (x *FindClassesRequest) Reset() {
+	*x = FindClassesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[15]
+		mi := &file_events_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindEventByIDRequest) String() string {
+func (x *FindClassesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindEventByIDRequest) ProtoMessage() {}
+func (*FindClassesRequest) ProtoMessage() {}
 
-func (x *FindEventByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[15]
+func (x *FindClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,175 +1147,140 @@ func (x *FindEventByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindEventByIDRequest.ProtoReflect.Descriptor instead.
-func (*FindEventByIDRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use FindClassesRequest.ProtoReflect.Descriptor instead.
+func (*FindClassesRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *FindEventByIDRequest) GetId() string {
+func (x *FindClassesRequest) GetLetter() string {
 	if x != nil {
-		return x.Id
+		return x.Letter
 	}
 	return ""
 }
 
-type FindEventByIDResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// event's info with the resources collected on that event
-	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
-}
-
-func (x *FindEventByIDResponse) Reset() {
-	*x = FindEventByIDResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *FindEventByIDResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FindEventByIDResponse) ProtoMessage() {}
-
-func (x *FindEventByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *FindClassesRequest) GetDateFormed() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateFormed
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FindEventByIDResponse.ProtoReflect.Descriptor instead.
-func (*FindEventByIDResponse) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{16}
+	return nil
 }
 
-func (x *FindEventByIDResponse) GetEvent() *Event {
+func (x *FindClassesRequest) GetEventFilters() *EventFilters {
 	if x != nil {
-		return x.Event
+		return x.EventFilters
 	}
 	return nil
 }
 
-type FindEventClassesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	// text search field with a class name as it was on the date of the event (class name changes depending on the
-	// event's date)
-	ClassName string       `protobuf:"bytes,2,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
-	Sorting   ClassSorting `protobuf:"varint,3,opt,name=sorting,proto3,enum=shanvl.garbage.events.v1.ClassSorting" json:"sorting,omitempty"`
-	Amount    uint32       `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Skip      uint32       `protobuf:"varint,5,opt,name=skip,proto3" json:"skip,omitempty"`
+func (x *FindClassesRequest) GetSorting() ClassSorting {
+	if x != nil {
+		return x.Sorting
+	}
+	return ClassSorting_CLASS_SORTING_UNKNOWN
 }
 
-func (x *FindEventClassesRequest) Reset() {
-	*x = FindEventClassesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *FindClassesRequest) GetEventSorting() EventSorting {
+	if x != nil {
+		return x.EventSorting
 	}
+	return EventSorting_EVENT_SORTING_UNKNOWN
 }
 
-func (x *FindEventClassesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FindClassesRequest) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (*FindEventClassesRequest) ProtoMessage() {}
-
-func (x *FindEventClassesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *FindClassesRequest) GetSkip() uint32 {
+	if x != nil {
+		return x.Skip
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use FindEventClassesRequest.ProtoReflect.Descriptor instead.
-func (*FindEventClassesRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{17}
+func (x *FindClassesRequest) GetSortingResource() string {
+	if x != nil {
+		return x.SortingResource
+	}
+	return ""
 }
 
-func (x *FindEventClassesRequest) GetEventId() string {
+func (x *FindClassesRequest) GetEventSortingResource() string {
 	if x != nil {
-		return x.EventId
+		return x.EventSortingResource
 	}
 	return ""
 }
 
-func (x *FindEventClassesRequest) GetClassName() string {
+func (x *FindClassesRequest) GetThenSorting() []ClassSorting {
 	if x != nil {
-		return x.ClassName
+		return x.ThenSorting
 	}
-	return ""
+	return nil
 }
 
-func (x *FindEventClassesRequest) GetSorting() ClassSorting {
+func (x *FindClassesRequest) GetThenEventSorting() []EventSorting {
 	if x != nil {
-		return x.Sorting
+		return x.ThenEventSorting
 	}
-	return ClassSorting_CLASS_SORTING_UNKNOWN
+	return nil
 }
 
-func (x *FindEventClassesRequest) GetAmount() uint32 {
+func (x *FindClassesRequest) GetPageSize() uint32 {
 	if x != nil {
-		return x.Amount
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *FindEventClassesRequest) GetSkip() uint32 {
+func (x *FindClassesRequest) GetPageToken() string {
 	if x != nil {
-		return x.Skip
+		return x.PageToken
 	}
-	return 0
+	return ""
 }
 
-type FindEventClassesResponse struct {
+func (x *FindClassesRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
+type FindClassesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// list of found classes with the resources each class brought to the event
-	Classes []*Class `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	// list of classes with aggregated info about the resources a class has brought to every event that
+	// passed the filters and a list of those events for each class
+	Classes []*ClassAggr `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
 	// total classes found
 	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// token of the next page, which is empty if there are no more classes
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *FindEventClassesResponse) Reset() {
-	*x = FindEventClassesResponse{}
+func (x *FindClassesResponse) Reset() {
+	*x = FindClassesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[18]
+		mi := &file_events_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindEventClassesResponse) String() string {
+func (x *FindClassesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindEventClassesResponse) ProtoMessage() {}
+func (*FindClassesResponse) ProtoMessage() {}
 
-func (x *FindEventClassesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[18]
+func (x *FindClassesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1165,56 +1291,72 @@ func (x *FindEventClassesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindEventClassesResponse.ProtoReflect.Descriptor instead.
-func (*FindEventClassesResponse) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use FindClassesResponse.ProtoReflect.Descriptor instead.
+func (*FindClassesResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *FindEventClassesResponse) GetClasses() []*Class {
+func (x *FindClassesResponse) GetClasses() []*ClassAggr {
 	if x != nil {
 		return x.Classes
 	}
 	return nil
 }
 
-func (x *FindEventClassesResponse) GetTotal() uint32 {
+func (x *FindClassesResponse) GetTotal() uint32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-type FindEventPupilsRequest struct {
+func (x *FindClassesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type FindEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	// text search field with the combination of pupils names and classes names as they were on the date of the event
-	// (class name changes depending on the event's date)
-	NameAndClass string       `protobuf:"bytes,2,opt,name=name_and_class,json=nameAndClass,proto3" json:"name_and_class,omitempty"`
-	Sorting      PupilSorting `protobuf:"varint,3,opt,name=sorting,proto3,enum=shanvl.garbage.events.v1.PupilSorting" json:"sorting,omitempty"`
-	Amount       uint32       `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Skip         uint32       `protobuf:"varint,5,opt,name=skip,proto3" json:"skip,omitempty"`
-}
-
-func (x *FindEventPupilsRequest) Reset() {
-	*x = FindEventPupilsRequest{}
+	Filters *EventFilters `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty"`
+	Sorting EventSorting  `protobuf:"varint,2,opt,name=sorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"sorting,omitempty"`
+	Amount  uint32        `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Skip    uint32        `protobuf:"varint,4,opt,name=skip,proto3" json:"skip,omitempty"`
+	// name of the resource the events are sorted by if the sorting is EVENT_SORTING_RESOURCE
+	SortingResource string `protobuf:"bytes,5,opt,name=sorting_resource,json=sortingResource,proto3" json:"sorting_resource,omitempty"`
+	// sortings applied in turn to the events which are equal by the previous ones. The sorting by a resource uses
+	// sorting_resource
+	ThenSorting []EventSorting `protobuf:"varint,6,rep,packed,name=then_sorting,json=thenSorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"then_sorting,omitempty"`
+	// max number of the events returned. It's used instead of amount if it's set
+	PageSize uint32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// token of the page, which is next_page_token of the previous response. The request must have the same sortings
+	// and filters as the previous one. skip is ignored if the token is set
+	PageToken string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// doesn't count the total number of the events found, which is 0 then. It makes the request cheaper
+	SkipTotal bool `protobuf:"varint,9,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"`
+}
+
+func (x *FindEventsRequest) Reset() {
+	*x = FindEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[19]
+		mi := &file_events_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindEventPupilsRequest) String() string {
+func (x *FindEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindEventPupilsRequest) ProtoMessage() {}
+func (*FindEventsRequest) ProtoMessage() {}
 
-func (x *FindEventPupilsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[19]
+func (x *FindEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,74 +1367,104 @@ func (x *FindEventPupilsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindEventPupilsRequest.ProtoReflect.Descriptor instead.
-func (*FindEventPupilsRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use FindEventsRequest.ProtoReflect.Descriptor instead.
+func (*FindEventsRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *FindEventPupilsRequest) GetEventId() string {
+func (x *FindEventsRequest) GetFilters() *EventFilters {
 	if x != nil {
-		return x.EventId
+		return x.Filters
 	}
-	return ""
+	return nil
 }
 
-func (x *FindEventPupilsRequest) GetNameAndClass() string {
+func (x *FindEventsRequest) GetSorting() EventSorting {
 	if x != nil {
-		return x.NameAndClass
+		return x.Sorting
 	}
-	return ""
+	return EventSorting_EVENT_SORTING_UNKNOWN
 }
 
-func (x *FindEventPupilsRequest) GetSorting() PupilSorting {
+func (x *FindEventsRequest) GetAmount() uint32 {
 	if x != nil {
-		return x.Sorting
+		return x.Amount
 	}
-	return PupilSorting_PUPIL_SORTING_UNKNOWN
+	return 0
 }
 
-func (x *FindEventPupilsRequest) GetAmount() uint32 {
+func (x *FindEventsRequest) GetSkip() uint32 {
 	if x != nil {
-		return x.Amount
+		return x.Skip
 	}
 	return 0
 }
 
-func (x *FindEventPupilsRequest) GetSkip() uint32 {
+func (x *FindEventsRequest) GetSortingResource() string {
 	if x != nil {
-		return x.Skip
+		return x.SortingResource
+	}
+	return ""
+}
+
+func (x *FindEventsRequest) GetThenSorting() []EventSorting {
+	if x != nil {
+		return x.ThenSorting
+	}
+	return nil
+}
+
+func (x *FindEventsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
 	}
 	return 0
 }
 
-type FindEventPupilsResponse struct {
+func (x *FindEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *FindEventsRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
+type FindEventsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// list of found pupils with the resources each pupil brought to the event
-	Pupils []*Pupil `protobuf:"bytes,1,rep,name=pupils,proto3" json:"pupils,omitempty"`
-	// total pupils found
+	// list of the events that passed the provided filters
+	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// total events found
 	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// token of the next page, which is empty if there are no more events
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *FindEventPupilsResponse) Reset() {
-	*x = FindEventPupilsResponse{}
+func (x *FindEventsResponse) Reset() {
+	*x = FindEventsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[20]
+		mi := &file_events_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindEventPupilsResponse) String() string {
+func (x *FindEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindEventPupilsResponse) ProtoMessage() {}
+func (*FindEventsResponse) ProtoMessage() {}
 
-func (x *FindEventPupilsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[20]
+func (x *FindEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1303,51 +1475,64 @@ func (x *FindEventPupilsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindEventPupilsResponse.ProtoReflect.Descriptor instead.
-func (*FindEventPupilsResponse) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use FindEventsResponse.ProtoReflect.Descriptor instead.
+func (*FindEventsResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *FindEventPupilsResponse) GetPupils() []*Pupil {
+func (x *FindEventsResponse) GetEvents() []*Event {
 	if x != nil {
-		return x.Pupils
+		return x.Events
 	}
 	return nil
 }
 
-func (x *FindEventPupilsResponse) GetTotal() uint32 {
+func (x *FindEventsResponse) GetTotal() uint32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-type FindEventPupilByIDRequest struct {
+func (x *FindEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type FindPupilByIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	PupilId string `protobuf:"bytes,2,opt,name=pupil_id,json=pupilId,proto3" json:"pupil_id,omitempty"`
+	Id           string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventFilters *EventFilters `protobuf:"bytes,2,opt,name=event_filters,json=eventFilters,proto3" json:"event_filters,omitempty"`
+	EventSorting EventSorting  `protobuf:"varint,3,opt,name=event_sorting,json=eventSorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"event_sorting,omitempty"`
+	// name of the resource the events are sorted by if the event sorting is EVENT_SORTING_RESOURCE
+	EventSortingResource string `protobuf:"bytes,4,opt,name=event_sorting_resource,json=eventSortingResource,proto3" json:"event_sorting_resource,omitempty"`
+	// sortings applied in turn to the events which are equal by the previous ones. The sorting by a resource uses
+	// event_sorting_resource
+	ThenEventSorting []EventSorting `protobuf:"varint,5,rep,packed,name=then_event_sorting,json=thenEventSorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"then_event_sorting,omitempty"`
 }
 
-func (x *FindEventPupilByIDRequest) Reset() {
-	*x = FindEventPupilByIDRequest{}
+func (x *FindPupilByIDRequest) Reset() {
+	*x = FindPupilByIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[21]
+		mi := &file_events_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindEventPupilByIDRequest) String() string {
+func (x *FindPupilByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindEventPupilByIDRequest) ProtoMessage() {}
+func (*FindPupilByIDRequest) ProtoMessage() {}
 
-func (x *FindEventPupilByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[21]
+func (x *FindPupilByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1358,51 +1543,73 @@ func (x *FindEventPupilByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindEventPupilByIDRequest.ProtoReflect.Descriptor instead.
-func (*FindEventPupilByIDRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use FindPupilByIDRequest.ProtoReflect.Descriptor instead.
+func (*FindPupilByIDRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *FindEventPupilByIDRequest) GetEventId() string {
+func (x *FindPupilByIDRequest) GetId() string {
 	if x != nil {
-		return x.EventId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *FindEventPupilByIDRequest) GetPupilId() string {
+func (x *FindPupilByIDRequest) GetEventFilters() *EventFilters {
 	if x != nil {
-		return x.PupilId
+		return x.EventFilters
+	}
+	return nil
+}
+
+func (x *FindPupilByIDRequest) GetEventSorting() EventSorting {
+	if x != nil {
+		return x.EventSorting
+	}
+	return EventSorting_EVENT_SORTING_UNKNOWN
+}
+
+func (x *FindPupilByIDRequest) GetEventSortingResource() string {
+	if x != nil {
+		return x.EventSortingResource
 	}
 	return ""
 }
 
-type FindEventPupilByIDResponse struct {
+func (x *FindPupilByIDRequest) GetThenEventSorting() []EventSorting {
+	if x != nil {
+		return x.ThenEventSorting
+	}
+	return nil
+}
+
+type FindPupilByIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// pupil info with the resources they brought to the event
-	Pupil *Pupil `protobuf:"bytes,1,opt,name=pupil,proto3" json:"pupil,omitempty"`
+	// pupil with aggregated info about the resources the pupil brought to every event that
+	// passed the filters and a list of those events
+	Pupil *PupilAggr `protobuf:"bytes,1,opt,name=pupil,proto3" json:"pupil,omitempty"`
 }
 
-func (x *FindEventPupilByIDResponse) Reset() {
-	*x = FindEventPupilByIDResponse{}
+func (x *FindPupilByIDResponse) Reset() {
+	*x = FindPupilByIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[22]
+		mi := &file_events_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindEventPupilByIDResponse) String() string {
+func (x *FindPupilByIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindEventPupilByIDResponse) ProtoMessage() {}
+func (*FindPupilByIDResponse) ProtoMessage() {}
 
-func (x *FindEventPupilByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[22]
+func (x *FindPupilByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,44 +1620,48 @@ func (x *FindEventPupilByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindEventPupilByIDResponse.ProtoReflect.Descriptor instead.
-func (*FindEventPupilByIDResponse) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use FindPupilByIDResponse.ProtoReflect.Descriptor instead.
+func (*FindPupilByIDResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *FindEventPupilByIDResponse) GetPupil() *Pupil {
+func (x *FindPupilByIDResponse) GetPupil() *PupilAggr {
 	if x != nil {
 		return x.Pupil
 	}
 	return nil
 }
 
-type RemovePupilsRequest struct {
+type FindPupilLeaderboardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ids of the pupils deleted
-	PupilIds []string `protobuf:"bytes,1,rep,name=pupil_ids,json=pupilIds,proto3" json:"pupil_ids,omitempty"`
+	// the period starts on this date
+	From *timestamp.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// the period ends on this date, which is included
+	To     *timestamp.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount uint32               `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Skip   uint32               `protobuf:"varint,4,opt,name=skip,proto3" json:"skip,omitempty"`
 }
 
-func (x *RemovePupilsRequest) Reset() {
-	*x = RemovePupilsRequest{}
+func (x *FindPupilLeaderboardRequest) Reset() {
+	*x = FindPupilLeaderboardRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[23]
+		mi := &file_events_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemovePupilsRequest) String() string {
+func (x *FindPupilLeaderboardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemovePupilsRequest) ProtoMessage() {}
+func (*FindPupilLeaderboardRequest) ProtoMessage() {}
 
-func (x *RemovePupilsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[23]
+func (x *FindPupilLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,46 +1672,67 @@ func (x *RemovePupilsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemovePupilsRequest.ProtoReflect.Descriptor instead.
-func (*RemovePupilsRequest) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use FindPupilLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*FindPupilLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *RemovePupilsRequest) GetPupilIds() []string {
+func (x *FindPupilLeaderboardRequest) GetFrom() *timestamp.Timestamp {
 	if x != nil {
-		return x.PupilIds
+		return x.From
 	}
 	return nil
 }
 
-type AddPupilsRequest_Pupil struct {
+func (x *FindPupilLeaderboardRequest) GetTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *FindPupilLeaderboardRequest) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FindPupilLeaderboardRequest) GetSkip() uint32 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+type FindPupilLeaderboardResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FirstName string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName  string `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	// class name as it is now (class name changes depending on the date)
-	Class string `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"`
+	// pupils which scored in the period, ranked by their scores
+	Pupils []*PupilStanding `protobuf:"bytes,1,rep,name=pupils,proto3" json:"pupils,omitempty"`
+	// total pupils ranked the user has access to
+	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *AddPupilsRequest_Pupil) Reset() {
-	*x = AddPupilsRequest_Pupil{}
+func (x *FindPupilLeaderboardResponse) Reset() {
+	*x = FindPupilLeaderboardResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_events_service_proto_msgTypes[24]
+		mi := &file_events_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddPupilsRequest_Pupil) String() string {
+func (x *FindPupilLeaderboardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPupilsRequest_Pupil) ProtoMessage() {}
+func (*FindPupilLeaderboardResponse) ProtoMessage() {}
 
-func (x *AddPupilsRequest_Pupil) ProtoReflect() protoreflect.Message {
-	mi := &file_events_service_proto_msgTypes[24]
+func (x *FindPupilLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1511,501 +1743,3160 @@ func (x *AddPupilsRequest_Pupil) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPupilsRequest_Pupil.ProtoReflect.Descriptor instead.
-func (*AddPupilsRequest_Pupil) Descriptor() ([]byte, []int) {
-	return file_events_service_proto_rawDescGZIP(), []int{0, 0}
-}
-
-func (x *AddPupilsRequest_Pupil) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+// Deprecated: Use FindPupilLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*FindPupilLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *AddPupilsRequest_Pupil) GetLastName() string {
+func (x *FindPupilLeaderboardResponse) GetPupils() []*PupilStanding {
 	if x != nil {
-		return x.LastName
+		return x.Pupils
 	}
-	return ""
+	return nil
 }
 
-func (x *AddPupilsRequest_Pupil) GetClass() string {
+func (x *FindPupilLeaderboardResponse) GetTotal() uint32 {
 	if x != nil {
-		return x.Class
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-var File_events_service_proto protoreflect.FileDescriptor
+type FindPupilsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_events_service_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67,
-	0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x1a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x73, 0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb7, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64,
-	0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a,
-	0x06, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
-	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x75, 0x70, 0x69,
-	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x52,
-	0x06, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x1a, 0x59, 0x0a, 0x05, 0x50, 0x75, 0x70, 0x69, 0x6c,
-	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61,
-	0x73, 0x73, 0x22, 0x30, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x70, 0x69, 0x6c,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x70, 0x69,
-	0x6c, 0x49, 0x64, 0x73, 0x22, 0x4a, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x75,
-	0x70, 0x69, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73,
-	0x22, 0xac, 0x01, 0x0a, 0x1b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x75, 0x70, 0x69, 0x6c,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70,
-	0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
-	0x75, 0x70, 0x69, 0x6c, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x5f, 0x62, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x52, 0x10, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x22,
-	0xa9, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67,
-	0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xf1, 0x02, 0x0a, 0x12, 0x46, 0x69, 0x6e,
-	0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f,
-	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f,
-	0x72, 0x6d, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x68,
-	0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x73, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62,
-	0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
-	0x61, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74,
-	0x69, 0x6e, 0x67, 0x12, 0x4b, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x72,
-	0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61,
-	0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69,
-	0x6e, 0x67, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x22, 0x6a, 0x0a, 0x13,
-	0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
-	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6c, 0x61, 0x73, 0x73, 0x41, 0x67, 0x67, 0x72, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73,
-	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xc3, 0x01, 0x0a, 0x11, 0x46, 0x69, 0x6e,
-	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
-	0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x12, 0x40, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69,
-	0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b,
-	0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x22, 0x63,
-	0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
-	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x22, 0xc0, 0x01, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69,
-	0x6c, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4b, 0x0a, 0x0d,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72,
-	0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0c, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x0d, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67,
-	0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53,
-	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x52, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75,
-	0x70, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x39, 0x0a, 0x05, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x41,
-	0x67, 0x67, 0x72, 0x52, 0x05, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x22, 0xc1, 0x02, 0x0a, 0x11, 0x46,
-	0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x63, 0x6c, 0x61,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x6e,
-	0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x4b, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
-	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
-	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x75, 0x70, 0x69, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x6f,
-	0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x4b, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73,
-	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73,
-	0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72,
-	0x74, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69,
-	0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b,
-	0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x22, 0x67,
-	0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
-	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x75, 0x70, 0x69, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x52, 0x06, 0x70, 0x75, 0x70, 0x69, 0x6c,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x26, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x4e, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c,
-	0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
-	0xc1, 0x01, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61,
-	0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73,
-	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e,
-	0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07,
-	0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
-	0x6b, 0x69, 0x70, 0x22, 0x6b, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x39, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67,
-	0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73,
-	0x73, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x22, 0xc7, 0x01, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75,
-	0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61,
-	0x6e, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x6e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x07,
-	0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e,
-	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x53, 0x6f,
-	0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x22, 0x68, 0x0a, 0x17, 0x46, 0x69,
-	0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67,
-	0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x52, 0x06, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x22, 0x51, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
-	0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x75, 0x70, 0x69, 0x6c, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
-	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x75, 0x70, 0x69, 0x6c, 0x52, 0x05, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x22, 0x32, 0x0a, 0x13,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x49, 0x64, 0x73,
-	0x32, 0x8e, 0x0f, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x7b, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12,
-	0x2a, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x75,
-	0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x68,
-	0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f,
-	0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x12,
-	0x7f, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x43, 0x6c,
-	0x61, 0x73, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72,
-	0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x1a, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x70, 0x69,
-	0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a,
-	0x12, 0x99, 0x01, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x75, 0x70, 0x69, 0x6c,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x73, 0x68, 0x61, 0x6e,
-	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x75, 0x70, 0x69, 0x6c,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c,
-	0x1a, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x2f, 0x7b,
-	0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x81, 0x01, 0x0a,
-	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x73,
-	0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x68, 0x61,
-	0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x0f, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x01, 0x2a,
-	0x12, 0x6c, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x2c, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x2a, 0x0f, 0x2f,
-	0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x7f,
-	0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2c, 0x2e,
-	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61,
-	0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x68,
-	0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12,
-	0x7b, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x2e,
-	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x68, 0x61,
-	0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c,
-	0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x89, 0x01, 0x0a,
-	0x0d, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x2e,
-	0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
-	0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa0, 0x01, 0x0a, 0x10, 0x46, 0x69, 0x6e,
-	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x31, 0x2e,
-	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x32, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67,
-	0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76,
-	0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x0f,
-	0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12,
-	0x30, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x31, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76,
-	0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x12, 0x46,
-	0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x42, 0x79, 0x49,
-	0x44, 0x12, 0x33, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e,
-	0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c,
-	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x75, 0x70, 0x69,
-	0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x89, 0x01,
-	0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44, 0x12,
-	0x2e, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50,
-	0x75, 0x70, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2f, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50,
-	0x75, 0x70, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75,
-	0x70, 0x69, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x7b, 0x0a, 0x0a, 0x46, 0x69, 0x6e,
-	0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0x2b, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c,
-	0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
-	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f,
-	0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0x69, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0x2d, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e,
-	0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x12, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x2a, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c,
-	0x73, 0x42, 0x7c, 0x5a, 0x0c, 0x2e, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x76, 0x31, 0x70,
-	0x62, 0x92, 0x41, 0x6b, 0x5a, 0x5b, 0x0a, 0x59, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72,
-	0x12, 0x4f, 0x08, 0x02, 0x12, 0x3a, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x20, 0x70, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x3a, 0x20,
-	0x27, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x3c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3e, 0x27,
-	0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
-	0x02, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// text search field that can be a combination of the name of a pupil and the name of their class
+	NameAndClass string        `protobuf:"bytes,1,opt,name=name_and_class,json=nameAndClass,proto3" json:"name_and_class,omitempty"`
+	EventFilters *EventFilters `protobuf:"bytes,2,opt,name=event_filters,json=eventFilters,proto3" json:"event_filters,omitempty"`
+	Sorting      PupilSorting  `protobuf:"varint,3,opt,name=sorting,proto3,enum=shanvl.garbage.events.v1.PupilSorting" json:"sorting,omitempty"`
+	EventSorting EventSorting  `protobuf:"varint,4,opt,name=event_sorting,json=eventSorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"event_sorting,omitempty"`
+	Amount       uint32        `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Skip         uint32        `protobuf:"varint,6,opt,name=skip,proto3" json:"skip,omitempty"`
+	// name of the resource the pupils are sorted by if the sorting is PUPIL_SORTING_RESOURCE
+	SortingResource string `protobuf:"bytes,7,opt,name=sorting_resource,json=sortingResource,proto3" json:"sorting_resource,omitempty"`
+	// name of the resource the events are sorted by if the event sorting is EVENT_SORTING_RESOURCE
+	EventSortingResource string `protobuf:"bytes,8,opt,name=event_sorting_resource,json=eventSortingResource,proto3" json:"event_sorting_resource,omitempty"`
+	// sortings applied in turn to the pupils which are equal by the previous ones. The sorting by a resource uses
+	// sorting_resource
+	ThenSorting []PupilSorting `protobuf:"varint,9,rep,packed,name=then_sorting,json=thenSorting,proto3,enum=shanvl.garbage.events.v1.PupilSorting" json:"then_sorting,omitempty"`
+	// sortings applied in turn to the events which are equal by the previous ones. The sorting by a resource uses
+	// event_sorting_resource
+	ThenEventSorting []EventSorting `protobuf:"varint,10,rep,packed,name=then_event_sorting,json=thenEventSorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"then_event_sorting,omitempty"`
+	// max number of the pupils returned. It's used instead of amount if it's set
+	PageSize uint32 `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// token of the page, which is next_page_token of the previous response. The request must have the same sortings
+	// and filters as the previous one. skip is ignored if the token is set
+	PageToken string `protobuf:"bytes,12,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// doesn't count the total number of the pupils found, which is 0 then. It makes the request cheaper
+	SkipTotal bool `protobuf:"varint,13,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"`
 }
 
-var (
-	file_events_service_proto_rawDescOnce sync.Once
-	file_events_service_proto_rawDescData = file_events_service_proto_rawDesc
-)
+func (x *FindPupilsRequest) Reset() {
+	*x = FindPupilsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_events_service_proto_rawDescGZIP() []byte {
-	file_events_service_proto_rawDescOnce.Do(func() {
-		file_events_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_events_service_proto_rawDescData)
-	})
-	return file_events_service_proto_rawDescData
+func (x *FindPupilsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_events_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
-var file_events_service_proto_goTypes = []interface{}{
-	(*AddPupilsRequest)(nil),            // 0: shanvl.garbage.events.v1.AddPupilsRequest
-	(*AddPupilsResponse)(nil),           // 1: shanvl.garbage.events.v1.AddPupilsResponse
-	(*ChangePupilClassRequest)(nil),     // 2: shanvl.garbage.events.v1.ChangePupilClassRequest
-	(*ChangePupilResourcesRequest)(nil), // 3: shanvl.garbage.events.v1.ChangePupilResourcesRequest
-	(*CreateEventRequest)(nil),          // 4: shanvl.garbage.events.v1.CreateEventRequest
-	(*CreateEventResponse)(nil),         // 5: shanvl.garbage.events.v1.CreateEventResponse
-	(*DeleteEventRequest)(nil),          // 6: shanvl.garbage.events.v1.DeleteEventRequest
-	(*FindClassesRequest)(nil),          // 7: shanvl.garbage.events.v1.FindClassesRequest
-	(*FindClassesResponse)(nil),         // 8: shanvl.garbage.events.v1.FindClassesResponse
-	(*FindEventsRequest)(nil),           // 9: shanvl.garbage.events.v1.FindEventsRequest
-	(*FindEventsResponse)(nil),          // 10: shanvl.garbage.events.v1.FindEventsResponse
-	(*FindPupilByIDRequest)(nil),        // 11: shanvl.garbage.events.v1.FindPupilByIDRequest
-	(*FindPupilByIDResponse)(nil),       // 12: shanvl.garbage.events.v1.FindPupilByIDResponse
-	(*FindPupilsRequest)(nil),           // 13: shanvl.garbage.events.v1.FindPupilsRequest
-	(*FindPupilsResponse)(nil),          // 14: shanvl.garbage.events.v1.FindPupilsResponse
-	(*FindEventByIDRequest)(nil),        // 15: shanvl.garbage.events.v1.FindEventByIDRequest
-	(*FindEventByIDResponse)(nil),       // 16: shanvl.garbage.events.v1.FindEventByIDResponse
-	(*FindEventClassesRequest)(nil),     // 17: shanvl.garbage.events.v1.FindEventClassesRequest
-	(*FindEventClassesResponse)(nil),    // 18: shanvl.garbage.events.v1.FindEventClassesResponse
-	(*FindEventPupilsRequest)(nil),      // 19: shanvl.garbage.events.v1.FindEventPupilsRequest
-	(*FindEventPupilsResponse)(nil),     // 20: shanvl.garbage.events.v1.FindEventPupilsResponse
-	(*FindEventPupilByIDRequest)(nil),   // 21: shanvl.garbage.events.v1.FindEventPupilByIDRequest
-	(*FindEventPupilByIDResponse)(nil),  // 22: shanvl.garbage.events.v1.FindEventPupilByIDResponse
-	(*RemovePupilsRequest)(nil),         // 23: shanvl.garbage.events.v1.RemovePupilsRequest
-	(*AddPupilsRequest_Pupil)(nil),      // 24: shanvl.garbage.events.v1.AddPupilsRequest.Pupil
-	(*ResourcesBrought)(nil),            // 25: shanvl.garbage.events.v1.ResourcesBrought
-	(*timestamp.Timestamp)(nil),         // 26: google.protobuf.Timestamp
-	(Resource)(0),                       // 27: shanvl.garbage.events.v1.Resource
-	(*EventFilters)(nil),                // 28: shanvl.garbage.events.v1.EventFilters
-	(ClassSorting)(0),                   // 29: shanvl.garbage.events.v1.ClassSorting
-	(EventSorting)(0),                   // 30: shanvl.garbage.events.v1.EventSorting
-	(*ClassAggr)(nil),                   // 31: shanvl.garbage.events.v1.ClassAggr
-	(*Event)(nil),                       // 32: shanvl.garbage.events.v1.Event
-	(*PupilAggr)(nil),                   // 33: shanvl.garbage.events.v1.PupilAggr
-	(PupilSorting)(0),                   // 34: shanvl.garbage.events.v1.PupilSorting
-	(*Class)(nil),                       // 35: shanvl.garbage.events.v1.Class
-	(*Pupil)(nil),                       // 36: shanvl.garbage.events.v1.Pupil
-	(*empty.Empty)(nil),                 // 37: google.protobuf.Empty
+func (*FindPupilsRequest) ProtoMessage() {}
+
+func (x *FindPupilsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_events_service_proto_depIdxs = []int32{
-	24, // 0: shanvl.garbage.events.v1.AddPupilsRequest.pupils:type_name -> shanvl.garbage.events.v1.AddPupilsRequest.Pupil
-	25, // 1: shanvl.garbage.events.v1.ChangePupilResourcesRequest.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
-	26, // 2: shanvl.garbage.events.v1.CreateEventRequest.date:type_name -> google.protobuf.Timestamp
-	27, // 3: shanvl.garbage.events.v1.CreateEventRequest.resources_allowed:type_name -> shanvl.garbage.events.v1.Resource
-	26, // 4: shanvl.garbage.events.v1.FindClassesRequest.date_formed:type_name -> google.protobuf.Timestamp
-	28, // 5: shanvl.garbage.events.v1.FindClassesRequest.event_filters:type_name -> shanvl.garbage.events.v1.EventFilters
-	29, // 6: shanvl.garbage.events.v1.FindClassesRequest.sorting:type_name -> shanvl.garbage.events.v1.ClassSorting
-	30, // 7: shanvl.garbage.events.v1.FindClassesRequest.event_sorting:type_name -> shanvl.garbage.events.v1.EventSorting
-	31, // 8: shanvl.garbage.events.v1.FindClassesResponse.classes:type_name -> shanvl.garbage.events.v1.ClassAggr
-	28, // 9: shanvl.garbage.events.v1.FindEventsRequest.filters:type_name -> shanvl.garbage.events.v1.EventFilters
-	30, // 10: shanvl.garbage.events.v1.FindEventsRequest.sorting:type_name -> shanvl.garbage.events.v1.EventSorting
-	32, // 11: shanvl.garbage.events.v1.FindEventsResponse.events:type_name -> shanvl.garbage.events.v1.Event
-	28, // 12: shanvl.garbage.events.v1.FindPupilByIDRequest.event_filters:type_name -> shanvl.garbage.events.v1.EventFilters
-	30, // 13: shanvl.garbage.events.v1.FindPupilByIDRequest.event_sorting:type_name -> shanvl.garbage.events.v1.EventSorting
-	33, // 14: shanvl.garbage.events.v1.FindPupilByIDResponse.pupil:type_name -> shanvl.garbage.events.v1.PupilAggr
-	28, // 15: shanvl.garbage.events.v1.FindPupilsRequest.event_filters:type_name -> shanvl.garbage.events.v1.EventFilters
-	34, // 16: shanvl.garbage.events.v1.FindPupilsRequest.sorting:type_name -> shanvl.garbage.events.v1.PupilSorting
-	30, // 17: shanvl.garbage.events.v1.FindPupilsRequest.event_sorting:type_name -> shanvl.garbage.events.v1.EventSorting
-	33, // 18: shanvl.garbage.events.v1.FindPupilsResponse.pupils:type_name -> shanvl.garbage.events.v1.PupilAggr
-	32, // 19: shanvl.garbage.events.v1.FindEventByIDResponse.event:type_name -> shanvl.garbage.events.v1.Event
-	29, // 20: shanvl.garbage.events.v1.FindEventClassesRequest.sorting:type_name -> shanvl.garbage.events.v1.ClassSorting
-	35, // 21: shanvl.garbage.events.v1.FindEventClassesResponse.classes:type_name -> shanvl.garbage.events.v1.Class
-	34, // 22: shanvl.garbage.events.v1.FindEventPupilsRequest.sorting:type_name -> shanvl.garbage.events.v1.PupilSorting
-	36, // 23: shanvl.garbage.events.v1.FindEventPupilsResponse.pupils:type_name -> shanvl.garbage.events.v1.Pupil
-	36, // 24: shanvl.garbage.events.v1.FindEventPupilByIDResponse.pupil:type_name -> shanvl.garbage.events.v1.Pupil
-	0,  // 25: shanvl.garbage.events.v1.EventsService.AddPupils:input_type -> shanvl.garbage.events.v1.AddPupilsRequest
-	2,  // 26: shanvl.garbage.events.v1.EventsService.ChangePupilClass:input_type -> shanvl.garbage.events.v1.ChangePupilClassRequest
-	3,  // 27: shanvl.garbage.events.v1.EventsService.ChangePupilResources:input_type -> shanvl.garbage.events.v1.ChangePupilResourcesRequest
-	4,  // 28: shanvl.garbage.events.v1.EventsService.CreateEvent:input_type -> shanvl.garbage.events.v1.CreateEventRequest
-	6,  // 29: shanvl.garbage.events.v1.EventsService.DeleteEvent:input_type -> shanvl.garbage.events.v1.DeleteEventRequest
-	7,  // 30: shanvl.garbage.events.v1.EventsService.FindClasses:input_type -> shanvl.garbage.events.v1.FindClassesRequest
-	9,  // 31: shanvl.garbage.events.v1.EventsService.FindEvents:input_type -> shanvl.garbage.events.v1.FindEventsRequest
-	15, // 32: shanvl.garbage.events.v1.EventsService.FindEventByID:input_type -> shanvl.garbage.events.v1.FindEventByIDRequest
-	17, // 33: shanvl.garbage.events.v1.EventsService.FindEventClasses:input_type -> shanvl.garbage.events.v1.FindEventClassesRequest
-	19, // 34: shanvl.garbage.events.v1.EventsService.FindEventPupils:input_type -> shanvl.garbage.events.v1.FindEventPupilsRequest
-	21, // 35: shanvl.garbage.events.v1.EventsService.FindEventPupilByID:input_type -> shanvl.garbage.events.v1.FindEventPupilByIDRequest
-	11, // 36: shanvl.garbage.events.v1.EventsService.FindPupilByID:input_type -> shanvl.garbage.events.v1.FindPupilByIDRequest
-	13, // 37: shanvl.garbage.events.v1.EventsService.FindPupils:input_type -> shanvl.garbage.events.v1.FindPupilsRequest
-	23, // 38: shanvl.garbage.events.v1.EventsService.RemovePupils:input_type -> shanvl.garbage.events.v1.RemovePupilsRequest
-	1,  // 39: shanvl.garbage.events.v1.EventsService.AddPupils:output_type -> shanvl.garbage.events.v1.AddPupilsResponse
-	37, // 40: shanvl.garbage.events.v1.EventsService.ChangePupilClass:output_type -> google.protobuf.Empty
-	37, // 41: shanvl.garbage.events.v1.EventsService.ChangePupilResources:output_type -> google.protobuf.Empty
-	5,  // 42: shanvl.garbage.events.v1.EventsService.CreateEvent:output_type -> shanvl.garbage.events.v1.CreateEventResponse
-	37, // 43: shanvl.garbage.events.v1.EventsService.DeleteEvent:output_type -> google.protobuf.Empty
-	8,  // 44: shanvl.garbage.events.v1.EventsService.FindClasses:output_type -> shanvl.garbage.events.v1.FindClassesResponse
-	10, // 45: shanvl.garbage.events.v1.EventsService.FindEvents:output_type -> shanvl.garbage.events.v1.FindEventsResponse
-	16, // 46: shanvl.garbage.events.v1.EventsService.FindEventByID:output_type -> shanvl.garbage.events.v1.FindEventByIDResponse
-	18, // 47: shanvl.garbage.events.v1.EventsService.FindEventClasses:output_type -> shanvl.garbage.events.v1.FindEventClassesResponse
-	20, // 48: shanvl.garbage.events.v1.EventsService.FindEventPupils:output_type -> shanvl.garbage.events.v1.FindEventPupilsResponse
-	22, // 49: shanvl.garbage.events.v1.EventsService.FindEventPupilByID:output_type -> shanvl.garbage.events.v1.FindEventPupilByIDResponse
-	12, // 50: shanvl.garbage.events.v1.EventsService.FindPupilByID:output_type -> shanvl.garbage.events.v1.FindPupilByIDResponse
-	14, // 51: shanvl.garbage.events.v1.EventsService.FindPupils:output_type -> shanvl.garbage.events.v1.FindPupilsResponse
-	37, // 52: shanvl.garbage.events.v1.EventsService.RemovePupils:output_type -> google.protobuf.Empty
-	39, // [39:53] is the sub-list for method output_type
-	25, // [25:39] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+
+// Deprecated: Use FindPupilsRequest.ProtoReflect.Descriptor instead.
+func (*FindPupilsRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{26}
 }
 
-func init() { file_events_service_proto_init() }
-func file_events_service_proto_init() {
-	if File_events_service_proto != nil {
-		return
+func (x *FindPupilsRequest) GetNameAndClass() string {
+	if x != nil {
+		return x.NameAndClass
 	}
-	file_events_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_events_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPupilsRequest); i {
+	return ""
+}
+
+func (x *FindPupilsRequest) GetEventFilters() *EventFilters {
+	if x != nil {
+		return x.EventFilters
+	}
+	return nil
+}
+
+func (x *FindPupilsRequest) GetSorting() PupilSorting {
+	if x != nil {
+		return x.Sorting
+	}
+	return PupilSorting_PUPIL_SORTING_UNKNOWN
+}
+
+func (x *FindPupilsRequest) GetEventSorting() EventSorting {
+	if x != nil {
+		return x.EventSorting
+	}
+	return EventSorting_EVENT_SORTING_UNKNOWN
+}
+
+func (x *FindPupilsRequest) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FindPupilsRequest) GetSkip() uint32 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *FindPupilsRequest) GetSortingResource() string {
+	if x != nil {
+		return x.SortingResource
+	}
+	return ""
+}
+
+func (x *FindPupilsRequest) GetEventSortingResource() string {
+	if x != nil {
+		return x.EventSortingResource
+	}
+	return ""
+}
+
+func (x *FindPupilsRequest) GetThenSorting() []PupilSorting {
+	if x != nil {
+		return x.ThenSorting
+	}
+	return nil
+}
+
+func (x *FindPupilsRequest) GetThenEventSorting() []EventSorting {
+	if x != nil {
+		return x.ThenEventSorting
+	}
+	return nil
+}
+
+func (x *FindPupilsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FindPupilsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *FindPupilsRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
+type FindPupilsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// list of pupils with aggregated info about the resources a pupil has brought to every event that
+	// passed the filters and a list of those events for each pupil
+	Pupils []*PupilAggr `protobuf:"bytes,1,rep,name=pupils,proto3" json:"pupils,omitempty"`
+	// total pupils found
+	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// token of the next page, which is empty if there are no more pupils
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *FindPupilsResponse) Reset() {
+	*x = FindPupilsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindPupilsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPupilsResponse) ProtoMessage() {}
+
+func (x *FindPupilsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPupilsResponse.ProtoReflect.Descriptor instead.
+func (*FindPupilsResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FindPupilsResponse) GetPupils() []*PupilAggr {
+	if x != nil {
+		return x.Pupils
+	}
+	return nil
+}
+
+func (x *FindPupilsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FindPupilsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type FindEventByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FindEventByIDRequest) Reset() {
+	*x = FindEventByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindEventByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindEventByIDRequest) ProtoMessage() {}
+
+func (x *FindEventByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindEventByIDRequest.ProtoReflect.Descriptor instead.
+func (*FindEventByIDRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FindEventByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FindEventByIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// event's info with the resources collected on that event
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *FindEventByIDResponse) Reset() {
+	*x = FindEventByIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindEventByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindEventByIDResponse) ProtoMessage() {}
+
+func (x *FindEventByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindEventByIDResponse.ProtoReflect.Descriptor instead.
+func (*FindEventByIDResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FindEventByIDResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type FindEventClassesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// text search field with a class name as it was on the date of the event (class name changes depending on the
+	// event's date)
+	ClassName string       `protobuf:"bytes,2,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	Sorting   ClassSorting `protobuf:"varint,3,opt,name=sorting,proto3,enum=shanvl.garbage.events.v1.ClassSorting" json:"sorting,omitempty"`
+	Amount    uint32       `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Skip      uint32       `protobuf:"varint,5,opt,name=skip,proto3" json:"skip,omitempty"`
+	// name of the resource the classes are sorted by if the sorting is CLASS_SORTING_RESOURCE
+	SortingResource string `protobuf:"bytes,6,opt,name=sorting_resource,json=sortingResource,proto3" json:"sorting_resource,omitempty"`
+	// sortings applied in turn to the classes which are equal by the previous ones. The sorting by a resource uses
+	// sorting_resource
+	ThenSorting []ClassSorting `protobuf:"varint,7,rep,packed,name=then_sorting,json=thenSorting,proto3,enum=shanvl.garbage.events.v1.ClassSorting" json:"then_sorting,omitempty"`
+	// max number of the classes returned. It's used instead of amount if it's set
+	PageSize uint32 `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// token of the page, which is next_page_token of the previous response. The request must have the same sortings
+	// and filters as the previous one. skip is ignored if the token is set
+	PageToken string `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// doesn't count the total number of the classes found, which is 0 then. It makes the request cheaper
+	SkipTotal bool `protobuf:"varint,10,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"`
+}
+
+func (x *FindEventClassesRequest) Reset() {
+	*x = FindEventClassesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindEventClassesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindEventClassesRequest) ProtoMessage() {}
+
+func (x *FindEventClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindEventClassesRequest.ProtoReflect.Descriptor instead.
+func (*FindEventClassesRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FindEventClassesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *FindEventClassesRequest) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *FindEventClassesRequest) GetSorting() ClassSorting {
+	if x != nil {
+		return x.Sorting
+	}
+	return ClassSorting_CLASS_SORTING_UNKNOWN
+}
+
+func (x *FindEventClassesRequest) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FindEventClassesRequest) GetSkip() uint32 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *FindEventClassesRequest) GetSortingResource() string {
+	if x != nil {
+		return x.SortingResource
+	}
+	return ""
+}
+
+func (x *FindEventClassesRequest) GetThenSorting() []ClassSorting {
+	if x != nil {
+		return x.ThenSorting
+	}
+	return nil
+}
+
+func (x *FindEventClassesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FindEventClassesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *FindEventClassesRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
+type FindEventClassesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// list of found classes with the resources each class brought to the event
+	Classes []*Class `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	// total classes found
+	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// token of the next page, which is empty if there are no more classes
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *FindEventClassesResponse) Reset() {
+	*x = FindEventClassesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindEventClassesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindEventClassesResponse) ProtoMessage() {}
+
+func (x *FindEventClassesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindEventClassesResponse.ProtoReflect.Descriptor instead.
+func (*FindEventClassesResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FindEventClassesResponse) GetClasses() []*Class {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+func (x *FindEventClassesResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FindEventClassesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type FindEventPupilsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// text search field with the combination of pupils names and classes names as they were on the date of the event
+	// (class name changes depending on the event's date)
+	NameAndClass string       `protobuf:"bytes,2,opt,name=name_and_class,json=nameAndClass,proto3" json:"name_and_class,omitempty"`
+	Sorting      PupilSorting `protobuf:"varint,3,opt,name=sorting,proto3,enum=shanvl.garbage.events.v1.PupilSorting" json:"sorting,omitempty"`
+	Amount       uint32       `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Skip         uint32       `protobuf:"varint,5,opt,name=skip,proto3" json:"skip,omitempty"`
+	// name of the resource the pupils are sorted by if the sorting is PUPIL_SORTING_RESOURCE
+	SortingResource string `protobuf:"bytes,6,opt,name=sorting_resource,json=sortingResource,proto3" json:"sorting_resource,omitempty"`
+	// sortings applied in turn to the pupils which are equal by the previous ones. The sorting by a resource uses
+	// sorting_resource
+	ThenSorting []PupilSorting `protobuf:"varint,7,rep,packed,name=then_sorting,json=thenSorting,proto3,enum=shanvl.garbage.events.v1.PupilSorting" json:"then_sorting,omitempty"`
+	// max number of the pupils returned. It's used instead of amount if it's set
+	PageSize uint32 `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// token of the page, which is next_page_token of the previous response. The request must have the same sortings
+	// and filters as the previous one. skip is ignored if the token is set
+	PageToken string `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// doesn't count the total number of the pupils found, which is 0 then. It makes the request cheaper
+	SkipTotal bool `protobuf:"varint,10,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"`
+}
+
+func (x *FindEventPupilsRequest) Reset() {
+	*x = FindEventPupilsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindEventPupilsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindEventPupilsRequest) ProtoMessage() {}
+
+func (x *FindEventPupilsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindEventPupilsRequest.ProtoReflect.Descriptor instead.
+func (*FindEventPupilsRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FindEventPupilsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *FindEventPupilsRequest) GetNameAndClass() string {
+	if x != nil {
+		return x.NameAndClass
+	}
+	return ""
+}
+
+func (x *FindEventPupilsRequest) GetSorting() PupilSorting {
+	if x != nil {
+		return x.Sorting
+	}
+	return PupilSorting_PUPIL_SORTING_UNKNOWN
+}
+
+func (x *FindEventPupilsRequest) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FindEventPupilsRequest) GetSkip() uint32 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *FindEventPupilsRequest) GetSortingResource() string {
+	if x != nil {
+		return x.SortingResource
+	}
+	return ""
+}
+
+func (x *FindEventPupilsRequest) GetThenSorting() []PupilSorting {
+	if x != nil {
+		return x.ThenSorting
+	}
+	return nil
+}
+
+func (x *FindEventPupilsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FindEventPupilsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *FindEventPupilsRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
+type FindEventPupilsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// list of found pupils with the resources each pupil brought to the event
+	Pupils []*Pupil `protobuf:"bytes,1,rep,name=pupils,proto3" json:"pupils,omitempty"`
+	// total pupils found
+	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// token of the next page, which is empty if there are no more pupils
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *FindEventPupilsResponse) Reset() {
+	*x = FindEventPupilsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindEventPupilsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindEventPupilsResponse) ProtoMessage() {}
+
+func (x *FindEventPupilsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindEventPupilsResponse.ProtoReflect.Descriptor instead.
+func (*FindEventPupilsResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FindEventPupilsResponse) GetPupils() []*Pupil {
+	if x != nil {
+		return x.Pupils
+	}
+	return nil
+}
+
+func (x *FindEventPupilsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FindEventPupilsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type FindEventPupilByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PupilId string `protobuf:"bytes,2,opt,name=pupil_id,json=pupilId,proto3" json:"pupil_id,omitempty"`
+}
+
+func (x *FindEventPupilByIDRequest) Reset() {
+	*x = FindEventPupilByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindEventPupilByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindEventPupilByIDRequest) ProtoMessage() {}
+
+func (x *FindEventPupilByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindEventPupilByIDRequest.ProtoReflect.Descriptor instead.
+func (*FindEventPupilByIDRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FindEventPupilByIDRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *FindEventPupilByIDRequest) GetPupilId() string {
+	if x != nil {
+		return x.PupilId
+	}
+	return ""
+}
+
+type FindEventPupilByIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pupil info with the resources they brought to the event
+	Pupil *Pupil `protobuf:"bytes,1,opt,name=pupil,proto3" json:"pupil,omitempty"`
+	// weigh-ins the resources add up from, including the voided ones
+	ResourceEntries []*ResourceEntry `protobuf:"bytes,2,rep,name=resource_entries,json=resourceEntries,proto3" json:"resource_entries,omitempty"`
+}
+
+func (x *FindEventPupilByIDResponse) Reset() {
+	*x = FindEventPupilByIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindEventPupilByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindEventPupilByIDResponse) ProtoMessage() {}
+
+func (x *FindEventPupilByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindEventPupilByIDResponse.ProtoReflect.Descriptor instead.
+func (*FindEventPupilByIDResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FindEventPupilByIDResponse) GetPupil() *Pupil {
+	if x != nil {
+		return x.Pupil
+	}
+	return nil
+}
+
+func (x *FindEventPupilByIDResponse) GetResourceEntries() []*ResourceEntry {
+	if x != nil {
+		return x.ResourceEntries
+	}
+	return nil
+}
+
+type FindResourceEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PupilId string `protobuf:"bytes,2,opt,name=pupil_id,json=pupilId,proto3" json:"pupil_id,omitempty"`
+}
+
+func (x *FindResourceEntriesRequest) Reset() {
+	*x = FindResourceEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindResourceEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindResourceEntriesRequest) ProtoMessage() {}
+
+func (x *FindResourceEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindResourceEntriesRequest.ProtoReflect.Descriptor instead.
+func (*FindResourceEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FindResourceEntriesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *FindResourceEntriesRequest) GetPupilId() string {
+	if x != nil {
+		return x.PupilId
+	}
+	return ""
+}
+
+type FindResourceEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*ResourceEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *FindResourceEntriesResponse) Reset() {
+	*x = FindResourceEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindResourceEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindResourceEntriesResponse) ProtoMessage() {}
+
+func (x *FindResourceEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindResourceEntriesResponse.ProtoReflect.Descriptor instead.
+func (*FindResourceEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FindResourceEntriesResponse) GetEntries() []*ResourceEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type FindResourceTypesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FindResourceTypesRequest) Reset() {
+	*x = FindResourceTypesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindResourceTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindResourceTypesRequest) ProtoMessage() {}
+
+func (x *FindResourceTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindResourceTypesRequest.ProtoReflect.Descriptor instead.
+func (*FindResourceTypesRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{38}
+}
+
+type FindResourceTypesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resource types sorted by their names
+	ResourceTypes []*ResourceType `protobuf:"bytes,1,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"`
+}
+
+func (x *FindResourceTypesResponse) Reset() {
+	*x = FindResourceTypesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindResourceTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindResourceTypesResponse) ProtoMessage() {}
+
+func (x *FindResourceTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindResourceTypesResponse.ProtoReflect.Descriptor instead.
+func (*FindResourceTypesResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FindResourceTypesResponse) GetResourceTypes() []*ResourceType {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
+type OpenEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *OpenEventRequest) Reset() {
+	*x = OpenEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenEventRequest) ProtoMessage() {}
+
+func (x *OpenEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenEventRequest.ProtoReflect.Descriptor instead.
+func (*OpenEventRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *OpenEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemovePupilsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ids of the pupils deleted
+	PupilIds []string `protobuf:"bytes,1,rep,name=pupil_ids,json=pupilIds,proto3" json:"pupil_ids,omitempty"`
+}
+
+func (x *RemovePupilsRequest) Reset() {
+	*x = RemovePupilsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemovePupilsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePupilsRequest) ProtoMessage() {}
+
+func (x *RemovePupilsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePupilsRequest.ProtoReflect.Descriptor instead.
+func (*RemovePupilsRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RemovePupilsRequest) GetPupilIds() []string {
+	if x != nil {
+		return x.PupilIds
+	}
+	return nil
+}
+
+type SetEventMultiplierRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the points scored at the event are multiplied by it. It must be greater than 0 and not greater than 10
+	Multiplier float32 `protobuf:"fixed32,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// if set, the multiplier is set only if the event is still of this version. The If-Match header can be used
+	// instead
+	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *SetEventMultiplierRequest) Reset() {
+	*x = SetEventMultiplierRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetEventMultiplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEventMultiplierRequest) ProtoMessage() {}
+
+func (x *SetEventMultiplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEventMultiplierRequest.ProtoReflect.Descriptor instead.
+func (*SetEventMultiplierRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetEventMultiplierRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetEventMultiplierRequest) GetMultiplier() float32 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *SetEventMultiplierRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type StreamEventPupilsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// text search field with the combination of pupils names and classes names as they were on the date of the event
+	// (class name changes depending on the event's date)
+	NameAndClass string       `protobuf:"bytes,2,opt,name=name_and_class,json=nameAndClass,proto3" json:"name_and_class,omitempty"`
+	Sorting      PupilSorting `protobuf:"varint,3,opt,name=sorting,proto3,enum=shanvl.garbage.events.v1.PupilSorting" json:"sorting,omitempty"`
+	// name of the resource the pupils are sorted by if the sorting is PUPIL_SORTING_RESOURCE
+	SortingResource string `protobuf:"bytes,4,opt,name=sorting_resource,json=sortingResource,proto3" json:"sorting_resource,omitempty"`
+	// sortings applied in turn to the pupils which are equal by the previous ones. The sorting by a resource uses
+	// sorting_resource
+	ThenSorting []PupilSorting `protobuf:"varint,5,rep,packed,name=then_sorting,json=thenSorting,proto3,enum=shanvl.garbage.events.v1.PupilSorting" json:"then_sorting,omitempty"`
+}
+
+func (x *StreamEventPupilsRequest) Reset() {
+	*x = StreamEventPupilsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEventPupilsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventPupilsRequest) ProtoMessage() {}
+
+func (x *StreamEventPupilsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventPupilsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventPupilsRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StreamEventPupilsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *StreamEventPupilsRequest) GetNameAndClass() string {
+	if x != nil {
+		return x.NameAndClass
+	}
+	return ""
+}
+
+func (x *StreamEventPupilsRequest) GetSorting() PupilSorting {
+	if x != nil {
+		return x.Sorting
+	}
+	return PupilSorting_PUPIL_SORTING_UNKNOWN
+}
+
+func (x *StreamEventPupilsRequest) GetSortingResource() string {
+	if x != nil {
+		return x.SortingResource
+	}
+	return ""
+}
+
+func (x *StreamEventPupilsRequest) GetThenSorting() []PupilSorting {
+	if x != nil {
+		return x.ThenSorting
+	}
+	return nil
+}
+
+type StreamEventPupilsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pupil with the resources they brought to the event
+	Pupil *Pupil `protobuf:"bytes,1,opt,name=pupil,proto3" json:"pupil,omitempty"`
+}
+
+func (x *StreamEventPupilsResponse) Reset() {
+	*x = StreamEventPupilsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEventPupilsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventPupilsResponse) ProtoMessage() {}
+
+func (x *StreamEventPupilsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventPupilsResponse.ProtoReflect.Descriptor instead.
+func (*StreamEventPupilsResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *StreamEventPupilsResponse) GetPupil() *Pupil {
+	if x != nil {
+		return x.Pupil
+	}
+	return nil
+}
+
+type StreamPupilsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// text search field that can be a combination of the name of a pupil and the name of their class
+	NameAndClass string        `protobuf:"bytes,1,opt,name=name_and_class,json=nameAndClass,proto3" json:"name_and_class,omitempty"`
+	EventFilters *EventFilters `protobuf:"bytes,2,opt,name=event_filters,json=eventFilters,proto3" json:"event_filters,omitempty"`
+	Sorting      PupilSorting  `protobuf:"varint,3,opt,name=sorting,proto3,enum=shanvl.garbage.events.v1.PupilSorting" json:"sorting,omitempty"`
+	EventSorting EventSorting  `protobuf:"varint,4,opt,name=event_sorting,json=eventSorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"event_sorting,omitempty"`
+	// name of the resource the pupils are sorted by if the sorting is PUPIL_SORTING_RESOURCE
+	SortingResource string `protobuf:"bytes,5,opt,name=sorting_resource,json=sortingResource,proto3" json:"sorting_resource,omitempty"`
+	// name of the resource the events are sorted by if the event sorting is EVENT_SORTING_RESOURCE
+	EventSortingResource string `protobuf:"bytes,6,opt,name=event_sorting_resource,json=eventSortingResource,proto3" json:"event_sorting_resource,omitempty"`
+	// sortings applied in turn to the pupils which are equal by the previous ones. The sorting by a resource uses
+	// sorting_resource
+	ThenSorting []PupilSorting `protobuf:"varint,7,rep,packed,name=then_sorting,json=thenSorting,proto3,enum=shanvl.garbage.events.v1.PupilSorting" json:"then_sorting,omitempty"`
+	// sortings applied in turn to the events which are equal by the previous ones. The sorting by a resource uses
+	// event_sorting_resource
+	ThenEventSorting []EventSorting `protobuf:"varint,8,rep,packed,name=then_event_sorting,json=thenEventSorting,proto3,enum=shanvl.garbage.events.v1.EventSorting" json:"then_event_sorting,omitempty"`
+}
+
+func (x *StreamPupilsRequest) Reset() {
+	*x = StreamPupilsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPupilsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPupilsRequest) ProtoMessage() {}
+
+func (x *StreamPupilsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPupilsRequest.ProtoReflect.Descriptor instead.
+func (*StreamPupilsRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StreamPupilsRequest) GetNameAndClass() string {
+	if x != nil {
+		return x.NameAndClass
+	}
+	return ""
+}
+
+func (x *StreamPupilsRequest) GetEventFilters() *EventFilters {
+	if x != nil {
+		return x.EventFilters
+	}
+	return nil
+}
+
+func (x *StreamPupilsRequest) GetSorting() PupilSorting {
+	if x != nil {
+		return x.Sorting
+	}
+	return PupilSorting_PUPIL_SORTING_UNKNOWN
+}
+
+func (x *StreamPupilsRequest) GetEventSorting() EventSorting {
+	if x != nil {
+		return x.EventSorting
+	}
+	return EventSorting_EVENT_SORTING_UNKNOWN
+}
+
+func (x *StreamPupilsRequest) GetSortingResource() string {
+	if x != nil {
+		return x.SortingResource
+	}
+	return ""
+}
+
+func (x *StreamPupilsRequest) GetEventSortingResource() string {
+	if x != nil {
+		return x.EventSortingResource
+	}
+	return ""
+}
+
+func (x *StreamPupilsRequest) GetThenSorting() []PupilSorting {
+	if x != nil {
+		return x.ThenSorting
+	}
+	return nil
+}
+
+func (x *StreamPupilsRequest) GetThenEventSorting() []EventSorting {
+	if x != nil {
+		return x.ThenEventSorting
+	}
+	return nil
+}
+
+type StreamPupilsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pupil with aggregated info about the resources they brought to every event that passed the filters and a list
+	// of those events
+	Pupil *PupilAggr `protobuf:"bytes,1,opt,name=pupil,proto3" json:"pupil,omitempty"`
+}
+
+func (x *StreamPupilsResponse) Reset() {
+	*x = StreamPupilsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPupilsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPupilsResponse) ProtoMessage() {}
+
+func (x *StreamPupilsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPupilsResponse.ProtoReflect.Descriptor instead.
+func (*StreamPupilsResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StreamPupilsResponse) GetPupil() *PupilAggr {
+	if x != nil {
+		return x.Pupil
+	}
+	return nil
+}
+
+type UpdateEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// event date
+	Date *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// if the name is empty, it's created from the date
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// names of the resources permitted on the event
+	ResourcesAllowed []string `protobuf:"bytes,6,rep,name=resources_allowed,json=resourcesAllowed,proto3" json:"resources_allowed,omitempty"`
+	// if set, the event is updated only if it's still of this version. The If-Match header can be used instead
+	Version uint32 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetResourcesAllowed() []string {
+	if x != nil {
+		return x.ResourcesAllowed
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// side effects of the update, e.g. the classes whose names differ on the new date
+	Warnings []string `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *UpdateEventResponse) Reset() {
+	*x = UpdateEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventResponse) ProtoMessage() {}
+
+func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEventResponse) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateEventResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type UpdateResourceTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// unit the amounts of the resource are measured in
+	Unit ResourceUnit `protobuf:"varint,2,opt,name=unit,proto3,enum=shanvl.garbage.events.v1.ResourceUnit" json:"unit,omitempty"`
+	// points given for a unit of the resource brought. 0 means the resource isn't scored
+	Points float32 `protobuf:"fixed32,3,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *UpdateResourceTypeRequest) Reset() {
+	*x = UpdateResourceTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateResourceTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResourceTypeRequest) ProtoMessage() {}
+
+func (x *UpdateResourceTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResourceTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateResourceTypeRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateResourceTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateResourceTypeRequest) GetUnit() ResourceUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return ResourceUnit_RESOURCE_UNIT_UNKNOWN
+}
+
+func (x *UpdateResourceTypeRequest) GetPoints() float32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type VoidResourceEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PupilId string `protobuf:"bytes,2,opt,name=pupil_id,json=pupilId,proto3" json:"pupil_id,omitempty"`
+	Id      string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *VoidResourceEntryRequest) Reset() {
+	*x = VoidResourceEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoidResourceEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidResourceEntryRequest) ProtoMessage() {}
+
+func (x *VoidResourceEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidResourceEntryRequest.ProtoReflect.Descriptor instead.
+func (*VoidResourceEntryRequest) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VoidResourceEntryRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *VoidResourceEntryRequest) GetPupilId() string {
+	if x != nil {
+		return x.PupilId
+	}
+	return ""
+}
+
+func (x *VoidResourceEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AddPupilsRequest_Pupil struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstName string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// class name as it is now (class name changes depending on the date)
+	Class string `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"`
+}
+
+func (x *AddPupilsRequest_Pupil) Reset() {
+	*x = AddPupilsRequest_Pupil{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_events_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPupilsRequest_Pupil) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPupilsRequest_Pupil) ProtoMessage() {}
+
+func (x *AddPupilsRequest_Pupil) ProtoReflect() protoreflect.Message {
+	mi := &file_events_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPupilsRequest_Pupil.ProtoReflect.Descriptor instead.
+func (*AddPupilsRequest_Pupil) Descriptor() ([]byte, []int) {
+	return file_events_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *AddPupilsRequest_Pupil) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *AddPupilsRequest_Pupil) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *AddPupilsRequest_Pupil) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+var File_events_service_proto protoreflect.FileDescriptor
+
+var file_events_service_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67,
+	0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x1a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x73, 0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa9, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x64,
+	0x50, 0x75, 0x70, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x11, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x62, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e,
+	0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67,
+	0x68, 0x74, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f,
+	0x75, 0x67, 0x68, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x50, 0x75, 0x70, 0x69,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x06, 0x70, 0x75, 0x70,
+	0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x52, 0x06, 0x70, 0x75, 0x70,
+	0x69, 0x6c, 0x73, 0x1a, 0x59, 0x0a, 0x05, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x30,
+	0x0a, 0x11, 0x41, 0x64, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x49, 0x64, 0x73,
+	0x22, 0x25, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x64, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd9, 0x01,
+	0x0a, 0x1b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x70, 0x69,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x70, 0x69,
+	0x6c, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x5f, 0x62, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x23, 0x0a, 0x11, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbc,
+	0x01, 0x0a, 0x1b, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x70,
+	0x69, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x70,
+	0x69, 0x6c, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x57, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x5f, 0x62, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x52, 0x10, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x22, 0x2e, 0x0a,
+	0x1c, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8b, 0x01,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x25, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x68, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4b, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e,
+	0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x24, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x2f, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x22, 0x70, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xa5, 0x01, 0x0a, 0x1b, 0x46, 0x69,
+	0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6b, 0x69,
+	0x70, 0x22, 0x77, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62,
+	0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xce, 0x05, 0x0a, 0x12, 0x46,
+	0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x4b, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73,
+	0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b,
+	0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x29,
+	0x0a, 0x10, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x49, 0x0a, 0x0c, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67,
+	0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x74,
+	0x68, 0x65, 0x6e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x54, 0x0a, 0x12, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e,
+	0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x10,
+	0x74, 0x68, 0x65, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x6b, 0x69, 0x70, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x73, 0x6b, 0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x92, 0x01, 0x0a, 0x13,
+	0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x41, 0x67, 0x67, 0x72, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x94, 0x03, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c,
+	0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c,
+	0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x0b, 0x74, 0x68, 0x65, 0x6e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6b, 0x69, 0x70,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6b,
+	0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xcc, 0x02, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75,
+	0x70, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4b,
+	0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67,
+	0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0c, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x0d, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62,
+	0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x54,
+	0x0a, 0x12, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61,
+	0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x10, 0x74, 0x68, 0x65, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x22, 0x52, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69,
+	0x6c, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x05, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73,
+	0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x41, 0x67, 0x67,
+	0x72, 0x52, 0x05, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x22, 0xa5, 0x01, 0x0a, 0x1b, 0x46, 0x69, 0x6e,
+	0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x6b, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70,
+	0x22, 0x75, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x06, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x70, 0x69,
+	0x6c, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x75, 0x70, 0x69, 0x6c,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x9e, 0x05, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64,
+	0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x4b, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61,
+	0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x40, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x70,
+	0x69, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x4b, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x73,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0c,
+	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62,
+	0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x70, 0x69, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x74, 0x68, 0x65, 0x6e,
+	0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x54, 0x0a, 0x12, 0x74, 0x68, 0x65, 0x6e, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72,
+	0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x74, 0x68, 0x65,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6b, 0x69,
+	0x70, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73,
+	0x6b, 0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x8f, 0x01, 0x0a, 0x12, 0x46, 0x69, 0x6e,
+	0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x06, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x70, 0x69, 0x6c,
+	0x41, 0x67, 0x67, 0x72, 0x52, 0x06, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x26, 0x0a, 0x14, 0x46, 0x69,
+	0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x4e, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42,
+	0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x61,
+	0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x92, 0x03, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c,
+	0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x0b, 0x74, 0x68, 0x65, 0x6e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6b, 0x69, 0x70,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6b,
+	0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x93, 0x01, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67,
+	0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x98, 0x03,
+	0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61, 0x6d,
+	0x65, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x6f, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61,
+	0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6f, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76,
+	0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x0b, 0x74, 0x68, 0x65, 0x6e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6b, 0x69,
+	0x70, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73,
+	0x6b, 0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x90, 0x01, 0x0a, 0x17, 0x46, 0x69, 0x6e,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x75, 0x70, 0x69, 0x6c, 0x52, 0x06, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x51, 0x0a, 0x19, 0x46,
+	0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x49, 0x64, 0x22, 0xa7,
+	0x01, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69,
+	0x6c, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x05, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73,
+	0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x52, 0x05, 0x70,
+	0x75, 0x70, 0x69, 0x6c, 0x12, 0x52, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x49, 0x64, 0x22, 0x60, 0x0a, 0x1b,
+	0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73,
+	0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x1a,
+	0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6a, 0x0a, 0x19, 0x46, 0x69,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x22, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x32, 0x0a, 0x13, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x49, 0x64, 0x73, 0x22, 0x65,
+	0x0a, 0x19, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x02, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x75, 0x70, 0x69, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x49, 0x0a, 0x0c, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e,
+	0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0b,
+	0x74, 0x68, 0x65, 0x6e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x52, 0x0a, 0x19, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x75, 0x70, 0x69,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c,
+	0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x52, 0x05, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x22,
+	0x99, 0x04, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x4b, 0x0a,
+	0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0c, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68,
+	0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x53, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x4b, 0x0a, 0x0d,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72,
+	0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6f, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x70, 0x69,
+	0x6c, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x74, 0x68, 0x65, 0x6e, 0x53, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x54, 0x0a, 0x12, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x74, 0x68, 0x65, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x51, 0x0a, 0x14, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62,
+	0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x70, 0x69, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x52, 0x05, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x22, 0xb5,
+	0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x31, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x19, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x04, 0x75,
+	0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x6e, 0x69,
+	0x74, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
+	0x60, 0x0a, 0x18, 0x56, 0x6f, 0x69, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x49,
+	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x32, 0x98, 0x24, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x9b, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x22, 0x2f, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x75, 0x70,
+	0x69, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01,
+	0x2a, 0x12, 0x7b, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0x2a,
+	0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x75, 0x70,
+	0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x68, 0x61,
+	0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22,
+	0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x76,
+	0x0a, 0x0c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2d,
+	0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x17, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x7f, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x50, 0x75, 0x70, 0x69, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x68, 0x61,
+	0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x75, 0x70, 0x69,
+	0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x1a, 0x15, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x75, 0x70, 0x69, 0x6c,
+	0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x99, 0x01, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x35, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x1a, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70,
+	0x75, 0x70, 0x69, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x7d,
+	0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x2b, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x15,
+	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0xc6, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x35,
+	0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67,
+	0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x39, 0x1a, 0x34, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x75, 0x70,
+	0x69, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x81,
+	0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2c,
+	0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73,
+	0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x80, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01,
+	0x2a, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x6c, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x11, 0x2a, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x12, 0x84, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x2e, 0x73, 0x68, 0x61,
+	0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x2a,
+	0x19, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2d, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x93, 0x01, 0x0a, 0x10, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x31, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62,
+	0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0xa7, 0x01, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x35, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x36, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
+	0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x73, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x7f, 0x0a, 0x0b, 0x46, 0x69,
+	0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c,
+	0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x7b, 0x0a, 0x0a, 0x46,
+	0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e,
+	0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x0d, 0x46, 0x69, 0x6e,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x2e, 0x2e, 0x73, 0x68, 0x61,
+	0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42,
+	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x68, 0x61,
+	0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42,
+	0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa0, 0x01, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73,
+	0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x68,
+	0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44, 0x12, 0x33, 0x2e,
+	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62,
+	0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29,
+	0x12, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x2f, 0x7b,
+	0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x89, 0x01, 0x0a, 0x0d, 0x46, 0x69,
+	0x6e, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44, 0x12, 0x2e, 0x2e, 0x73, 0x68,
+	0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x68,
+	0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa6, 0x01, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75,
+	0x70, 0x69, 0x6c, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x35,
+	0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75,
+	0x70, 0x69, 0x6c, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67,
+	0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0x7b,
+	0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0x2b, 0x2e, 0x73,
+	0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12,
+	0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x13,
+	0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72,
+	0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x73, 0x68, 0x61, 0x6e,
+	0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x98, 0x01, 0x0a, 0x11, 0x46, 0x69,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x32, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72,
+	0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x6d, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x2a, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x14, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6f,
+	0x70, 0x65, 0x6e, 0x12, 0x69, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x75, 0x70,
+	0x69, 0x6c, 0x73, 0x12, 0x2d, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72,
+	0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0c, 0x2a, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0x88,
+	0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x33, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67,
+	0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x1a, 0x1a, 0x2f, 0x76, 0x31, 0x2f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0xab, 0x01, 0x0a, 0x11, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12,
+	0x32, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72,
+	0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x12, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x3a, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x8a, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x12, 0x2d, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76,
+	0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c,
+	0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12,
+	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x3a, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x30, 0x01, 0x12, 0x86, 0x01, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62,
+	0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x1a, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x87, 0x01,
+	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x2e, 0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x1a, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xa2, 0x01, 0x0a, 0x11, 0x56, 0x6f, 0x69, 0x64,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x32, 0x2e,
+	0x73, 0x68, 0x61, 0x6e, 0x76, 0x6c, 0x2e, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3b, 0x22, 0x39, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x73, 0x2f,
+	0x7b, 0x70, 0x75, 0x70, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x6f, 0x69, 0x64, 0x42, 0x7a, 0x5a, 0x0c,
+	0x2e, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x76, 0x31, 0x70, 0x62, 0x92, 0x41, 0x69, 0x5a,
+	0x5b, 0x0a, 0x59, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x4f, 0x08, 0x02, 0x12,
+	0x3a, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x64, 0x20,
+	0x62, 0x79, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x3a, 0x20, 0x27, 0x42, 0x65, 0x61, 0x72,
+	0x65, 0x72, 0x20, 0x3c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3e, 0x27, 0x1a, 0x0d, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x02, 0x62, 0x0a, 0x0a, 0x08,
+	0x0a, 0x06, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_events_service_proto_rawDescOnce sync.Once
+	file_events_service_proto_rawDescData = file_events_service_proto_rawDesc
+)
+
+func file_events_service_proto_rawDescGZIP() []byte {
+	file_events_service_proto_rawDescOnce.Do(func() {
+		file_events_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_events_service_proto_rawDescData)
+	})
+	return file_events_service_proto_rawDescData
+}
+
+var file_events_service_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_events_service_proto_goTypes = []interface{}{
+	(*AddPupilResourcesRequest)(nil),     // 0: shanvl.garbage.events.v1.AddPupilResourcesRequest
+	(*AddPupilsRequest)(nil),             // 1: shanvl.garbage.events.v1.AddPupilsRequest
+	(*AddPupilsResponse)(nil),            // 2: shanvl.garbage.events.v1.AddPupilsResponse
+	(*ArchiveEventRequest)(nil),          // 3: shanvl.garbage.events.v1.ArchiveEventRequest
+	(*ChangePupilClassRequest)(nil),      // 4: shanvl.garbage.events.v1.ChangePupilClassRequest
+	(*ChangePupilResourcesRequest)(nil),  // 5: shanvl.garbage.events.v1.ChangePupilResourcesRequest
+	(*CloseEventRequest)(nil),            // 6: shanvl.garbage.events.v1.CloseEventRequest
+	(*CorrectResourceEntryRequest)(nil),  // 7: shanvl.garbage.events.v1.CorrectResourceEntryRequest
+	(*CorrectResourceEntryResponse)(nil), // 8: shanvl.garbage.events.v1.CorrectResourceEntryResponse
+	(*CreateEventRequest)(nil),           // 9: shanvl.garbage.events.v1.CreateEventRequest
+	(*CreateEventResponse)(nil),          // 10: shanvl.garbage.events.v1.CreateEventResponse
+	(*CreateResourceTypeRequest)(nil),    // 11: shanvl.garbage.events.v1.CreateResourceTypeRequest
+	(*DeleteEventRequest)(nil),           // 12: shanvl.garbage.events.v1.DeleteEventRequest
+	(*DeleteResourceTypeRequest)(nil),    // 13: shanvl.garbage.events.v1.DeleteResourceTypeRequest
+	(*FindAuditEntriesRequest)(nil),      // 14: shanvl.garbage.events.v1.FindAuditEntriesRequest
+	(*FindAuditEntriesResponse)(nil),     // 15: shanvl.garbage.events.v1.FindAuditEntriesResponse
+	(*FindClassLeaderboardRequest)(nil),  // 16: shanvl.garbage.events.v1.FindClassLeaderboardRequest
+	(*FindClassLeaderboardResponse)(nil), // 17: shanvl.garbage.events.v1.FindClassLeaderboardResponse
+	(*FindClassesRequest)(nil),           // 18: shanvl.garbage.events.v1.FindClassesRequest
+	(*FindClassesResponse)(nil),          // 19: shanvl.garbage.events.v1.FindClassesResponse
+	(*FindEventsRequest)(nil),            // 20: shanvl.garbage.events.v1.FindEventsRequest
+	(*FindEventsResponse)(nil),           // 21: shanvl.garbage.events.v1.FindEventsResponse
+	(*FindPupilByIDRequest)(nil),         // 22: shanvl.garbage.events.v1.FindPupilByIDRequest
+	(*FindPupilByIDResponse)(nil),        // 23: shanvl.garbage.events.v1.FindPupilByIDResponse
+	(*FindPupilLeaderboardRequest)(nil),  // 24: shanvl.garbage.events.v1.FindPupilLeaderboardRequest
+	(*FindPupilLeaderboardResponse)(nil), // 25: shanvl.garbage.events.v1.FindPupilLeaderboardResponse
+	(*FindPupilsRequest)(nil),            // 26: shanvl.garbage.events.v1.FindPupilsRequest
+	(*FindPupilsResponse)(nil),           // 27: shanvl.garbage.events.v1.FindPupilsResponse
+	(*FindEventByIDRequest)(nil),         // 28: shanvl.garbage.events.v1.FindEventByIDRequest
+	(*FindEventByIDResponse)(nil),        // 29: shanvl.garbage.events.v1.FindEventByIDResponse
+	(*FindEventClassesRequest)(nil),      // 30: shanvl.garbage.events.v1.FindEventClassesRequest
+	(*FindEventClassesResponse)(nil),     // 31: shanvl.garbage.events.v1.FindEventClassesResponse
+	(*FindEventPupilsRequest)(nil),       // 32: shanvl.garbage.events.v1.FindEventPupilsRequest
+	(*FindEventPupilsResponse)(nil),      // 33: shanvl.garbage.events.v1.FindEventPupilsResponse
+	(*FindEventPupilByIDRequest)(nil),    // 34: shanvl.garbage.events.v1.FindEventPupilByIDRequest
+	(*FindEventPupilByIDResponse)(nil),   // 35: shanvl.garbage.events.v1.FindEventPupilByIDResponse
+	(*FindResourceEntriesRequest)(nil),   // 36: shanvl.garbage.events.v1.FindResourceEntriesRequest
+	(*FindResourceEntriesResponse)(nil),  // 37: shanvl.garbage.events.v1.FindResourceEntriesResponse
+	(*FindResourceTypesRequest)(nil),     // 38: shanvl.garbage.events.v1.FindResourceTypesRequest
+	(*FindResourceTypesResponse)(nil),    // 39: shanvl.garbage.events.v1.FindResourceTypesResponse
+	(*OpenEventRequest)(nil),             // 40: shanvl.garbage.events.v1.OpenEventRequest
+	(*RemovePupilsRequest)(nil),          // 41: shanvl.garbage.events.v1.RemovePupilsRequest
+	(*SetEventMultiplierRequest)(nil),    // 42: shanvl.garbage.events.v1.SetEventMultiplierRequest
+	(*StreamEventPupilsRequest)(nil),     // 43: shanvl.garbage.events.v1.StreamEventPupilsRequest
+	(*StreamEventPupilsResponse)(nil),    // 44: shanvl.garbage.events.v1.StreamEventPupilsResponse
+	(*StreamPupilsRequest)(nil),          // 45: shanvl.garbage.events.v1.StreamPupilsRequest
+	(*StreamPupilsResponse)(nil),         // 46: shanvl.garbage.events.v1.StreamPupilsResponse
+	(*UpdateEventRequest)(nil),           // 47: shanvl.garbage.events.v1.UpdateEventRequest
+	(*UpdateEventResponse)(nil),          // 48: shanvl.garbage.events.v1.UpdateEventResponse
+	(*UpdateResourceTypeRequest)(nil),    // 49: shanvl.garbage.events.v1.UpdateResourceTypeRequest
+	(*VoidResourceEntryRequest)(nil),     // 50: shanvl.garbage.events.v1.VoidResourceEntryRequest
+	(*AddPupilsRequest_Pupil)(nil),       // 51: shanvl.garbage.events.v1.AddPupilsRequest.Pupil
+	(*ResourcesBrought)(nil),             // 52: shanvl.garbage.events.v1.ResourcesBrought
+	(*timestamp.Timestamp)(nil),          // 53: google.protobuf.Timestamp
+	(*ResourceType)(nil),                 // 54: shanvl.garbage.events.v1.ResourceType
+	(*AuditEntry)(nil),                   // 55: shanvl.garbage.events.v1.AuditEntry
+	(*ClassStanding)(nil),                // 56: shanvl.garbage.events.v1.ClassStanding
+	(*EventFilters)(nil),                 // 57: shanvl.garbage.events.v1.EventFilters
+	(ClassSorting)(0),                    // 58: shanvl.garbage.events.v1.ClassSorting
+	(EventSorting)(0),                    // 59: shanvl.garbage.events.v1.EventSorting
+	(*ClassAggr)(nil),                    // 60: shanvl.garbage.events.v1.ClassAggr
+	(*Event)(nil),                        // 61: shanvl.garbage.events.v1.Event
+	(*PupilAggr)(nil),                    // 62: shanvl.garbage.events.v1.PupilAggr
+	(*PupilStanding)(nil),                // 63: shanvl.garbage.events.v1.PupilStanding
+	(PupilSorting)(0),                    // 64: shanvl.garbage.events.v1.PupilSorting
+	(*Class)(nil),                        // 65: shanvl.garbage.events.v1.Class
+	(*Pupil)(nil),                        // 66: shanvl.garbage.events.v1.Pupil
+	(*ResourceEntry)(nil),                // 67: shanvl.garbage.events.v1.ResourceEntry
+	(ResourceUnit)(0),                    // 68: shanvl.garbage.events.v1.ResourceUnit
+	(*empty.Empty)(nil),                  // 69: google.protobuf.Empty
+}
+var file_events_service_proto_depIdxs = []int32{
+	52, // 0: shanvl.garbage.events.v1.AddPupilResourcesRequest.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
+	51, // 1: shanvl.garbage.events.v1.AddPupilsRequest.pupils:type_name -> shanvl.garbage.events.v1.AddPupilsRequest.Pupil
+	52, // 2: shanvl.garbage.events.v1.ChangePupilResourcesRequest.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
+	52, // 3: shanvl.garbage.events.v1.CorrectResourceEntryRequest.resources_brought:type_name -> shanvl.garbage.events.v1.ResourcesBrought
+	53, // 4: shanvl.garbage.events.v1.CreateEventRequest.date:type_name -> google.protobuf.Timestamp
+	54, // 5: shanvl.garbage.events.v1.CreateResourceTypeRequest.resource_type:type_name -> shanvl.garbage.events.v1.ResourceType
+	53, // 6: shanvl.garbage.events.v1.FindAuditEntriesRequest.from:type_name -> google.protobuf.Timestamp
+	53, // 7: shanvl.garbage.events.v1.FindAuditEntriesRequest.to:type_name -> google.protobuf.Timestamp
+	55, // 8: shanvl.garbage.events.v1.FindAuditEntriesResponse.entries:type_name -> shanvl.garbage.events.v1.AuditEntry
+	53, // 9: shanvl.garbage.events.v1.FindClassLeaderboardRequest.from:type_name -> google.protobuf.Timestamp
+	53, // 10: shanvl.garbage.events.v1.FindClassLeaderboardRequest.to:type_name -> google.protobuf.Timestamp
+	56, // 11: shanvl.garbage.events.v1.FindClassLeaderboardResponse.classes:type_name -> shanvl.garbage.events.v1.ClassStanding
+	53, // 12: shanvl.garbage.events.v1.FindClassesRequest.date_formed:type_name -> google.protobuf.Timestamp
+	57, // 13: shanvl.garbage.events.v1.FindClassesRequest.event_filters:type_name -> shanvl.garbage.events.v1.EventFilters
+	58, // 14: shanvl.garbage.events.v1.FindClassesRequest.sorting:type_name -> shanvl.garbage.events.v1.ClassSorting
+	59, // 15: shanvl.garbage.events.v1.FindClassesRequest.event_sorting:type_name -> shanvl.garbage.events.v1.EventSorting
+	58, // 16: shanvl.garbage.events.v1.FindClassesRequest.then_sorting:type_name -> shanvl.garbage.events.v1.ClassSorting
+	59, // 17: shanvl.garbage.events.v1.FindClassesRequest.then_event_sorting:type_name -> shanvl.garbage.events.v1.EventSorting
+	60, // 18: shanvl.garbage.events.v1.FindClassesResponse.classes:type_name -> shanvl.garbage.events.v1.ClassAggr
+	57, // 19: shanvl.garbage.events.v1.FindEventsRequest.filters:type_name -> shanvl.garbage.events.v1.EventFilters
+	59, // 20: shanvl.garbage.events.v1.FindEventsRequest.sorting:type_name -> shanvl.garbage.events.v1.EventSorting
+	59, // 21: shanvl.garbage.events.v1.FindEventsRequest.then_sorting:type_name -> shanvl.garbage.events.v1.EventSorting
+	61, // 22: shanvl.garbage.events.v1.FindEventsResponse.events:type_name -> shanvl.garbage.events.v1.Event
+	57, // 23: shanvl.garbage.events.v1.FindPupilByIDRequest.event_filters:type_name -> shanvl.garbage.events.v1.EventFilters
+	59, // 24: shanvl.garbage.events.v1.FindPupilByIDRequest.event_sorting:type_name -> shanvl.garbage.events.v1.EventSorting
+	59, // 25: shanvl.garbage.events.v1.FindPupilByIDRequest.then_event_sorting:type_name -> shanvl.garbage.events.v1.EventSorting
+	62, // 26: shanvl.garbage.events.v1.FindPupilByIDResponse.pupil:type_name -> shanvl.garbage.events.v1.PupilAggr
+	53, // 27: shanvl.garbage.events.v1.FindPupilLeaderboardRequest.from:type_name -> google.protobuf.Timestamp
+	53, // 28: shanvl.garbage.events.v1.FindPupilLeaderboardRequest.to:type_name -> google.protobuf.Timestamp
+	63, // 29: shanvl.garbage.events.v1.FindPupilLeaderboardResponse.pupils:type_name -> shanvl.garbage.events.v1.PupilStanding
+	57, // 30: shanvl.garbage.events.v1.FindPupilsRequest.event_filters:type_name -> shanvl.garbage.events.v1.EventFilters
+	64, // 31: shanvl.garbage.events.v1.FindPupilsRequest.sorting:type_name -> shanvl.garbage.events.v1.PupilSorting
+	59, // 32: shanvl.garbage.events.v1.FindPupilsRequest.event_sorting:type_name -> shanvl.garbage.events.v1.EventSorting
+	64, // 33: shanvl.garbage.events.v1.FindPupilsRequest.then_sorting:type_name -> shanvl.garbage.events.v1.PupilSorting
+	59, // 34: shanvl.garbage.events.v1.FindPupilsRequest.then_event_sorting:type_name -> shanvl.garbage.events.v1.EventSorting
+	62, // 35: shanvl.garbage.events.v1.FindPupilsResponse.pupils:type_name -> shanvl.garbage.events.v1.PupilAggr
+	61, // 36: shanvl.garbage.events.v1.FindEventByIDResponse.event:type_name -> shanvl.garbage.events.v1.Event
+	58, // 37: shanvl.garbage.events.v1.FindEventClassesRequest.sorting:type_name -> shanvl.garbage.events.v1.ClassSorting
+	58, // 38: shanvl.garbage.events.v1.FindEventClassesRequest.then_sorting:type_name -> shanvl.garbage.events.v1.ClassSorting
+	65, // 39: shanvl.garbage.events.v1.FindEventClassesResponse.classes:type_name -> shanvl.garbage.events.v1.Class
+	64, // 40: shanvl.garbage.events.v1.FindEventPupilsRequest.sorting:type_name -> shanvl.garbage.events.v1.PupilSorting
+	64, // 41: shanvl.garbage.events.v1.FindEventPupilsRequest.then_sorting:type_name -> shanvl.garbage.events.v1.PupilSorting
+	66, // 42: shanvl.garbage.events.v1.FindEventPupilsResponse.pupils:type_name -> shanvl.garbage.events.v1.Pupil
+	66, // 43: shanvl.garbage.events.v1.FindEventPupilByIDResponse.pupil:type_name -> shanvl.garbage.events.v1.Pupil
+	67, // 44: shanvl.garbage.events.v1.FindEventPupilByIDResponse.resource_entries:type_name -> shanvl.garbage.events.v1.ResourceEntry
+	67, // 45: shanvl.garbage.events.v1.FindResourceEntriesResponse.entries:type_name -> shanvl.garbage.events.v1.ResourceEntry
+	54, // 46: shanvl.garbage.events.v1.FindResourceTypesResponse.resource_types:type_name -> shanvl.garbage.events.v1.ResourceType
+	64, // 47: shanvl.garbage.events.v1.StreamEventPupilsRequest.sorting:type_name -> shanvl.garbage.events.v1.PupilSorting
+	64, // 48: shanvl.garbage.events.v1.StreamEventPupilsRequest.then_sorting:type_name -> shanvl.garbage.events.v1.PupilSorting
+	66, // 49: shanvl.garbage.events.v1.StreamEventPupilsResponse.pupil:type_name -> shanvl.garbage.events.v1.Pupil
+	57, // 50: shanvl.garbage.events.v1.StreamPupilsRequest.event_filters:type_name -> shanvl.garbage.events.v1.EventFilters
+	64, // 51: shanvl.garbage.events.v1.StreamPupilsRequest.sorting:type_name -> shanvl.garbage.events.v1.PupilSorting
+	59, // 52: shanvl.garbage.events.v1.StreamPupilsRequest.event_sorting:type_name -> shanvl.garbage.events.v1.EventSorting
+	64, // 53: shanvl.garbage.events.v1.StreamPupilsRequest.then_sorting:type_name -> shanvl.garbage.events.v1.PupilSorting
+	59, // 54: shanvl.garbage.events.v1.StreamPupilsRequest.then_event_sorting:type_name -> shanvl.garbage.events.v1.EventSorting
+	62, // 55: shanvl.garbage.events.v1.StreamPupilsResponse.pupil:type_name -> shanvl.garbage.events.v1.PupilAggr
+	53, // 56: shanvl.garbage.events.v1.UpdateEventRequest.date:type_name -> google.protobuf.Timestamp
+	68, // 57: shanvl.garbage.events.v1.UpdateResourceTypeRequest.unit:type_name -> shanvl.garbage.events.v1.ResourceUnit
+	0,  // 58: shanvl.garbage.events.v1.EventsService.AddPupilResources:input_type -> shanvl.garbage.events.v1.AddPupilResourcesRequest
+	1,  // 59: shanvl.garbage.events.v1.EventsService.AddPupils:input_type -> shanvl.garbage.events.v1.AddPupilsRequest
+	3,  // 60: shanvl.garbage.events.v1.EventsService.ArchiveEvent:input_type -> shanvl.garbage.events.v1.ArchiveEventRequest
+	4,  // 61: shanvl.garbage.events.v1.EventsService.ChangePupilClass:input_type -> shanvl.garbage.events.v1.ChangePupilClassRequest
+	5,  // 62: shanvl.garbage.events.v1.EventsService.ChangePupilResources:input_type -> shanvl.garbage.events.v1.ChangePupilResourcesRequest
+	6,  // 63: shanvl.garbage.events.v1.EventsService.CloseEvent:input_type -> shanvl.garbage.events.v1.CloseEventRequest
+	7,  // 64: shanvl.garbage.events.v1.EventsService.CorrectResourceEntry:input_type -> shanvl.garbage.events.v1.CorrectResourceEntryRequest
+	9,  // 65: shanvl.garbage.events.v1.EventsService.CreateEvent:input_type -> shanvl.garbage.events.v1.CreateEventRequest
+	11, // 66: shanvl.garbage.events.v1.EventsService.CreateResourceType:input_type -> shanvl.garbage.events.v1.CreateResourceTypeRequest
+	12, // 67: shanvl.garbage.events.v1.EventsService.DeleteEvent:input_type -> shanvl.garbage.events.v1.DeleteEventRequest
+	13, // 68: shanvl.garbage.events.v1.EventsService.DeleteResourceType:input_type -> shanvl.garbage.events.v1.DeleteResourceTypeRequest
+	14, // 69: shanvl.garbage.events.v1.EventsService.FindAuditEntries:input_type -> shanvl.garbage.events.v1.FindAuditEntriesRequest
+	16, // 70: shanvl.garbage.events.v1.EventsService.FindClassLeaderboard:input_type -> shanvl.garbage.events.v1.FindClassLeaderboardRequest
+	18, // 71: shanvl.garbage.events.v1.EventsService.FindClasses:input_type -> shanvl.garbage.events.v1.FindClassesRequest
+	20, // 72: shanvl.garbage.events.v1.EventsService.FindEvents:input_type -> shanvl.garbage.events.v1.FindEventsRequest
+	28, // 73: shanvl.garbage.events.v1.EventsService.FindEventByID:input_type -> shanvl.garbage.events.v1.FindEventByIDRequest
+	30, // 74: shanvl.garbage.events.v1.EventsService.FindEventClasses:input_type -> shanvl.garbage.events.v1.FindEventClassesRequest
+	32, // 75: shanvl.garbage.events.v1.EventsService.FindEventPupils:input_type -> shanvl.garbage.events.v1.FindEventPupilsRequest
+	34, // 76: shanvl.garbage.events.v1.EventsService.FindEventPupilByID:input_type -> shanvl.garbage.events.v1.FindEventPupilByIDRequest
+	22, // 77: shanvl.garbage.events.v1.EventsService.FindPupilByID:input_type -> shanvl.garbage.events.v1.FindPupilByIDRequest
+	24, // 78: shanvl.garbage.events.v1.EventsService.FindPupilLeaderboard:input_type -> shanvl.garbage.events.v1.FindPupilLeaderboardRequest
+	26, // 79: shanvl.garbage.events.v1.EventsService.FindPupils:input_type -> shanvl.garbage.events.v1.FindPupilsRequest
+	36, // 80: shanvl.garbage.events.v1.EventsService.FindResourceEntries:input_type -> shanvl.garbage.events.v1.FindResourceEntriesRequest
+	38, // 81: shanvl.garbage.events.v1.EventsService.FindResourceTypes:input_type -> shanvl.garbage.events.v1.FindResourceTypesRequest
+	40, // 82: shanvl.garbage.events.v1.EventsService.OpenEvent:input_type -> shanvl.garbage.events.v1.OpenEventRequest
+	41, // 83: shanvl.garbage.events.v1.EventsService.RemovePupils:input_type -> shanvl.garbage.events.v1.RemovePupilsRequest
+	42, // 84: shanvl.garbage.events.v1.EventsService.SetEventMultiplier:input_type -> shanvl.garbage.events.v1.SetEventMultiplierRequest
+	43, // 85: shanvl.garbage.events.v1.EventsService.StreamEventPupils:input_type -> shanvl.garbage.events.v1.StreamEventPupilsRequest
+	45, // 86: shanvl.garbage.events.v1.EventsService.StreamPupils:input_type -> shanvl.garbage.events.v1.StreamPupilsRequest
+	47, // 87: shanvl.garbage.events.v1.EventsService.UpdateEvent:input_type -> shanvl.garbage.events.v1.UpdateEventRequest
+	49, // 88: shanvl.garbage.events.v1.EventsService.UpdateResourceType:input_type -> shanvl.garbage.events.v1.UpdateResourceTypeRequest
+	50, // 89: shanvl.garbage.events.v1.EventsService.VoidResourceEntry:input_type -> shanvl.garbage.events.v1.VoidResourceEntryRequest
+	69, // 90: shanvl.garbage.events.v1.EventsService.AddPupilResources:output_type -> google.protobuf.Empty
+	2,  // 91: shanvl.garbage.events.v1.EventsService.AddPupils:output_type -> shanvl.garbage.events.v1.AddPupilsResponse
+	69, // 92: shanvl.garbage.events.v1.EventsService.ArchiveEvent:output_type -> google.protobuf.Empty
+	69, // 93: shanvl.garbage.events.v1.EventsService.ChangePupilClass:output_type -> google.protobuf.Empty
+	69, // 94: shanvl.garbage.events.v1.EventsService.ChangePupilResources:output_type -> google.protobuf.Empty
+	69, // 95: shanvl.garbage.events.v1.EventsService.CloseEvent:output_type -> google.protobuf.Empty
+	8,  // 96: shanvl.garbage.events.v1.EventsService.CorrectResourceEntry:output_type -> shanvl.garbage.events.v1.CorrectResourceEntryResponse
+	10, // 97: shanvl.garbage.events.v1.EventsService.CreateEvent:output_type -> shanvl.garbage.events.v1.CreateEventResponse
+	69, // 98: shanvl.garbage.events.v1.EventsService.CreateResourceType:output_type -> google.protobuf.Empty
+	69, // 99: shanvl.garbage.events.v1.EventsService.DeleteEvent:output_type -> google.protobuf.Empty
+	69, // 100: shanvl.garbage.events.v1.EventsService.DeleteResourceType:output_type -> google.protobuf.Empty
+	15, // 101: shanvl.garbage.events.v1.EventsService.FindAuditEntries:output_type -> shanvl.garbage.events.v1.FindAuditEntriesResponse
+	17, // 102: shanvl.garbage.events.v1.EventsService.FindClassLeaderboard:output_type -> shanvl.garbage.events.v1.FindClassLeaderboardResponse
+	19, // 103: shanvl.garbage.events.v1.EventsService.FindClasses:output_type -> shanvl.garbage.events.v1.FindClassesResponse
+	21, // 104: shanvl.garbage.events.v1.EventsService.FindEvents:output_type -> shanvl.garbage.events.v1.FindEventsResponse
+	29, // 105: shanvl.garbage.events.v1.EventsService.FindEventByID:output_type -> shanvl.garbage.events.v1.FindEventByIDResponse
+	31, // 106: shanvl.garbage.events.v1.EventsService.FindEventClasses:output_type -> shanvl.garbage.events.v1.FindEventClassesResponse
+	33, // 107: shanvl.garbage.events.v1.EventsService.FindEventPupils:output_type -> shanvl.garbage.events.v1.FindEventPupilsResponse
+	35, // 108: shanvl.garbage.events.v1.EventsService.FindEventPupilByID:output_type -> shanvl.garbage.events.v1.FindEventPupilByIDResponse
+	23, // 109: shanvl.garbage.events.v1.EventsService.FindPupilByID:output_type -> shanvl.garbage.events.v1.FindPupilByIDResponse
+	25, // 110: shanvl.garbage.events.v1.EventsService.FindPupilLeaderboard:output_type -> shanvl.garbage.events.v1.FindPupilLeaderboardResponse
+	27, // 111: shanvl.garbage.events.v1.EventsService.FindPupils:output_type -> shanvl.garbage.events.v1.FindPupilsResponse
+	37, // 112: shanvl.garbage.events.v1.EventsService.FindResourceEntries:output_type -> shanvl.garbage.events.v1.FindResourceEntriesResponse
+	39, // 113: shanvl.garbage.events.v1.EventsService.FindResourceTypes:output_type -> shanvl.garbage.events.v1.FindResourceTypesResponse
+	69, // 114: shanvl.garbage.events.v1.EventsService.OpenEvent:output_type -> google.protobuf.Empty
+	69, // 115: shanvl.garbage.events.v1.EventsService.RemovePupils:output_type -> google.protobuf.Empty
+	69, // 116: shanvl.garbage.events.v1.EventsService.SetEventMultiplier:output_type -> google.protobuf.Empty
+	44, // 117: shanvl.garbage.events.v1.EventsService.StreamEventPupils:output_type -> shanvl.garbage.events.v1.StreamEventPupilsResponse
+	46, // 118: shanvl.garbage.events.v1.EventsService.StreamPupils:output_type -> shanvl.garbage.events.v1.StreamPupilsResponse
+	48, // 119: shanvl.garbage.events.v1.EventsService.UpdateEvent:output_type -> shanvl.garbage.events.v1.UpdateEventResponse
+	69, // 120: shanvl.garbage.events.v1.EventsService.UpdateResourceType:output_type -> google.protobuf.Empty
+	69, // 121: shanvl.garbage.events.v1.EventsService.VoidResourceEntry:output_type -> google.protobuf.Empty
+	90, // [90:122] is the sub-list for method output_type
+	58, // [58:90] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
+}
+
+func init() { file_events_service_proto_init() }
+func file_events_service_proto_init() {
+	if File_events_service_proto != nil {
+		return
+	}
+	file_events_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_events_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPupilResourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPupilsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPupilsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePupilClassRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePupilResourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CorrectResourceEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CorrectResourceEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateResourceTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResourceTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAuditEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAuditEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindClassLeaderboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindClassLeaderboardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindClassesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindClassesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindPupilByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindPupilByIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindPupilLeaderboardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2016,8 +4907,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPupilsResponse); i {
+		file_events_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindPupilLeaderboardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2028,8 +4919,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangePupilClassRequest); i {
+		file_events_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindPupilsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2040,8 +4931,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangePupilResourcesRequest); i {
+		file_events_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindPupilsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2052,8 +4943,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEventRequest); i {
+		file_events_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindEventByIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2064,8 +4955,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEventResponse); i {
+		file_events_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindEventByIDResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2076,8 +4967,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteEventRequest); i {
+		file_events_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindEventClassesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2088,8 +4979,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindClassesRequest); i {
+		file_events_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindEventClassesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2100,8 +4991,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindClassesResponse); i {
+		file_events_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindEventPupilsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2112,8 +5003,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEventsRequest); i {
+		file_events_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindEventPupilsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2124,8 +5015,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEventsResponse); i {
+		file_events_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindEventPupilByIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2136,8 +5027,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindPupilByIDRequest); i {
+		file_events_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindEventPupilByIDResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2148,8 +5039,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindPupilByIDResponse); i {
+		file_events_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindResourceEntriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2160,8 +5051,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindPupilsRequest); i {
+		file_events_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindResourceEntriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2172,8 +5063,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindPupilsResponse); i {
+		file_events_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindResourceTypesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2184,8 +5075,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEventByIDRequest); i {
+		file_events_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindResourceTypesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2196,8 +5087,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEventByIDResponse); i {
+		file_events_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenEventRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2208,8 +5099,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEventClassesRequest); i {
+		file_events_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePupilsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2220,8 +5111,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEventClassesResponse); i {
+		file_events_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEventMultiplierRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2232,8 +5123,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEventPupilsRequest); i {
+		file_events_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEventPupilsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2244,8 +5135,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEventPupilsResponse); i {
+		file_events_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEventPupilsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2256,8 +5147,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEventPupilByIDRequest); i {
+		file_events_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPupilsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2268,8 +5159,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindEventPupilByIDResponse); i {
+		file_events_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPupilsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2280,8 +5171,8 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemovePupilsRequest); i {
+		file_events_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEventRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2292,7 +5183,43 @@ func file_events_service_proto_init() {
 				return nil
 			}
 		}
-		file_events_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_events_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResourceTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoidResourceEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_events_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddPupilsRequest_Pupil); i {
 			case 0:
 				return &v.state
@@ -2311,7 +5238,7 @@ func file_events_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_events_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2337,16 +5264,37 @@ const _ = grpc.SupportPackageIsVersion6
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type EventsServiceClient interface {
+	// AddPupilResources adds the signed amounts to the resources brought by the pupil to the event and records the
+	// weigh-in. Unlike ChangePupilResources, it doesn't overwrite the weigh-ins recorded by others in the meantime.
+	// The event must be open
+	AddPupilResources(ctx context.Context, in *AddPupilResourcesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// AddPupils adds the given pupils and returns the ids of the added
 	AddPupils(ctx context.Context, in *AddPupilsRequest, opts ...grpc.CallOption) (*AddPupilsResponse, error)
+	// ArchiveEvent archives the closed event
+	ArchiveEvent(ctx context.Context, in *ArchiveEventRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// ChangePupilClass changes the class of the pupil
 	ChangePupilClass(ctx context.Context, in *ChangePupilClassRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// ChangePupilResources changes the amount of resources brought by the pupil to the event
+	// ChangePupilResources sets the amount of resources brought by the pupil to the event. The event must be open
 	ChangePupilResources(ctx context.Context, in *ChangePupilResourcesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// CreateEvent creates and stores the event
+	// CloseEvent closes the open event. The resources of the closed event can't be changed
+	CloseEvent(ctx context.Context, in *CloseEventRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CorrectResourceEntry voids the weigh-in and records the one holding the corrected amounts instead. The event must
+	// be open
+	CorrectResourceEntry(ctx context.Context, in *CorrectResourceEntryRequest, opts ...grpc.CallOption) (*CorrectResourceEntryResponse, error)
+	// CreateEvent creates and stores the planned event
 	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*CreateEventResponse, error)
+	// CreateResourceType adds the resource type to the catalogue of the resources
+	CreateResourceType(ctx context.Context, in *CreateResourceTypeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// DeleteEvent deletes the event
 	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteResourceType removes the resource type from the catalogue of the resources. The resource type which has
+	// been allowed on the events or brought to them can't be removed
+	DeleteResourceType(ctx context.Context, in *DeleteResourceTypeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// FindAuditEntries returns the records of the changes made by calling the mutating RPCs of the service
+	FindAuditEntries(ctx context.Context, in *FindAuditEntriesRequest, opts ...grpc.CallOption) (*FindAuditEntriesResponse, error)
+	// FindClassLeaderboard returns the classes ranked by the points they scored at the events of the period, along with
+	// their ranks in the previous period of the same length
+	FindClassLeaderboard(ctx context.Context, in *FindClassLeaderboardRequest, opts ...grpc.CallOption) (*FindClassLeaderboardResponse, error)
 	// FindClasses returns a list of sorted classes, each of which has a list of events that passed the given filters
 	FindClasses(ctx context.Context, in *FindClassesRequest, opts ...grpc.CallOption) (*FindClassesResponse, error)
 	// FindEvents returns a list of sorted events that passed the provided filters
@@ -2361,10 +5309,40 @@ type EventsServiceClient interface {
 	FindEventPupilByID(ctx context.Context, in *FindEventPupilByIDRequest, opts ...grpc.CallOption) (*FindEventPupilByIDResponse, error)
 	// FindPupilByID returns a pupil with the given ID and a list of events they has attended
 	FindPupilByID(ctx context.Context, in *FindPupilByIDRequest, opts ...grpc.CallOption) (*FindPupilByIDResponse, error)
+	// FindPupilLeaderboard returns the pupils ranked by the points they scored at the events of the period, along with
+	// their ranks in the previous period of the same length. The pupils are ranked among all the pupils, but only the
+	// ones the user has access to are returned
+	FindPupilLeaderboard(ctx context.Context, in *FindPupilLeaderboardRequest, opts ...grpc.CallOption) (*FindPupilLeaderboardResponse, error)
 	// FindPupils returns a list of sorted classes, each of which has a list of events that passed the given filters
 	FindPupils(ctx context.Context, in *FindPupilsRequest, opts ...grpc.CallOption) (*FindPupilsResponse, error)
+	// FindResourceEntries returns the weigh-ins of the resources brought by the pupil to the event in the order they
+	// were recorded, including the voided ones
+	FindResourceEntries(ctx context.Context, in *FindResourceEntriesRequest, opts ...grpc.CallOption) (*FindResourceEntriesResponse, error)
+	// FindResourceTypes returns the catalogue of the resources which can be allowed on the events
+	FindResourceTypes(ctx context.Context, in *FindResourceTypesRequest, opts ...grpc.CallOption) (*FindResourceTypesResponse, error)
+	// OpenEvent opens the planned event, so that the resources can be brought to it
+	OpenEvent(ctx context.Context, in *OpenEventRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// RemovePupils removes the pupils with the given IDs
 	RemovePupils(ctx context.Context, in *RemovePupilsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// SetEventMultiplier sets the multiplier of the points scored at the event which isn't closed yet
+	SetEventMultiplier(ctx context.Context, in *SetEventMultiplierRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// StreamEventPupils streams all the sorted pupils with the resources they brought to the specified event, one
+	// pupil per message. Unlike FindEventPupils, it isn't limited by the max amount, so that the pupils can be
+	// exported. The REST gateway responds with newline-delimited JSON
+	StreamEventPupils(ctx context.Context, in *StreamEventPupilsRequest, opts ...grpc.CallOption) (EventsService_StreamEventPupilsClient, error)
+	// StreamPupils streams all the sorted pupils, each of which has a list of events that passed the given filters,
+	// one pupil per message. Unlike FindPupils, it isn't limited by the max amount, so that the pupils can be
+	// exported. The REST gateway responds with newline-delimited JSON
+	StreamPupils(ctx context.Context, in *StreamPupilsRequest, opts ...grpc.CallOption) (EventsService_StreamPupilsClient, error)
+	// UpdateEvent changes the date, the name and the allowed resources of the event which isn't closed yet.
+	// The resources which have already been brought to the event can't be disallowed
+	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*UpdateEventResponse, error)
+	// UpdateResourceType changes the unit and the points of the resource type. The unit of the resource type which
+	// has been brought to the events can't be changed
+	UpdateResourceType(ctx context.Context, in *UpdateResourceTypeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// VoidResourceEntry voids the weigh-in, so that it doesn't count towards the resources brought by the pupil.
+	// The event must be open
+	VoidResourceEntry(ctx context.Context, in *VoidResourceEntryRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type eventsServiceClient struct {
@@ -2375,6 +5353,15 @@ func NewEventsServiceClient(cc grpc.ClientConnInterface) EventsServiceClient {
 	return &eventsServiceClient{cc}
 }
 
+func (c *eventsServiceClient) AddPupilResources(ctx context.Context, in *AddPupilResourcesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/AddPupilResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventsServiceClient) AddPupils(ctx context.Context, in *AddPupilsRequest, opts ...grpc.CallOption) (*AddPupilsResponse, error) {
 	out := new(AddPupilsResponse)
 	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/AddPupils", in, out, opts...)
@@ -2384,6 +5371,15 @@ func (c *eventsServiceClient) AddPupils(ctx context.Context, in *AddPupilsReques
 	return out, nil
 }
 
+func (c *eventsServiceClient) ArchiveEvent(ctx context.Context, in *ArchiveEventRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/ArchiveEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventsServiceClient) ChangePupilClass(ctx context.Context, in *ChangePupilClassRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/ChangePupilClass", in, out, opts...)
@@ -2402,6 +5398,24 @@ func (c *eventsServiceClient) ChangePupilResources(ctx context.Context, in *Chan
 	return out, nil
 }
 
+func (c *eventsServiceClient) CloseEvent(ctx context.Context, in *CloseEventRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/CloseEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) CorrectResourceEntry(ctx context.Context, in *CorrectResourceEntryRequest, opts ...grpc.CallOption) (*CorrectResourceEntryResponse, error) {
+	out := new(CorrectResourceEntryResponse)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/CorrectResourceEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventsServiceClient) CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*CreateEventResponse, error) {
 	out := new(CreateEventResponse)
 	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/CreateEvent", in, out, opts...)
@@ -2411,6 +5425,15 @@ func (c *eventsServiceClient) CreateEvent(ctx context.Context, in *CreateEventRe
 	return out, nil
 }
 
+func (c *eventsServiceClient) CreateResourceType(ctx context.Context, in *CreateResourceTypeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/CreateResourceType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventsServiceClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/DeleteEvent", in, out, opts...)
@@ -2420,6 +5443,33 @@ func (c *eventsServiceClient) DeleteEvent(ctx context.Context, in *DeleteEventRe
 	return out, nil
 }
 
+func (c *eventsServiceClient) DeleteResourceType(ctx context.Context, in *DeleteResourceTypeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/DeleteResourceType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) FindAuditEntries(ctx context.Context, in *FindAuditEntriesRequest, opts ...grpc.CallOption) (*FindAuditEntriesResponse, error) {
+	out := new(FindAuditEntriesResponse)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/FindAuditEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) FindClassLeaderboard(ctx context.Context, in *FindClassLeaderboardRequest, opts ...grpc.CallOption) (*FindClassLeaderboardResponse, error) {
+	out := new(FindClassLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/FindClassLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventsServiceClient) FindClasses(ctx context.Context, in *FindClassesRequest, opts ...grpc.CallOption) (*FindClassesResponse, error) {
 	out := new(FindClassesResponse)
 	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/FindClasses", in, out, opts...)
@@ -2483,6 +5533,15 @@ func (c *eventsServiceClient) FindPupilByID(ctx context.Context, in *FindPupilBy
 	return out, nil
 }
 
+func (c *eventsServiceClient) FindPupilLeaderboard(ctx context.Context, in *FindPupilLeaderboardRequest, opts ...grpc.CallOption) (*FindPupilLeaderboardResponse, error) {
+	out := new(FindPupilLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/FindPupilLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventsServiceClient) FindPupils(ctx context.Context, in *FindPupilsRequest, opts ...grpc.CallOption) (*FindPupilsResponse, error) {
 	out := new(FindPupilsResponse)
 	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/FindPupils", in, out, opts...)
@@ -2492,6 +5551,33 @@ func (c *eventsServiceClient) FindPupils(ctx context.Context, in *FindPupilsRequ
 	return out, nil
 }
 
+func (c *eventsServiceClient) FindResourceEntries(ctx context.Context, in *FindResourceEntriesRequest, opts ...grpc.CallOption) (*FindResourceEntriesResponse, error) {
+	out := new(FindResourceEntriesResponse)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/FindResourceEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) FindResourceTypes(ctx context.Context, in *FindResourceTypesRequest, opts ...grpc.CallOption) (*FindResourceTypesResponse, error) {
+	out := new(FindResourceTypesResponse)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/FindResourceTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) OpenEvent(ctx context.Context, in *OpenEventRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/OpenEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventsServiceClient) RemovePupils(ctx context.Context, in *RemovePupilsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/RemovePupils", in, out, opts...)
@@ -2501,18 +5587,139 @@ func (c *eventsServiceClient) RemovePupils(ctx context.Context, in *RemovePupils
 	return out, nil
 }
 
+func (c *eventsServiceClient) SetEventMultiplier(ctx context.Context, in *SetEventMultiplierRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/SetEventMultiplier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) StreamEventPupils(ctx context.Context, in *StreamEventPupilsRequest, opts ...grpc.CallOption) (EventsService_StreamEventPupilsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EventsService_serviceDesc.Streams[0], "/shanvl.garbage.events.v1.EventsService/StreamEventPupils", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventsServiceStreamEventPupilsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EventsService_StreamEventPupilsClient interface {
+	Recv() (*StreamEventPupilsResponse, error)
+	grpc.ClientStream
+}
+
+type eventsServiceStreamEventPupilsClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventsServiceStreamEventPupilsClient) Recv() (*StreamEventPupilsResponse, error) {
+	m := new(StreamEventPupilsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *eventsServiceClient) StreamPupils(ctx context.Context, in *StreamPupilsRequest, opts ...grpc.CallOption) (EventsService_StreamPupilsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EventsService_serviceDesc.Streams[1], "/shanvl.garbage.events.v1.EventsService/StreamPupils", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventsServiceStreamPupilsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EventsService_StreamPupilsClient interface {
+	Recv() (*StreamPupilsResponse, error)
+	grpc.ClientStream
+}
+
+type eventsServiceStreamPupilsClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventsServiceStreamPupilsClient) Recv() (*StreamPupilsResponse, error) {
+	m := new(StreamPupilsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *eventsServiceClient) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*UpdateEventResponse, error) {
+	out := new(UpdateEventResponse)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/UpdateEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) UpdateResourceType(ctx context.Context, in *UpdateResourceTypeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/UpdateResourceType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) VoidResourceEntry(ctx context.Context, in *VoidResourceEntryRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/shanvl.garbage.events.v1.EventsService/VoidResourceEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventsServiceServer is the server API for EventsService service.
 type EventsServiceServer interface {
+	// AddPupilResources adds the signed amounts to the resources brought by the pupil to the event and records the
+	// weigh-in. Unlike ChangePupilResources, it doesn't overwrite the weigh-ins recorded by others in the meantime.
+	// The event must be open
+	AddPupilResources(context.Context, *AddPupilResourcesRequest) (*empty.Empty, error)
 	// AddPupils adds the given pupils and returns the ids of the added
 	AddPupils(context.Context, *AddPupilsRequest) (*AddPupilsResponse, error)
+	// ArchiveEvent archives the closed event
+	ArchiveEvent(context.Context, *ArchiveEventRequest) (*empty.Empty, error)
 	// ChangePupilClass changes the class of the pupil
 	ChangePupilClass(context.Context, *ChangePupilClassRequest) (*empty.Empty, error)
-	// ChangePupilResources changes the amount of resources brought by the pupil to the event
+	// ChangePupilResources sets the amount of resources brought by the pupil to the event. The event must be open
 	ChangePupilResources(context.Context, *ChangePupilResourcesRequest) (*empty.Empty, error)
-	// CreateEvent creates and stores the event
+	// CloseEvent closes the open event. The resources of the closed event can't be changed
+	CloseEvent(context.Context, *CloseEventRequest) (*empty.Empty, error)
+	// CorrectResourceEntry voids the weigh-in and records the one holding the corrected amounts instead. The event must
+	// be open
+	CorrectResourceEntry(context.Context, *CorrectResourceEntryRequest) (*CorrectResourceEntryResponse, error)
+	// CreateEvent creates and stores the planned event
 	CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error)
+	// CreateResourceType adds the resource type to the catalogue of the resources
+	CreateResourceType(context.Context, *CreateResourceTypeRequest) (*empty.Empty, error)
 	// DeleteEvent deletes the event
 	DeleteEvent(context.Context, *DeleteEventRequest) (*empty.Empty, error)
+	// DeleteResourceType removes the resource type from the catalogue of the resources. The resource type which has
+	// been allowed on the events or brought to them can't be removed
+	DeleteResourceType(context.Context, *DeleteResourceTypeRequest) (*empty.Empty, error)
+	// FindAuditEntries returns the records of the changes made by calling the mutating RPCs of the service
+	FindAuditEntries(context.Context, *FindAuditEntriesRequest) (*FindAuditEntriesResponse, error)
+	// FindClassLeaderboard returns the classes ranked by the points they scored at the events of the period, along with
+	// their ranks in the previous period of the same length
+	FindClassLeaderboard(context.Context, *FindClassLeaderboardRequest) (*FindClassLeaderboardResponse, error)
 	// FindClasses returns a list of sorted classes, each of which has a list of events that passed the given filters
 	FindClasses(context.Context, *FindClassesRequest) (*FindClassesResponse, error)
 	// FindEvents returns a list of sorted events that passed the provided filters
@@ -2527,31 +5734,85 @@ type EventsServiceServer interface {
 	FindEventPupilByID(context.Context, *FindEventPupilByIDRequest) (*FindEventPupilByIDResponse, error)
 	// FindPupilByID returns a pupil with the given ID and a list of events they has attended
 	FindPupilByID(context.Context, *FindPupilByIDRequest) (*FindPupilByIDResponse, error)
+	// FindPupilLeaderboard returns the pupils ranked by the points they scored at the events of the period, along with
+	// their ranks in the previous period of the same length. The pupils are ranked among all the pupils, but only the
+	// ones the user has access to are returned
+	FindPupilLeaderboard(context.Context, *FindPupilLeaderboardRequest) (*FindPupilLeaderboardResponse, error)
 	// FindPupils returns a list of sorted classes, each of which has a list of events that passed the given filters
 	FindPupils(context.Context, *FindPupilsRequest) (*FindPupilsResponse, error)
+	// FindResourceEntries returns the weigh-ins of the resources brought by the pupil to the event in the order they
+	// were recorded, including the voided ones
+	FindResourceEntries(context.Context, *FindResourceEntriesRequest) (*FindResourceEntriesResponse, error)
+	// FindResourceTypes returns the catalogue of the resources which can be allowed on the events
+	FindResourceTypes(context.Context, *FindResourceTypesRequest) (*FindResourceTypesResponse, error)
+	// OpenEvent opens the planned event, so that the resources can be brought to it
+	OpenEvent(context.Context, *OpenEventRequest) (*empty.Empty, error)
 	// RemovePupils removes the pupils with the given IDs
 	RemovePupils(context.Context, *RemovePupilsRequest) (*empty.Empty, error)
+	// SetEventMultiplier sets the multiplier of the points scored at the event which isn't closed yet
+	SetEventMultiplier(context.Context, *SetEventMultiplierRequest) (*empty.Empty, error)
+	// StreamEventPupils streams all the sorted pupils with the resources they brought to the specified event, one
+	// pupil per message. Unlike FindEventPupils, it isn't limited by the max amount, so that the pupils can be
+	// exported. The REST gateway responds with newline-delimited JSON
+	StreamEventPupils(*StreamEventPupilsRequest, EventsService_StreamEventPupilsServer) error
+	// StreamPupils streams all the sorted pupils, each of which has a list of events that passed the given filters,
+	// one pupil per message. Unlike FindPupils, it isn't limited by the max amount, so that the pupils can be
+	// exported. The REST gateway responds with newline-delimited JSON
+	StreamPupils(*StreamPupilsRequest, EventsService_StreamPupilsServer) error
+	// UpdateEvent changes the date, the name and the allowed resources of the event which isn't closed yet.
+	// The resources which have already been brought to the event can't be disallowed
+	UpdateEvent(context.Context, *UpdateEventRequest) (*UpdateEventResponse, error)
+	// UpdateResourceType changes the unit and the points of the resource type. The unit of the resource type which
+	// has been brought to the events can't be changed
+	UpdateResourceType(context.Context, *UpdateResourceTypeRequest) (*empty.Empty, error)
+	// VoidResourceEntry voids the weigh-in, so that it doesn't count towards the resources brought by the pupil.
+	// The event must be open
+	VoidResourceEntry(context.Context, *VoidResourceEntryRequest) (*empty.Empty, error)
 }
 
 // UnimplementedEventsServiceServer can be embedded to have forward compatible implementations.
 type UnimplementedEventsServiceServer struct {
 }
 
+func (*UnimplementedEventsServiceServer) AddPupilResources(context.Context, *AddPupilResourcesRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPupilResources not implemented")
+}
 func (*UnimplementedEventsServiceServer) AddPupils(context.Context, *AddPupilsRequest) (*AddPupilsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPupils not implemented")
 }
+func (*UnimplementedEventsServiceServer) ArchiveEvent(context.Context, *ArchiveEventRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveEvent not implemented")
+}
 func (*UnimplementedEventsServiceServer) ChangePupilClass(context.Context, *ChangePupilClassRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePupilClass not implemented")
 }
 func (*UnimplementedEventsServiceServer) ChangePupilResources(context.Context, *ChangePupilResourcesRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePupilResources not implemented")
 }
+func (*UnimplementedEventsServiceServer) CloseEvent(context.Context, *CloseEventRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseEvent not implemented")
+}
+func (*UnimplementedEventsServiceServer) CorrectResourceEntry(context.Context, *CorrectResourceEntryRequest) (*CorrectResourceEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CorrectResourceEntry not implemented")
+}
 func (*UnimplementedEventsServiceServer) CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
 }
+func (*UnimplementedEventsServiceServer) CreateResourceType(context.Context, *CreateResourceTypeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateResourceType not implemented")
+}
 func (*UnimplementedEventsServiceServer) DeleteEvent(context.Context, *DeleteEventRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
 }
+func (*UnimplementedEventsServiceServer) DeleteResourceType(context.Context, *DeleteResourceTypeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteResourceType not implemented")
+}
+func (*UnimplementedEventsServiceServer) FindAuditEntries(context.Context, *FindAuditEntriesRequest) (*FindAuditEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAuditEntries not implemented")
+}
+func (*UnimplementedEventsServiceServer) FindClassLeaderboard(context.Context, *FindClassLeaderboardRequest) (*FindClassLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindClassLeaderboard not implemented")
+}
 func (*UnimplementedEventsServiceServer) FindClasses(context.Context, *FindClassesRequest) (*FindClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindClasses not implemented")
 }
@@ -2573,17 +5834,65 @@ func (*UnimplementedEventsServiceServer) FindEventPupilByID(context.Context, *Fi
 func (*UnimplementedEventsServiceServer) FindPupilByID(context.Context, *FindPupilByIDRequest) (*FindPupilByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindPupilByID not implemented")
 }
+func (*UnimplementedEventsServiceServer) FindPupilLeaderboard(context.Context, *FindPupilLeaderboardRequest) (*FindPupilLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPupilLeaderboard not implemented")
+}
 func (*UnimplementedEventsServiceServer) FindPupils(context.Context, *FindPupilsRequest) (*FindPupilsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindPupils not implemented")
 }
+func (*UnimplementedEventsServiceServer) FindResourceEntries(context.Context, *FindResourceEntriesRequest) (*FindResourceEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindResourceEntries not implemented")
+}
+func (*UnimplementedEventsServiceServer) FindResourceTypes(context.Context, *FindResourceTypesRequest) (*FindResourceTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindResourceTypes not implemented")
+}
+func (*UnimplementedEventsServiceServer) OpenEvent(context.Context, *OpenEventRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenEvent not implemented")
+}
 func (*UnimplementedEventsServiceServer) RemovePupils(context.Context, *RemovePupilsRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemovePupils not implemented")
 }
+func (*UnimplementedEventsServiceServer) SetEventMultiplier(context.Context, *SetEventMultiplierRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEventMultiplier not implemented")
+}
+func (*UnimplementedEventsServiceServer) StreamEventPupils(*StreamEventPupilsRequest, EventsService_StreamEventPupilsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEventPupils not implemented")
+}
+func (*UnimplementedEventsServiceServer) StreamPupils(*StreamPupilsRequest, EventsService_StreamPupilsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPupils not implemented")
+}
+func (*UnimplementedEventsServiceServer) UpdateEvent(context.Context, *UpdateEventRequest) (*UpdateEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEvent not implemented")
+}
+func (*UnimplementedEventsServiceServer) UpdateResourceType(context.Context, *UpdateResourceTypeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateResourceType not implemented")
+}
+func (*UnimplementedEventsServiceServer) VoidResourceEntry(context.Context, *VoidResourceEntryRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidResourceEntry not implemented")
+}
 
 func RegisterEventsServiceServer(s *grpc.Server, srv EventsServiceServer) {
 	s.RegisterService(&_EventsService_serviceDesc, srv)
 }
 
+func _EventsService_AddPupilResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPupilResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).AddPupilResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/AddPupilResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).AddPupilResources(ctx, req.(*AddPupilResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventsService_AddPupils_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddPupilsRequest)
 	if err := dec(in); err != nil {
@@ -2602,6 +5911,24 @@ func _EventsService_AddPupils_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventsService_ArchiveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).ArchiveEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/ArchiveEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).ArchiveEvent(ctx, req.(*ArchiveEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventsService_ChangePupilClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChangePupilClassRequest)
 	if err := dec(in); err != nil {
@@ -2638,6 +5965,42 @@ func _EventsService_ChangePupilResources_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventsService_CloseEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).CloseEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/CloseEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).CloseEvent(ctx, req.(*CloseEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_CorrectResourceEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrectResourceEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).CorrectResourceEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/CorrectResourceEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).CorrectResourceEntry(ctx, req.(*CorrectResourceEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventsService_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateEventRequest)
 	if err := dec(in); err != nil {
@@ -2656,6 +6019,24 @@ func _EventsService_CreateEvent_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventsService_CreateResourceType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateResourceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).CreateResourceType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/CreateResourceType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).CreateResourceType(ctx, req.(*CreateResourceTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventsService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteEventRequest)
 	if err := dec(in); err != nil {
@@ -2674,6 +6055,60 @@ func _EventsService_DeleteEvent_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventsService_DeleteResourceType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResourceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).DeleteResourceType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/DeleteResourceType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).DeleteResourceType(ctx, req.(*DeleteResourceTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_FindAuditEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAuditEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).FindAuditEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/FindAuditEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).FindAuditEntries(ctx, req.(*FindAuditEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_FindClassLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindClassLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).FindClassLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/FindClassLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).FindClassLeaderboard(ctx, req.(*FindClassLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventsService_FindClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindClassesRequest)
 	if err := dec(in); err != nil {
@@ -2800,6 +6235,24 @@ func _EventsService_FindPupilByID_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventsService_FindPupilLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPupilLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).FindPupilLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/FindPupilLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).FindPupilLeaderboard(ctx, req.(*FindPupilLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventsService_FindPupils_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindPupilsRequest)
 	if err := dec(in); err != nil {
@@ -2818,6 +6271,60 @@ func _EventsService_FindPupils_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventsService_FindResourceEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindResourceEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).FindResourceEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/FindResourceEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).FindResourceEntries(ctx, req.(*FindResourceEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_FindResourceTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindResourceTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).FindResourceTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/FindResourceTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).FindResourceTypes(ctx, req.(*FindResourceTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_OpenEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).OpenEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/OpenEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).OpenEvent(ctx, req.(*OpenEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventsService_RemovePupils_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemovePupilsRequest)
 	if err := dec(in); err != nil {
@@ -2836,14 +6343,136 @@ func _EventsService_RemovePupils_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventsService_SetEventMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEventMultiplierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).SetEventMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/SetEventMultiplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).SetEventMultiplier(ctx, req.(*SetEventMultiplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_StreamEventPupils_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventPupilsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventsServiceServer).StreamEventPupils(m, &eventsServiceStreamEventPupilsServer{stream})
+}
+
+type EventsService_StreamEventPupilsServer interface {
+	Send(*StreamEventPupilsResponse) error
+	grpc.ServerStream
+}
+
+type eventsServiceStreamEventPupilsServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventsServiceStreamEventPupilsServer) Send(m *StreamEventPupilsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EventsService_StreamPupils_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPupilsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventsServiceServer).StreamPupils(m, &eventsServiceStreamPupilsServer{stream})
+}
+
+type EventsService_StreamPupilsServer interface {
+	Send(*StreamPupilsResponse) error
+	grpc.ServerStream
+}
+
+type eventsServiceStreamPupilsServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventsServiceStreamPupilsServer) Send(m *StreamPupilsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EventsService_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).UpdateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/UpdateEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).UpdateEvent(ctx, req.(*UpdateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_UpdateResourceType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateResourceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).UpdateResourceType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/UpdateResourceType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).UpdateResourceType(ctx, req.(*UpdateResourceTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_VoidResourceEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidResourceEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).VoidResourceEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shanvl.garbage.events.v1.EventsService/VoidResourceEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).VoidResourceEntry(ctx, req.(*VoidResourceEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _EventsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "shanvl.garbage.events.v1.EventsService",
 	HandlerType: (*EventsServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddPupilResources",
+			Handler:    _EventsService_AddPupilResources_Handler,
+		},
 		{
 			MethodName: "AddPupils",
 			Handler:    _EventsService_AddPupils_Handler,
 		},
+		{
+			MethodName: "ArchiveEvent",
+			Handler:    _EventsService_ArchiveEvent_Handler,
+		},
 		{
 			MethodName: "ChangePupilClass",
 			Handler:    _EventsService_ChangePupilClass_Handler,
@@ -2852,14 +6481,38 @@ var _EventsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangePupilResources",
 			Handler:    _EventsService_ChangePupilResources_Handler,
 		},
+		{
+			MethodName: "CloseEvent",
+			Handler:    _EventsService_CloseEvent_Handler,
+		},
+		{
+			MethodName: "CorrectResourceEntry",
+			Handler:    _EventsService_CorrectResourceEntry_Handler,
+		},
 		{
 			MethodName: "CreateEvent",
 			Handler:    _EventsService_CreateEvent_Handler,
 		},
+		{
+			MethodName: "CreateResourceType",
+			Handler:    _EventsService_CreateResourceType_Handler,
+		},
 		{
 			MethodName: "DeleteEvent",
 			Handler:    _EventsService_DeleteEvent_Handler,
 		},
+		{
+			MethodName: "DeleteResourceType",
+			Handler:    _EventsService_DeleteResourceType_Handler,
+		},
+		{
+			MethodName: "FindAuditEntries",
+			Handler:    _EventsService_FindAuditEntries_Handler,
+		},
+		{
+			MethodName: "FindClassLeaderboard",
+			Handler:    _EventsService_FindClassLeaderboard_Handler,
+		},
 		{
 			MethodName: "FindClasses",
 			Handler:    _EventsService_FindClasses_Handler,
@@ -2888,15 +6541,58 @@ var _EventsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FindPupilByID",
 			Handler:    _EventsService_FindPupilByID_Handler,
 		},
+		{
+			MethodName: "FindPupilLeaderboard",
+			Handler:    _EventsService_FindPupilLeaderboard_Handler,
+		},
 		{
 			MethodName: "FindPupils",
 			Handler:    _EventsService_FindPupils_Handler,
 		},
+		{
+			MethodName: "FindResourceEntries",
+			Handler:    _EventsService_FindResourceEntries_Handler,
+		},
+		{
+			MethodName: "FindResourceTypes",
+			Handler:    _EventsService_FindResourceTypes_Handler,
+		},
+		{
+			MethodName: "OpenEvent",
+			Handler:    _EventsService_OpenEvent_Handler,
+		},
 		{
 			MethodName: "RemovePupils",
 			Handler:    _EventsService_RemovePupils_Handler,
 		},
+		{
+			MethodName: "SetEventMultiplier",
+			Handler:    _EventsService_SetEventMultiplier_Handler,
+		},
+		{
+			MethodName: "UpdateEvent",
+			Handler:    _EventsService_UpdateEvent_Handler,
+		},
+		{
+			MethodName: "UpdateResourceType",
+			Handler:    _EventsService_UpdateResourceType_Handler,
+		},
+		{
+			MethodName: "VoidResourceEntry",
+			Handler:    _EventsService_VoidResourceEntry_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEventPupils",
+			Handler:       _EventsService_StreamEventPupils_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPupils",
+			Handler:       _EventsService_StreamPupils_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "events_service.proto",
 }
@@ -6,20 +6,29 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
 	"time"
 
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/log/zapadapter"
+	notificationsv1pb "github.com/shanvl/garbage/api/notifications/v1/pb"
 	"github.com/shanvl/garbage/internal/authsvc/authent"
 	"github.com/shanvl/garbage/internal/authsvc/authoriz"
 	"github.com/shanvl/garbage/internal/authsvc/grpc"
 	"github.com/shanvl/garbage/internal/authsvc/jwt"
+	"github.com/shanvl/garbage/internal/authsvc/mail"
 	"github.com/shanvl/garbage/internal/authsvc/postgres"
 	"github.com/shanvl/garbage/internal/authsvc/rest"
 	"github.com/shanvl/garbage/internal/authsvc/users"
+	"github.com/shanvl/garbage/pkg/audit"
+	"github.com/shanvl/garbage/pkg/broker"
 	"github.com/shanvl/garbage/pkg/env"
+	"github.com/shanvl/garbage/pkg/svcauth"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	goGRPC "google.golang.org/grpc"
 )
 
 func main() {
@@ -65,30 +74,69 @@ func main() {
 	}
 
 	// create repos
+	auditRepo := audit.NewPostgresRepo(postgresPool)
 	authentRepo := postgres.NewAuthentRepo(postgresPool)
 	usersRepo := postgres.NewUsersRepo(postgresPool)
+	// revocations are looked up on every request to a protected RPC, so they are cached for a short time
+	authorizRepo := authoriz.NewCachedRepository(postgres.NewAuthorizRepo(postgresPool),
+		env.Duration("REVOCATION_CACHE_TTL", 5*time.Second))
+	// store the default policies on the first start. The policies changed later are left intact
+	if err := authorizRepo.SeedPolicies(context.Background(), authoriz.ProtectedRPCMap()); err != nil {
+		logger.Fatal("couldn't seed policies", zap.Error(err), zap.String("protocol", "postgres"))
+	}
 
-	// get private and public keys for the token manager
-	privateKeyPath := env.String("TOKEN_PRIVATE_KEY_PATH", "./internal/authsvc/jwt/keys/test.rsa")
-	publicKeyPath := env.String("TOKEN_PUBLIC_KEY_PATH", "./internal/authsvc/jwt/keys_test/test.rsa.pub")
-	privateKey, publicKey, err := jwt.KeysFromFiles(privateKeyPath, publicKeyPath)
+	// load the keys for the token manager. The newest key signs the tokens, the others only verify them
+	keysDir := env.String("TOKEN_KEYS_DIR", "./internal/authsvc/jwt/keys_test")
+	keySet, err := jwt.KeySetFromDir(keysDir)
 	if err != nil {
-		logger.Fatal("couldn't load keys for the token manager", zap.Error(err))
+		logger.Fatal("couldn't load keys for the token manager", zap.Error(err), zap.String("dir", keysDir))
 	}
+	// the keys are rotated by changing the contents of the dir and sending SIGHUP
+	go func() {
+		hupCh := make(chan os.Signal, 1)
+		signal.Notify(hupCh, syscall.SIGHUP)
+		for range hupCh {
+			if err := keySet.Reload(); err != nil {
+				logger.Error("couldn't reload keys for the token manager", zap.Error(err), zap.String("dir",
+					keysDir))
+				continue
+			}
+			kid, _ := keySet.ActiveKey()
+			logger.Info("keys for the token manager have been reloaded", zap.String("active_kid", kid))
+		}
+	}()
 	// get tokens duration
 	accessTokenDuration := env.Duration("ACCESS_TOKEN_DURATION", 30*time.Minute)
 	refreshTokenDuration := env.Duration("REFRESH_TOKEN_DURATION", 720*time.Hour)
 
 	// create services
-	tokenManager := jwt.NewManagerRSA(accessTokenDuration, refreshTokenDuration, privateKey, publicKey)
-	authentSvc := authent.NewService(authentRepo, tokenManager)
-	authorizSvc := authoriz.NewService(tokenManager, authoriz.ProtectedRPCMap())
-	usersSvc := users.NewService(usersRepo)
+	tokenManager := jwt.NewManagerRSA(accessTokenDuration, refreshTokenDuration, keySet)
+	auditSvc := audit.NewService(auditRepo)
+	// the revocations go through the cache of authorizRepo, so that they take effect here without waiting for it
+	authentSvc := authent.NewService(authent.WithRevocationStore(authentRepo, authorizRepo), tokenManager)
+	authorizSvc := authoriz.NewService(authorizRepo, tokenManager, authoriz.ProtectedRPCMap(),
+		env.Duration("POLICY_CACHE_TTL", 30*time.Second))
+	usersSvc := users.NewService(users.WithRevocationStore(usersRepo, authorizRepo), newMailer())
+
+	// the services authenticate each other with the shared token when they call the internal RPCs
+	serviceToken := env.String("SERVICE_TOKEN", "")
+
+	// domain events are published to the notification service if its address is provided
+	publisher := broker.NewNopPublisher()
+	if notifSrvAddr := env.String("GRPC_NOTIFICATIONS_SERVICE_ADDR", ""); notifSrvAddr != "" {
+		cc, err := goGRPC.Dial(notifSrvAddr, goGRPC.WithInsecure(),
+			goGRPC.WithPerRPCCredentials(svcauth.NewCredentials(serviceToken)))
+		if err != nil {
+			logger.Fatal("notification server connection error", zap.Error(err), zap.String("addr", notifSrvAddr))
+		}
+		notifSvcTimeout := env.Duration("GRPC_NOTIFICATIONS_SERVICE_TIMEOUT", 500*time.Millisecond)
+		publisher = broker.NewGRPCPublisher(notificationsv1pb.NewNotificationsServiceClient(cc), notifSvcTimeout)
+	}
 
 	grpcPort, restPort := env.Int("GRPC_PORT", 0), env.Int("REST_PORT", 0)
 	// run REST gateway
 	go func() {
-		if err := rest.NewServer(logger).Run(restPort, fmt.Sprintf(":%d", grpcPort)); err != nil && !errors.Is(err,
+		if err := rest.NewServer(keySet, logger).Run(restPort, fmt.Sprintf(":%d", grpcPort)); err != nil && !errors.Is(err,
 			http.ErrServerClosed) {
 
 			logger.Fatal("REST gateway error",
@@ -99,7 +147,8 @@ func main() {
 		}
 	}()
 	// run gRPC server
-	if err := grpc.NewServer(authentSvc, authorizSvc, usersSvc, logger).Run(grpcPort); err != nil {
+	if err := grpc.NewServer(auditSvc, authentSvc, authorizSvc, usersSvc, publisher, serviceToken,
+		logger).Run(grpcPort); err != nil {
 		logger.Fatal("gRPC server error",
 			zap.Error(err),
 			zap.Int("port", grpcPort),
@@ -107,3 +156,28 @@ func main() {
 		)
 	}
 }
+
+// newMailer returns a mailer which sends the emails through the smtp server if its host is provided.
+// Otherwise, the emails are dropped into a directory
+func newMailer() users.Mailer {
+	from := env.String("SMTP_FROM", "noreply@garbage.local")
+	activationURL := env.String("MAIL_ACTIVATION_URL", "http://localhost/activate")
+	passwordResetURL := env.String("MAIL_PASSWORD_RESET_URL", "http://localhost/reset-password")
+	if smtpHost := env.String("SMTP_HOST", ""); smtpHost != "" {
+		return mail.NewSMTP(mail.SMTPConfig{
+			Host:             smtpHost,
+			Port:             env.Int("SMTP_PORT", 25),
+			User:             env.String("SMTP_USER", ""),
+			Password:         env.String("SMTP_PASSWORD", ""),
+			From:             from,
+			ActivationURL:    activationURL,
+			PasswordResetURL: passwordResetURL,
+		})
+	}
+	return mail.NewFile(mail.FileConfig{
+		Dir:              env.String("MAIL_DIR", filepath.Join(os.TempDir(), "garbage-mail")),
+		From:             from,
+		ActivationURL:    activationURL,
+		PasswordResetURL: passwordResetURL,
+	})
+}
@@ -4,20 +4,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"time"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/log/zapadapter"
 	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
+	notificationsv1pb "github.com/shanvl/garbage/api/notifications/v1/pb"
 	"github.com/shanvl/garbage/internal/eventsvc/aggregating"
+	"github.com/shanvl/garbage/internal/eventsvc/cataloguing"
 	"github.com/shanvl/garbage/internal/eventsvc/eventing"
 	"github.com/shanvl/garbage/internal/eventsvc/grpc"
 	"github.com/shanvl/garbage/internal/eventsvc/postgres"
 	"github.com/shanvl/garbage/internal/eventsvc/rest"
 	"github.com/shanvl/garbage/internal/eventsvc/schooling"
+	"github.com/shanvl/garbage/internal/eventsvc/scoring"
+	"github.com/shanvl/garbage/pkg/audit"
+	"github.com/shanvl/garbage/pkg/broker"
 	"github.com/shanvl/garbage/pkg/env"
+	"github.com/shanvl/garbage/pkg/jwks"
+	"github.com/shanvl/garbage/pkg/svcauth"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	goGRPC "google.golang.org/grpc"
@@ -67,13 +76,19 @@ func main() {
 
 	// create repos
 	aggregatingRepo := postgres.NewAggregatingRepo(postgresPool)
+	auditRepo := audit.NewPostgresRepo(postgresPool)
+	cataloguingRepo := postgres.NewCataloguingRepo(postgresPool)
 	eventingRepo := postgres.NewEventingRepo(postgresPool)
 	schoolingRepo := postgres.NewSchoolingRepo(postgresPool)
+	scoringRepo := postgres.NewScoringRepo(postgresPool)
 
 	// get conn to auth server and create its client
 	// TODO: remove fallback
 	authSrvAddr := env.String("GRPC_AUTH_SERVICE_ADDR", "")
-	cc, err := goGRPC.Dial(authSrvAddr, goGRPC.WithInsecure())
+	// the internal RPCs of the auth service, which local authorization uses, require the service token
+	serviceToken := env.String("SERVICE_TOKEN", "")
+	cc, err := goGRPC.Dial(authSrvAddr, goGRPC.WithInsecure(),
+		goGRPC.WithPerRPCCredentials(svcauth.NewCredentials(serviceToken)))
 	if err != nil {
 		logger.Fatal("auth server connection error", zap.Error(err), zap.String("addr", authSrvAddr))
 	}
@@ -81,10 +96,33 @@ func main() {
 
 	// create services
 	authSvcTimeout := env.Duration("GRPC_AUTH_SERVICE_TIMEOUT", 500*time.Millisecond)
-	authorizationService := grpc.NewAuthService(authClient, authSvcTimeout)
+	authorizationService := newAuthorizationService(authClient, authSvcTimeout, logger)
 	aggregatingService := aggregating.NewService(aggregatingRepo)
+	auditService := audit.NewService(auditRepo)
+	cataloguingService := cataloguing.NewService(cataloguingRepo)
 	eventingService := eventing.NewService(eventingRepo)
 	schoolingService := schooling.NewService(schoolingRepo)
+	scoringService := scoring.NewService(scoringRepo)
+
+	// relay the messages stored in the outbox to the notifications service. If its address isn't set, the messages
+	// are kept in the outbox until it is
+	if notifSrvAddr := env.String("GRPC_NOTIFICATIONS_SERVICE_ADDR", ""); notifSrvAddr != "" {
+		cc, err := goGRPC.Dial(notifSrvAddr, goGRPC.WithInsecure(),
+			goGRPC.WithPerRPCCredentials(svcauth.NewCredentials(serviceToken)))
+		if err != nil {
+			logger.Fatal("notifications server connection error", zap.Error(err), zap.String("addr", notifSrvAddr))
+		}
+		notifSvcTimeout := env.Duration("GRPC_NOTIFICATIONS_SERVICE_TIMEOUT", 500*time.Millisecond)
+		relay := broker.NewRelay(
+			postgres.NewOutbox(postgresPool),
+			broker.NewGRPCPublisher(notificationsv1pb.NewNotificationsServiceClient(cc), notifSvcTimeout),
+			env.Duration("OUTBOX_RELAY_INTERVAL", time.Second),
+			env.Int("OUTBOX_RELAY_BATCH", 100),
+		)
+		go relay.Run(context.Background(), func(err error) {
+			logger.Error("outbox relay error", zap.Error(err), zap.String("addr", notifSrvAddr))
+		})
+	}
 
 	grpcPort, restPort := env.Int("GRPC_PORT", 0), env.Int("REST_PORT", 0)
 	// run REST gateway
@@ -103,8 +141,11 @@ func main() {
 	if err := grpc.NewServer(
 		authorizationService,
 		aggregatingService,
+		auditService,
+		cataloguingService,
 		eventingService,
 		schoolingService,
+		scoringService,
 		logger,
 	).Run(grpcPort); err != nil {
 
@@ -115,3 +156,42 @@ func main() {
 		)
 	}
 }
+
+// newAuthorizationService returns the authorization service selected by AUTHORIZATION_MODE. In "remote" mode every
+// request is authorized by the auth service. In "local" mode the access tokens are verified with the auth service's
+// public keys, which are taken from AUTH_JWKS_URL or, if it's not set, from AUTH_PUBLIC_KEY_PATH. Local mode caches the
+// protected RPCs and the revocations for AUTH_PROTECTED_RPCS_TTL, so a revoked token keeps working no longer than that
+func newAuthorizationService(authClient authv1pb.AuthServiceClient, timeout time.Duration,
+	logger *zap.Logger) grpc.AuthorizationService {
+
+	mode := env.String("AUTHORIZATION_MODE", "remote")
+	switch mode {
+	case "remote":
+		return grpc.NewAuthService(authClient, timeout)
+	case "local":
+	default:
+		logger.Fatal("unknown authorization mode", zap.String("mode", mode))
+	}
+
+	var keys grpc.KeySource
+	if jwksURL := env.String("AUTH_JWKS_URL", ""); jwksURL != "" {
+		keys = jwks.NewRemoteSet(
+			jwksURL,
+			&http.Client{Timeout: timeout},
+			env.Duration("AUTH_JWKS_MIN_REFRESH_INTERVAL", 10*time.Second),
+			env.Duration("AUTH_JWKS_MAX_AGE", time.Hour),
+		)
+	} else {
+		keyPath := env.String("AUTH_PUBLIC_KEY_PATH", "")
+		b, err := ioutil.ReadFile(keyPath)
+		if err != nil {
+			logger.Fatal("couldn't read auth public key", zap.Error(err), zap.String("path", keyPath))
+		}
+		key, err := jwt.ParseRSAPublicKeyFromPEM(b)
+		if err != nil {
+			logger.Fatal("couldn't parse auth public key", zap.Error(err), zap.String("path", keyPath))
+		}
+		keys = grpc.NewStaticKey(key)
+	}
+	return grpc.NewLocalAuthService(keys, authClient, timeout, env.Duration("AUTH_PROTECTED_RPCS_TTL", time.Minute))
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/log/zapadapter"
+	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
+	"github.com/shanvl/garbage/internal/notifsvc"
+	"github.com/shanvl/garbage/internal/notifsvc/grpc"
+	"github.com/shanvl/garbage/internal/notifsvc/notifying"
+	"github.com/shanvl/garbage/internal/notifsvc/postgres"
+	"github.com/shanvl/garbage/internal/notifsvc/rest"
+	"github.com/shanvl/garbage/internal/notifsvc/sender"
+	"github.com/shanvl/garbage/pkg/broker"
+	"github.com/shanvl/garbage/pkg/env"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	goGRPC "google.golang.org/grpc"
+)
+
+func main() {
+	// create logger
+	loggerConf := zap.NewProductionConfig()
+	loggerConf.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	logger, err := loggerConf.Build()
+	if err != nil {
+		fmt.Println("couldn't initialize the logger")
+		os.Exit(1)
+	}
+	defer logger.Sync()
+
+	// should the db log its actions
+	var dbLogger pgx.Logger = nil
+	if env.Bool("POSTGRES_LOG", false) {
+		dbLogger = zapadapter.NewLogger(logger)
+	}
+	// create postgres connection pool
+	postgresConf := postgres.Config{
+		Database:             env.String("POSTGRES_DB", ""),
+		Host:                 env.String("POSTGRES_HOST", ""),
+		User:                 env.String("POSTGRES_USER", ""),
+		Password:             env.String("POSTGRES_PASSWORD", ""),
+		Port:                 env.Int("POSTGRES_PORT", 0),
+		MaxConns:             env.Int("POSTGRES_MAX_CONN", 25),
+		MaxConnLifetime:      env.Duration("POSTGRES_CON_LIFE", 5*time.Minute),
+		PreferSimpleProtocol: env.Bool("POSTGRES_SIMPLE_PROTOCOL", false),
+		Logger:               dbLogger,
+	}
+	postgresPool, err := postgres.Connect(postgresConf)
+	if err != nil {
+		logger.Fatal("postgres connection error",
+			zap.Error(err),
+			zap.String("protocol", "postgres"),
+			zap.String("addr", fmt.Sprintf("%s:%d", postgresConf.Host, postgresConf.Port)),
+		)
+	}
+	// apply migrations
+	err = postgres.ValidateSchema(context.Background(), postgresPool)
+	if err != nil {
+		logger.Fatal("migrations failed", zap.Error(err), zap.String("protocol", "postgres"))
+	}
+
+	// create repos
+	notifyingRepo := postgres.NewNotifyingRepo(postgresPool)
+
+	// get conn to auth server and create its client
+	authSrvAddr := env.String("GRPC_AUTH_SERVICE_ADDR", "")
+	cc, err := goGRPC.Dial(authSrvAddr, goGRPC.WithInsecure())
+	if err != nil {
+		logger.Fatal("auth server connection error", zap.Error(err), zap.String("addr", authSrvAddr))
+	}
+	authClient := authv1pb.NewAuthServiceClient(cc)
+
+	// create the senders. Log and webhook channels are always available, email requires an smtp server
+	senders := map[notifsvc.Channel]notifying.Sender{
+		notifsvc.Log:     sender.NewLog(logger),
+		notifsvc.Webhook: sender.NewWebhook(env.Duration("WEBHOOK_TIMEOUT", 5*time.Second)),
+	}
+	if smtpHost := env.String("SMTP_HOST", ""); smtpHost != "" {
+		senders[notifsvc.Email] = sender.NewEmail(sender.EmailConfig{
+			Host:     smtpHost,
+			Port:     env.Int("SMTP_PORT", 25),
+			User:     env.String("SMTP_USER", ""),
+			Password: env.String("SMTP_PASSWORD", ""),
+			From:     env.String("SMTP_FROM", ""),
+		})
+	}
+
+	// create services
+	authSvcTimeout := env.Duration("GRPC_AUTH_SERVICE_TIMEOUT", 500*time.Millisecond)
+	authorizationService := grpc.NewAuthService(authClient, authSvcTimeout)
+	notifyingService := notifying.NewService(notifyingRepo, senders)
+
+	// messages published to the service are dispatched by the in-process broker to the notifying service
+	inProcBroker := broker.NewInProc()
+	for _, topic := range broker.Topics() {
+		if err := inProcBroker.Subscribe(topic, notifyingService.HandleMessage); err != nil {
+			logger.Fatal("couldn't subscribe to the topic", zap.Error(err), zap.String("topic", topic))
+		}
+	}
+
+	grpcPort, restPort := env.Int("GRPC_PORT", 0), env.Int("REST_PORT", 0)
+	// run REST gateway
+	go func() {
+		if err := rest.NewServer(logger).Run(restPort, fmt.Sprintf(":%d", grpcPort)); err != nil && !errors.Is(err,
+			http.ErrServerClosed) {
+
+			logger.Fatal("REST gateway error",
+				zap.Error(err),
+				zap.Int("port", restPort),
+				zap.String("protocol", "HTTP"),
+			)
+		}
+	}()
+	// run gRPC server
+	if err := grpc.NewServer(
+		authorizationService,
+		notifyingService,
+		inProcBroker,
+		// the other services authenticate with the shared token when they publish the messages
+		env.String("SERVICE_TOKEN", ""),
+		logger,
+	).Run(grpcPort); err != nil {
+
+		logger.Fatal("gRPC server error",
+			zap.Error(err),
+			zap.Int("port", grpcPort),
+			zap.String("protocol", "gRPC"),
+		)
+	}
+}
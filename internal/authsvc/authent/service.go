@@ -3,6 +3,7 @@ package authent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	gonanoid "github.com/matoous/go-nanoid"
@@ -14,19 +15,51 @@ type Repository interface {
 	ClientByID(ctx context.Context, clientID string) (client Client, err error)
 	DeleteClient(ctx context.Context, clientID string) error
 	DeleteUserClients(ctx context.Context, userID string) error
+	// RevokeClient deletes the client along with its refresh token and records the security event which has caused
+	// the revocation
+	RevokeClient(ctx context.Context, clientID string, event authsvc.SecurityEvent) error
+	// RotateRefreshToken replaces the refresh token of the client with the new one only if it's still the given one.
+	// Otherwise, the token has already been rotated by a concurrent refresh, and authsvc.ErrRefreshTokenReuse is
+	// returned
+	RotateRefreshToken(ctx context.Context, client Client, oldToken string) error
 	StoreClient(ctx context.Context, client Client) error
+	// StoreRevocation stores the revocation. If the subject has already been revoked, the later not-before
+	// timestamp is kept
+	StoreRevocation(ctx context.Context, revocation authsvc.Revocation) error
 	UserByEmail(ctx context.Context, email string) (*authsvc.User, error)
+	// UserScope returns the classes the user is assigned to and the pupils the user is linked to
+	UserScope(ctx context.Context, userID string) (authsvc.Scope, error)
+}
+
+// revocationRepo is a repo which stores the revocations in a separate store
+type revocationRepo struct {
+	Repository
+	store authsvc.RevocationStore
+}
+
+// WithRevocationStore wraps the repo, so that the revocations are stored in the given store instead. It lets the
+// revocations go through the store which evicts the cached not-before timestamps they affect
+func WithRevocationStore(repo Repository, store authsvc.RevocationStore) Repository {
+	return &revocationRepo{repo, store}
+}
+
+// StoreRevocation stores the revocation in the store
+func (r *revocationRepo) StoreRevocation(ctx context.Context, revocation authsvc.Revocation) error {
+	return r.store.StoreRevocation(ctx, revocation)
 }
 
 // Service is responsible for authentication
 type Service interface {
 	// Login generates, saves and returns auth credentials for the user if the given password and the email are correct
 	Login(ctx context.Context, email, password string) (*authsvc.User, AuthCreds, error)
-	// Logout deletes the user's client and refresh token from the db, thus, logging the user out
+	// Logout deletes the user's client and refresh token from the db and revokes the access tokens of the client,
+	// thus, logging the user out
 	Logout(ctx context.Context, clientID string) error
-	// LogoutAllClients deletes all the user's clients and refresh tokens, thus, logging the user out of every device
+	// LogoutAllClients deletes all the user's clients and refresh tokens and revokes their access tokens, thus,
+	// logging the user out of every device
 	LogoutAllClients(ctx context.Context, userID string) error
-	// RefreshTokens verifies the given refresh token and then creates, saves and returns new auth credentials
+	// RefreshTokens verifies the given refresh token and then creates, saves and returns new auth credentials.
+	// If the token has already been rotated, the client is revoked
 	RefreshTokens(ctx context.Context, refreshToken string) (AuthCreds, error)
 }
 
@@ -65,8 +98,13 @@ func (s *service) Login(ctx context.Context, email, password string) (*authsvc.U
 	if !user.IsCorrectPassword(password) {
 		return nil, AuthCreds{}, authsvc.ErrInvalidPassword
 	}
+	// the scope of the user is put into the tokens, so that the other services can restrict the user to it
+	scope, err := s.repo.UserScope(ctx, user.ID)
+	if err != nil {
+		return nil, AuthCreds{}, err
+	}
 	// generate auth credentials
-	creds, err := s.generateAuthCreds(user.ID, user.Role)
+	creds, err := s.generateAuthCreds(user.ID, user.Role, scope)
 	if err != nil {
 		return nil, AuthCreds{}, err
 	}
@@ -83,20 +121,28 @@ func (s *service) Login(ctx context.Context, email, password string) (*authsvc.U
 	return user, creds, nil
 }
 
-// Logout deletes the user's client and refresh token from the db, thus, logging the user out
+// Logout deletes the user's client and refresh token from the db and revokes the access tokens of the client,
+// thus, logging the user out
 func (s *service) Logout(ctx context.Context, clientID string) error {
 	if clientID == "" {
 		return valid.NewError("clientID", "clientID is required")
 	}
-	return s.repo.DeleteClient(ctx, clientID)
+	if err := s.repo.DeleteClient(ctx, clientID); err != nil {
+		return err
+	}
+	return s.revoke(ctx, authsvc.ClientRevocation, clientID)
 }
 
-// LogoutAllClients deletes all the user's clients and refresh tokens, thus, logging the user out of every device
+// LogoutAllClients deletes all the user's clients and refresh tokens and revokes their access tokens, thus,
+// logging the user out of every device
 func (s *service) LogoutAllClients(ctx context.Context, userID string) error {
 	if userID == "" {
 		return valid.NewError("userID", "userID is required")
 	}
-	return s.repo.DeleteUserClients(ctx, userID)
+	if err := s.repo.DeleteUserClients(ctx, userID); err != nil {
+		return err
+	}
+	return s.revoke(ctx, authsvc.UserRevocation, userID)
 }
 
 // RefreshTokens verifies the given refresh token and then creates, saves and returns new auth credentials
@@ -110,31 +156,49 @@ func (s *service) RefreshTokens(ctx context.Context, refreshToken string) (AuthC
 	if err != nil {
 		return AuthCreds{}, fmt.Errorf("%w: %v", authsvc.ErrInvalidRefreshToken, err)
 	}
+	if claims.Type != authsvc.Refresh.String() {
+		return AuthCreds{}, fmt.Errorf("%w: not a refresh token", authsvc.ErrInvalidRefreshToken)
+	}
 	// use the claims to get and compare clientID, userID and refreshToken saved in the db
 	client, err := s.repo.ClientByID(ctx, claims.ClientID)
 	if err != nil {
 		return AuthCreds{}, err
 	}
-	if client.ID != claims.ClientID || client.RefreshToken != refreshToken || client.UserID != claims.Subject {
+	if client.ID != claims.ClientID || client.UserID != claims.Subject {
 		return AuthCreds{}, authsvc.ErrInvalidRefreshToken
 	}
+	// the token has been issued to the client, but it's not the latest one. It means that it has already been rotated
+	// and is being replayed, so the client can't be trusted anymore
+	if client.RefreshToken != refreshToken {
+		return AuthCreds{}, s.revokeClient(ctx, client)
+	}
 	// convert string role from claims to authsvc.Role
 	role, err := authsvc.StringToRole(claims.Role)
 	if err != nil {
 		return AuthCreds{}, err
 	}
+	// the scope is carried over from the refresh token. When it's changed, the user is logged out, so the
+	// refresh token can't have the outdated one
+	scope, err := claims.UserScope()
+	if err != nil {
+		return AuthCreds{}, fmt.Errorf("%w: %v", authsvc.ErrInvalidRefreshToken, err)
+	}
 	// generate new tokens
-	tokens, err := s.generateTokenPair(client.ID, client.UserID, role)
+	tokens, err := s.generateTokenPair(client.ID, client.UserID, role, scope)
 	if err != nil {
 		return AuthCreds{}, err
 	}
-	// store a newly created refresh token along with the clientID and the userID
+	// replace the refresh token with the newly created one. If the token has been replaced in the meantime, a
+	// concurrent refresh has used it first, so it's being reused as well
 	c := Client{
 		ID:           client.ID,
 		UserID:       client.UserID,
 		RefreshToken: tokens.Refresh,
 	}
-	err = s.repo.StoreClient(ctx, c)
+	err = s.repo.RotateRefreshToken(ctx, c, refreshToken)
+	if errors.Is(err, authsvc.ErrRefreshTokenReuse) {
+		return AuthCreds{}, s.revokeClient(ctx, client)
+	}
 	if err != nil {
 		return AuthCreds{}, err
 	}
@@ -144,15 +208,41 @@ func (s *service) RefreshTokens(ctx context.Context, refreshToken string) (AuthC
 	}, nil
 }
 
+// revokeClient deletes the client whose rotated refresh token has been reused, thus, logging out both the legitimate
+// user and the attacker, and records the security event
+func (s *service) revokeClient(ctx context.Context, client Client) error {
+	eventID, err := gonanoid.Nanoid()
+	if err != nil {
+		return fmt.Errorf("security event id generation error: %w", err)
+	}
+	event := authsvc.NewSecurityEvent(eventID, authsvc.RefreshTokenReuse, client.UserID, client.ID)
+	if err := s.repo.RevokeClient(ctx, client.ID, event); err != nil {
+		return err
+	}
+	if err := s.revoke(ctx, authsvc.ClientRevocation, client.ID); err != nil {
+		return err
+	}
+	return authsvc.ErrRefreshTokenReuse
+}
+
+// revoke invalidates the access tokens issued to the client or to the user up to now
+func (s *service) revoke(ctx context.Context, kind authsvc.RevocationKind, subjectID string) error {
+	revocation, err := authsvc.NewRevocation(kind, subjectID)
+	if err != nil {
+		return err
+	}
+	return s.repo.StoreRevocation(ctx, revocation)
+}
+
 // generateAuthCreds creates client id, access token and refresh token
-func (s *service) generateAuthCreds(userID string, role authsvc.Role) (AuthCreds, error) {
+func (s *service) generateAuthCreds(userID string, role authsvc.Role, scope authsvc.Scope) (AuthCreds, error) {
 	// create clientID
 	clientID, err := gonanoid.Nanoid(15)
 	if err != nil {
 		return AuthCreds{}, fmt.Errorf("client id generation error: %w", err)
 	}
 	// create access and refresh tokens
-	tokens, err := s.generateTokenPair(clientID, userID, role)
+	tokens, err := s.generateTokenPair(clientID, userID, role, scope)
 	if err != nil {
 		return AuthCreds{}, err
 	}
@@ -163,12 +253,14 @@ func (s *service) generateAuthCreds(userID string, role authsvc.Role) (AuthCreds
 }
 
 // generateTokenPair generates access and refresh tokens
-func (s *service) generateTokenPair(clientID, userID string, role authsvc.Role) (Tokens, error) {
-	accessToken, err := s.tokenManager.Generate(authsvc.Access, clientID, userID, role)
+func (s *service) generateTokenPair(clientID, userID string, role authsvc.Role, scope authsvc.Scope) (Tokens,
+	error) {
+
+	accessToken, err := s.tokenManager.Generate(authsvc.Access, clientID, userID, role, scope)
 	if err != nil {
 		return Tokens{}, fmt.Errorf("access token generation error: %w", err)
 	}
-	refreshToken, err := s.tokenManager.Generate(authsvc.Refresh, clientID, userID, role)
+	refreshToken, err := s.tokenManager.Generate(authsvc.Refresh, clientID, userID, role, scope)
 	if err != nil {
 		return Tokens{}, fmt.Errorf("refresh token generation error: %w", err)
 	}
@@ -183,6 +275,8 @@ type AuthCreds struct {
 	ClientID string
 }
 
+// Client is a user's browser, app etc. It's also a family of refresh tokens: every refresh rotates the token of the
+// client, and only the latest one can be used
 type Client struct {
 	ID           string
 	UserID       string
@@ -3,6 +3,7 @@ package authent_test
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/dgrijalva/jwt-go"
@@ -55,11 +56,19 @@ func Test_service_Login(t *testing.T) {
 		}
 		return nil
 	}
+	teacherScope := authsvc.Scope{Classes: []authsvc.Class{{Letter: "b", YearFormed: 2018}}}
+	r.UserScopeFn = func(ctx context.Context, userID string) (authsvc.Scope, error) {
+		return teacherScope, nil
+	}
 	tm := &mock.TokenManager{}
-	tm.GenerateFn = func(tokenType authsvc.TokenType, clientID, userID string, role authsvc.Role) (string, error) {
+	tm.GenerateFn = func(tokenType authsvc.TokenType, clientID, userID string, role authsvc.Role,
+		scope authsvc.Scope) (string, error) {
 		if userID == tmError {
 			return "", errors.New("tm error")
 		}
+		if !reflect.DeepEqual(scope, teacherScope) {
+			return "", errors.New("the scope of the user hasn't been put into the token")
+		}
 		if userID == repoStoreError {
 			return repoStoreError, nil
 		}
@@ -170,6 +179,11 @@ func Test_service_Logout(t *testing.T) {
 		}
 		return nil
 	}
+	var revocation authsvc.Revocation
+	r.StoreRevocationFn = func(ctx context.Context, rev authsvc.Revocation) error {
+		revocation = rev
+		return nil
+	}
 	tm := &mock.TokenManager{}
 	s := authent.NewService(r, tm)
 	type args struct {
@@ -197,11 +211,15 @@ func Test_service_Logout(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			revocation = authsvc.Revocation{}
 			err := s.Logout(ctx, tt.args.clientID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Logout() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if !tt.wantErr && (revocation.Kind != authsvc.ClientRevocation || revocation.SubjectID != tt.args.clientID) {
+				t.Errorf("Logout() revocation = %v, want the client revoked", revocation)
+			}
 		})
 	}
 }
@@ -219,6 +237,11 @@ func Test_service_LogoutAllClients(t *testing.T) {
 		}
 		return nil
 	}
+	var revocation authsvc.Revocation
+	r.StoreRevocationFn = func(ctx context.Context, rev authsvc.Revocation) error {
+		revocation = rev
+		return nil
+	}
 	tm := &mock.TokenManager{}
 	s := authent.NewService(r, tm)
 	type args struct {
@@ -246,11 +269,15 @@ func Test_service_LogoutAllClients(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			revocation = authsvc.Revocation{}
 			err := s.LogoutAllClients(ctx, tt.args.userID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("LogoutAllClients() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if !tt.wantErr && (revocation.Kind != authsvc.UserRevocation || revocation.SubjectID != tt.args.userID) {
+				t.Errorf("LogoutAllClients() revocation = %v, want the user revoked", revocation)
+			}
 		})
 	}
 }
@@ -264,6 +291,8 @@ func Test_service_RefreshTokens(t *testing.T) {
 		generateError     = "generateerror"
 		userID            = "userID"
 		validRefreshToken = "token"
+		rotatedToken      = "rotatedtoken"
+		accessToken       = "accesstoken"
 		clientID          = "clientID"
 	)
 	ctx := context.Background()
@@ -272,27 +301,53 @@ func Test_service_RefreshTokens(t *testing.T) {
 		if id == repoGetError {
 			return authent.Client{}, errors.New("error")
 		}
-		return authent.Client{clientID, userID, validRefreshToken}, nil
+		return authent.Client{ID: clientID, UserID: userID, RefreshToken: validRefreshToken}, nil
 	}
-	r.StoreClientFn = func(ctx context.Context, client authent.Client) error {
+	// the token has been rotated by a concurrent refresh unless it's the latest one
+	latestToken := validRefreshToken
+	r.RotateRefreshTokenFn = func(ctx context.Context, client authent.Client, oldToken string) error {
 		if client.ID == repoStoreError {
 			return errors.New("error")
 		}
+		if oldToken != latestToken {
+			return authsvc.ErrRefreshTokenReuse
+		}
 		return nil
 	}
 	tm := &mock.TokenManager{}
-	tm.GenerateFn = func(tokenType authsvc.TokenType, clientID, userID string, role authsvc.Role) (string, error) {
+	tm.GenerateFn = func(tokenType authsvc.TokenType, clientID, userID string, role authsvc.Role,
+		scope authsvc.Scope) (string, error) {
 		if clientID == generateError {
 			return "", errors.New("error")
 		}
+		want := authsvc.Scope{Classes: []authsvc.Class{{Letter: "b", YearFormed: 2018}}, PupilIDs: []string{"pupil"}}
+		if !reflect.DeepEqual(scope, want) {
+			return "", errors.New("the scope hasn't been carried over from the refresh token")
+		}
 		return validRefreshToken, nil
 	}
 	tm.VerifyFn = func(token string) (authsvc.UserClaims, error) {
 		if token == verifyError {
 			return authsvc.UserClaims{}, errors.New("error")
 		}
+		tokenType := authsvc.Refresh
+		if token == accessToken {
+			tokenType = authsvc.Access
+		}
 		return authsvc.UserClaims{ClientID: clientID, StandardClaims: jwt.StandardClaims{Subject: userID},
-			Role: "member"}, nil
+			Role: "member", Type: tokenType.String(), Classes: []string{"2018b"}, Pupils: []string{"pupil"}}, nil
+	}
+	r.RevokeClientFn = func(ctx context.Context, id string, event authsvc.SecurityEvent) error {
+		if id != clientID || event.Kind != authsvc.RefreshTokenReuse || event.UserID != userID {
+			t.Errorf("RevokeClient() clientID = %v, event = %+v", id, event)
+		}
+		return nil
+	}
+	r.StoreRevocationFn = func(ctx context.Context, rev authsvc.Revocation) error {
+		if rev.Kind != authsvc.ClientRevocation || rev.SubjectID != clientID {
+			t.Errorf("StoreRevocation() revocation = %+v", rev)
+		}
+		return nil
 	}
 	s := authent.NewService(r, tm)
 	type args struct {
@@ -317,6 +372,13 @@ func Test_service_RefreshTokens(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "access token",
+			args: args{
+				refreshToken: accessToken,
+			},
+			wantErr: true,
+		},
 		{
 			name: "get client repo error",
 			args: args{
@@ -357,4 +419,29 @@ func Test_service_RefreshTokens(t *testing.T) {
 			}
 		})
 	}
+	t.Run("rotated token reuse", func(t *testing.T) {
+		r.RevokeClientInvoked = false
+		r.StoreRevocationInvoked = false
+		_, err := s.RefreshTokens(ctx, rotatedToken)
+		if !errors.Is(err, authsvc.ErrRefreshTokenReuse) {
+			t.Errorf("RefreshTokens() error = %v, want ErrRefreshTokenReuse", err)
+		}
+		if !r.RevokeClientInvoked || !r.StoreRevocationInvoked {
+			t.Errorf("RefreshTokens() client wasn't revoked")
+		}
+	})
+	t.Run("concurrent refresh with the same token", func(t *testing.T) {
+		r.RevokeClientInvoked = false
+		r.StoreRevocationInvoked = false
+		// the client still has the token when it's read, but another refresh rotates it before this one does
+		latestToken = "tokenofanotherrefresh"
+		defer func() { latestToken = validRefreshToken }()
+		_, err := s.RefreshTokens(ctx, validRefreshToken)
+		if !errors.Is(err, authsvc.ErrRefreshTokenReuse) {
+			t.Errorf("RefreshTokens() error = %v, want ErrRefreshTokenReuse", err)
+		}
+		if !r.RevokeClientInvoked || !r.StoreRevocationInvoked {
+			t.Errorf("RefreshTokens() client wasn't revoked")
+		}
+	})
 }
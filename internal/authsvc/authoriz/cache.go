@@ -0,0 +1,101 @@
+package authoriz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/shanvl/garbage/internal/authsvc"
+)
+
+// cachedRepository keeps the not-before timestamps of the clients for ttl, so that the revocations aren't queried
+// on every request. The policies aren't cached here, since the service caches them itself
+type cachedRepository struct {
+	Repository
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[cacheKey]cacheEntry
+	// generation is bumped on every eviction, so that a lookup which has started before it doesn't cache the
+	// not-before timestamp it has read
+	generation uint64
+	lastSweep  time.Time
+}
+
+type cacheKey struct {
+	clientID string
+	userID   string
+}
+
+type cacheEntry struct {
+	notBefore time.Time
+	expiresAt time.Time
+}
+
+// NewCachedRepository wraps the repo with a cache. The revocations stored through the returned repo take effect
+// immediately, the ones made elsewhere take effect no later than ttl after they have been made
+func NewCachedRepository(repo Repository, ttl time.Duration) Repository {
+	return &cachedRepository{
+		Repository: repo,
+		ttl:        ttl,
+		entries:    make(map[cacheKey]cacheEntry),
+		lastSweep:  time.Now(),
+	}
+}
+
+// NotBefore returns the latest not-before timestamp of the revocations of the client and of the user.
+// The result is taken from the cache if it hasn't expired yet
+func (c *cachedRepository) NotBefore(ctx context.Context, clientID, userID string) (time.Time, error) {
+	key := cacheKey{clientID, userID}
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	generation := c.generation
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.notBefore, nil
+	}
+
+	notBefore, err := c.Repository.NotBefore(ctx, clientID, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// the timestamp might have been read before a revocation stored meanwhile, so it isn't cached
+	if generation != c.generation {
+		return notBefore, nil
+	}
+	c.entries[key] = cacheEntry{notBefore, now.Add(c.ttl)}
+	// remove the expired entries from time to time, so that the cache doesn't keep the clients that are gone
+	if now.Sub(c.lastSweep) > c.ttl {
+		for k, e := range c.entries {
+			if !now.Before(e.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastSweep = now
+	}
+	return notBefore, nil
+}
+
+// StoreRevocation stores the revocation and evicts the cached not-before timestamps of the revoked client or of all
+// the clients of the revoked user
+func (c *cachedRepository) StoreRevocation(ctx context.Context, revocation authsvc.Revocation) error {
+	if err := c.Repository.StoreRevocation(ctx, revocation); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for k := range c.entries {
+		if (revocation.Kind == authsvc.ClientRevocation && k.clientID == revocation.SubjectID) ||
+			(revocation.Kind == authsvc.UserRevocation && k.userID == revocation.SubjectID) {
+			delete(c.entries, k)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package authoriz_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shanvl/garbage/internal/authsvc"
+	"github.com/shanvl/garbage/internal/authsvc/authoriz"
+	"github.com/shanvl/garbage/internal/authsvc/mock"
+)
+
+func TestCachedRepository_NotBefore(t *testing.T) {
+	t.Parallel()
+	const errClientID = "error"
+	notBefore := time.Now().Truncate(time.Second)
+	calls := 0
+	repo := &mock.AuthorizRepo{}
+	repo.NotBeforeFn = func(ctx context.Context, clientID, userID string) (time.Time, error) {
+		calls++
+		if clientID == errClientID {
+			return time.Time{}, errors.New("error")
+		}
+		return notBefore, nil
+	}
+	const ttl = 50 * time.Millisecond
+	c := authoriz.NewCachedRepository(repo, ttl)
+	ctx := context.Background()
+
+	t.Run("repo error isn't cached", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			if _, err := c.NotBefore(ctx, errClientID, "userID"); err == nil {
+				t.Errorf("NotBefore() error = nil")
+			}
+		}
+		if calls != 2 {
+			t.Errorf("NotBefore() repo calls = %d, want 2", calls)
+		}
+	})
+	t.Run("cached until ttl expires", func(t *testing.T) {
+		calls = 0
+		for i := 0; i < 3; i++ {
+			got, err := c.NotBefore(ctx, "clientID", "userID")
+			if err != nil {
+				t.Fatalf("NotBefore() error = %v", err)
+			}
+			if !got.Equal(notBefore) {
+				t.Errorf("NotBefore() got = %v, want %v", got, notBefore)
+			}
+		}
+		if calls != 1 {
+			t.Errorf("NotBefore() repo calls = %d, want 1", calls)
+		}
+		time.Sleep(ttl)
+		if _, err := c.NotBefore(ctx, "clientID", "userID"); err != nil {
+			t.Fatalf("NotBefore() error = %v", err)
+		}
+		if calls != 2 {
+			t.Errorf("NotBefore() expired entry, repo calls = %d, want 2", calls)
+		}
+	})
+}
+
+func TestCachedRepository_StoreRevocation(t *testing.T) {
+	t.Parallel()
+	notBefore := time.Now().Truncate(time.Millisecond)
+	// the latest not-before timestamps of the revoked clients and users
+	revoked := make(map[string]time.Time)
+	calls := 0
+	repo := &mock.AuthorizRepo{}
+	repo.NotBeforeFn = func(ctx context.Context, clientID, userID string) (time.Time, error) {
+		calls++
+		if nb, ok := revoked[clientID]; ok {
+			return nb, nil
+		}
+		return revoked[userID], nil
+	}
+	repo.StoreRevocationFn = func(ctx context.Context, revocation authsvc.Revocation) error {
+		if revocation.SubjectID == "error" {
+			return errors.New("error")
+		}
+		revoked[revocation.SubjectID] = revocation.NotBefore
+		return nil
+	}
+	c := authoriz.NewCachedRepository(repo, time.Hour)
+	ctx := context.Background()
+
+	// fill the cache with the clients of two users
+	keys := [][2]string{{"client1", "user1"}, {"client2", "user1"}, {"client3", "user2"}}
+	for _, k := range keys {
+		if _, err := c.NotBefore(ctx, k[0], k[1]); err != nil {
+			t.Fatalf("NotBefore() error = %v", err)
+		}
+	}
+	tests := []struct {
+		name       string
+		revocation authsvc.Revocation
+		wantErr    bool
+		// wantCalls is the number of the lookups of keys that reach the repo after the revocation
+		wantCalls int
+	}{
+		{
+			name:       "repo error",
+			revocation: authsvc.Revocation{Kind: authsvc.ClientRevocation, SubjectID: "error", NotBefore: notBefore},
+			wantErr:    true,
+			wantCalls:  0,
+		},
+		{
+			name:       "client revocation evicts only the client",
+			revocation: authsvc.Revocation{Kind: authsvc.ClientRevocation, SubjectID: "client1", NotBefore: notBefore},
+			wantCalls:  1,
+		},
+		{
+			name:       "user revocation evicts all the clients of the user",
+			revocation: authsvc.Revocation{Kind: authsvc.UserRevocation, SubjectID: "user1", NotBefore: notBefore},
+			wantCalls:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := c.StoreRevocation(ctx, tt.revocation); (err != nil) != tt.wantErr {
+				t.Fatalf("StoreRevocation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			calls = 0
+			for _, k := range keys {
+				got, err := c.NotBefore(ctx, k[0], k[1])
+				if err != nil {
+					t.Fatalf("NotBefore() error = %v", err)
+				}
+				if (k[0] == tt.revocation.SubjectID || k[1] == tt.revocation.SubjectID) && !tt.wantErr &&
+					!got.Equal(notBefore) {
+					t.Errorf("NotBefore() got = %v, want %v", got, notBefore)
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("NotBefore() repo calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCachedRepository_StoreRevocationDuringLookup(t *testing.T) {
+	t.Parallel()
+	notBefore := time.Now().Truncate(time.Millisecond)
+	var c authoriz.Repository
+	calls := 0
+	repo := &mock.AuthorizRepo{}
+	repo.StoreRevocationFn = func(ctx context.Context, revocation authsvc.Revocation) error {
+		return nil
+	}
+	repo.NotBeforeFn = func(ctx context.Context, clientID, userID string) (time.Time, error) {
+		calls++
+		if calls > 1 {
+			return notBefore, nil
+		}
+		// the revocation is stored after the stale timestamp has been read, but before it's cached
+		rev := authsvc.Revocation{Kind: authsvc.UserRevocation, SubjectID: userID, NotBefore: notBefore}
+		if err := c.StoreRevocation(ctx, rev); err != nil {
+			t.Fatalf("StoreRevocation() error = %v", err)
+		}
+		return time.Time{}, nil
+	}
+	c = authoriz.NewCachedRepository(repo, time.Hour)
+	ctx := context.Background()
+
+	if _, err := c.NotBefore(ctx, "clientID", "userID"); err != nil {
+		t.Fatalf("NotBefore() error = %v", err)
+	}
+	got, err := c.NotBefore(ctx, "clientID", "userID")
+	if err != nil {
+		t.Fatalf("NotBefore() error = %v", err)
+	}
+	if !got.Equal(notBefore) {
+		t.Errorf("NotBefore() stale timestamp has been cached, got = %v, want %v", got, notBefore)
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/shanvl/garbage/internal/authsvc"
 	"github.com/shanvl/garbage/pkg/valid"
@@ -12,29 +15,81 @@ import (
 
 var ErrUnauthorized = errors.New("unauthorized")
 
+// Repository is a repo required by Service
+type Repository interface {
+	// NotBefore returns the latest not-before timestamp of the revocations of the client and of the user.
+	// If neither of them has been revoked, zero time is returned
+	NotBefore(ctx context.Context, clientID, userID string) (time.Time, error)
+	// Policies returns the protected RPCs along with the roles allowed to call them
+	Policies(ctx context.Context) (map[string][]authsvc.Role, error)
+	// ResetPolicies replaces all the policies with the given ones
+	ResetPolicies(ctx context.Context, policies map[string][]authsvc.Role) error
+	// SeedPolicies stores the policies of the methods which don't have one yet
+	SeedPolicies(ctx context.Context, policies map[string][]authsvc.Role) error
+	// SetMethodRoles stores the roles allowed to call the method
+	SetMethodRoles(ctx context.Context, method string, roles []authsvc.Role) error
+	// StoreRevocation stores the revocation. If the subject has already been revoked, the later not-before
+	// timestamp is kept
+	StoreRevocation(ctx context.Context, revocation authsvc.Revocation) error
+}
+
 // Service is responsible for authorization of the users' requests
 type Service interface {
 	// Authorize decides whether the user has access to the requested RPC
 	Authorize(ctx context.Context, accessToken, method string) (authsvc.UserClaims, error)
+	// ListPolicies returns the protected RPCs along with the roles allowed to call them
+	ListPolicies(ctx context.Context) (map[string][]authsvc.Role, error)
+	// NotBefore returns the latest not-before timestamp of the revocations of the client and of the user. The services
+	// which verify the access tokens on their own use it to reject the revoked ones
+	NotBefore(ctx context.Context, clientID, userID string) (time.Time, error)
+	// ProtectedRPCs returns the protected RPCs whose names start with the prefix along with the roles allowed to
+	// call them
+	ProtectedRPCs(ctx context.Context, methodPrefix string) (map[string][]authsvc.Role, error)
+	// ResetPolicies discards the changes made to the policies and restores the default ones
+	ResetPolicies(ctx context.Context) error
+	// SetMethodRoles changes the roles allowed to call the method. If the method isn't protected yet, it becomes
+	// protected. Empty roles mean that no one can call the method
+	SetMethodRoles(ctx context.Context, method string, roles []authsvc.Role) error
 }
 
 type service struct {
-	tm           authsvc.TokenManager
-	protectedRPC map[string][]authsvc.Role
+	repo Repository
+	tm   authsvc.TokenManager
+	// defaultPolicies are restored by ResetPolicies
+	defaultPolicies map[string][]authsvc.Role
+	// policies are cached for policiesTTL. The cache is dropped when the policies are changed by this instance,
+	// while the other instances pick the changes up when their caches expire
+	policiesTTL time.Duration
+
+	mu               sync.RWMutex
+	policies         map[string][]authsvc.Role
+	policiesLoadedAt time.Time
+	// policiesGeneration is bumped every time the cache is dropped, so that a load which has started before that
+	// doesn't cache the policies it has read
+	policiesGeneration uint64
 }
 
-func NewService(tm authsvc.TokenManager, protectedRPC map[string][]authsvc.Role) Service {
-	return &service{tm, protectedRPC}
+// NewService returns an authorization service. The repo is consulted on every request to a protected RPC,
+// so it's better to wrap it with NewCachedRepository
+func NewService(repo Repository, tm authsvc.TokenManager, defaultPolicies map[string][]authsvc.Role,
+	policiesTTL time.Duration) Service {
+
+	return &service{repo: repo, tm: tm, defaultPolicies: defaultPolicies, policiesTTL: policiesTTL}
 }
 
 // Authorize decides whether the user has access to the requested RPC
-func (s *service) Authorize(_ context.Context, accessToken, method string) (authsvc.UserClaims, error) {
+func (s *service) Authorize(ctx context.Context, accessToken, method string) (authsvc.UserClaims, error) {
 	// validate the arguments
 	if method == "" {
 		return authsvc.UserClaims{}, valid.NewError("method", "method is required")
 	}
+	policies, err := s.loadPolicies(ctx)
+	if err != nil {
+		return authsvc.UserClaims{}, err
+	}
 	// if the method is not protected, it doesn't need an access token. A method isn't protected if it isn't in the map
-	if _, ok := s.protectedRPC[method]; !ok {
+	roles, ok := policies[method]
+	if !ok {
 		return authsvc.UserClaims{}, nil
 	}
 	// verify the token and extract its claims
@@ -48,44 +103,194 @@ func (s *service) Authorize(_ context.Context, accessToken, method string) (auth
 		return authsvc.UserClaims{}, fmt.Errorf("%w: invalid role: %s: %v", authsvc.ErrInvalidAccessToken, role, err)
 	}
 	// check whether the user's role has access to the method
-	roles, ok := s.protectedRPC[method]
-	if ok {
-		for _, r := range roles {
-			if r == role {
-				return claims, nil
-			}
-		}
+	if !hasRole(roles, role) {
 		return authsvc.UserClaims{}, ErrUnauthorized
 	}
+	// the token mustn't be issued before the client or the user has been revoked
+	notBefore, err := s.repo.NotBefore(ctx, claims.ClientID, claims.Subject)
+	if err != nil {
+		return authsvc.UserClaims{}, err
+	}
+	if authsvc.IsRevoked(claims, notBefore) {
+		return authsvc.UserClaims{}, fmt.Errorf("%w: token has been revoked", authsvc.ErrInvalidAccessToken)
+	}
 	return claims, nil
 }
 
-// ProtectedRPCMap creates a map of protected RPCs. Later it can be changed to more elaborate procedure involving db,
-// but for now it's a simple map in memory
+// ListPolicies returns the protected RPCs along with the roles allowed to call them
+func (s *service) ListPolicies(ctx context.Context) (map[string][]authsvc.Role, error) {
+	return s.ProtectedRPCs(ctx, "")
+}
+
+// NotBefore returns the latest not-before timestamp of the revocations of the client and of the user.
+// If neither of them has been revoked, zero time is returned
+func (s *service) NotBefore(ctx context.Context, clientID, userID string) (time.Time, error) {
+	if clientID == "" {
+		return time.Time{}, valid.NewError("clientID", "clientID is required")
+	}
+	if userID == "" {
+		return time.Time{}, valid.NewError("userID", "userID is required")
+	}
+	return s.repo.NotBefore(ctx, clientID, userID)
+}
+
+// ProtectedRPCs returns the protected RPCs whose names start with the prefix along with the roles allowed to
+// call them
+func (s *service) ProtectedRPCs(ctx context.Context, methodPrefix string) (map[string][]authsvc.Role, error) {
+	policies, err := s.loadPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rpcs := make(map[string][]authsvc.Role)
+	for method, roles := range policies {
+		if strings.HasPrefix(method, methodPrefix) {
+			rpcs[method] = append([]authsvc.Role(nil), roles...)
+		}
+	}
+	return rpcs, nil
+}
+
+// ResetPolicies discards the changes made to the policies and restores the default ones
+func (s *service) ResetPolicies(ctx context.Context) error {
+	defer s.dropPolicies()
+	return s.repo.ResetPolicies(ctx, s.defaultPolicies)
+}
+
+// SetMethodRoles changes the roles allowed to call the method. If the method isn't protected yet, it becomes
+// protected. Empty roles mean that no one can call the method
+func (s *service) SetMethodRoles(ctx context.Context, method string, roles []authsvc.Role) error {
+	// validate the arguments
+	if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 {
+		return valid.NewError("method", "method must be a full RPC name, e.g. /package.Service/Method")
+	}
+	// root mustn't lose the access to the policies, otherwise no one could restore it
+	if policyMethods[method] && !hasRole(roles, authsvc.Root) {
+		return valid.NewError("roles", fmt.Sprintf("%s must be allowed to call %s", authsvc.Root, method))
+	}
+	defer s.dropPolicies()
+	return s.repo.SetMethodRoles(ctx, method, dedupRoles(roles))
+}
+
+// loadPolicies returns the cached policies, loading them from the repo if the cache is empty or expired
+func (s *service) loadPolicies(ctx context.Context) (map[string][]authsvc.Role, error) {
+	s.mu.RLock()
+	policies, loadedAt, generation := s.policies, s.policiesLoadedAt, s.policiesGeneration
+	s.mu.RUnlock()
+	if policies != nil && time.Since(loadedAt) < s.policiesTTL {
+		return policies, nil
+	}
+	policies, err := s.repo.Policies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	// the policies might have been read before a change made meanwhile, so they aren't cached
+	if generation == s.policiesGeneration {
+		s.policies, s.policiesLoadedAt = policies, time.Now()
+	}
+	s.mu.Unlock()
+	return policies, nil
+}
+
+// dropPolicies drops the cached policies, so that they are loaded from the repo on the next request
+func (s *service) dropPolicies() {
+	s.mu.Lock()
+	s.policies = nil
+	s.policiesGeneration++
+	s.mu.Unlock()
+}
+
+// hasRole tells whether the role is among the roles
+func hasRole(roles []authsvc.Role, role authsvc.Role) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// dedupRoles returns the roles without duplicates
+func dedupRoles(roles []authsvc.Role) []authsvc.Role {
+	deduped := make([]authsvc.Role, 0, len(roles))
+	for _, r := range roles {
+		if !hasRole(deduped, r) {
+			deduped = append(deduped, r)
+		}
+	}
+	return deduped
+}
+
+const authSvcPrefix = "/shanvl.garbage.auth.v1.AuthService/"
+
+// policyMethods are the RPCs managing the policies. Root can't be deprived of them
+var policyMethods = map[string]bool{
+	authSvcPrefix + "ListPolicies":   true,
+	authSvcPrefix + "ResetPolicies":  true,
+	authSvcPrefix + "SetMethodRoles": true,
+}
+
+// ProtectedRPCMap creates a map of protected RPCs. It's the default policy, which is stored in the db on the first start
+// and restored by ResetPolicies. Later changes to the policies are made through SetMethodRoles.
+// Guardians can only manage their own accounts and see the pupils linked to them
 func ProtectedRPCMap() map[string][]authsvc.Role {
-	const authSvcPrefix = "/shanvl.garbage.auth.v1.AuthService/"
 	const eventSvcPrefix = "/shanvl.garbage.events.v1.EventsService/"
+	const notifSvcPrefix = "/shanvl.garbage.notifications.v1.NotificationsService/"
 	return map[string][]authsvc.Role{
+		authSvcPrefix + "ChangeOwnPassword":     {authsvc.Admin, authsvc.Guardian, authsvc.Member, authsvc.Root},
 		authSvcPrefix + "ChangeUserRole":        {authsvc.Admin, authsvc.Root},
 		authSvcPrefix + "CreateUser":            {authsvc.Admin, authsvc.Root},
 		authSvcPrefix + "DeleteUser":            {authsvc.Admin, authsvc.Root},
+		authSvcPrefix + "FindAuditEntries":      {authsvc.Root},
 		authSvcPrefix + "FindUser":              {authsvc.Admin, authsvc.Member, authsvc.Root},
+		authSvcPrefix + "FindUserClasses":       {authsvc.Admin, authsvc.Member, authsvc.Root},
+		authSvcPrefix + "FindUserPupils":        {authsvc.Admin, authsvc.Member, authsvc.Root},
 		authSvcPrefix + "FindUsers":             {authsvc.Admin, authsvc.Member, authsvc.Root},
-		authSvcPrefix + "Logout":                {authsvc.Admin, authsvc.Member, authsvc.Root},
-		authSvcPrefix + "LogoutAllClients":      {authsvc.Admin, authsvc.Member, authsvc.Root},
-		authSvcPrefix + "RefreshTokens":         {authsvc.Admin, authsvc.Member, authsvc.Root},
+		authSvcPrefix + "ListPolicies":          {authsvc.Root},
+		authSvcPrefix + "Logout":                {authsvc.Admin, authsvc.Guardian, authsvc.Member, authsvc.Root},
+		authSvcPrefix + "LogoutAllClients":      {authsvc.Admin, authsvc.Guardian, authsvc.Member, authsvc.Root},
+		authSvcPrefix + "RefreshTokens":         {authsvc.Admin, authsvc.Guardian, authsvc.Member, authsvc.Root},
+		authSvcPrefix + "ResendActivation":      {authsvc.Admin, authsvc.Root},
+		authSvcPrefix + "ResetPolicies":         {authsvc.Root},
+		authSvcPrefix + "SetMethodRoles":        {authsvc.Root},
+		authSvcPrefix + "SetUserClasses":        {authsvc.Admin, authsvc.Root},
+		authSvcPrefix + "SetUserPupils":         {authsvc.Admin, authsvc.Root},
+		authSvcPrefix + "UpdateProfile":         {authsvc.Admin, authsvc.Guardian, authsvc.Member, authsvc.Root},
+		eventSvcPrefix + "AddPupilResources":    {authsvc.Admin, authsvc.Member, authsvc.Root},
 		eventSvcPrefix + "AddPupils":            {authsvc.Admin, authsvc.Root},
+		eventSvcPrefix + "ArchiveEvent":         {authsvc.Admin, authsvc.Root},
 		eventSvcPrefix + "ChangePupilClass":     {authsvc.Admin, authsvc.Root},
 		eventSvcPrefix + "ChangePupilResources": {authsvc.Admin, authsvc.Member, authsvc.Root},
+		eventSvcPrefix + "CloseEvent":           {authsvc.Admin, authsvc.Root},
+		eventSvcPrefix + "CorrectResourceEntry": {authsvc.Admin, authsvc.Member, authsvc.Root},
 		eventSvcPrefix + "CreateEvent":          {authsvc.Admin, authsvc.Root},
+		eventSvcPrefix + "CreateResourceType":   {authsvc.Admin, authsvc.Root},
 		eventSvcPrefix + "DeleteEvent":          {authsvc.Admin, authsvc.Root},
+		eventSvcPrefix + "DeleteResourceType":   {authsvc.Admin, authsvc.Root},
+		eventSvcPrefix + "FindAuditEntries":     {authsvc.Root},
+		eventSvcPrefix + "FindClassLeaderboard": {authsvc.Admin, authsvc.Member, authsvc.Root},
 		eventSvcPrefix + "FindClasses":          {authsvc.Admin, authsvc.Member, authsvc.Root},
 		eventSvcPrefix + "FindEvents":           {authsvc.Admin, authsvc.Member, authsvc.Root},
 		eventSvcPrefix + "FindEventByID":        {authsvc.Admin, authsvc.Member, authsvc.Root},
 		eventSvcPrefix + "FindEventClasses":     {authsvc.Admin, authsvc.Member, authsvc.Root},
 		eventSvcPrefix + "FindEventPupils":      {authsvc.Admin, authsvc.Member, authsvc.Root},
-		eventSvcPrefix + "FindEventPupilByID":   {authsvc.Admin, authsvc.Member, authsvc.Root},
+		eventSvcPrefix + "FindEventPupilByID":   {authsvc.Admin, authsvc.Guardian, authsvc.Member, authsvc.Root},
+		eventSvcPrefix + "FindPupilByID":        {authsvc.Admin, authsvc.Guardian, authsvc.Member, authsvc.Root},
+		eventSvcPrefix + "FindPupilLeaderboard": {authsvc.Admin, authsvc.Member, authsvc.Root},
 		eventSvcPrefix + "FindPupils":           {authsvc.Admin, authsvc.Member, authsvc.Root},
+		eventSvcPrefix + "FindResourceEntries":  {authsvc.Admin, authsvc.Member, authsvc.Root},
+		eventSvcPrefix + "FindResourceTypes":    {authsvc.Admin, authsvc.Member, authsvc.Root},
+		eventSvcPrefix + "OpenEvent":            {authsvc.Admin, authsvc.Root},
 		eventSvcPrefix + "RemovePupils":         {authsvc.Admin, authsvc.Root},
+		eventSvcPrefix + "SetEventMultiplier":   {authsvc.Admin, authsvc.Root},
+		eventSvcPrefix + "StreamEventPupils":    {authsvc.Admin, authsvc.Member, authsvc.Root},
+		eventSvcPrefix + "StreamPupils":         {authsvc.Admin, authsvc.Member, authsvc.Root},
+		eventSvcPrefix + "UpdateEvent":          {authsvc.Admin, authsvc.Root},
+		eventSvcPrefix + "UpdateResourceType":   {authsvc.Admin, authsvc.Root},
+		eventSvcPrefix + "VoidResourceEntry":    {authsvc.Admin, authsvc.Member, authsvc.Root},
+		notifSvcPrefix + "CreateSubscription":   {authsvc.Admin, authsvc.Root},
+		notifSvcPrefix + "DeleteSubscription":   {authsvc.Admin, authsvc.Root},
+		notifSvcPrefix + "FindNotifications":    {authsvc.Admin, authsvc.Root},
+		notifSvcPrefix + "FindSubscriptions":    {authsvc.Admin, authsvc.Root},
 	}
 }
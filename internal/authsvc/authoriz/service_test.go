@@ -5,6 +5,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/shanvl/garbage/internal/authsvc"
 	"github.com/shanvl/garbage/internal/authsvc/authoriz"
@@ -14,6 +15,7 @@ import (
 func Test_service_Authorize(t *testing.T) {
 	t.Parallel()
 	const invalidToken = "tmerror"
+	const revokedToken = "revoked"
 	const protectedRPCName = "somename"
 	protectedRPC := map[string][]authsvc.Role{
 		protectedRPCName: {authsvc.Root, authsvc.Admin},
@@ -23,9 +25,22 @@ func Test_service_Authorize(t *testing.T) {
 		if token == invalidToken {
 			return authsvc.UserClaims{}, errors.New("error")
 		}
+		if token == revokedToken {
+			return authsvc.UserClaims{ClientID: revokedToken, Role: "admin"}, nil
+		}
 		return authsvc.UserClaims{Role: token}, nil
 	}
-	s := authoriz.NewService(tm, protectedRPC)
+	repo := &mock.AuthorizRepo{}
+	repo.NotBeforeFn = func(ctx context.Context, clientID, userID string) (time.Time, error) {
+		if clientID == revokedToken {
+			return time.Now(), nil
+		}
+		return time.Time{}, nil
+	}
+	repo.PoliciesFn = func(ctx context.Context) (map[string][]authsvc.Role, error) {
+		return protectedRPC, nil
+	}
+	s := authoriz.NewService(repo, tm, protectedRPC, time.Minute)
 	type args struct {
 		accessToken string
 		method      string
@@ -72,6 +87,15 @@ func Test_service_Authorize(t *testing.T) {
 			wantClaims: authsvc.UserClaims{},
 			wantErr:    true,
 		},
+		{
+			name: "revoked token",
+			args: args{
+				accessToken: revokedToken,
+				method:      protectedRPCName,
+			},
+			wantClaims: authsvc.UserClaims{},
+			wantErr:    true,
+		},
 		{
 			name: "unprotected RPC",
 			args: args{
@@ -113,3 +137,252 @@ func Test_service_Authorize(t *testing.T) {
 		})
 	}
 }
+
+func Test_service_NotBefore(t *testing.T) {
+	t.Parallel()
+	notBefore := time.Now().Truncate(time.Millisecond)
+	repo := &mock.AuthorizRepo{}
+	repo.NotBeforeFn = func(ctx context.Context, clientID, userID string) (time.Time, error) {
+		return notBefore, nil
+	}
+	s := authoriz.NewService(repo, &mock.TokenManager{}, nil, time.Minute)
+	tests := []struct {
+		name     string
+		clientID string
+		userID   string
+		wantErr  bool
+	}{
+		{
+			name:     "no client id",
+			clientID: "",
+			userID:   "userID",
+			wantErr:  true,
+		},
+		{
+			name:     "no user id",
+			clientID: "clientID",
+			userID:   "",
+			wantErr:  true,
+		},
+		{
+			name:     "ok",
+			clientID: "clientID",
+			userID:   "userID",
+			wantErr:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.NotBefore(context.Background(), tt.clientID, tt.userID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NotBefore() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(notBefore) {
+				t.Errorf("NotBefore() got = %v, want %v", got, notBefore)
+			}
+		})
+	}
+}
+
+func Test_service_ProtectedRPCs(t *testing.T) {
+	t.Parallel()
+	protectedRPC := map[string][]authsvc.Role{
+		"/a.Service/One":   {authsvc.Admin},
+		"/a.Service/Two":   {authsvc.Admin, authsvc.Member},
+		"/b.Service/Three": {authsvc.Root},
+	}
+	repo := &mock.AuthorizRepo{}
+	repo.PoliciesFn = func(ctx context.Context) (map[string][]authsvc.Role, error) {
+		return protectedRPC, nil
+	}
+	s := authoriz.NewService(repo, &mock.TokenManager{}, protectedRPC, time.Minute)
+	tests := []struct {
+		name   string
+		prefix string
+		want   map[string][]authsvc.Role
+	}{
+		{
+			name:   "no prefix",
+			prefix: "",
+			want:   protectedRPC,
+		},
+		{
+			name:   "service prefix",
+			prefix: "/a.Service/",
+			want: map[string][]authsvc.Role{
+				"/a.Service/One": {authsvc.Admin},
+				"/a.Service/Two": {authsvc.Admin, authsvc.Member},
+			},
+		},
+		{
+			name:   "unknown prefix",
+			prefix: "/c.Service/",
+			want:   map[string][]authsvc.Role{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.ProtectedRPCs(context.Background(), tt.prefix)
+			if err != nil {
+				t.Fatalf("ProtectedRPCs() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProtectedRPCs() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_service_SetMethodRoles(t *testing.T) {
+	t.Parallel()
+	const setPolicies = "/shanvl.garbage.auth.v1.AuthService/SetMethodRoles"
+	repo := &mock.AuthorizRepo{}
+	repo.SetMethodRolesFn = func(ctx context.Context, method string, roles []authsvc.Role) error {
+		return nil
+	}
+	s := authoriz.NewService(repo, &mock.TokenManager{}, nil, time.Minute)
+	type args struct {
+		method string
+		roles  []authsvc.Role
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "no method",
+			args:    args{method: "", roles: []authsvc.Role{authsvc.Admin}},
+			wantErr: true,
+		},
+		{
+			name:    "not a full method name",
+			args:    args{method: "FindUsers", roles: []authsvc.Role{authsvc.Admin}},
+			wantErr: true,
+		},
+		{
+			name:    "root deprived of policies",
+			args:    args{method: setPolicies, roles: []authsvc.Role{authsvc.Admin}},
+			wantErr: true,
+		},
+		{
+			name:    "policies",
+			args:    args{method: setPolicies, roles: []authsvc.Role{authsvc.Admin, authsvc.Root}},
+			wantErr: false,
+		},
+		{
+			name:    "no roles",
+			args:    args{method: "/a.Service/One", roles: nil},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo.SetMethodRolesInvoked = false
+			err := s.SetMethodRoles(context.Background(), tt.args.method, tt.args.roles)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetMethodRoles() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if repo.SetMethodRolesInvoked == tt.wantErr {
+				t.Errorf("SetMethodRoles() repo invoked = %v, want %v", repo.SetMethodRolesInvoked, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_service_policiesCache(t *testing.T) {
+	t.Parallel()
+	defaultPolicies := map[string][]authsvc.Role{"/a.Service/One": {authsvc.Admin}}
+	stored := map[string][]authsvc.Role{"/a.Service/One": {authsvc.Admin}}
+	calls := 0
+	repo := &mock.AuthorizRepo{}
+	repo.PoliciesFn = func(ctx context.Context) (map[string][]authsvc.Role, error) {
+		calls++
+		policies := make(map[string][]authsvc.Role, len(stored))
+		for m, r := range stored {
+			policies[m] = r
+		}
+		return policies, nil
+	}
+	repo.SetMethodRolesFn = func(ctx context.Context, method string, roles []authsvc.Role) error {
+		stored[method] = roles
+		return nil
+	}
+	repo.ResetPoliciesFn = func(ctx context.Context, policies map[string][]authsvc.Role) error {
+		stored = map[string][]authsvc.Role{}
+		for m, r := range policies {
+			stored[m] = r
+		}
+		return nil
+	}
+	s := authoriz.NewService(repo, &mock.TokenManager{}, defaultPolicies, time.Minute)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := s.ListPolicies(ctx); err != nil {
+			t.Fatalf("ListPolicies() error = %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("ListPolicies() repo calls = %d, want 1", calls)
+	}
+	// the change must be seen right away
+	if err := s.SetMethodRoles(ctx, "/a.Service/Two", []authsvc.Role{authsvc.Root, authsvc.Root}); err != nil {
+		t.Fatalf("SetMethodRoles() error = %v", err)
+	}
+	got, err := s.ListPolicies(ctx)
+	if err != nil {
+		t.Fatalf("ListPolicies() error = %v", err)
+	}
+	want := map[string][]authsvc.Role{"/a.Service/One": {authsvc.Admin}, "/a.Service/Two": {authsvc.Root}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListPolicies() after SetMethodRoles() got = %v, want %v", got, want)
+	}
+	// as well as the reset
+	if err := s.ResetPolicies(ctx); err != nil {
+		t.Fatalf("ResetPolicies() error = %v", err)
+	}
+	got, err = s.ListPolicies(ctx)
+	if err != nil {
+		t.Fatalf("ListPolicies() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, defaultPolicies) {
+		t.Errorf("ListPolicies() after ResetPolicies() got = %v, want %v", got, defaultPolicies)
+	}
+}
+
+func Test_service_policiesCacheDropDuringLoad(t *testing.T) {
+	t.Parallel()
+	stale := map[string][]authsvc.Role{"/a.Service/One": {authsvc.Admin}}
+	changed := map[string][]authsvc.Role{"/a.Service/One": {authsvc.Root}}
+	var s authoriz.Service
+	calls := 0
+	repo := &mock.AuthorizRepo{}
+	repo.SetMethodRolesFn = func(ctx context.Context, method string, roles []authsvc.Role) error {
+		return nil
+	}
+	repo.PoliciesFn = func(ctx context.Context) (map[string][]authsvc.Role, error) {
+		calls++
+		if calls > 1 {
+			return changed, nil
+		}
+		// the policies are changed after the stale ones have been read, but before they are cached
+		if err := s.SetMethodRoles(ctx, "/a.Service/One", []authsvc.Role{authsvc.Root}); err != nil {
+			t.Fatalf("SetMethodRoles() error = %v", err)
+		}
+		return stale, nil
+	}
+	s = authoriz.NewService(repo, &mock.TokenManager{}, stale, time.Minute)
+	ctx := context.Background()
+
+	if _, err := s.ListPolicies(ctx); err != nil {
+		t.Fatalf("ListPolicies() error = %v", err)
+	}
+	got, err := s.ListPolicies(ctx)
+	if err != nil {
+		t.Fatalf("ListPolicies() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, changed) {
+		t.Errorf("ListPolicies() stale policies have been cached, got = %v, want %v", got, changed)
+	}
+}
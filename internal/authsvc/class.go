@@ -0,0 +1,49 @@
+package authsvc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+var ErrInvalidClass = errors.New("invalid class")
+
+// Class is a school class the user is assigned to, e.g. the class teacher of the class with the letter "b" formed
+// in 2018. Unlike the event service, the auth service doesn't know about the pupils, so the class is identified only
+// by its letter and the year it was formed in, which never change
+type Class struct {
+	Letter     string
+	YearFormed int
+}
+
+// NewClass creates a class, normalizing its letter to lower case
+func NewClass(letter string, yearFormed int) (Class, error) {
+	r, size := utf8.DecodeRuneInString(letter)
+	if size == 0 || size != len(letter) || !unicode.IsLetter(r) {
+		return Class{}, fmt.Errorf("%w: the letter must be a single letter: %q", ErrInvalidClass, letter)
+	}
+	if yearFormed < 1900 || yearFormed > 9999 {
+		return Class{}, fmt.Errorf("%w: invalid year: %d", ErrInvalidClass, yearFormed)
+	}
+	return Class{Letter: string(unicode.ToLower(r)), YearFormed: yearFormed}, nil
+}
+
+// ParseClass parses the string returned by Class.String
+func ParseClass(s string) (Class, error) {
+	if len(s) < 5 {
+		return Class{}, fmt.Errorf("%w: %q", ErrInvalidClass, s)
+	}
+	year, err := strconv.Atoi(s[:4])
+	if err != nil {
+		return Class{}, fmt.Errorf("%w: %q", ErrInvalidClass, s)
+	}
+	return NewClass(s[4:], year)
+}
+
+// String returns the year the class was formed in followed by its letter, e.g. "2018b". It's how the class is put
+// into the token claims
+func (c Class) String() string {
+	return fmt.Sprintf("%04d%s", c.YearFormed, c.Letter)
+}
@@ -0,0 +1,125 @@
+package authsvc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewClass(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		letter     string
+		yearFormed int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Class
+		wantErr bool
+	}{
+		{
+			name:    "no letter",
+			args:    args{letter: "", yearFormed: 2018},
+			want:    Class{},
+			wantErr: true,
+		},
+		{
+			name:    "two letters",
+			args:    args{letter: "ab", yearFormed: 2018},
+			want:    Class{},
+			wantErr: true,
+		},
+		{
+			name:    "digit",
+			args:    args{letter: "1", yearFormed: 2018},
+			want:    Class{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid year",
+			args:    args{letter: "b", yearFormed: 18},
+			want:    Class{},
+			wantErr: true,
+		},
+		{
+			name:    "upper case",
+			args:    args{letter: "B", yearFormed: 2018},
+			want:    Class{Letter: "b", YearFormed: 2018},
+			wantErr: false,
+		},
+		{
+			name:    "cyrillic",
+			args:    args{letter: "Б", yearFormed: 2018},
+			want:    Class{Letter: "б", YearFormed: 2018},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewClass(tt.args.letter, tt.args.yearFormed)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewClass() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewClass() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseClass(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		s       string
+		want    Class
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			s:       "",
+			want:    Class{},
+			wantErr: true,
+		},
+		{
+			name:    "no year",
+			s:       "b",
+			want:    Class{},
+			wantErr: true,
+		},
+		{
+			name:    "no letter",
+			s:       "2018",
+			want:    Class{},
+			wantErr: true,
+		},
+		{
+			name:    "letter first",
+			s:       "b2018",
+			want:    Class{},
+			wantErr: true,
+		},
+		{
+			name:    "ok",
+			s:       "2018b",
+			want:    Class{Letter: "b", YearFormed: 2018},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseClass(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseClass() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseClass() got = %v, want %v", got, tt.want)
+			}
+			if err == nil && got.String() != tt.s {
+				t.Errorf("String() got = %v, want %v", got.String(), tt.s)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
+	"github.com/shanvl/garbage/pkg/audit"
+	"google.golang.org/grpc"
+)
+
+// authSvcPrefix is the prefix of the full method names of the service's RPCs
+const authSvcPrefix = "/shanvl.garbage.auth.v1.AuthService/"
+
+// FindAuditEntries returns the records of the changes made by calling the mutating RPCs of the service
+func (s *Server) FindAuditEntries(ctx context.Context, req *authv1pb.FindAuditEntriesRequest) (*authv1pb.
+	FindAuditEntriesResponse, error) {
+
+	filters, err := protoToAuditFilters(req)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	entries, total, err := s.auditSvc.Entries(ctx, filters, int(req.GetAmount()), int(req.GetSkip()))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	entriesProto := make([]*authv1pb.AuditEntry, len(entries))
+	for i, entry := range entries {
+		entryProto, err := auditEntryToProto(entry)
+		if err != nil {
+			return nil, s.handleError(err)
+		}
+		entriesProto[i] = entryProto
+	}
+	return &authv1pb.FindAuditEntriesResponse{Entries: entriesProto, Total: uint32(total)}, nil
+}
+
+// auditUnaryInterceptor records the successful calls of the mutating RPCs. It relies on the claims put to ctx by
+// the auth interceptor
+func (s *Server) auditUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return audit.UnaryServerInterceptor(s.auditSvc, s.auditSpecs(), func(ctx context.Context) (string, string) {
+		claims, err := authClaimsFromCtx(ctx)
+		if err != nil {
+			return "", ""
+		}
+		return claims.Subject, claims.ClientID
+	}, s.log)
+}
+
+// auditSpecs lists the mutating RPCs of the service. Login and RefreshTokens only issue tokens, so they aren't
+// audited. The snapshots are taken with the finders of the service, so that the secrets of the users aren't recorded
+func (s *Server) auditSpecs() map[string]audit.Spec {
+	userSnapshot := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.FindUser(ctx, &authv1pb.FindUserRequest{Id: req.(interface{ GetId() string }).GetId()})
+	}
+	ownUserSnapshot := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		claims, err := authClaimsFromCtx(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return s.FindUser(ctx, &authv1pb.FindUserRequest{Id: claims.Subject})
+	}
+	// the RPCs changing the user who has made the call target that user
+	ownTargets := func(ctx context.Context, _, _ interface{}) []string {
+		claims, err := authClaimsFromCtx(ctx)
+		if err != nil {
+			return nil
+		}
+		return []string{claims.Subject}
+	}
+	return map[string]audit.Spec{
+		authSvcPrefix + "ActivateUser":         {},
+		authSvcPrefix + "ChangeOwnPassword":    {Targets: ownTargets},
+		authSvcPrefix + "ChangeUserRole":       {Snapshot: userSnapshot},
+		authSvcPrefix + "CreateUser":           {},
+		authSvcPrefix + "DeleteUser":           {Snapshot: userSnapshot},
+		authSvcPrefix + "Logout":               {Targets: ownTargets},
+		authSvcPrefix + "LogoutAllClients":     {Targets: ownTargets},
+		authSvcPrefix + "RequestPasswordReset": {},
+		authSvcPrefix + "ResendActivation":     {},
+		authSvcPrefix + "ResetPassword":        {},
+		authSvcPrefix + "ResetPolicies": {Snapshot: func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return s.ListPolicies(ctx, &empty.Empty{})
+		}},
+		authSvcPrefix + "SetMethodRoles": {
+			Snapshot: func(ctx context.Context, req interface{}) (interface{}, error) {
+				resp, err := s.ListPolicies(ctx, &empty.Empty{})
+				if err != nil {
+					return nil, err
+				}
+				method := req.(*authv1pb.SetMethodRolesRequest).GetMethod()
+				for _, p := range resp.GetPolicies() {
+					if p.GetMethod() == method {
+						return p, nil
+					}
+				}
+				// the method hasn't been protected
+				return nil, nil
+			},
+			// the policy is identified by its method
+			Targets: func(_ context.Context, req, _ interface{}) []string {
+				return []string{req.(*authv1pb.SetMethodRolesRequest).GetMethod()}
+			},
+		},
+		authSvcPrefix + "SetUserClasses": {Snapshot: func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.FindUserClasses(ctx, &authv1pb.FindUserClassesRequest{
+				Id: req.(*authv1pb.SetUserClassesRequest).GetId(),
+			})
+		}},
+		authSvcPrefix + "SetUserPupils": {Snapshot: func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.FindUserPupils(ctx, &authv1pb.FindUserPupilsRequest{
+				Id: req.(*authv1pb.SetUserPupilsRequest).GetId(),
+			})
+		}},
+		authSvcPrefix + "UpdateProfile": {Snapshot: ownUserSnapshot, Targets: ownTargets},
+	}
+}
+
+// protoToAuditFilters transforms the request to audit.Filters
+func protoToAuditFilters(req *authv1pb.FindAuditEntriesRequest) (audit.Filters, error) {
+	filters := audit.Filters{
+		ActorID:  req.GetActorId(),
+		Method:   req.GetMethod(),
+		TargetID: req.GetTargetId(),
+	}
+	var err error
+	if req.GetFrom() != nil {
+		if filters.From, err = ptypes.Timestamp(req.GetFrom()); err != nil {
+			return audit.Filters{}, ErrInvalidTimestamp
+		}
+	}
+	if req.GetTo() != nil {
+		if filters.To, err = ptypes.Timestamp(req.GetTo()); err != nil {
+			return audit.Filters{}, ErrInvalidTimestamp
+		}
+	}
+	return filters, nil
+}
+
+// auditEntryToProto transforms audit.Entry to *authv1pb.AuditEntry
+func auditEntryToProto(entry audit.Entry) (*authv1pb.AuditEntry, error) {
+	occurredAt, err := ptypes.TimestampProto(entry.OccurredAt)
+	if err != nil {
+		return nil, ErrInvalidTimestamp
+	}
+	return &authv1pb.AuditEntry{
+		Id:         entry.ID,
+		ActorId:    entry.ActorID,
+		ClientId:   entry.ClientID,
+		Method:     entry.Method,
+		TargetIds:  entry.TargetIDs,
+		Before:     string(entry.Before),
+		After:      string(entry.After),
+		OccurredAt: occurredAt,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
+	"github.com/shanvl/garbage/pkg/audit"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_FindAuditEntries(t *testing.T) {
+	ctx := context.Background()
+	// the entries aren't deleted, so the id of the actor is unique
+	actorID := fmt.Sprintf("grpcauditactor%d", time.Now().UnixNano())
+	entry, err := audit.NewEntry(actorID, "clientid", "/shanvl.garbage.auth.v1.AuthService/DeleteUser",
+		[]string{"deleteduserid"}, map[string]string{"id": "deleteduserid"}, nil)
+	if err != nil {
+		t.Fatalf("couldn't create an audit entry: %v", err)
+	}
+	if err := auditRepo.StoreEntry(ctx, entry); err != nil {
+		t.Fatalf("couldn't store the audit entry: %v", err)
+	}
+	now := time.Now()
+	from, _ := ptypes.TimestampProto(now)
+	to, _ := ptypes.TimestampProto(now.Add(-time.Hour))
+	tests := []struct {
+		name    string
+		req     *authv1pb.FindAuditEntriesRequest
+		code    codes.Code
+		wantIDs []string
+	}{
+		{
+			name:    "ok",
+			req:     &authv1pb.FindAuditEntriesRequest{ActorId: actorID, TargetId: "deleteduserid"},
+			code:    codes.OK,
+			wantIDs: []string{entry.ID},
+		},
+		{
+			name:    "not found",
+			req:     &authv1pb.FindAuditEntriesRequest{ActorId: actorID, TargetId: "anotheruserid"},
+			code:    codes.OK,
+			wantIDs: []string{},
+		},
+		{
+			name: "from after to",
+			req:  &authv1pb.FindAuditEntriesRequest{ActorId: actorID, From: from, To: to},
+			code: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.FindAuditEntries(ctx, tt.req)
+			if status.Code(err) != tt.code {
+				t.Fatalf("FindAuditEntries() error = %v, want code %v", err, tt.code)
+			}
+			if err != nil {
+				return
+			}
+			if int(resp.GetTotal()) != len(tt.wantIDs) || len(resp.GetEntries()) != len(tt.wantIDs) {
+				t.Fatalf("FindAuditEntries() got %d of %d entries, want %d", len(resp.GetEntries()),
+					resp.GetTotal(), len(tt.wantIDs))
+			}
+			for i, e := range resp.GetEntries() {
+				if e.GetId() != tt.wantIDs[i] || e.GetAfter() != "" || e.GetBefore() == "" {
+					t.Errorf("FindAuditEntries() entry = %v, want the entry %s with the state before", e,
+						tt.wantIDs[i])
+				}
+			}
+		})
+	}
+}
@@ -271,6 +271,12 @@ func TestServer_RefreshTokens(t *testing.T) {
 			req:  &authv1pb.RefreshTokensRequest{RefreshToken: rt},
 			code: codes.OK,
 		},
+		{
+			// the token has been rotated in the previous case
+			name: "reused token",
+			req:  &authv1pb.RefreshTokensRequest{RefreshToken: rt},
+			code: codes.Unauthenticated,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -283,6 +289,11 @@ func TestServer_RefreshTokens(t *testing.T) {
 					t.Errorf("RefreshTokens() res == nil, want != nil")
 				}
 			} else {
+				if tt.code == codes.Unauthenticated {
+					if _, err := authentRepo.ClientByID(ctx, clientID); err == nil {
+						t.Errorf("RefreshTokens() client wasn't revoked after the token reuse")
+					}
+				}
 				if err == nil {
 					t.Errorf("RefreshTokens() error == nil, wantErr == true")
 				}
@@ -360,7 +371,7 @@ func newUser(t *testing.T, id, email, password string, role authsvc.Role) *auths
 }
 
 func generateRefreshToken(t *testing.T, clientID, userID string, role authsvc.Role) string {
-	rt, err := tokenManager.Generate(authsvc.Refresh, clientID, userID, role)
+	rt, err := tokenManager.Generate(authsvc.Refresh, clientID, userID, role, authsvc.Scope{})
 	if err != nil {
 		t.Fatalf("couldn't generate a token")
 	}
@@ -2,8 +2,12 @@ package grpc
 
 import (
 	"context"
+	"sort"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
 	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
+	"github.com/shanvl/garbage/internal/authsvc"
 )
 
 // Authorize decides whether the user has access to the requested RPC
@@ -12,5 +16,110 @@ func (s *Server) Authorize(ctx context.Context, req *authv1pb.AuthorizeRequest)
 	if err != nil {
 		return nil, s.handleError(err)
 	}
-	return &authv1pb.AuthorizeResponse{UserId: claims.Subject, ClientId: claims.ClientID}, nil
+	// the claims are empty if the method isn't protected
+	if claims.Subject == "" {
+		return &authv1pb.AuthorizeResponse{}, nil
+	}
+	role, err := authsvc.StringToRole(claims.Role)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	scope, err := claims.UserScope()
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &authv1pb.AuthorizeResponse{
+		UserId:   claims.Subject,
+		ClientId: claims.ClientID,
+		Role:     roleProtoMap[role],
+		Classes:  classesToProto(scope.Classes),
+		PupilIds: scope.PupilIDs,
+	}, nil
+}
+
+// FindNotBefore returns the latest not-before timestamp of the revocations of the client and of the user
+func (s *Server) FindNotBefore(ctx context.Context, req *authv1pb.FindNotBeforeRequest) (*authv1pb.
+	FindNotBeforeResponse, error) {
+
+	notBefore, err := s.authorizSvc.NotBefore(ctx, req.GetClientId(), req.GetUserId())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	// the timestamp isn't set if there are no revocations
+	if notBefore.IsZero() {
+		return &authv1pb.FindNotBeforeResponse{}, nil
+	}
+	notBeforeProto, err := ptypes.TimestampProto(notBefore)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &authv1pb.FindNotBeforeResponse{NotBefore: notBeforeProto}, nil
+}
+
+// FindProtectedRPCs returns the protected RPCs along with the roles allowed to call them
+func (s *Server) FindProtectedRPCs(ctx context.Context, req *authv1pb.FindProtectedRPCsRequest) (*authv1pb.
+	FindProtectedRPCsResponse, error) {
+
+	rpcs, err := s.authorizSvc.ProtectedRPCs(ctx, req.GetMethodPrefix())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	methods := sortedMethods(rpcs)
+	resp := &authv1pb.FindProtectedRPCsResponse{Rpcs: make([]*authv1pb.FindProtectedRPCsResponse_ProtectedRPC, 0,
+		len(methods))}
+	for _, method := range methods {
+		resp.Rpcs = append(resp.Rpcs, &authv1pb.FindProtectedRPCsResponse_ProtectedRPC{
+			Method: method,
+			Roles:  rolesToProto(rpcs[method]),
+		})
+	}
+	return resp, nil
+}
+
+// ListPolicies returns the protected RPCs along with the roles allowed to call them
+func (s *Server) ListPolicies(ctx context.Context, _ *empty.Empty) (*authv1pb.ListPoliciesResponse, error) {
+	policies, err := s.authorizSvc.ListPolicies(ctx)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	methods := sortedMethods(policies)
+	resp := &authv1pb.ListPoliciesResponse{Policies: make([]*authv1pb.Policy, 0, len(methods))}
+	for _, method := range methods {
+		resp.Policies = append(resp.Policies, &authv1pb.Policy{Method: method, Roles: rolesToProto(policies[method])})
+	}
+	return resp, nil
+}
+
+// ResetPolicies discards the changes made to the policies and restores the default ones
+func (s *Server) ResetPolicies(ctx context.Context, _ *empty.Empty) (*empty.Empty, error) {
+	if err := s.authorizSvc.ResetPolicies(ctx); err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// SetMethodRoles changes the roles allowed to call the RPC
+func (s *Server) SetMethodRoles(ctx context.Context, req *authv1pb.SetMethodRolesRequest) (*empty.Empty, error) {
+	roles := make([]authsvc.Role, 0, len(req.GetRoles()))
+	for _, r := range req.GetRoles() {
+		role, err := protoToRole(r)
+		if err != nil {
+			return nil, s.handleError(err)
+		}
+		roles = append(roles, role)
+	}
+	if err := s.authorizSvc.SetMethodRoles(ctx, req.GetMethod(), roles); err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// sortedMethods returns the sorted methods of the policies, so that the responses don't depend on the order of the map
+func sortedMethods(policies map[string][]authsvc.Role) []string {
+	methods := make([]string, 0, len(policies))
+	for method := range policies {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return methods
 }
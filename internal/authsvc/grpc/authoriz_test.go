@@ -2,17 +2,39 @@ package grpc_test
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
 	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
 	"github.com/shanvl/garbage/internal/authsvc"
+	"github.com/shanvl/garbage/internal/authsvc/grpc"
+	"github.com/shanvl/garbage/pkg/broker"
+	"go.uber.org/zap"
+	goGRPC "google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 func TestServer_Authorize(t *testing.T) {
 	ctx := context.Background()
 	accessToken := generateAccessToken(t, "clientid", "userid", authsvc.Member)
+	// the token of a client logged out after the token has been issued. The id of the client is unique, because
+	// the revocations can't be moved back
+	revokedClientID := fmt.Sprintf("revokedclientid%d", time.Now().UnixNano())
+	revokedToken := generateAccessToken(t, revokedClientID, "userid", authsvc.Member)
+	err := authentRepo.StoreRevocation(ctx, authsvc.Revocation{
+		Kind:      authsvc.ClientRevocation,
+		SubjectID: revokedClientID,
+		NotBefore: time.Now().Add(time.Second),
+	})
+	if err != nil {
+		t.Fatalf("couldn't revoke the client: %v", err)
+	}
 	tests := []struct {
 		name string
 		req  *authv1pb.AuthorizeRequest
@@ -42,6 +64,14 @@ func TestServer_Authorize(t *testing.T) {
 			},
 			code: codes.PermissionDenied,
 		},
+		{
+			name: "revoked token",
+			req: &authv1pb.AuthorizeRequest{
+				Method: "/shanvl.garbage.auth.v1.AuthService/Logout",
+				Token:  revokedToken,
+			},
+			code: codes.Unauthenticated,
+		},
 		{
 			name: "protected, ok",
 			req: &authv1pb.AuthorizeRequest{
@@ -89,9 +119,176 @@ func TestServer_Authorize(t *testing.T) {
 }
 
 func generateAccessToken(t *testing.T, clientID, userID string, role authsvc.Role) string {
-	token, err := tokenManager.Generate(authsvc.Access, clientID, userID, role)
+	token, err := tokenManager.Generate(authsvc.Access, clientID, userID, role, authsvc.Scope{})
 	if err != nil {
 		t.Fatalf("couldn't generate access token: %v", err)
 	}
 	return token
 }
+
+func TestServer_FindNotBefore(t *testing.T) {
+	ctx := context.Background()
+	clientID, userID := "notBeforeClient", "notBeforeUser"
+	res, err := server.FindNotBefore(ctx, &authv1pb.FindNotBeforeRequest{ClientId: clientID, UserId: userID})
+	if err != nil {
+		t.Fatalf("FindNotBefore() error = %v", err)
+	}
+	if res.GetNotBefore() != nil {
+		t.Errorf("FindNotBefore() no revocations, got = %v", res.GetNotBefore())
+	}
+	rev, err := authsvc.NewRevocation(authsvc.UserRevocation, userID)
+	if err != nil {
+		t.Fatalf("couldn't create revocation: %v", err)
+	}
+	if err := authentRepo.StoreRevocation(ctx, rev); err != nil {
+		t.Fatalf("couldn't store revocation: %v", err)
+	}
+	res, err = server.FindNotBefore(ctx, &authv1pb.FindNotBeforeRequest{ClientId: clientID, UserId: userID})
+	if err != nil {
+		t.Fatalf("FindNotBefore() error = %v", err)
+	}
+	if got, _ := ptypes.Timestamp(res.GetNotBefore()); !got.Equal(rev.NotBefore) {
+		t.Errorf("FindNotBefore() got = %v, want %v", got, rev.NotBefore)
+	}
+	if _, err := server.FindNotBefore(ctx, &authv1pb.FindNotBeforeRequest{UserId: userID}); status.Code(err) !=
+		codes.InvalidArgument {
+		t.Errorf("FindNotBefore() no client id, error = %v", err)
+	}
+}
+
+func TestServer_FindProtectedRPCs(t *testing.T) {
+	const prefix = "/shanvl.garbage.events.v1.EventsService/"
+	res, err := server.FindProtectedRPCs(context.Background(), &authv1pb.FindProtectedRPCsRequest{
+		MethodPrefix: prefix,
+	})
+	if err != nil {
+		t.Fatalf("FindProtectedRPCs() error == %v", err)
+	}
+	if len(res.GetRpcs()) == 0 {
+		t.Fatalf("FindProtectedRPCs() no rpcs returned")
+	}
+	for _, rpc := range res.GetRpcs() {
+		if !strings.HasPrefix(rpc.GetMethod(), prefix) {
+			t.Errorf("FindProtectedRPCs() method %q doesn't have the prefix", rpc.GetMethod())
+		}
+		if len(rpc.GetRoles()) == 0 {
+			t.Errorf("FindProtectedRPCs() method %q has no roles", rpc.GetMethod())
+		}
+	}
+}
+
+func TestServer_Policies(t *testing.T) {
+	ctx := context.Background()
+	const method = "/test.Service/Method"
+	defer func() {
+		if _, err := server.ResetPolicies(ctx, &empty.Empty{}); err != nil {
+			t.Errorf("ResetPolicies() error == %v", err)
+		}
+	}()
+	tests := []struct {
+		name string
+		req  *authv1pb.SetMethodRolesRequest
+		code codes.Code
+	}{
+		{
+			name: "no method",
+			req:  &authv1pb.SetMethodRolesRequest{Method: "", Roles: []authv1pb.Role{authv1pb.Role_ROLE_ADMIN}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unknown role",
+			req:  &authv1pb.SetMethodRolesRequest{Method: method, Roles: []authv1pb.Role{authv1pb.Role_ROLE_UNKNOWN}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "root deprived of policies",
+			req: &authv1pb.SetMethodRolesRequest{
+				Method: "/shanvl.garbage.auth.v1.AuthService/ListPolicies",
+				Roles:  []authv1pb.Role{authv1pb.Role_ROLE_ADMIN},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "ok",
+			req:  &authv1pb.SetMethodRolesRequest{Method: method, Roles: []authv1pb.Role{authv1pb.Role_ROLE_ADMIN}},
+			code: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.SetMethodRoles(ctx, tt.req)
+			if st, _ := status.FromError(err); st.Code() != tt.code {
+				t.Errorf("SetMethodRoles() err codes mismatch: code == %v, want == %v", st.Code(), tt.code)
+			}
+		})
+	}
+	t.Run("changed policy is applied", func(t *testing.T) {
+		res, err := server.ListPolicies(ctx, &empty.Empty{})
+		if err != nil {
+			t.Fatalf("ListPolicies() error == %v", err)
+		}
+		found := false
+		for _, p := range res.GetPolicies() {
+			if p.GetMethod() == method {
+				found = len(p.GetRoles()) == 1 && p.GetRoles()[0] == authv1pb.Role_ROLE_ADMIN
+			}
+		}
+		if !found {
+			t.Errorf("ListPolicies() the changed policy hasn't been found")
+		}
+		_, err = server.Authorize(ctx, &authv1pb.AuthorizeRequest{
+			Method: method,
+			Token:  generateAccessToken(t, "clientid", "userid", authsvc.Member),
+		})
+		if st, _ := status.FromError(err); st.Code() != codes.PermissionDenied {
+			t.Errorf("Authorize() err codes mismatch: code == %v, want == %v", st.Code(), codes.PermissionDenied)
+		}
+	})
+	t.Run("reset", func(t *testing.T) {
+		if _, err := server.ResetPolicies(ctx, &empty.Empty{}); err != nil {
+			t.Fatalf("ResetPolicies() error == %v", err)
+		}
+		res, err := server.ListPolicies(ctx, &empty.Empty{})
+		if err != nil {
+			t.Fatalf("ListPolicies() error == %v", err)
+		}
+		for _, p := range res.GetPolicies() {
+			if p.GetMethod() == method {
+				t.Errorf("ListPolicies() the changed policy is still there after reset")
+			}
+		}
+	})
+}
+
+func TestServer_InternalRPCs(t *testing.T) {
+	// the internal rpcs don't reach the services, so they aren't needed
+	interceptor := grpc.NewServer(nil, nil, nil, nil, broker.NewNopPublisher(), testServiceToken, zap.NewNop()).
+		AuthUnaryInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &empty.Empty{}, nil
+	}
+	serviceCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-service-token",
+		testServiceToken))
+	// the access token of a user doesn't give access to the internal rpcs
+	userCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+	for _, method := range []string{"FindNotBefore", "FindProtectedRPCs"} {
+		info := &goGRPC.UnaryServerInfo{FullMethod: "/shanvl.garbage.auth.v1.AuthService/" + method}
+		tests := []struct {
+			name string
+			ctx  context.Context
+			code codes.Code
+		}{
+			{name: "anonymous", ctx: context.Background(), code: codes.Unauthenticated},
+			{name: "user", ctx: userCtx, code: codes.Unauthenticated},
+			{name: "service", ctx: serviceCtx, code: codes.OK},
+		}
+		for _, tt := range tests {
+			t.Run(method+" "+tt.name, func(t *testing.T) {
+				_, err := interceptor(tt.ctx, nil, info, handler)
+				if status.Code(err) != tt.code {
+					t.Errorf("%s code = %v, want %v", method, status.Code(err), tt.code)
+				}
+			})
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/shanvl/garbage/internal/authsvc"
 	"github.com/shanvl/garbage/internal/authsvc/authoriz"
+	"github.com/shanvl/garbage/pkg/etag"
 	"github.com/shanvl/garbage/pkg/valid"
 	"go.uber.org/zap"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -23,12 +24,20 @@ func (s *Server) handleError(err error) error {
 	switch {
 	case errors.As(err, &validErr):
 		return errWithDetails(codes.InvalidArgument, validErr.Error(), validErr.Fields())
+	case errors.Is(err, authsvc.ErrActiveUser):
+		fallthrough
 	case errors.Is(err, authsvc.ErrInactiveUser):
 		fallthrough
 	case errors.Is(err, authsvc.ErrInvalidActivationToken):
 		fallthrough
+	case errors.Is(err, authsvc.ErrInvalidClass):
+		fallthrough
 	case errors.Is(err, authsvc.ErrInvalidRefreshToken):
 		fallthrough
+	case errors.Is(err, authsvc.ErrInvalidResetToken):
+		fallthrough
+	case errors.Is(err, etag.ErrInvalid):
+		fallthrough
 	case errors.Is(err, ErrInvalidTimestamp):
 		fallthrough
 	case errors.Is(err, authsvc.ErrUnknownClient):
@@ -39,8 +48,12 @@ func (s *Server) handleError(err error) error {
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, authsvc.ErrInvalidPassword):
 		fallthrough
+	case errors.Is(err, authsvc.ErrRefreshTokenReuse):
+		fallthrough
 	case errors.Is(err, authsvc.ErrInvalidAccessToken):
 		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, authsvc.ErrVersionMismatch):
+		return status.Error(codes.Aborted, err.Error())
 	case errors.Is(err, authoriz.ErrUnauthorized):
 		return status.Error(codes.PermissionDenied, err.Error())
 	default:
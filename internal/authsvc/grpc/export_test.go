@@ -0,0 +1,8 @@
+package grpc
+
+import "google.golang.org/grpc"
+
+// AuthUnaryInterceptor exposes the authorization interceptor to the tests
+func (s *Server) AuthUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return s.authUnaryInterceptor()
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/shanvl/garbage/internal/authsvc"
+	"github.com/shanvl/garbage/pkg/svcauth"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -19,6 +20,13 @@ func (s *Server) handleRecovery(p interface{}) error {
 	return status.Error(codes.Internal, "internal server error")
 }
 
+// internalRPCs are called only by the other services. They aren't protected by the policies, since the services don't
+// have access tokens, and are authenticated with the service token instead
+var internalRPCs = map[string]bool{
+	"/shanvl.garbage.auth.v1.AuthService/FindNotBefore":     true,
+	"/shanvl.garbage.auth.v1.AuthService/FindProtectedRPCs": true,
+}
+
 // authUnaryInterceptor talks to the auth service to get the permission to access the rpc and populates ctx with info
 // about the request
 func (s *Server) authUnaryInterceptor() grpc.UnaryServerInterceptor {
@@ -29,6 +37,14 @@ func (s *Server) authUnaryInterceptor() grpc.UnaryServerInterceptor {
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
 
+		// the internal rpcs are called by the services, which authenticate with the service token
+		if internalRPCs[info.FullMethod] {
+			if !svcauth.Verify(ctx, s.serviceToken) {
+				return nil, status.Error(codes.Unauthenticated, "service token is required")
+			}
+			return handler(ctx, req)
+		}
+
 		// get access token from auth header
 		token := getAccessTokenFromAuthHeader(ctx, "bearer")
 
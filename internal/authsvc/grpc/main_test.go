@@ -1,6 +1,8 @@
 package grpc_test
 
 import (
+	"context"
+	"errors"
 	"log"
 	"os"
 	"testing"
@@ -11,16 +13,42 @@ import (
 	"github.com/shanvl/garbage/internal/authsvc/authoriz"
 	"github.com/shanvl/garbage/internal/authsvc/grpc"
 	"github.com/shanvl/garbage/internal/authsvc/jwt"
+	"github.com/shanvl/garbage/internal/authsvc/mock"
 	"github.com/shanvl/garbage/internal/authsvc/postgres"
 	"github.com/shanvl/garbage/internal/authsvc/users"
+	"github.com/shanvl/garbage/pkg/audit"
+	"github.com/shanvl/garbage/pkg/broker"
 	"go.uber.org/zap"
 )
 
+const (
+	// testServiceToken authenticates the calls of the internal RPCs
+	testServiceToken = "test service token"
+	// testUnreachableEmail is the email the mailer fails to send the emails to
+	testUnreachableEmail = "unreachable@email.com"
+)
+
 var (
 	server       *grpc.Server
+	auditRepo    audit.Repository
 	usersRepo    users.Repository
 	authentRepo  authent.Repository
 	tokenManager authsvc.TokenManager
+	// resetTokens are the password reset tokens "sent" by the mailer, by email
+	resetTokens = map[string]string{}
+	// mailer pretends to send the emails
+	mailer = &mock.Mailer{
+		SendActivationFn: func(ctx context.Context, email, activationToken string) error {
+			return nil
+		},
+		SendPasswordResetFn: func(ctx context.Context, email, resetToken string) error {
+			if email == testUnreachableEmail {
+				return errors.New("mailbox unavailable")
+			}
+			resetTokens[email] = resetToken
+			return nil
+		},
+	}
 )
 
 func TestMain(m *testing.M) {
@@ -47,6 +75,7 @@ func testMain(m *testing.M) int {
 	defer db.Close()
 
 	// repos with a test db
+	auditRepo = audit.NewPostgresRepo(db)
 	authentRepo = postgres.NewAuthentRepo(db)
 	usersRepo = postgres.NewUsersRepo(db)
 	// token manager with test keys
@@ -60,11 +89,17 @@ func testMain(m *testing.M) int {
 		log.Print(err)
 		return 1
 	}
-	tokenManager = jwt.NewManagerRSA(30*time.Minute, 120*time.Hour, prKey, pubKey)
+	tokenManager = jwt.NewManagerRSA(30*time.Minute, 120*time.Hour, jwt.NewKeySet("test", prKey, pubKey))
 	// create services
 	authentSvc := authent.NewService(authentRepo, tokenManager)
-	authorizSvc := authoriz.NewService(tokenManager, authoriz.ProtectedRPCMap())
-	usersSvc := users.NewService(usersRepo)
+	authorizRepo := postgres.NewAuthorizRepo(db)
+	if err := authorizRepo.ResetPolicies(context.Background(), authoriz.ProtectedRPCMap()); err != nil {
+		log.Print(err)
+		return 1
+	}
+	authorizSvc := authoriz.NewService(authorizRepo, tokenManager, authoriz.ProtectedRPCMap(), time.Minute)
+	usersSvc := users.NewService(usersRepo, mailer)
+	auditSvc := audit.NewService(auditRepo)
 	// logger
 	logger, err := zap.NewProduction()
 	if err != nil {
@@ -72,6 +107,7 @@ func testMain(m *testing.M) int {
 		return 1
 	}
 	// create gRPC server
-	server = grpc.NewServer(authentSvc, authorizSvc, usersSvc, logger)
+	server = grpc.NewServer(auditSvc, authentSvc, authorizSvc, usersSvc, broker.NewNopPublisher(), testServiceToken,
+		logger)
 	return m.Run()
 }
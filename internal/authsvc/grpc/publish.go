@@ -0,0 +1,20 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/shanvl/garbage/pkg/broker"
+	"go.uber.org/zap"
+)
+
+// publish publishes a domain event. The event is published after the change has been stored, so a failure to publish
+// it mustn't fail the request. It is logged instead
+func (s *Server) publish(ctx context.Context, topic string, payload interface{}) {
+	msg, err := broker.NewMessage(topic, payload)
+	if err == nil {
+		err = s.publisher.Publish(ctx, msg)
+	}
+	if err != nil {
+		s.log.Error("couldn't publish the message", zap.Error(err), zap.String("topic", topic))
+	}
+}
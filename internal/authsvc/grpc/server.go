@@ -15,6 +15,8 @@ import (
 	"github.com/shanvl/garbage/internal/authsvc/authent"
 	"github.com/shanvl/garbage/internal/authsvc/authoriz"
 	"github.com/shanvl/garbage/internal/authsvc/users"
+	"github.com/shanvl/garbage/pkg/audit"
+	"github.com/shanvl/garbage/pkg/broker"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -22,17 +24,27 @@ import (
 
 type Server struct {
 	log         *zap.Logger
+	auditSvc    audit.Service
 	authentSvc  authent.Service
 	authorizSvc authoriz.Service
 	usersSvc    users.Service
+	// publisher publishes the domain events to the notification service
+	publisher broker.Publisher
+	// serviceToken authenticates the other services calling the internal RPCs
+	serviceToken string
 }
 
-func NewServer(authent authent.Service, authoriz authoriz.Service, users users.Service, log *zap.Logger) *Server {
+func NewServer(audit audit.Service, authent authent.Service, authoriz authoriz.Service, users users.Service,
+	publisher broker.Publisher, serviceToken string, log *zap.Logger) *Server {
+
 	server := &Server{
-		log:         log,
-		authentSvc:  authent,
-		authorizSvc: authoriz,
-		usersSvc:    users,
+		log:          log,
+		auditSvc:     audit,
+		authentSvc:   authent,
+		authorizSvc:  authoriz,
+		usersSvc:     users,
+		publisher:    publisher,
+		serviceToken: serviceToken,
 	}
 	return server
 }
@@ -51,6 +63,8 @@ func (s *Server) Run(port int) error {
 			grpc_zap.UnaryServerInterceptor(s.log),
 			// authorization interceptor
 			s.authUnaryInterceptor(),
+			// audit interceptor, it needs the claims put to ctx by the authorization one
+			s.auditUnaryInterceptor(),
 			// panic recovery
 			grpcRecovery.UnaryServerInterceptor(grpcRecovery.WithRecoveryHandler(s.handleRecovery)),
 		)),
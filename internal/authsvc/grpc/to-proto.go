@@ -8,15 +8,17 @@ import (
 )
 
 var roleProtoMap = map[authsvc.Role]authv1pb.Role{
-	authsvc.Admin:  authv1pb.Role_ROLE_ADMIN,
-	authsvc.Member: authv1pb.Role_ROLE_MEMBER,
-	authsvc.Root:   authv1pb.Role_ROLE_ROOT,
+	authsvc.Admin:    authv1pb.Role_ROLE_ADMIN,
+	authsvc.Guardian: authv1pb.Role_ROLE_GUARDIAN,
+	authsvc.Member:   authv1pb.Role_ROLE_MEMBER,
+	authsvc.Root:     authv1pb.Role_ROLE_ROOT,
 }
 
 var protoRoleMap = map[authv1pb.Role]authsvc.Role{
-	authv1pb.Role_ROLE_ADMIN:  authsvc.Admin,
-	authv1pb.Role_ROLE_MEMBER: authsvc.Member,
-	authv1pb.Role_ROLE_ROOT:   authsvc.Root,
+	authv1pb.Role_ROLE_ADMIN:    authsvc.Admin,
+	authv1pb.Role_ROLE_GUARDIAN: authsvc.Guardian,
+	authv1pb.Role_ROLE_MEMBER:   authsvc.Member,
+	authv1pb.Role_ROLE_ROOT:     authsvc.Root,
 }
 
 var protoUserSortingMap = map[authv1pb.UserSorting]users.Sorting{
@@ -36,6 +38,33 @@ func protoToRole(proto authv1pb.Role) (authsvc.Role, error) {
 	return role, nil
 }
 
+// classesToProto converts []authsvc.Class to []*authv1pb.Class
+func classesToProto(classes []authsvc.Class) []*authv1pb.Class {
+	protos := make([]*authv1pb.Class, 0, len(classes))
+	for _, c := range classes {
+		protos = append(protos, &authv1pb.Class{Letter: c.Letter, YearFormed: int32(c.YearFormed)})
+	}
+	return protos
+}
+
+// protoToClasses converts []*authv1pb.Class to []authsvc.Class. The classes are validated by the service
+func protoToClasses(protos []*authv1pb.Class) []authsvc.Class {
+	classes := make([]authsvc.Class, 0, len(protos))
+	for _, p := range protos {
+		classes = append(classes, authsvc.Class{Letter: p.GetLetter(), YearFormed: int(p.GetYearFormed())})
+	}
+	return classes
+}
+
+// rolesToProto converts []authsvc.Role to []authv1pb.Role
+func rolesToProto(roles []authsvc.Role) []authv1pb.Role {
+	protos := make([]authv1pb.Role, 0, len(roles))
+	for _, role := range roles {
+		protos = append(protos, roleProtoMap[role])
+	}
+	return protos
+}
+
 // userToProto converts *authsvc.User to *authv1pb.User
 func userToProto(user *authsvc.User) *authv1pb.User {
 	return &authv1pb.User{
@@ -44,6 +73,7 @@ func userToProto(user *authsvc.User) *authv1pb.User {
 		FirstName: user.FirstName,
 		LastName:  user.LastName,
 		Role:      roleProtoMap[user.Role],
+		Version:   uint32(user.Version),
 	}
 }
 
@@ -2,9 +2,15 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
+	"github.com/shanvl/garbage/internal/authsvc/users"
+	"github.com/shanvl/garbage/pkg/broker"
+	"github.com/shanvl/garbage/pkg/etag"
+	"github.com/shanvl/garbage/pkg/paging"
+	"go.uber.org/zap"
 )
 
 // ActivateUser changes the active state of the user to active and populates it with the provided additional info
@@ -18,13 +24,32 @@ func (s *Server) ActivateUser(ctx context.Context, req *authv1pb.ActivateUserReq
 	return &empty.Empty{}, nil
 }
 
+// ChangeOwnPassword changes the password of the user making the request if the old password is correct
+func (s *Server) ChangeOwnPassword(ctx context.Context, req *authv1pb.ChangeOwnPasswordRequest) (*empty.Empty, error) {
+	// claims are put to ctx by auth interceptor
+	claims, err := authClaimsFromCtx(ctx)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	err = s.usersSvc.ChangeOwnPassword(ctx, claims.Subject, claims.ClientID, req.GetOldPassword(),
+		req.GetNewPassword(), req.GetLogoutOtherClients())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
 // ChangeUserRole changes the user's role to the provided role
 func (s *Server) ChangeUserRole(ctx context.Context, req *authv1pb.ChangeUserRoleRequest) (*empty.Empty, error) {
 	role, err := protoToRole(req.GetRole())
 	if err != nil {
 		return nil, s.handleError(err)
 	}
-	err = s.usersSvc.ChangeUserRole(ctx, req.GetId(), role)
+	version, err := etag.Precondition(ctx, req.GetVersion())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	err = s.usersSvc.ChangeUserRole(ctx, req.GetId(), role, version)
 	if err != nil {
 		return nil, s.handleError(err)
 	}
@@ -37,9 +62,13 @@ func (s *Server) CreateUser(ctx context.Context, req *authv1pb.CreateUserRequest
 	error) {
 
 	userID, activationToken, err := s.usersSvc.CreateUser(ctx, req.GetEmail())
-	if err != nil {
+	// the user has been created anyway, so the token is returned to the caller, who can pass it to the user by hand
+	if errors.Is(err, users.ErrActivationNotSent) {
+		s.log.Warn("activation email not sent", zap.Error(err), zap.String("userID", userID))
+	} else if err != nil {
 		return nil, s.handleError(err)
 	}
+	s.publish(ctx, broker.TopicUserCreated, broker.UserCreated{UserID: userID, Email: req.GetEmail()})
 
 	return &authv1pb.CreateUserResponse{Id: userID, ActivationToken: activationToken}, nil
 }
@@ -62,15 +91,36 @@ func (s *Server) FindUser(ctx context.Context, req *authv1pb.FindUserRequest) (*
 	return &authv1pb.FindUserResponse{User: userToProto(user)}, nil
 }
 
+// FindUserClasses returns the classes the user is assigned to
+func (s *Server) FindUserClasses(ctx context.Context, req *authv1pb.FindUserClassesRequest) (*authv1pb.
+	FindUserClassesResponse, error) {
+
+	classes, err := s.usersSvc.UserClasses(ctx, req.GetId())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &authv1pb.FindUserClassesResponse{Classes: classesToProto(classes)}, nil
+}
+
+// FindUserPupils returns the ids of the pupils the user is linked to
+func (s *Server) FindUserPupils(ctx context.Context, req *authv1pb.FindUserPupilsRequest) (*authv1pb.
+	FindUserPupilsResponse, error) {
+
+	pupilIDs, err := s.usersSvc.UserPupils(ctx, req.GetId())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &authv1pb.FindUserPupilsResponse{PupilIds: pupilIDs}, nil
+}
+
 // FindUsers returns a sorted list of users
 // "nameAndEmail" may consist of any combination of the email, first name and last name parts
 func (s *Server) FindUsers(ctx context.Context, req *authv1pb.FindUsersRequest) (*authv1pb.FindUsersResponse, error) {
-	users, total, err := s.usersSvc.Users(
+	users, total, nextToken, err := s.usersSvc.Users(
 		ctx,
 		req.GetNameAndEmail(),
 		protoUserSortingMap[req.GetSorting()],
-		int(req.GetAmount()),
-		int(req.GetSkip()),
+		paging.FromRequest(req),
 	)
 	if err != nil {
 		return nil, s.handleError(err)
@@ -80,5 +130,83 @@ func (s *Server) FindUsers(ctx context.Context, req *authv1pb.FindUsersRequest)
 	for i, user := range users {
 		usersProto[i] = userToProto(user)
 	}
-	return &authv1pb.FindUsersResponse{Users: usersProto, Total: uint32(total)}, nil
+	return &authv1pb.FindUsersResponse{Users: usersProto, Total: uint32(total), NextPageToken: nextToken}, nil
+}
+
+// RequestPasswordReset emails a single-use password reset token to the user with the given email.
+// It doesn't tell whether such a user exists
+func (s *Server) RequestPasswordReset(ctx context.Context, req *authv1pb.RequestPasswordResetRequest) (*empty.Empty,
+	error) {
+
+	err := s.usersSvc.RequestPasswordReset(ctx, req.GetEmail())
+	// the failure to send the email is only logged, since it would tell the caller that the user exists
+	if errors.Is(err, users.ErrPasswordResetNotSent) {
+		s.log.Warn("password reset email not sent", zap.Error(err))
+	} else if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// ResendActivation issues a fresh activation token for the inactive user and emails it to them. The old token
+// can't be used anymore
+func (s *Server) ResendActivation(ctx context.Context, req *authv1pb.ResendActivationRequest) (
+	*authv1pb.ResendActivationResponse, error) {
+
+	activationToken, err := s.usersSvc.ResendActivation(ctx, req.GetId())
+	// the token has been renewed anyway, so it's returned to the caller, who can pass it to the user by hand
+	if errors.Is(err, users.ErrActivationNotSent) {
+		s.log.Warn("activation email not sent", zap.Error(err), zap.String("userID", req.GetId()))
+	} else if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &authv1pb.ResendActivationResponse{ActivationToken: activationToken}, nil
+}
+
+// ResetPassword sets a new password for the user the reset token has been issued to and logs them out from
+// all of their clients
+func (s *Server) ResetPassword(ctx context.Context, req *authv1pb.ResetPasswordRequest) (*empty.Empty, error) {
+	err := s.usersSvc.ResetPassword(ctx, req.GetResetToken(), req.GetPassword())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// SetUserClasses assigns the user to the classes, replacing the previous ones, and logs the user out
+func (s *Server) SetUserClasses(ctx context.Context, req *authv1pb.SetUserClassesRequest) (*empty.Empty, error) {
+	err := s.usersSvc.SetUserClasses(ctx, req.GetId(), protoToClasses(req.GetClasses()))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// SetUserPupils links the user to the pupils, replacing the previous ones, and logs the user out
+func (s *Server) SetUserPupils(ctx context.Context, req *authv1pb.SetUserPupilsRequest) (*empty.Empty, error) {
+	err := s.usersSvc.SetUserPupils(ctx, req.GetId(), req.GetPupilIds())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// UpdateProfile changes the first and the last names of the user making the request
+func (s *Server) UpdateProfile(ctx context.Context, req *authv1pb.UpdateProfileRequest) (*authv1pb.UpdateProfileResponse,
+	error) {
+
+	// claims are put to ctx by auth interceptor
+	claims, err := authClaimsFromCtx(ctx)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	version, err := etag.Precondition(ctx, req.GetVersion())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	user, err := s.usersSvc.UpdateProfile(ctx, claims.Subject, req.GetFirstName(), req.GetLastName(), version)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &authv1pb.UpdateProfileResponse{User: userToProto(user)}, nil
 }
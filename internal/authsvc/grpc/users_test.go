@@ -5,8 +5,11 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/dgrijalva/jwt-go"
 	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
 	"github.com/shanvl/garbage/internal/authsvc"
+	"github.com/shanvl/garbage/internal/authsvc/authent"
+	"github.com/shanvl/garbage/internal/authsvc/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -268,6 +271,15 @@ func TestServer_ChangeUserRole(t *testing.T) {
 			},
 			code: codes.InvalidArgument,
 		},
+		{
+			name: "changed in the meantime",
+			req: &authv1pb.ChangeUserRoleRequest{
+				Id:      u.ID,
+				Role:    authv1pb.Role_ROLE_ADMIN,
+				Version: 5,
+			},
+			code: codes.Aborted,
+		},
 		{
 			name: "ok",
 			req: &authv1pb.ChangeUserRoleRequest{
@@ -461,6 +473,342 @@ func TestServer_FindUsers(t *testing.T) {
 	}
 }
 
+func TestServer_ResendActivation(t *testing.T) {
+	ctx := context.Background()
+	inactive := &authsvc.User{ID: "inactiveid", Email: "inactive@email.com", ActivationToken: "oldtoken"}
+	storeUser(t, inactive)
+	defer deleteUserByID(t, inactive.ID)
+	active := &authsvc.User{ID: "activeid", Email: "active@email.com", Active: true}
+	storeUser(t, active)
+	defer deleteUserByID(t, active.ID)
+	tests := []struct {
+		name string
+		req  *authv1pb.ResendActivationRequest
+		code codes.Code
+	}{
+		{
+			name: "no id",
+			req:  &authv1pb.ResendActivationRequest{Id: ""},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "no user with such id",
+			req:  &authv1pb.ResendActivationRequest{Id: "somerandomid"},
+			code: codes.NotFound,
+		},
+		{
+			name: "active user",
+			req:  &authv1pb.ResendActivationRequest{Id: active.ID},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "ok",
+			req:  &authv1pb.ResendActivationRequest{Id: inactive.ID},
+			code: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := server.ResendActivation(ctx, tt.req)
+			if tt.code == codes.OK {
+				if err != nil {
+					t.Errorf("ResendActivation() error == %v, wantErr == false", err)
+				}
+				if res.GetActivationToken() == "" {
+					t.Errorf("ResendActivation() activation token == \"\", want != \"\"")
+				}
+				user := userByID(t, tt.req.GetId())
+				if user.ActivationToken != res.GetActivationToken() {
+					t.Errorf("ResendActivation() stored token == %v, want == %v", user.ActivationToken,
+						res.GetActivationToken())
+				}
+			} else {
+				if err == nil {
+					t.Errorf("ResendActivation() error == nil, wantErr == true")
+				}
+				if res != nil {
+					t.Errorf("ResendActivation() res == %v, want == nil", res)
+				}
+				st, ok := status.FromError(err)
+				if ok != true {
+					t.Errorf("ResendActivation() couldn't get status from err %v", err)
+				}
+				if st.Code() != tt.code {
+					t.Errorf("ResendActivation() err codes mismatch: code == %v, want == %v", st.Code(), tt.code)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_ResetPassword(t *testing.T) {
+	ctx := context.Background()
+	u := &authsvc.User{ID: "resetid", Email: "reset@email.com", Active: true}
+	storeUser(t, u)
+	defer deleteUserByID(t, u.ID)
+	storeClient(t, authent.Client{ID: "resetclient", UserID: u.ID, RefreshToken: "token"})
+
+	// unknown emails are accepted silently
+	if _, err := server.RequestPasswordReset(ctx, &authv1pb.RequestPasswordResetRequest{
+		Email: "unknown@email.com",
+	}); err != nil {
+		t.Fatalf("RequestPasswordReset() error == %v, wantErr == false", err)
+	}
+	if _, err := server.RequestPasswordReset(ctx, &authv1pb.RequestPasswordResetRequest{Email: u.Email}); err != nil {
+		t.Fatalf("RequestPasswordReset() error == %v, wantErr == false", err)
+	}
+	resetToken := resetTokens[u.Email]
+	if resetToken == "" {
+		t.Fatalf("RequestPasswordReset() didn't send the reset token")
+	}
+	// the emails which can't be sent aren't reported, since it would tell that the user exists
+	unreachable := &authsvc.User{ID: "unreachableid", Email: testUnreachableEmail, Active: true}
+	storeUser(t, unreachable)
+	defer deleteUserByID(t, unreachable.ID)
+	if _, err := server.RequestPasswordReset(ctx, &authv1pb.RequestPasswordResetRequest{
+		Email: unreachable.Email,
+	}); err != nil {
+		t.Fatalf("RequestPasswordReset() of the unreachable email error == %v, wantErr == false", err)
+	}
+	tests := []struct {
+		name string
+		req  *authv1pb.ResetPasswordRequest
+		code codes.Code
+	}{
+		{
+			name: "no token",
+			req:  &authv1pb.ResetPasswordRequest{Password: "password"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "short password",
+			req:  &authv1pb.ResetPasswordRequest{ResetToken: resetToken, Password: "pass"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unknown token",
+			req:  &authv1pb.ResetPasswordRequest{ResetToken: "unknowntoken", Password: "password"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "ok",
+			req:  &authv1pb.ResetPasswordRequest{ResetToken: resetToken, Password: "password"},
+			code: codes.OK,
+		},
+		{
+			name: "used token",
+			req:  &authv1pb.ResetPasswordRequest{ResetToken: resetToken, Password: "password"},
+			code: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := server.ResetPassword(ctx, tt.req)
+			if tt.code == codes.OK {
+				if err != nil {
+					t.Errorf("ResetPassword() error == %v, wantErr == false", err)
+				}
+				if res == nil {
+					t.Errorf("ResetPassword() res == nil, want != nil")
+				}
+				if !userByID(t, u.ID).IsCorrectPassword(tt.req.GetPassword()) {
+					t.Errorf("ResetPassword() password wasn't changed")
+				}
+				if _, err := authentRepo.ClientByID(ctx, "resetclient"); err == nil {
+					t.Errorf("ResetPassword() user's clients weren't deleted")
+				}
+			} else {
+				if err == nil {
+					t.Errorf("ResetPassword() error == nil, wantErr == true")
+				}
+				if res != nil {
+					t.Errorf("ResetPassword() res == %v, want == nil", res)
+				}
+				st, ok := status.FromError(err)
+				if ok != true {
+					t.Errorf("ResetPassword() couldn't get status from err %v", err)
+				}
+				if st.Code() != tt.code {
+					t.Errorf("ResetPassword() err codes mismatch: code == %v, want == %v", st.Code(), tt.code)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_ChangeOwnPassword(t *testing.T) {
+	const (
+		password      = "password"
+		clientID      = "currentclient"
+		otherClientID = "otherclient"
+	)
+	u := newUser(t, "changepasswordid", "changepassword@email.com", password, authsvc.Member)
+	storeUser(t, u)
+	defer deleteUserByID(t, u.ID)
+	storeClient(t, authent.Client{ID: clientID, UserID: u.ID, RefreshToken: "token"})
+	storeClient(t, authent.Client{ID: otherClientID, UserID: u.ID, RefreshToken: "token"})
+	ctx := context.WithValue(context.Background(), grpc.AuthCtxKey, authsvc.UserClaims{
+		StandardClaims: jwt.StandardClaims{Subject: u.ID},
+		ClientID:       clientID,
+	})
+	// the access tokens stay valid until they expire unless the clients are revoked
+	accessToken := generateAccessToken(t, clientID, u.ID, authsvc.Member)
+	otherAccessToken := generateAccessToken(t, otherClientID, u.ID, authsvc.Member)
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  *authv1pb.ChangeOwnPasswordRequest
+		code codes.Code
+	}{
+		{
+			name: "empty context",
+			ctx:  context.Background(),
+			req:  &authv1pb.ChangeOwnPasswordRequest{OldPassword: password, NewPassword: "newpassword"},
+			code: codes.Internal,
+		},
+		{
+			name: "short new password",
+			ctx:  ctx,
+			req:  &authv1pb.ChangeOwnPasswordRequest{OldPassword: password, NewPassword: "new"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "wrong old password",
+			ctx:  ctx,
+			req:  &authv1pb.ChangeOwnPasswordRequest{OldPassword: "wrongpassword", NewPassword: "newpassword"},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "ok",
+			ctx:  ctx,
+			req: &authv1pb.ChangeOwnPasswordRequest{OldPassword: password, NewPassword: "newpassword",
+				LogoutOtherClients: true},
+			code: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := server.ChangeOwnPassword(tt.ctx, tt.req)
+			if tt.code == codes.OK {
+				if err != nil {
+					t.Errorf("ChangeOwnPassword() error == %v, wantErr == false", err)
+				}
+				if res == nil {
+					t.Errorf("ChangeOwnPassword() res == nil, want != nil")
+				}
+				if !userByID(t, u.ID).IsCorrectPassword(tt.req.GetNewPassword()) {
+					t.Errorf("ChangeOwnPassword() password wasn't changed")
+				}
+				if _, err := authentRepo.ClientByID(tt.ctx, otherClientID); err == nil {
+					t.Errorf("ChangeOwnPassword() other clients weren't deleted")
+				}
+				if _, err := authentRepo.ClientByID(tt.ctx, clientID); err != nil {
+					t.Errorf("ChangeOwnPassword() current client was deleted")
+				}
+				_, err = server.Authorize(tt.ctx, &authv1pb.AuthorizeRequest{
+					Method: "/shanvl.garbage.auth.v1.AuthService/Logout",
+					Token:  otherAccessToken,
+				})
+				if status.Code(err) != codes.Unauthenticated {
+					t.Errorf("ChangeOwnPassword() other client's token wasn't revoked, Authorize() error == %v", err)
+				}
+				_, err = server.Authorize(tt.ctx, &authv1pb.AuthorizeRequest{
+					Method: "/shanvl.garbage.auth.v1.AuthService/Logout",
+					Token:  accessToken,
+				})
+				if err != nil {
+					t.Errorf("ChangeOwnPassword() current client's token was revoked, Authorize() error == %v", err)
+				}
+			} else {
+				if err == nil {
+					t.Errorf("ChangeOwnPassword() error == nil, wantErr == true")
+				}
+				if res != nil {
+					t.Errorf("ChangeOwnPassword() res == %v, want == nil", res)
+				}
+				st, ok := status.FromError(err)
+				if ok != true {
+					t.Errorf("ChangeOwnPassword() couldn't get status from err %v", err)
+				}
+				if st.Code() != tt.code {
+					t.Errorf("ChangeOwnPassword() err codes mismatch: code == %v, want == %v", st.Code(), tt.code)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_UpdateProfile(t *testing.T) {
+	u := newUser(t, "updateprofileid", "updateprofile@email.com", "password", authsvc.Member)
+	storeUser(t, u)
+	defer deleteUserByID(t, u.ID)
+	ctx := context.WithValue(context.Background(), grpc.AuthCtxKey, authsvc.UserClaims{
+		StandardClaims: jwt.StandardClaims{Subject: u.ID},
+	})
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  *authv1pb.UpdateProfileRequest
+		code codes.Code
+	}{
+		{
+			name: "empty context",
+			ctx:  context.Background(),
+			req:  &authv1pb.UpdateProfileRequest{FirstName: "newfn", LastName: "newln"},
+			code: codes.Internal,
+		},
+		{
+			name: "no last name",
+			ctx:  ctx,
+			req:  &authv1pb.UpdateProfileRequest{FirstName: "newfn"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "changed in the meantime",
+			ctx:  ctx,
+			req:  &authv1pb.UpdateProfileRequest{FirstName: "newfn", LastName: "newln", Version: 5},
+			code: codes.Aborted,
+		},
+		{
+			name: "ok",
+			ctx:  ctx,
+			req:  &authv1pb.UpdateProfileRequest{FirstName: "newfn", LastName: "newln"},
+			code: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := server.UpdateProfile(tt.ctx, tt.req)
+			if tt.code == codes.OK {
+				if err != nil {
+					t.Errorf("UpdateProfile() error == %v, wantErr == false", err)
+				}
+				if res.GetUser().GetFirstName() != tt.req.GetFirstName() {
+					t.Errorf("UpdateProfile() res == %v, want the updated user", res)
+				}
+				user := userByID(t, u.ID)
+				if user.FirstName != tt.req.GetFirstName() || user.LastName != tt.req.GetLastName() {
+					t.Errorf("UpdateProfile() user's names weren't changed: %v", user)
+				}
+			} else {
+				if err == nil {
+					t.Errorf("UpdateProfile() error == nil, wantErr == true")
+				}
+				if res != nil {
+					t.Errorf("UpdateProfile() res == %v, want == nil", res)
+				}
+				st, ok := status.FromError(err)
+				if ok != true {
+					t.Errorf("UpdateProfile() couldn't get status from err %v", err)
+				}
+				if st.Code() != tt.code {
+					t.Errorf("UpdateProfile() err codes mismatch: code == %v, want == %v", st.Code(), tt.code)
+				}
+			}
+		})
+	}
+}
+
 func userByEmail(t *testing.T, email string) *authsvc.User {
 	u, err := authentRepo.UserByEmail(context.Background(), email)
 	if err != nil {
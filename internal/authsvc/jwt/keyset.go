@@ -0,0 +1,136 @@
+package jwt
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/shanvl/garbage/pkg/jwks"
+)
+
+const (
+	privateKeyExt = ".rsa"
+	publicKeyExt  = ".rsa.pub"
+)
+
+// KeySet is a set of rsa keys identified by their ids (kid). The active key signs the tokens, while every key of
+// the set verifies them. Thus, the tokens signed with a retired key keep working until they expire
+type KeySet struct {
+	// dir is the directory the keys are loaded from. It's empty if the set can't be reloaded
+	dir string
+
+	mu         sync.RWMutex
+	activeKID  string
+	signingKey *rsa.PrivateKey
+	publicKeys map[string]*rsa.PublicKey
+}
+
+// NewKeySet returns a set consisting of a single key pair
+func NewKeySet(kid string, privateKey *rsa.PrivateKey, publicKey *rsa.PublicKey) *KeySet {
+	return &KeySet{
+		activeKID:  kid,
+		signingKey: privateKey,
+		publicKeys: map[string]*rsa.PublicKey{kid: publicKey},
+	}
+}
+
+// KeySetFromDir loads the keys from the directory. Private keys must be named "<kid>.rsa" and public keys must be
+// named "<kid>.rsa.pub". The private key with the greatest kid becomes the active one, so a new key is rolled out
+// by putting it into the directory under a greater kid (a date, for example) and reloading the set.
+// A retired key is kept for verification by removing its private key and leaving the public one
+func KeySetFromDir(dir string) (*KeySet, error) {
+	ks := &KeySet{dir: dir}
+	if err := ks.Reload(); err != nil {
+		return nil, err
+	}
+	return ks, nil
+}
+
+// Reload reloads the keys from the directory. If the new keys can't be loaded, the set is left intact
+func (ks *KeySet) Reload() error {
+	if ks.dir == "" {
+		return errors.New("the key set hasn't been loaded from a directory")
+	}
+	files, err := ioutil.ReadDir(ks.dir)
+	if err != nil {
+		return fmt.Errorf("couldn't read keys dir: %w", err)
+	}
+	privateKeys := make(map[string]*rsa.PrivateKey)
+	publicKeys := make(map[string]*rsa.PublicKey)
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		name, path := f.Name(), filepath.Join(ks.dir, f.Name())
+		switch {
+		case strings.HasSuffix(name, publicKeyExt):
+			key, err := PublicKeyFromFile(path)
+			if err != nil {
+				return err
+			}
+			publicKeys[strings.TrimSuffix(name, publicKeyExt)] = key
+		case strings.HasSuffix(name, privateKeyExt):
+			key, err := PrivateKeyFromFile(path)
+			if err != nil {
+				return err
+			}
+			privateKeys[strings.TrimSuffix(name, privateKeyExt)] = key
+		}
+	}
+	if len(privateKeys) == 0 {
+		return fmt.Errorf("no private keys found in %s", ks.dir)
+	}
+	// pick the active key and make sure the tokens signed with any private key can be verified
+	kids := make([]string, 0, len(privateKeys))
+	for kid, key := range privateKeys {
+		kids = append(kids, kid)
+		if _, ok := publicKeys[kid]; !ok {
+			publicKeys[kid] = &key.PublicKey
+		}
+	}
+	sort.Strings(kids)
+	activeKID := kids[len(kids)-1]
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.activeKID = activeKID
+	ks.signingKey = privateKeys[activeKID]
+	ks.publicKeys = publicKeys
+	return nil
+}
+
+// ActiveKey returns the key which signs the tokens along with its id
+func (ks *KeySet) ActiveKey() (string, *rsa.PrivateKey) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	return ks.activeKID, ks.signingKey
+}
+
+// PublicKey returns the public key with the given id
+func (ks *KeySet) PublicKey(kid string) (*rsa.PublicKey, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	key, ok := ks.publicKeys[kid]
+	return key, ok
+}
+
+// JWKS returns the public keys of the set as a JSON Web Key Set
+func (ks *KeySet) JWKS() jwks.Set {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	kids := make([]string, 0, len(ks.publicKeys))
+	for kid := range ks.publicKeys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+	set := jwks.Set{Keys: make([]jwks.Key, 0, len(kids))}
+	for _, kid := range kids {
+		set.Keys = append(set.Keys, jwks.NewRSAKey(kid, ks.publicKeys[kid]))
+	}
+	return set
+}
@@ -0,0 +1,130 @@
+package jwt
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shanvl/garbage/internal/authsvc"
+)
+
+func TestKeySetFromDir(t *testing.T) {
+	t.Parallel()
+	ks, err := KeySetFromDir("./keys_test")
+	if err != nil {
+		t.Fatalf("KeySetFromDir() error = %v", err)
+	}
+	if kid, key := ks.ActiveKey(); kid != "test" || key == nil {
+		t.Errorf("KeySetFromDir() active key = %q", kid)
+	}
+	if _, ok := ks.PublicKey("test"); !ok {
+		t.Errorf("KeySetFromDir() no public key")
+	}
+	if _, err := KeySetFromDir("./no_such_dir"); err == nil {
+		t.Errorf("KeySetFromDir() unknown dir, error = nil")
+	}
+	emptyDir := tempDir(t)
+	defer os.RemoveAll(emptyDir)
+	if _, err := KeySetFromDir(emptyDir); err == nil {
+		t.Errorf("KeySetFromDir() no keys, error = nil")
+	}
+}
+
+func TestKeySet_Reload(t *testing.T) {
+	t.Parallel()
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	writeKey(t, dir, "2020-01-01")
+	ks, err := KeySetFromDir(dir)
+	if err != nil {
+		t.Fatalf("KeySetFromDir() error = %v", err)
+	}
+	m := NewManagerRSA(time.Minute, time.Hour, ks)
+	oldToken, err := m.Generate(authsvc.Access, "clientID", "userID", authsvc.Member, authsvc.Scope{})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	// roll out a new key and retire the old one, keeping its public key
+	writeKey(t, dir, "2021-01-01")
+	if err := os.Remove(filepath.Join(dir, "2020-01-01"+privateKeyExt)); err != nil {
+		t.Fatalf("couldn't remove the old private key: %v", err)
+	}
+	if err := ks.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if kid, _ := ks.ActiveKey(); kid != "2021-01-01" {
+		t.Errorf("Reload() active key = %q, want the newest one", kid)
+	}
+	if _, err := m.Verify(oldToken); err != nil {
+		t.Errorf("Verify() the token signed with the retired key, error = %v", err)
+	}
+	newToken, err := m.Generate(authsvc.Access, "clientID", "userID", authsvc.Member, authsvc.Scope{})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if _, err := m.Verify(newToken); err != nil {
+		t.Errorf("Verify() the token signed with the new key, error = %v", err)
+	}
+	if set := ks.JWKS(); len(set.Keys) != 2 {
+		t.Errorf("JWKS() got %d keys, want 2", len(set.Keys))
+	}
+
+	// the tokens signed with the keys that aren't in the set anymore are rejected
+	if err := os.Remove(filepath.Join(dir, "2020-01-01"+publicKeyExt)); err != nil {
+		t.Fatalf("couldn't remove the old public key: %v", err)
+	}
+	if err := ks.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if _, err := m.Verify(oldToken); err == nil {
+		t.Errorf("Verify() the token signed with the removed key, error = nil")
+	}
+
+	// a broken key doesn't spoil the loaded set
+	if err := ioutil.WriteFile(filepath.Join(dir, "broken"+privateKeyExt), []byte("broken"), 0600); err != nil {
+		t.Fatalf("couldn't write the broken key: %v", err)
+	}
+	if err := ks.Reload(); err == nil {
+		t.Errorf("Reload() broken key, error = nil")
+	}
+	if kid, _ := ks.ActiveKey(); kid != "2021-01-01" {
+		t.Errorf("Reload() failed reload changed the active key to %q", kid)
+	}
+}
+
+func tempDir(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "keys")
+	if err != nil {
+		t.Fatalf("couldn't create a temp dir: %v", err)
+	}
+	return dir
+}
+
+// writeKey generates and writes the key pair with the given id into the dir
+func writeKey(t *testing.T, dir, kid string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("couldn't generate a key: %v", err)
+	}
+	private := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := ioutil.WriteFile(filepath.Join(dir, kid+privateKeyExt), private, 0600); err != nil {
+		t.Fatalf("couldn't write the private key: %v", err)
+	}
+	pubBytes, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("couldn't marshal the public key: %v", err)
+	}
+	public := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubBytes})
+	if err := ioutil.WriteFile(filepath.Join(dir, kid+publicKeyExt), public, 0600); err != nil {
+		t.Fatalf("couldn't write the public key: %v", err)
+	}
+}
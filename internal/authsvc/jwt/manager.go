@@ -2,36 +2,35 @@
 package jwt
 
 import (
-	"crypto/rsa"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
+	gonanoid "github.com/matoous/go-nanoid"
 	"github.com/shanvl/garbage/internal/authsvc"
 )
 
-// managerRSA is an implementation of Manager which uses RSA method to sign and verify jwt
+// managerRSA is an implementation of Manager which uses RSA method to sign and verify jwt.
+// The tokens are signed with the active key of the key set, and the id of the key is put into their "kid" header
 type managerRSA struct {
 	accessTokenDuration  time.Duration
 	refreshTokenDuration time.Duration
-	privateKey           *rsa.PrivateKey
-	publicKey            *rsa.PublicKey
+	keys                 *KeySet
 }
 
-func NewManagerRSA(accessTokenDuration, refreshTokenDuration time.Duration, privateKey *rsa.PrivateKey,
-	publicKey *rsa.PublicKey) authsvc.TokenManager {
-
+func NewManagerRSA(accessTokenDuration, refreshTokenDuration time.Duration, keys *KeySet) authsvc.TokenManager {
 	return &managerRSA{
 		accessTokenDuration:  accessTokenDuration,
 		refreshTokenDuration: refreshTokenDuration,
-		privateKey:           privateKey,
-		publicKey:            publicKey,
+		keys:                 keys,
 	}
 }
 
 // Generate generates jwt
-func (m *managerRSA) Generate(tokenType authsvc.TokenType, clientID, userID string, role authsvc.Role) (string, error) {
+func (m *managerRSA) Generate(tokenType authsvc.TokenType, clientID, userID string, role authsvc.Role,
+	scope authsvc.Scope) (string, error) {
+
 	if clientID == "" {
 		return "", errors.New("clientID must be provided")
 	}
@@ -45,18 +44,33 @@ func (m *managerRSA) Generate(tokenType authsvc.TokenType, clientID, userID stri
 	if tokenType == authsvc.Refresh {
 		expAt = time.Now().Add(m.refreshTokenDuration).Unix()
 	}
+	// every token gets a unique id, so that the rotated refresh tokens of a client never match the latest one
+	tokenID, err := gonanoid.Nanoid()
+	if err != nil {
+		return "", fmt.Errorf("token id generation error: %w", err)
+	}
+	now := time.Now()
 	claims := authsvc.UserClaims{
 		StandardClaims: jwt.StandardClaims{
+			Id:        tokenID,
 			Subject:   userID,
+			IssuedAt:  now.Unix(),
 			ExpiresAt: expAt,
 		},
-		ClientID: clientID,
-		Role:     role.String(),
-		Type:     tokenType.String(),
+		IssuedAtMs: now.UnixNano() / int64(time.Millisecond),
+		ClientID:   clientID,
+		Role:       role.String(),
+		Type:       tokenType.String(),
+	}
+	for _, c := range scope.Classes {
+		claims.Classes = append(claims.Classes, c.String())
 	}
+	claims.Pupils = scope.PupilIDs
 
+	kid, privateKey := m.keys.ActiveKey()
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
-	return token.SignedString(m.privateKey)
+	token.Header["kid"] = kid
+	return token.SignedString(privateKey)
 }
 
 // Verify verifies jwt
@@ -69,7 +83,16 @@ func (m *managerRSA) Verify(token string) (authsvc.UserClaims, error) {
 		if !ok {
 			return authsvc.UserClaims{}, errors.New("unexpected signing algorithm")
 		}
-		return m.publicKey, nil
+		// the tokens issued before the keys got their ids are verified with the active key
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			kid, _ = m.keys.ActiveKey()
+		}
+		key, ok := m.keys.PublicKey(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown key id: %q", kid)
+		}
+		return key, nil
 	})
 	if err != nil {
 		return authsvc.UserClaims{}, fmt.Errorf("invalid token: %w", err)
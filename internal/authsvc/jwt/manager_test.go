@@ -2,6 +2,7 @@ package jwt
 
 import (
 	"io/ioutil"
+	"reflect"
 	"testing"
 	"time"
 
@@ -67,7 +68,8 @@ func TestManagerRSA_Generate(t *testing.T) {
 	manager := newTestManagerRSA(t)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := manager.Generate(tt.args.tokenType, tt.args.clientID, tt.args.userID, tt.args.role)
+			got, err := manager.Generate(tt.args.tokenType, tt.args.clientID, tt.args.userID, tt.args.role,
+				authsvc.Scope{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Generate() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -86,6 +88,7 @@ func TestManagerRSA_Verify(t *testing.T) {
 		clientID  string
 		userID    string
 		role      authsvc.Role
+		scope     authsvc.Scope
 	}
 	tests := []struct {
 		name    string
@@ -112,11 +115,36 @@ func TestManagerRSA_Verify(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "classes",
+			args: args{
+				tokenType: authsvc.Access,
+				clientID:  "clientID",
+				userID:    "userID",
+				role:      authsvc.Member,
+				scope: authsvc.Scope{
+					Classes: []authsvc.Class{{Letter: "b", YearFormed: 2018}, {Letter: "a", YearFormed: 2019}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pupils",
+			args: args{
+				tokenType: authsvc.Access,
+				clientID:  "clientID",
+				userID:    "userID",
+				role:      authsvc.Guardian,
+				scope:     authsvc.Scope{PupilIDs: []string{"pupil1", "pupil2"}},
+			},
+			wantErr: false,
+		},
 	}
 	manager := newTestManagerRSA(t)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			token, err := manager.Generate(tt.args.tokenType, tt.args.clientID, tt.args.userID, tt.args.role)
+			token, err := manager.Generate(tt.args.tokenType, tt.args.clientID, tt.args.userID, tt.args.role,
+				tt.args.scope)
 			if err != nil {
 				t.Fatalf("couldn't generate a token")
 			}
@@ -129,6 +157,12 @@ func TestManagerRSA_Verify(t *testing.T) {
 				ClientID != tt.args.clientID || claims.Role != tt.args.role.String()) {
 				t.Errorf("Verify() no error, claims don't match")
 			}
+			if scope, _ := claims.UserScope(); err == nil && !reflect.DeepEqual(scope, tt.args.scope) {
+				t.Errorf("Verify() scope got = %v, want %v", scope, tt.args.scope)
+			}
+			if err == nil && claims.IssuedAtTime().Unix() != claims.IssuedAt {
+				t.Errorf("Verify() issue time in ms = %v, in seconds = %v", claims.IssuedAtMs, claims.IssuedAt)
+			}
 		})
 	}
 }
@@ -151,5 +185,5 @@ func newTestManagerRSA(t *testing.T) authsvc.TokenManager {
 		t.Fatalf("couldn't get public key: %v", err)
 	}
 
-	return NewManagerRSA(30*time.Minute, 120*time.Hour, prKey, pubKey)
+	return NewManagerRSA(30*time.Minute, 120*time.Hour, NewKeySet("test", prKey, pubKey))
 }
@@ -0,0 +1,61 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/shanvl/garbage/internal/authsvc/users"
+)
+
+// FileConfig allows to configure the mailer which drops the emails into a directory
+type FileConfig struct {
+	// Dir is the directory the emails are written to. It's created if it doesn't exist
+	Dir string
+	// From is the address the emails are sent from
+	From string
+	// ActivationURL is the address of the page where the users activate their accounts
+	ActivationURL string
+	// PasswordResetURL is the address of the page where the users reset their passwords
+	PasswordResetURL string
+}
+
+type fileMailer struct {
+	conf FileConfig
+}
+
+// NewFile returns a mailer which writes every email to a separate .eml file instead of sending it.
+// It's meant to be used in development and tests
+func NewFile(conf FileConfig) users.Mailer {
+	return &fileMailer{conf}
+}
+
+// SendActivation writes the email with the activation token to the directory
+func (m *fileMailer) SendActivation(_ context.Context, email, activationToken string) error {
+	return m.send(activationEmail, email, m.conf.ActivationURL, activationToken)
+}
+
+// SendPasswordReset writes the email with the password reset token to the directory
+func (m *fileMailer) SendPasswordReset(_ context.Context, email, resetToken string) error {
+	return m.send(passwordResetEmail, email, m.conf.PasswordResetURL, resetToken)
+}
+
+func (m *fileMailer) send(e email, to, pageURL, token string) error {
+	msg, err := e.message(m.conf.From, to, pageURL, token)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(m.conf.Dir, 0755); err != nil {
+		return fmt.Errorf("create mail dir: %w", err)
+	}
+	// file names are sortable by the time the emails were sent
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), strings.NewReplacer("/", "_", "\\", "_").Replace(to))
+	if err := ioutil.WriteFile(filepath.Join(m.conf.Dir, name), msg, 0644); err != nil {
+		return fmt.Errorf("write email to %s: %w", to, err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Package mail contains the implementations of users.Mailer
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// email is a kind of the emails sent to the users. Every kind of emails carries a token and a link to the page where
+// the token can be used
+type email struct {
+	subject string
+	body    *template.Template
+}
+
+var activationEmail = email{
+	subject: "Activate your account",
+	body: template.Must(template.New("activation").Parse(`Hello,
+
+An account has been created for {{.Email}}. To activate it, follow the link below:
+
+{{.Link}}
+
+If the link doesn't work, use the activation token instead: {{.Token}}
+`)),
+}
+
+var passwordResetEmail = email{
+	subject: "Reset your password",
+	body: template.Must(template.New("passwordReset").Parse(`Hello,
+
+A password reset has been requested for {{.Email}}. To set a new password, follow the link below:
+
+{{.Link}}
+
+If the link doesn't work, use the reset token instead: {{.Token}}
+The token can be used only once. If you haven't requested the reset, ignore this email
+`)),
+}
+
+// emailData is the data the emails are rendered with
+type emailData struct {
+	Email string
+	Token string
+	Link  string
+}
+
+// message renders the email and builds an RFC 822 message from it.
+// pageURL is the address of the page where the token is used, the token is appended to it as the "token" query param
+func (e email) message(from, to, pageURL, token string) ([]byte, error) {
+	link, err := url.Parse(pageURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse page url: %w", err)
+	}
+	q := link.Query()
+	q.Set("token", token)
+	link.RawQuery = q.Encode()
+
+	var body bytes.Buffer
+	err = e.body.Execute(&body, emailData{
+		Email: to,
+		Token: token,
+		Link:  link.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("render %s email: %w", e.body.Name(), err)
+	}
+
+	var b strings.Builder
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + to + "\r\n")
+	b.WriteString("Subject: " + e.subject + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(body.String(), "\n", "\r\n"))
+	return []byte(b.String()), nil
+}
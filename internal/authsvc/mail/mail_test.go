@@ -0,0 +1,106 @@
+package mail
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_email_message(t *testing.T) {
+	tests := []struct {
+		name     string
+		pageURL  string
+		wantLink string
+		wantErr  bool
+	}{
+		{
+			name:     "ok",
+			pageURL:  "https://garbage.dev/activate",
+			wantLink: "https://garbage.dev/activate?token=token",
+		},
+		{
+			name:     "url with query",
+			pageURL:  "https://garbage.dev/activate?lang=en",
+			wantLink: "https://garbage.dev/activate?lang=en&token=token",
+		},
+		{
+			name:    "invalid url",
+			pageURL: "http://[::1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := activationEmail.message("from@garbage.dev", "to@garbage.dev", tt.pageURL, "token")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("message() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			for _, want := range []string{"To: to@garbage.dev\r\n", "Subject: " + activationEmail.subject, tt.wantLink} {
+				if !strings.Contains(string(msg), want) {
+					t.Errorf("message() = %s, want it to contain %q", msg, want)
+				}
+			}
+		})
+	}
+}
+
+func Test_fileMailer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mail")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m := NewFile(FileConfig{
+		Dir:              dir,
+		From:             "from@garbage.dev",
+		ActivationURL:    "https://garbage.dev/activate",
+		PasswordResetURL: "https://garbage.dev/reset",
+	})
+	tests := []struct {
+		name     string
+		send     func(ctx context.Context, email, token string) error
+		to       string
+		wantLink string
+	}{
+		{
+			name:     "activation",
+			send:     m.SendActivation,
+			to:       "activation@garbage.dev",
+			wantLink: "https://garbage.dev/activate?token=token",
+		},
+		{
+			name:     "password reset",
+			send:     m.SendPasswordReset,
+			to:       "reset@garbage.dev",
+			wantLink: "https://garbage.dev/reset?token=token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.send(context.Background(), tt.to, "token"); err != nil {
+				t.Fatalf("send error = %v", err)
+			}
+			files, err := filepath.Glob(filepath.Join(dir, "*-"+tt.to+".eml"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) != 1 {
+				t.Fatalf("send wrote %v, want a single email file", files)
+			}
+			msg, err := ioutil.ReadFile(files[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(msg), tt.wantLink) {
+				t.Errorf("send wrote %s, want it to contain %s", msg, tt.wantLink)
+			}
+		})
+	}
+}
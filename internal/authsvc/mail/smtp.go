@@ -0,0 +1,57 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+
+	"github.com/shanvl/garbage/internal/authsvc/users"
+)
+
+// SMTPConfig allows to configure the smtp server used to send emails
+type SMTPConfig struct {
+	Host, User, Password string
+	Port                 int
+	// From is the address the emails are sent from
+	From string
+	// ActivationURL is the address of the page where the users activate their accounts
+	ActivationURL string
+	// PasswordResetURL is the address of the page where the users reset their passwords
+	PasswordResetURL string
+}
+
+type smtpMailer struct {
+	conf SMTPConfig
+}
+
+// NewSMTP returns a mailer which sends the emails through the smtp server
+func NewSMTP(conf SMTPConfig) users.Mailer {
+	return &smtpMailer{conf}
+}
+
+// SendActivation sends the activation token to the given email
+func (m *smtpMailer) SendActivation(_ context.Context, email, activationToken string) error {
+	return m.send(activationEmail, email, m.conf.ActivationURL, activationToken)
+}
+
+// SendPasswordReset sends the password reset token to the given email
+func (m *smtpMailer) SendPasswordReset(_ context.Context, email, resetToken string) error {
+	return m.send(passwordResetEmail, email, m.conf.PasswordResetURL, resetToken)
+}
+
+func (m *smtpMailer) send(e email, to, pageURL, token string) error {
+	msg, err := e.message(m.conf.From, to, pageURL, token)
+	if err != nil {
+		return err
+	}
+	var auth smtp.Auth
+	if m.conf.User != "" {
+		auth = smtp.PlainAuth("", m.conf.User, m.conf.Password, m.conf.Host)
+	}
+	addr := net.JoinHostPort(m.conf.Host, fmt.Sprint(m.conf.Port))
+	if err := smtp.SendMail(addr, auth, m.conf.From, []string{to}, msg); err != nil {
+		return fmt.Errorf("send email to %s: %w", to, err)
+	}
+	return nil
+}
@@ -2,20 +2,40 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	"github.com/shanvl/garbage/internal/authsvc"
 	"github.com/shanvl/garbage/internal/authsvc/authent"
 	"github.com/shanvl/garbage/internal/authsvc/users"
+	"github.com/shanvl/garbage/pkg/paging"
 )
 
 // UsersRepo mocks users service's repository
 type UsersRepo struct {
-	ChangeUserRoleFn      func(ctx context.Context, id string, role authsvc.Role) error
+	ChangeUserRoleFn      func(ctx context.Context, id string, role authsvc.Role, version int) error
 	ChangeUserRoleInvoked bool
 
+	DeletePasswordResetFn      func(ctx context.Context, token string) (*authsvc.PasswordReset, error)
+	DeletePasswordResetInvoked bool
+
 	DeleteUserFn      func(ctx context.Context, id string) error
 	DeleteUserInvoked bool
 
+	DeleteUserClientsFn      func(ctx context.Context, userID string, exceptClientIDs ...string) ([]string, error)
+	DeleteUserClientsInvoked bool
+
+	SetUserClassesFn      func(ctx context.Context, userID string, classes []authsvc.Class) error
+	SetUserClassesInvoked bool
+
+	SetUserPupilsFn      func(ctx context.Context, userID string, pupilIDs []string) error
+	SetUserPupilsInvoked bool
+
+	StorePasswordResetFn      func(ctx context.Context, reset *authsvc.PasswordReset) error
+	StorePasswordResetInvoked bool
+
+	StoreRevocationFn      func(ctx context.Context, revocation authsvc.Revocation) error
+	StoreRevocationInvoked bool
+
 	StoreUserFn      func(ctx context.Context, user *authsvc.User) error
 	StoreUserInvoked bool
 
@@ -25,14 +45,28 @@ type UsersRepo struct {
 	UserByActivationTokenFn      func(ctx context.Context, activationToken string) (*authsvc.User, error)
 	UserByActivationTokenInvoked bool
 
-	UsersFn func(ctx context.Context, nameAndEmail string, sorting users.Sorting, amount,
-		skip int) ([]*authsvc.User, int, error)
+	UserByEmailFn      func(ctx context.Context, email string) (*authsvc.User, error)
+	UserByEmailInvoked bool
+
+	UserClassesFn      func(ctx context.Context, userID string) ([]authsvc.Class, error)
+	UserClassesInvoked bool
+
+	UserPupilsFn      func(ctx context.Context, userID string) ([]string, error)
+	UserPupilsInvoked bool
+
+	UsersFn func(ctx context.Context, nameAndEmail string, sorting users.Sorting,
+		page paging.Page) ([]*authsvc.User, int, string, error)
 	UsersInvoked bool
 }
 
-func (u *UsersRepo) ChangeUserRole(ctx context.Context, id string, role authsvc.Role) error {
+func (u *UsersRepo) ChangeUserRole(ctx context.Context, id string, role authsvc.Role, version int) error {
 	u.ChangeUserRoleInvoked = true
-	return u.ChangeUserRoleFn(ctx, id, role)
+	return u.ChangeUserRoleFn(ctx, id, role, version)
+}
+
+func (u *UsersRepo) DeletePasswordReset(ctx context.Context, token string) (*authsvc.PasswordReset, error) {
+	u.DeletePasswordResetInvoked = true
+	return u.DeletePasswordResetFn(ctx, token)
 }
 
 func (u *UsersRepo) DeleteUser(ctx context.Context, id string) error {
@@ -40,6 +74,33 @@ func (u *UsersRepo) DeleteUser(ctx context.Context, id string) error {
 	return u.DeleteUserFn(ctx, id)
 }
 
+func (u *UsersRepo) DeleteUserClients(ctx context.Context, userID string, exceptClientIDs ...string) ([]string,
+	error) {
+
+	u.DeleteUserClientsInvoked = true
+	return u.DeleteUserClientsFn(ctx, userID, exceptClientIDs...)
+}
+
+func (u *UsersRepo) SetUserClasses(ctx context.Context, userID string, classes []authsvc.Class) error {
+	u.SetUserClassesInvoked = true
+	return u.SetUserClassesFn(ctx, userID, classes)
+}
+
+func (u *UsersRepo) SetUserPupils(ctx context.Context, userID string, pupilIDs []string) error {
+	u.SetUserPupilsInvoked = true
+	return u.SetUserPupilsFn(ctx, userID, pupilIDs)
+}
+
+func (u *UsersRepo) StorePasswordReset(ctx context.Context, reset *authsvc.PasswordReset) error {
+	u.StorePasswordResetInvoked = true
+	return u.StorePasswordResetFn(ctx, reset)
+}
+
+func (u *UsersRepo) StoreRevocation(ctx context.Context, revocation authsvc.Revocation) error {
+	u.StoreRevocationInvoked = true
+	return u.StoreRevocationFn(ctx, revocation)
+}
+
 func (u *UsersRepo) StoreUser(ctx context.Context, user *authsvc.User) error {
 	u.StoreUserInvoked = true
 	return u.StoreUserFn(ctx, user)
@@ -50,15 +111,49 @@ func (u *UsersRepo) UserByActivationToken(ctx context.Context, activationToken s
 	return u.UserByActivationTokenFn(ctx, activationToken)
 }
 
+func (u *UsersRepo) UserByEmail(ctx context.Context, email string) (*authsvc.User, error) {
+	u.UserByEmailInvoked = true
+	return u.UserByEmailFn(ctx, email)
+}
+
 func (u *UsersRepo) UserByID(ctx context.Context, id string) (*authsvc.User, error) {
 	u.UserByIDInvoked = true
 	return u.UserByIDFn(ctx, id)
 }
 
-func (u *UsersRepo) Users(ctx context.Context, nameAndEmail string, sorting users.Sorting, amount,
-	skip int) ([]*authsvc.User, int, error) {
+func (u *UsersRepo) UserClasses(ctx context.Context, userID string) ([]authsvc.Class, error) {
+	u.UserClassesInvoked = true
+	return u.UserClassesFn(ctx, userID)
+}
+
+func (u *UsersRepo) UserPupils(ctx context.Context, userID string) ([]string, error) {
+	u.UserPupilsInvoked = true
+	return u.UserPupilsFn(ctx, userID)
+}
+
+func (u *UsersRepo) Users(ctx context.Context, nameAndEmail string, sorting users.Sorting,
+	page paging.Page) ([]*authsvc.User, int, string, error) {
 	u.UsersInvoked = true
-	return u.UsersFn(ctx, nameAndEmail, sorting, amount, skip)
+	return u.UsersFn(ctx, nameAndEmail, sorting, page)
+}
+
+// Mailer mocks users service's mailer
+type Mailer struct {
+	SendActivationFn      func(ctx context.Context, email, activationToken string) error
+	SendActivationInvoked bool
+
+	SendPasswordResetFn      func(ctx context.Context, email, resetToken string) error
+	SendPasswordResetInvoked bool
+}
+
+func (m *Mailer) SendActivation(ctx context.Context, email, activationToken string) error {
+	m.SendActivationInvoked = true
+	return m.SendActivationFn(ctx, email, activationToken)
+}
+
+func (m *Mailer) SendPasswordReset(ctx context.Context, email, resetToken string) error {
+	m.SendPasswordResetInvoked = true
+	return m.SendPasswordResetFn(ctx, email, resetToken)
 }
 
 // AuthRepo mocks auth service's repository
@@ -72,11 +167,23 @@ type AuthRepo struct {
 	DeleteUserClientsFn      func(ctx context.Context, userID string) error
 	DeleteUserClientsInvoked bool
 
+	RevokeClientFn      func(ctx context.Context, clientID string, event authsvc.SecurityEvent) error
+	RevokeClientInvoked bool
+
+	RotateRefreshTokenFn      func(ctx context.Context, client authent.Client, oldToken string) error
+	RotateRefreshTokenInvoked bool
+
 	StoreClientFn      func(ctx context.Context, client authent.Client) error
 	StoreClientInvoked bool
 
+	StoreRevocationFn      func(ctx context.Context, revocation authsvc.Revocation) error
+	StoreRevocationInvoked bool
+
 	UserByEmailFn      func(ctx context.Context, email string) (*authsvc.User, error)
 	UserByEmailInvoked bool
+
+	UserScopeFn      func(ctx context.Context, userID string) (authsvc.Scope, error)
+	UserScopeInvoked bool
 }
 
 func (a *AuthRepo) ClientByID(ctx context.Context, clientID string) (client authent.Client, err error) {
@@ -94,28 +201,102 @@ func (a *AuthRepo) DeleteUserClients(ctx context.Context, userID string) error {
 	return a.DeleteUserClientsFn(ctx, userID)
 }
 
+func (a *AuthRepo) RevokeClient(ctx context.Context, clientID string, event authsvc.SecurityEvent) error {
+	a.RevokeClientInvoked = true
+	return a.RevokeClientFn(ctx, clientID, event)
+}
+
+func (a *AuthRepo) RotateRefreshToken(ctx context.Context, client authent.Client, oldToken string) error {
+	a.RotateRefreshTokenInvoked = true
+	return a.RotateRefreshTokenFn(ctx, client, oldToken)
+}
+
 func (a *AuthRepo) StoreClient(ctx context.Context, client authent.Client) error {
 	a.StoreClientInvoked = true
 	return a.StoreClientFn(ctx, client)
 }
 
+func (a *AuthRepo) StoreRevocation(ctx context.Context, revocation authsvc.Revocation) error {
+	a.StoreRevocationInvoked = true
+	return a.StoreRevocationFn(ctx, revocation)
+}
+
 func (a *AuthRepo) UserByEmail(ctx context.Context, userID string) (*authsvc.User, error) {
 	a.UserByEmailInvoked = true
 	return a.UserByEmailFn(ctx, userID)
 }
 
+func (a *AuthRepo) UserScope(ctx context.Context, userID string) (authsvc.Scope, error) {
+	a.UserScopeInvoked = true
+	return a.UserScopeFn(ctx, userID)
+}
+
+// AuthorizRepo mocks authoriz service's repository
+type AuthorizRepo struct {
+	NotBeforeFn      func(ctx context.Context, clientID, userID string) (time.Time, error)
+	NotBeforeInvoked bool
+
+	PoliciesFn      func(ctx context.Context) (map[string][]authsvc.Role, error)
+	PoliciesInvoked bool
+
+	ResetPoliciesFn      func(ctx context.Context, policies map[string][]authsvc.Role) error
+	ResetPoliciesInvoked bool
+
+	SeedPoliciesFn      func(ctx context.Context, policies map[string][]authsvc.Role) error
+	SeedPoliciesInvoked bool
+
+	SetMethodRolesFn      func(ctx context.Context, method string, roles []authsvc.Role) error
+	SetMethodRolesInvoked bool
+
+	StoreRevocationFn      func(ctx context.Context, revocation authsvc.Revocation) error
+	StoreRevocationInvoked bool
+}
+
+func (a *AuthorizRepo) NotBefore(ctx context.Context, clientID, userID string) (time.Time, error) {
+	a.NotBeforeInvoked = true
+	return a.NotBeforeFn(ctx, clientID, userID)
+}
+
+func (a *AuthorizRepo) Policies(ctx context.Context) (map[string][]authsvc.Role, error) {
+	a.PoliciesInvoked = true
+	return a.PoliciesFn(ctx)
+}
+
+func (a *AuthorizRepo) ResetPolicies(ctx context.Context, policies map[string][]authsvc.Role) error {
+	a.ResetPoliciesInvoked = true
+	return a.ResetPoliciesFn(ctx, policies)
+}
+
+func (a *AuthorizRepo) SeedPolicies(ctx context.Context, policies map[string][]authsvc.Role) error {
+	a.SeedPoliciesInvoked = true
+	return a.SeedPoliciesFn(ctx, policies)
+}
+
+func (a *AuthorizRepo) SetMethodRoles(ctx context.Context, method string, roles []authsvc.Role) error {
+	a.SetMethodRolesInvoked = true
+	return a.SetMethodRolesFn(ctx, method, roles)
+}
+
+func (a *AuthorizRepo) StoreRevocation(ctx context.Context, revocation authsvc.Revocation) error {
+	a.StoreRevocationInvoked = true
+	return a.StoreRevocationFn(ctx, revocation)
+}
+
 // TokenManager mocks authsvc.TokenManager
 type TokenManager struct {
-	GenerateFn      func(tokenType authsvc.TokenType, clientID, userID string, role authsvc.Role) (string, error)
+	GenerateFn func(tokenType authsvc.TokenType, clientID, userID string, role authsvc.Role,
+		scope authsvc.Scope) (string, error)
 	GenerateInvoked bool
 
 	VerifyFn      func(token string) (authsvc.UserClaims, error)
 	VerifyInvoked bool
 }
 
-func (t *TokenManager) Generate(tokenType authsvc.TokenType, clientID, userID string, role authsvc.Role) (string, error) {
+func (t *TokenManager) Generate(tokenType authsvc.TokenType, clientID, userID string, role authsvc.Role,
+	scope authsvc.Scope) (string, error) {
+
 	t.GenerateInvoked = true
-	return t.GenerateFn(tokenType, clientID, userID, role)
+	return t.GenerateFn(tokenType, clientID, userID, role, scope)
 }
 
 func (t *TokenManager) Verify(token string) (authsvc.UserClaims, error) {
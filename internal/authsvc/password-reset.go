@@ -0,0 +1,33 @@
+package authsvc
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidResetToken = errors.New("invalid password reset token")
+
+// PasswordReset allows the user who has forgotten their password to set a new one. It can be used only once and
+// only until it expires
+type PasswordReset struct {
+	Token     string
+	UserID    string
+	ExpiresAt time.Time
+}
+
+// NewPasswordReset creates a password reset for the user, which expires after the given duration
+func NewPasswordReset(token, userID string, ttl time.Duration) (*PasswordReset, error) {
+	if token == "" {
+		return nil, ErrInvalidResetToken
+	}
+	return &PasswordReset{
+		Token:     token,
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(ttl),
+	}, nil
+}
+
+// IsExpired checks whether the password reset can't be used anymore
+func (r *PasswordReset) IsExpired() bool {
+	return !time.Now().Before(r.ExpiresAt)
+}
@@ -0,0 +1,68 @@
+package authsvc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewPasswordReset(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		token   string
+		ttl     time.Duration
+		wantErr bool
+	}{
+		{
+			name:    "no token",
+			token:   "",
+			ttl:     time.Hour,
+			wantErr: true,
+		},
+		{
+			name:    "ok",
+			token:   "token",
+			ttl:     time.Hour,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPasswordReset(tt.token, "userID", tt.ttl)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewPasswordReset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (got.Token != tt.token || got.UserID != "userID" || got.IsExpired()) {
+				t.Errorf("NewPasswordReset() got = %v", got)
+			}
+		})
+	}
+}
+
+func TestPasswordReset_IsExpired(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		want      bool
+	}{
+		{
+			name:      "expired",
+			expiresAt: time.Now().Add(-time.Minute),
+			want:      true,
+		},
+		{
+			name:      "not expired",
+			expiresAt: time.Now().Add(time.Minute),
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PasswordReset{Token: "token", ExpiresAt: tt.expiresAt}
+			if got := r.IsExpired(); got != tt.want {
+				t.Errorf("IsExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package postgres_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shanvl/garbage/pkg/audit"
+)
+
+// the audit repo is shared by the services, it's tested here against the db of the auth service
+func TestAuditRepo_Entries(t *testing.T) {
+	r := audit.NewPostgresRepo(db)
+	ctx := context.Background()
+	const actorID = "auditactorid"
+	now := time.Now().UTC().Truncate(time.Second)
+	entries := []audit.Entry{
+		{
+			ID:         "auditentry1",
+			ActorID:    actorID,
+			ClientID:   "auditclientid",
+			Method:     "/shanvl.garbage.auth.v1.AuthService/ChangeUserRole",
+			TargetIDs:  []string{"audittarget1"},
+			Before:     []byte(`{"role": "ROLE_MEMBER"}`),
+			After:      []byte(`{"role": "ROLE_ADMIN"}`),
+			OccurredAt: now.Add(-2 * time.Hour),
+		},
+		{
+			ID:         "auditentry2",
+			ActorID:    actorID,
+			ClientID:   "auditclientid",
+			Method:     "/shanvl.garbage.auth.v1.AuthService/DeleteUser",
+			TargetIDs:  []string{"audittarget2"},
+			Before:     []byte(`{"id": "audittarget2"}`),
+			OccurredAt: now.Add(-time.Hour),
+		},
+		{
+			ID:         "auditentry3",
+			ActorID:    actorID,
+			ClientID:   "auditclientid",
+			Method:     "/shanvl.garbage.auth.v1.AuthService/ChangeUserRole",
+			TargetIDs:  []string{"audittarget1"},
+			After:      []byte(`{"role": "ROLE_ROOT"}`),
+			OccurredAt: now,
+		},
+	}
+	for _, e := range entries {
+		if err := r.StoreEntry(ctx, e); err != nil {
+			t.Fatalf("prepare db: StoreEntry error: %v", err)
+		}
+		defer deleteAuditEntry(t, e.ID)
+	}
+
+	tests := []struct {
+		name      string
+		filters   audit.Filters
+		amount    int
+		skip      int
+		wantIDs   []string
+		wantTotal int
+	}{
+		{
+			name:      "by actor, the latest first",
+			filters:   audit.Filters{ActorID: actorID},
+			amount:    10,
+			wantIDs:   []string{"auditentry3", "auditentry2", "auditentry1"},
+			wantTotal: 3,
+		},
+		{
+			name:      "by method",
+			filters:   audit.Filters{ActorID: actorID, Method: "/shanvl.garbage.auth.v1.AuthService/DeleteUser"},
+			amount:    10,
+			wantIDs:   []string{"auditentry2"},
+			wantTotal: 1,
+		},
+		{
+			name:      "by target",
+			filters:   audit.Filters{ActorID: actorID, TargetID: "audittarget1"},
+			amount:    10,
+			wantIDs:   []string{"auditentry3", "auditentry1"},
+			wantTotal: 2,
+		},
+		{
+			name: "by time",
+			filters: audit.Filters{
+				ActorID: actorID,
+				From:    now.Add(-90 * time.Minute),
+				To:      now.Add(-30 * time.Minute),
+			},
+			amount:    10,
+			wantIDs:   []string{"auditentry2"},
+			wantTotal: 1,
+		},
+		{
+			name:      "paginated",
+			filters:   audit.Filters{ActorID: actorID},
+			amount:    1,
+			skip:      1,
+			wantIDs:   []string{"auditentry2"},
+			wantTotal: 3,
+		},
+		{
+			name:      "skip all",
+			filters:   audit.Filters{ActorID: actorID},
+			amount:    10,
+			skip:      10,
+			wantIDs:   []string{},
+			wantTotal: 3,
+		},
+		{
+			name:      "not found",
+			filters:   audit.Filters{ActorID: "unknownauditactor"},
+			amount:    10,
+			wantIDs:   []string{},
+			wantTotal: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, total, err := r.Entries(ctx, tt.filters, tt.amount, tt.skip)
+			if err != nil {
+				t.Fatalf("Entries() error = %v", err)
+			}
+			if total != tt.wantTotal {
+				t.Errorf("Entries() total = %v, want %v", total, tt.wantTotal)
+			}
+			gotIDs := make([]string, len(got))
+			for i, e := range got {
+				gotIDs[i] = e.ID
+			}
+			if !reflect.DeepEqual(gotIDs, tt.wantIDs) {
+				t.Errorf("Entries() ids = %v, want %v", gotIDs, tt.wantIDs)
+			}
+		})
+	}
+
+	t.Run("stored as is", func(t *testing.T) {
+		got, _, err := r.Entries(ctx, audit.Filters{ActorID: actorID, Method: entries[1].Method}, 10, 0)
+		if err != nil {
+			t.Fatalf("Entries() error = %v", err)
+		}
+		if len(got) != 1 {
+			t.Fatalf("Entries() got %d entries, want 1", len(got))
+		}
+		e := got[0]
+		if !reflect.DeepEqual(e.TargetIDs, entries[1].TargetIDs) || !e.OccurredAt.Equal(entries[1].OccurredAt) ||
+			e.ClientID != entries[1].ClientID {
+			t.Errorf("Entries() got = %+v, want %+v", e, entries[1])
+		}
+		// the deleted targets have no state after the change
+		if e.After != nil || len(e.Before) == 0 {
+			t.Errorf("Entries() before = %s, after = %s, want only the state before", e.Before, e.After)
+		}
+	})
+}
+
+func deleteAuditEntry(t *testing.T, id string) {
+	t.Helper()
+	_, err := db.Exec(context.Background(), "delete from audit_entries where id = $1", id)
+	if err != nil {
+		t.Fatalf("test helper: couldn't delete an audit entry: %v", err)
+	}
+}
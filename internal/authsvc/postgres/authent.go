@@ -55,6 +55,51 @@ func (a *authentRepo) DeleteUserClients(ctx context.Context, userID string) erro
 	return err
 }
 
+const storeSecurityEventQuery = `
+	insert into security_events (id, kind, user_id, client_id, occurred_at)
+	values ($1, $2, $3, $4, $5);
+`
+
+// RevokeClient deletes the client along with its refresh token and records the security event which has caused
+// the revocation
+func (a *authentRepo) RevokeClient(ctx context.Context, clientID string, event authsvc.SecurityEvent) error {
+	tx, err := a.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, deleteClientQuery, clientID); err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, storeSecurityEventQuery, event.ID, event.Kind.String(), event.UserID, event.ClientID,
+		event.OccurredAt)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+const rotateRefreshTokenQuery = `
+	update clients
+	set refresh_token = $3
+	where id = $1
+	  and refresh_token = $2;
+`
+
+// RotateRefreshToken replaces the refresh token of the client with the new one only if it's still the given one.
+// Otherwise, the token has already been rotated by a concurrent refresh, and authsvc.ErrRefreshTokenReuse is returned
+func (a *authentRepo) RotateRefreshToken(ctx context.Context, client authent.Client, oldToken string) error {
+	res, err := a.db.Exec(ctx, rotateRefreshTokenQuery, client.ID, oldToken, client.RefreshToken)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return authsvc.ErrRefreshTokenReuse
+	}
+	return nil
+}
+
 const storeClientQuery = `
 	insert into clients (id, user_id, refresh_token)
 	values ($1, $2, $3)
@@ -67,8 +112,14 @@ func (a *authentRepo) StoreClient(ctx context.Context, client authent.Client) er
 	return err
 }
 
+// StoreRevocation stores the revocation. If the subject has already been revoked, the later not-before
+// timestamp is kept
+func (a *authentRepo) StoreRevocation(ctx context.Context, revocation authsvc.Revocation) error {
+	return storeRevocation(ctx, a.db, revocation)
+}
+
 const userByEmailQuery = `
-	select id, active, activation_token, email, first_name, last_name, password_hash, role
+	select id, active, activation_token, email, first_name, last_name, password_hash, role, version
 	from users
 	where lower(email) = $1;
 `
@@ -77,7 +128,7 @@ func (a *authentRepo) UserByEmail(ctx context.Context, email string) (*authsvc.U
 	u := &authsvc.User{}
 	var roleStr string
 	err := a.db.QueryRow(ctx, userByEmailQuery, email).Scan(&u.ID, &u.Active, &u.ActivationToken, &u.Email,
-		&u.FirstName, &u.LastName, &u.PasswordHash, &roleStr)
+		&u.FirstName, &u.LastName, &u.PasswordHash, &roleStr, &u.Version)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, authsvc.ErrUnknownUser
@@ -90,3 +141,16 @@ func (a *authentRepo) UserByEmail(ctx context.Context, email string) (*authsvc.U
 	}
 	return u, nil
 }
+
+// UserScope returns the classes the user is assigned to and the pupils the user is linked to
+func (a *authentRepo) UserScope(ctx context.Context, userID string) (authsvc.Scope, error) {
+	classes, err := userClasses(ctx, a.db, userID)
+	if err != nil {
+		return authsvc.Scope{}, err
+	}
+	pupilIDs, err := userPupils(ctx, a.db, userID)
+	if err != nil {
+		return authsvc.Scope{}, err
+	}
+	return authsvc.Scope{Classes: classes, PupilIDs: pupilIDs}, nil
+}
@@ -2,6 +2,7 @@ package postgres_test
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 
@@ -137,6 +138,76 @@ func TestRepository_DeleteUserClients(t *testing.T) {
 	}
 }
 
+func TestRepository_RevokeClient(t *testing.T) {
+	r := postgres.NewAuthentRepo(db)
+	ctx := context.Background()
+	u := &authsvc.User{ID: "userid"}
+	storeUser(t, u)
+	defer deleteUserByID(t, u.ID)
+	c := authent.Client{
+		ID:           "someid",
+		UserID:       u.ID,
+		RefreshToken: "token",
+	}
+	storeClient(t, c)
+	defer deleteClientByID(t, c.ID)
+	event := authsvc.NewSecurityEvent("someeventid", authsvc.RefreshTokenReuse, u.ID, c.ID)
+	defer func() {
+		if _, err := db.Exec(ctx, "delete from security_events where id = $1", event.ID); err != nil {
+			t.Fatalf("test helper: couldn't delete the security event: %v", err)
+		}
+	}()
+
+	if err := r.RevokeClient(ctx, c.ID, event); err != nil {
+		t.Fatalf("RevokeClient() error == %v, wantErr == false", err)
+	}
+	if _, err := clientByIDWithErr(t, c.ID); err == nil {
+		t.Errorf("RevokeClient() client wasn't deleted")
+	}
+	var kind, clientID string
+	err := db.QueryRow(ctx, "select kind, client_id from security_events where id = $1", event.ID).Scan(&kind,
+		&clientID)
+	if err != nil {
+		t.Fatalf("RevokeClient() security event wasn't stored: %v", err)
+	}
+	if kind != authsvc.RefreshTokenReuse.String() || clientID != c.ID {
+		t.Errorf("RevokeClient() stored event kind == %s, clientID == %s", kind, clientID)
+	}
+}
+
+func TestRepository_RotateRefreshToken(t *testing.T) {
+	r := postgres.NewAuthentRepo(db)
+	ctx := context.Background()
+	u := &authsvc.User{ID: "userid"}
+	storeUser(t, u)
+	defer deleteUserByID(t, u.ID)
+	c := authent.Client{
+		ID:           "someid",
+		UserID:       u.ID,
+		RefreshToken: "token",
+	}
+	storeClient(t, c)
+	defer deleteClientByID(t, c.ID)
+
+	rotated := c
+	rotated.RefreshToken = "rotated token"
+	if err := r.RotateRefreshToken(ctx, rotated, c.RefreshToken); err != nil {
+		t.Fatalf("RotateRefreshToken() error == %v, wantErr == false", err)
+	}
+	if savedClient := clientByID(t, c.ID); !reflect.DeepEqual(savedClient, rotated) {
+		t.Errorf("RotateRefreshToken() saved client == %+v, want == %+v", savedClient, rotated)
+	}
+	// a concurrent refresh with the same token comes second
+	another := c
+	another.RefreshToken = "another token"
+	if err := r.RotateRefreshToken(ctx, another, c.RefreshToken); !errors.Is(err, authsvc.ErrRefreshTokenReuse) {
+		t.Errorf("RotateRefreshToken() error == %v, want == %v", err, authsvc.ErrRefreshTokenReuse)
+	}
+	if savedClient := clientByID(t, c.ID); !reflect.DeepEqual(savedClient, rotated) {
+		t.Errorf("RotateRefreshToken() saved client == %+v, want == %+v", savedClient, rotated)
+	}
+}
+
 func TestRepository_StoreClient(t *testing.T) {
 	r := postgres.NewAuthentRepo(db)
 	ctx := context.Background()
@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/shanvl/garbage/internal/authsvc"
+	"github.com/shanvl/garbage/internal/authsvc/authoriz"
+)
+
+type authorizRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewAuthorizRepo(db *pgxpool.Pool) authoriz.Repository {
+	return &authorizRepo{db}
+}
+
+const notBeforeQuery = `
+	select max(not_before)
+	from revocations
+	where (kind = $1 and subject_id = $2)
+	   or (kind = $3 and subject_id = $4);
+`
+
+// NotBefore returns the latest not-before timestamp of the revocations of the client and of the user.
+// If neither of them has been revoked, zero time is returned
+func (a *authorizRepo) NotBefore(ctx context.Context, clientID, userID string) (time.Time, error) {
+	var notBefore *time.Time
+	err := a.db.QueryRow(ctx, notBeforeQuery, authsvc.ClientRevocation.String(), clientID,
+		authsvc.UserRevocation.String(), userID).Scan(&notBefore)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if notBefore == nil {
+		return time.Time{}, nil
+	}
+	return *notBefore, nil
+}
+
+const storeRevocationQuery = `
+	insert into revocations (kind, subject_id, not_before)
+	values ($1, $2, $3)
+	on conflict (kind, subject_id) do update
+		set not_before = greatest(revocations.not_before, excluded.not_before);
+`
+
+// storeRevocation is shared by the repos of the services that revoke the tokens
+func storeRevocation(ctx context.Context, db *pgxpool.Pool, revocation authsvc.Revocation) error {
+	_, err := db.Exec(ctx, storeRevocationQuery, revocation.Kind.String(), revocation.SubjectID,
+		revocation.NotBefore)
+	return err
+}
+
+// StoreRevocation stores the revocation. If the subject has already been revoked, the later not-before
+// timestamp is kept
+func (a *authorizRepo) StoreRevocation(ctx context.Context, revocation authsvc.Revocation) error {
+	return storeRevocation(ctx, a.db, revocation)
+}
+
+const policiesQuery = `
+	select method, roles
+	from policies;
+`
+
+// Policies returns the protected RPCs along with the roles allowed to call them
+func (a *authorizRepo) Policies(ctx context.Context) (map[string][]authsvc.Role, error) {
+	rows, err := a.db.Query(ctx, policiesQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	policies := make(map[string][]authsvc.Role)
+	for rows.Next() {
+		var (
+			method   string
+			rolesStr []string
+		)
+		if err := rows.Scan(&method, &rolesStr); err != nil {
+			return nil, err
+		}
+		roles := make([]authsvc.Role, 0, len(rolesStr))
+		for _, s := range rolesStr {
+			role, err := authsvc.StringToRole(s)
+			if err != nil {
+				return nil, err
+			}
+			roles = append(roles, role)
+		}
+		policies[method] = roles
+	}
+	return policies, rows.Err()
+}
+
+const deletePoliciesQuery = `
+	delete from policies;
+`
+
+// ResetPolicies replaces all the policies with the given ones
+func (a *authorizRepo) ResetPolicies(ctx context.Context, policies map[string][]authsvc.Role) error {
+	tx, err := a.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, deletePoliciesQuery); err != nil {
+		return err
+	}
+	for method, roles := range policies {
+		if _, err := tx.Exec(ctx, setMethodRolesQuery, method, rolesToStrings(roles)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+const seedPolicyQuery = `
+	insert into policies (method, roles)
+	values ($1, $2::text[]::role[])
+	on conflict (method) do nothing;
+`
+
+// SeedPolicies stores the policies of the methods which don't have one yet. The policies changed in the db are
+// left intact
+func (a *authorizRepo) SeedPolicies(ctx context.Context, policies map[string][]authsvc.Role) error {
+	tx, err := a.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for method, roles := range policies {
+		if _, err := tx.Exec(ctx, seedPolicyQuery, method, rolesToStrings(roles)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+const setMethodRolesQuery = `
+	insert into policies (method, roles)
+	values ($1, $2::text[]::role[])
+	on conflict (method) do update
+		set roles = excluded.roles;
+`
+
+// SetMethodRoles stores the roles allowed to call the method
+func (a *authorizRepo) SetMethodRoles(ctx context.Context, method string, roles []authsvc.Role) error {
+	_, err := a.db.Exec(ctx, setMethodRolesQuery, method, rolesToStrings(roles))
+	return err
+}
+
+// rolesToStrings converts the roles to the strings stored in the db
+func rolesToStrings(roles []authsvc.Role) []string {
+	ss := make([]string, 0, len(roles))
+	for _, r := range roles {
+		ss = append(ss, r.String())
+	}
+	return ss
+}
@@ -0,0 +1,159 @@
+package postgres_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shanvl/garbage/internal/authsvc"
+	"github.com/shanvl/garbage/internal/authsvc/postgres"
+)
+
+func TestRepository_NotBefore(t *testing.T) {
+	r := postgres.NewAuthorizRepo(db)
+	usersRepo := postgres.NewUsersRepo(db)
+	ctx := context.Background()
+	const (
+		clientID = "revokedclientid"
+		userID   = "revokeduserid"
+	)
+	defer deleteRevocation(t, clientID)
+	defer deleteRevocation(t, userID)
+
+	t.Run("nothing revoked", func(t *testing.T) {
+		got, err := r.NotBefore(ctx, clientID, userID)
+		if err != nil {
+			t.Fatalf("NotBefore() error = %v", err)
+		}
+		if !got.IsZero() {
+			t.Errorf("NotBefore() got = %v, want zero time", got)
+		}
+	})
+	userNotBefore := time.Now().Truncate(time.Second)
+	t.Run("user revoked", func(t *testing.T) {
+		err := usersRepo.StoreRevocation(ctx, authsvc.Revocation{
+			Kind:      authsvc.UserRevocation,
+			SubjectID: userID,
+			NotBefore: userNotBefore,
+		})
+		if err != nil {
+			t.Fatalf("StoreRevocation() error = %v", err)
+		}
+		got, err := r.NotBefore(ctx, clientID, userID)
+		if err != nil {
+			t.Fatalf("NotBefore() error = %v", err)
+		}
+		if !got.Equal(userNotBefore) {
+			t.Errorf("NotBefore() got = %v, want %v", got, userNotBefore)
+		}
+		// the revocation of the user isn't applied to a client with the same id
+		got, err = r.NotBefore(ctx, userID, "anotheruserid")
+		if err != nil {
+			t.Fatalf("NotBefore() error = %v", err)
+		}
+		if !got.IsZero() {
+			t.Errorf("NotBefore() revoked by the kind of another subject, got = %v", got)
+		}
+	})
+	t.Run("the latest revocation wins", func(t *testing.T) {
+		clientNotBefore := userNotBefore.Add(time.Minute)
+		err := postgres.NewAuthentRepo(db).StoreRevocation(ctx, authsvc.Revocation{
+			Kind:      authsvc.ClientRevocation,
+			SubjectID: clientID,
+			NotBefore: clientNotBefore,
+		})
+		if err != nil {
+			t.Fatalf("StoreRevocation() error = %v", err)
+		}
+		// an earlier revocation of the same user mustn't move its not-before timestamp back
+		err = usersRepo.StoreRevocation(ctx, authsvc.Revocation{
+			Kind:      authsvc.UserRevocation,
+			SubjectID: userID,
+			NotBefore: userNotBefore.Add(-time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("StoreRevocation() error = %v", err)
+		}
+		got, err := r.NotBefore(ctx, clientID, userID)
+		if err != nil {
+			t.Fatalf("NotBefore() error = %v", err)
+		}
+		if !got.Equal(clientNotBefore) {
+			t.Errorf("NotBefore() got = %v, want %v", got, clientNotBefore)
+		}
+		got, err = r.NotBefore(ctx, "anotherclientid", userID)
+		if err != nil {
+			t.Fatalf("NotBefore() error = %v", err)
+		}
+		if !got.Equal(userNotBefore) {
+			t.Errorf("NotBefore() got = %v, want %v", got, userNotBefore)
+		}
+	})
+}
+
+func TestRepository_Policies(t *testing.T) {
+	r := postgres.NewAuthorizRepo(db)
+	ctx := context.Background()
+	const (
+		seededMethod  = "/test.Service/Seeded"
+		changedMethod = "/test.Service/Changed"
+	)
+	defer deletePolicy(t, seededMethod)
+	defer deletePolicy(t, changedMethod)
+
+	t.Run("seed", func(t *testing.T) {
+		err := r.SetMethodRoles(ctx, changedMethod, []authsvc.Role{authsvc.Root})
+		if err != nil {
+			t.Fatalf("SetMethodRoles() error = %v", err)
+		}
+		// the seed mustn't override the policy that has been changed
+		err = r.SeedPolicies(ctx, map[string][]authsvc.Role{
+			seededMethod:  {authsvc.Admin, authsvc.Member},
+			changedMethod: {authsvc.Admin},
+		})
+		if err != nil {
+			t.Fatalf("SeedPolicies() error = %v", err)
+		}
+		policies, err := r.Policies(ctx)
+		if err != nil {
+			t.Fatalf("Policies() error = %v", err)
+		}
+		if got := policies[seededMethod]; !reflect.DeepEqual(got, []authsvc.Role{authsvc.Admin, authsvc.Member}) {
+			t.Errorf("Policies() seeded roles = %v", got)
+		}
+		if got := policies[changedMethod]; !reflect.DeepEqual(got, []authsvc.Role{authsvc.Root}) {
+			t.Errorf("Policies() changed roles = %v", got)
+		}
+	})
+	t.Run("no roles", func(t *testing.T) {
+		err := r.SetMethodRoles(ctx, changedMethod, []authsvc.Role{})
+		if err != nil {
+			t.Fatalf("SetMethodRoles() error = %v", err)
+		}
+		policies, err := r.Policies(ctx)
+		if err != nil {
+			t.Fatalf("Policies() error = %v", err)
+		}
+		roles, ok := policies[changedMethod]
+		if !ok || len(roles) != 0 {
+			t.Errorf("Policies() got = %v, %v, want the method without roles", roles, ok)
+		}
+	})
+}
+
+func deletePolicy(t *testing.T, method string) {
+	t.Helper()
+	_, err := db.Exec(context.Background(), "delete from policies where method = $1", method)
+	if err != nil {
+		t.Fatalf("test helper: couldn't delete a policy: %v", err)
+	}
+}
+
+func deleteRevocation(t *testing.T, subjectID string) {
+	t.Helper()
+	_, err := db.Exec(context.Background(), "delete from revocations where subject_id = $1", subjectID)
+	if err != nil {
+		t.Fatalf("test helper: couldn't delete a revocation: %v", err)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/shanvl/garbage/internal/authsvc"
 	usersSvc "github.com/shanvl/garbage/internal/authsvc/users"
+	"github.com/shanvl/garbage/pkg/paging"
 	pgtextsearch "github.com/shanvl/garbage/pkg/pg-text-search"
 )
 
@@ -33,25 +34,51 @@ var sortingToOrderMap = map[usersSvc.Sorting]string{
 
 const changeUserRoleQuery = `
 	update users
-	set role = $1
+	set role    = $1,
+	    version = version + 1
 	where id = $2
-	returning id;
+	  and ($3 = 0 or version = $3);
 `
 
-// ChangeUserRole changes the user's role to the provided role
-func (u *usersRepo) ChangeUserRole(ctx context.Context, id string, role authsvc.Role) error {
-	var returnedID string
-	err := u.db.QueryRow(ctx, changeUserRoleQuery, role.String(), id).Scan(&returnedID)
+// ChangeUserRole changes the user's role to the provided role. If the version isn't 0, the role is changed only if
+// the user is still of that version
+func (u *usersRepo) ChangeUserRole(ctx context.Context, id string, role authsvc.Role, version int) error {
+	res, err := u.db.Exec(ctx, changeUserRoleQuery, role.String(), id, version)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return authsvc.ErrUnknownUser
-		}
 		return err
 	}
-	if returnedID == "" {
+	if res.RowsAffected() > 0 {
+		return nil
+	}
+	// either there's no such user or it has been changed in the meantime
+	var exists bool
+	if err := u.db.QueryRow(ctx, `select exists(select 1 from users where id = $1)`, id).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
 		return authsvc.ErrUnknownUser
 	}
-	return nil
+	return authsvc.ErrVersionMismatch
+}
+
+const deletePasswordResetQuery = `
+	delete from password_resets
+	where token = $1
+	returning token, user_id, expires_at;
+`
+
+// DeletePasswordReset deletes the password reset with the given token and returns it, so that it can't be used
+// twice
+func (u *usersRepo) DeletePasswordReset(ctx context.Context, token string) (*authsvc.PasswordReset, error) {
+	r := &authsvc.PasswordReset{}
+	err := u.db.QueryRow(ctx, deletePasswordResetQuery, token).Scan(&r.Token, &r.UserID, &r.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, authsvc.ErrInvalidResetToken
+		}
+		return nil, err
+	}
+	return r, nil
 }
 
 const deleteUserQuery = `
@@ -65,18 +92,158 @@ func (u *usersRepo) DeleteUser(ctx context.Context, id string) error {
 	return err
 }
 
+const deleteUserClientsExceptQuery = `
+	delete from clients
+	where user_id = $1
+	  and id <> all ($2)
+	returning id;
+`
+
+// DeleteUserClients deletes all the clients of the user except the ones with the given ids and returns the ids of the
+// deleted clients
+func (u *usersRepo) DeleteUserClients(ctx context.Context, userID string, exceptClientIDs ...string) ([]string,
+	error) {
+
+	if exceptClientIDs == nil {
+		exceptClientIDs = []string{}
+	}
+	rows, err := u.db.Query(ctx, deleteUserClientsExceptQuery, userID, exceptClientIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var clientIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		clientIDs = append(clientIDs, id)
+	}
+	return clientIDs, rows.Err()
+}
+
+const lockUserQuery = `
+	select id
+	from users
+	where id = $1
+	for update;
+`
+
+const deleteUserClassesQuery = `
+	delete from user_classes
+	where user_id = $1;
+`
+
+const storeUserClassQuery = `
+	insert into user_classes (user_id, letter, year_formed)
+	values ($1, $2, $3);
+`
+
+// SetUserClasses replaces the classes the user is assigned to
+func (u *usersRepo) SetUserClasses(ctx context.Context, userID string, classes []authsvc.Class) error {
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// lock the user, so that it isn't deleted in the meantime
+	var id string
+	if err := tx.QueryRow(ctx, lockUserQuery, userID).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return authsvc.ErrUnknownUser
+		}
+		return err
+	}
+	if _, err := tx.Exec(ctx, deleteUserClassesQuery, userID); err != nil {
+		return err
+	}
+	for _, c := range classes {
+		if _, err := tx.Exec(ctx, storeUserClassQuery, userID, c.Letter, c.YearFormed); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+const deleteUserPupilsQuery = `
+	delete from user_pupils
+	where user_id = $1;
+`
+
+const storeUserPupilQuery = `
+	insert into user_pupils (user_id, pupil_id)
+	values ($1, $2);
+`
+
+// SetUserPupils replaces the pupils the user is linked to
+func (u *usersRepo) SetUserPupils(ctx context.Context, userID string, pupilIDs []string) error {
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// lock the user, so that it isn't deleted in the meantime
+	var id string
+	if err := tx.QueryRow(ctx, lockUserQuery, userID).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return authsvc.ErrUnknownUser
+		}
+		return err
+	}
+	if _, err := tx.Exec(ctx, deleteUserPupilsQuery, userID); err != nil {
+		return err
+	}
+	for _, pupilID := range pupilIDs {
+		if _, err := tx.Exec(ctx, storeUserPupilQuery, userID, pupilID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+const storePasswordResetQuery = `
+	insert into password_resets (token, user_id, expires_at)
+	values ($1, $2, $3)
+	on conflict (user_id) do update
+		set (token, expires_at) = ($1, $3);
+`
+
+// StorePasswordReset stores the password reset replacing the previous one of the user, if any
+func (u *usersRepo) StorePasswordReset(ctx context.Context, reset *authsvc.PasswordReset) error {
+	_, err := u.db.Exec(ctx, storePasswordResetQuery, reset.Token, reset.UserID, reset.ExpiresAt)
+	return err
+}
+
+// StoreRevocation stores the revocation. If the subject has already been revoked, the later not-before
+// timestamp is kept
+func (u *usersRepo) StoreRevocation(ctx context.Context, revocation authsvc.Revocation) error {
+	return storeRevocation(ctx, u.db, revocation)
+}
+
 const storeUserQuery = `
 	insert into users (id, active, activation_token, email, first_name, last_name, password_hash, role)
 	values ($1, $2, $3, $4, $5, $6, $7, $8)
 	on conflict (id) do update
-		set (active, activation_token, email, first_name, last_name, password_hash, role)
-				= ($2, $3, $4, $5, $6, $7, $8);
+		set (active, activation_token, email, first_name, last_name, password_hash, role, version)
+				= ($2, $3, $4, $5, $6, $7, $8, users.version + 1)
+		where $9 = 0
+		   or users.version = $9
+	returning version;
 `
 
-// StoreUser upserts the given to user to the db
+// StoreUser upserts the given to user to the db and sets the user's version to the stored one. If the user's version
+// isn't 0, the existing user is updated only if it's still of that version
 func (u *usersRepo) StoreUser(ctx context.Context, user *authsvc.User) error {
-	_, err := u.db.Exec(ctx, storeUserQuery, user.ID, user.Active, user.ActivationToken, user.Email, user.FirstName,
-		user.LastName, user.PasswordHash, user.Role.String())
+	err := u.db.QueryRow(ctx, storeUserQuery, user.ID, user.Active, user.ActivationToken, user.Email, user.FirstName,
+		user.LastName, user.PasswordHash, user.Role.String(), user.Version).Scan(&user.Version)
+	// no row is returned only if the existing user isn't of the given version
+	if errors.Is(err, pgx.ErrNoRows) {
+		return authsvc.ErrVersionMismatch
+	}
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
 		if pgErr.ConstraintName == "users_unique_lower_email_idx" {
@@ -87,7 +254,7 @@ func (u *usersRepo) StoreUser(ctx context.Context, user *authsvc.User) error {
 }
 
 const userByActivationTokenQuery = `
-	select id, active, activation_token, email, first_name, last_name, password_hash, role
+	select id, active, activation_token, email, first_name, last_name, password_hash, role, version
 	from users
 	where activation_token = $1;
 `
@@ -97,7 +264,27 @@ func (u *usersRepo) UserByActivationToken(ctx context.Context, activationToken s
 	user := &authsvc.User{}
 	var roleStr string
 	err := u.db.QueryRow(ctx, userByActivationTokenQuery, activationToken).Scan(&user.ID, &user.Active, &user.ActivationToken,
-		&user.Email, &user.FirstName, &user.LastName, &user.PasswordHash, &roleStr)
+		&user.Email, &user.FirstName, &user.LastName, &user.PasswordHash, &roleStr, &user.Version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, authsvc.ErrUnknownUser
+		}
+		return nil, err
+	}
+	user.Role, err = authsvc.StringToRole(roleStr)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// UserByEmail gets a user with the given email
+func (u *usersRepo) UserByEmail(ctx context.Context, email string) (*authsvc.User, error) {
+	user := &authsvc.User{}
+	var roleStr string
+	err := u.db.QueryRow(ctx, userByEmailQuery, strings.ToLower(email)).Scan(&user.ID, &user.Active,
+		&user.ActivationToken, &user.Email, &user.FirstName, &user.LastName, &user.PasswordHash, &roleStr,
+		&user.Version)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, authsvc.ErrUnknownUser
@@ -112,7 +299,7 @@ func (u *usersRepo) UserByActivationToken(ctx context.Context, activationToken s
 }
 
 const userByIDQuery = `
-	select id, active, activation_token, email, first_name, last_name, password_hash, role
+	select id, active, activation_token, email, first_name, last_name, password_hash, role, version
 	from users
 	where id = $1;
 `
@@ -122,7 +309,7 @@ func (u *usersRepo) UserByID(ctx context.Context, id string) (*authsvc.User, err
 	user := &authsvc.User{}
 	var roleStr string
 	err := u.db.QueryRow(ctx, userByIDQuery, id).Scan(&user.ID, &user.Active, &user.ActivationToken,
-		&user.Email, &user.FirstName, &user.LastName, &user.PasswordHash, &roleStr)
+		&user.Email, &user.FirstName, &user.LastName, &user.PasswordHash, &roleStr, &user.Version)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, authsvc.ErrUnknownUser
@@ -136,29 +323,106 @@ func (u *usersRepo) UserByID(ctx context.Context, id string) (*authsvc.User, err
 	return user, nil
 }
 
+// UserClasses returns the classes the user is assigned to
+func (u *usersRepo) UserClasses(ctx context.Context, userID string) ([]authsvc.Class, error) {
+	return userClasses(ctx, u.db, userID)
+}
+
+const userClassesQuery = `
+	select letter, year_formed
+	from user_classes
+	where user_id = $1
+	order by year_formed, letter;
+`
+
+// userClasses is shared by the repos of the services that need the classes of the user
+func userClasses(ctx context.Context, db *pgxpool.Pool, userID string) ([]authsvc.Class, error) {
+	rows, err := db.Query(ctx, userClassesQuery, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var classes []authsvc.Class
+	for rows.Next() {
+		var c authsvc.Class
+		if err := rows.Scan(&c.Letter, &c.YearFormed); err != nil {
+			return nil, err
+		}
+		classes = append(classes, c)
+	}
+	return classes, rows.Err()
+}
+
+// UserPupils returns the ids of the pupils the user is linked to
+func (u *usersRepo) UserPupils(ctx context.Context, userID string) ([]string, error) {
+	return userPupils(ctx, u.db, userID)
+}
+
+const userPupilsQuery = `
+	select pupil_id
+	from user_pupils
+	where user_id = $1
+	order by pupil_id;
+`
+
+// userPupils is shared by the repos of the services that need the pupils of the user
+func userPupils(ctx context.Context, db *pgxpool.Pool, userID string) ([]string, error) {
+	rows, err := db.Query(ctx, userPupilsQuery, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pupilIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		pupilIDs = append(pupilIDs, id)
+	}
+	return pupilIDs, rows.Err()
+}
+
 const usersQuery = `
 	with query as (
-    select id, active, activation_token, email, first_name, last_name, password_hash, role
+    select id, active, activation_token, email, first_name, last_name, password_hash, role, version
     from users
 	where 1=1 %s
 	),  pagination as (
-		select *
+		select *, %s as page_cursor
 		from query
+		where %s
 		order by %s
 		limit ? offset ?
 	)
 	select *
 	from pagination
-			 right join (select count(*) FROM query) as c(total) on true;
+			 right join (%s) as c(total) on true
+	order by %s;
 `
 
-// Users returns a list of sorted users
+// Users returns a page of the list of sorted users. The page either skips a number of the users or follows the page
+// token
 // "nameAndEmail" may consist of any combination of the email, first name and last name parts
-func (u *usersRepo) Users(ctx context.Context, nameAndEmail string, sorting usersSvc.Sorting, amount,
-	skip int) ([]*authsvc.User, int, error) {
+func (u *usersRepo) Users(ctx context.Context, nameAndEmail string, sorting usersSvc.Sorting,
+	page paging.Page) ([]*authsvc.User, int, string, error) {
 
-	// get the "order by" part of the query
-	orderBy := sortingToOrderMap[sorting]
+	// the users are sorted by the id after the sorting, so that each of them has a place of its own
+	columns := append(paging.ParseColumns(sortingToOrderMap[sorting]), paging.Column{Expr: "id"})
+	cursor, err := page.Cursor(sorting.String())
+	if err != nil {
+		return nil, 0, "", err
+	}
+	after, afterArgs, err := paging.After(columns, cursor)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	totalQuery := "select count(*) from query"
+	if page.SkipTotal {
+		totalQuery = "select 0"
+	}
 	// create the "where" part of the query
 	where := strings.Builder{}
 	var args []interface{}
@@ -167,16 +431,18 @@ func (u *usersRepo) Users(ctx context.Context, nameAndEmail string, sorting user
 		where.WriteString("and text_search @@ to_tsquery('simple', ?) ")
 		args = append(args, textSearch)
 	}
-	// add limit and offset
-	args = append(args, amount, skip)
-	// embed the "where" and the "order by" parts to the query
-	q := fmt.Sprintf(usersQuery, where.String(), orderBy)
+	// add the page's condition, limit and offset
+	args = append(args, afterArgs...)
+	args = append(args, page.Size, page.Offset())
+	// embed the "where", the paging and the "order by" parts to the query
+	orderBy := paging.OrderBy(columns)
+	q := fmt.Sprintf(usersQuery, where.String(), paging.Keys(columns), after, orderBy, totalQuery, orderBy)
 	// change "?" to "$" in the query
 	q = sqlx.Rebind(sqlx.BindType("pgx"), q)
 
 	rows, err := u.db.Query(ctx, q, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 	defer rows.Close()
 	// "total" column will always be returned, so other columns might be null
@@ -189,18 +455,20 @@ func (u *usersRepo) Users(ctx context.Context, nameAndEmail string, sorting user
 		lN       pgtype.Varchar
 		pasH     pgtype.Text
 		role     pgtype.Text
+		version  pgtype.Int4
+		keys     []byte
 		total    int
 	)
 	users := []*authsvc.User{}
 	for rows.Next() {
-		err := rows.Scan(&id, &active, &actToken, &email, &fN, &lN, &pasH, &role, &total)
+		err := rows.Scan(&id, &active, &actToken, &email, &fN, &lN, &pasH, &role, &version, &keys, &total)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, "", err
 		}
 		// next will happen if the offset >= total rows found or no users matching the provided criteria have been
 		// found. In that case we simply return total w/o additional work
 		if id.Status != pgtype.Present {
-			return nil, 0, nil
+			return nil, 0, "", nil
 		}
 		// create user
 		user := &authsvc.User{
@@ -211,18 +479,24 @@ func (u *usersRepo) Users(ctx context.Context, nameAndEmail string, sorting user
 			FirstName:       fN.String,
 			LastName:        lN.String,
 			PasswordHash:    pasH.String,
+			Version:         int(version.Int),
 		}
 		// convert role string to Role type
 		r, err := authsvc.StringToRole(role.String)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, "", err
 		}
 		user.Role = r
 		// push the user to the users
 		users = append(users, user)
 	}
 	if rows.Err() != nil {
-		return nil, 0, nil
+		return nil, 0, "", nil
+	}
+	// if the page is full, the next one starts after its last user
+	nextToken, err := page.NextToken(sorting.String(), len(users), keys)
+	if err != nil {
+		return nil, 0, "", err
 	}
-	return users, total, nil
+	return users, total, nextToken, nil
 }
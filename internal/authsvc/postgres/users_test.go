@@ -5,10 +5,13 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/shanvl/garbage/internal/authsvc"
+	"github.com/shanvl/garbage/internal/authsvc/authent"
 	"github.com/shanvl/garbage/internal/authsvc/postgres"
 	usersSvc "github.com/shanvl/garbage/internal/authsvc/users"
+	"github.com/shanvl/garbage/pkg/paging"
 )
 
 func TestRepository_ChangeUserRole(t *testing.T) {
@@ -21,18 +24,50 @@ func TestRepository_ChangeUserRole(t *testing.T) {
 		}
 		storeUser(t, u)
 		defer deleteUserByID(t, u.ID)
-		if err := r.ChangeUserRole(ctx, u.ID, authsvc.Admin); err != nil {
+		if err := r.ChangeUserRole(ctx, u.ID, authsvc.Admin, 0); err != nil {
 			t.Errorf("ChangeUserRole() error == %v, wantErr == false", err)
 		}
 		u = userByID(t, u.ID)
 		if u.Role != authsvc.Admin {
 			t.Errorf("ChangeUserRole() user role == %s, want == admin", u.Role.String())
 		}
+		if u.Version != 2 {
+			t.Errorf("ChangeUserRole() user version == %d, want == 2", u.Version)
+		}
+	})
+	t.Run("same version", func(t *testing.T) {
+		u := &authsvc.User{
+			ID:   "someid",
+			Role: authsvc.Member,
+		}
+		storeUser(t, u)
+		defer deleteUserByID(t, u.ID)
+		if err := r.ChangeUserRole(ctx, u.ID, authsvc.Admin, u.Version); err != nil {
+			t.Errorf("ChangeUserRole() error == %v, wantErr == false", err)
+		}
+		if u = userByID(t, u.ID); u.Role != authsvc.Admin {
+			t.Errorf("ChangeUserRole() user role == %s, want == admin", u.Role.String())
+		}
+	})
+	t.Run("changed in the meantime", func(t *testing.T) {
+		u := &authsvc.User{
+			ID:   "someid",
+			Role: authsvc.Member,
+		}
+		storeUser(t, u)
+		defer deleteUserByID(t, u.ID)
+		err := r.ChangeUserRole(ctx, u.ID, authsvc.Admin, u.Version+1)
+		if !errors.Is(err, authsvc.ErrVersionMismatch) {
+			t.Errorf("ChangeUserRole() error == %v, wantErr == ErrVersionMismatch", err)
+		}
+		if u = userByID(t, u.ID); u.Role != authsvc.Member {
+			t.Errorf("ChangeUserRole() user role == %s, want == member", u.Role.String())
+		}
 	})
 	t.Run("unknown user", func(t *testing.T) {
 		id := "unknownuser"
-		if err := r.ChangeUserRole(ctx, id, authsvc.Admin); err == nil {
-			t.Errorf("ChangeUserRole() error == nil, wantErr == ErrUnknownUser")
+		if err := r.ChangeUserRole(ctx, id, authsvc.Admin, 0); !errors.Is(err, authsvc.ErrUnknownUser) {
+			t.Errorf("ChangeUserRole() error == %v, wantErr == ErrUnknownUser", err)
 		}
 	})
 }
@@ -109,6 +144,19 @@ func TestRepository_StoreUser(t *testing.T) {
 			t.Errorf("StoreUser() saved user == %+v, want == %+v", savedUser, u)
 		}
 	})
+	t.Run("changed in the meantime", func(t *testing.T) {
+		stale := *u
+		stale.Version--
+		stale.FirstName = "stale name"
+		err := r.StoreUser(ctx, &stale)
+		if !errors.Is(err, authsvc.ErrVersionMismatch) {
+			t.Errorf("StoreUser() error == %v, wantErr == authsvc.ErrVersionMismatch", err)
+		}
+		savedUser := userByID(t, u.ID)
+		if !reflect.DeepEqual(savedUser, u) {
+			t.Errorf("StoreUser() saved user == %+v, want == %+v", savedUser, u)
+		}
+	})
 	t.Run("duplicate email", func(t *testing.T) {
 		u.ID = "someotherid"
 		err := r.StoreUser(ctx, u)
@@ -279,7 +327,7 @@ func TestRepository_Users(t *testing.T) {
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			users, _, err := r.Users(ctx, tt.textSearch, tt.sorting, tt.amount, tt.skip)
+			users, _, _, err := r.Users(ctx, tt.textSearch, tt.sorting, paging.Page{Size: tt.amount, Skip: tt.skip})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Users() error == %v, want == %v", err, tt.wantErr)
 			}
@@ -288,24 +336,234 @@ func TestRepository_Users(t *testing.T) {
 			}
 		})
 	}
+	t.Run("page token", func(t *testing.T) {
+		// the first page is full, so it has the token of the next one
+		page := paging.Page{Size: 2, SkipTotal: true}
+		users, total, token, err := r.Users(ctx, "fn ln email", usersSvc.EmailDes, page)
+		if err != nil {
+			t.Fatalf("Users() error == %v", err)
+		}
+		if !reflect.DeepEqual(users, []*authsvc.User{uu[2], uu[1]}) || total != 0 || token == "" {
+			t.Fatalf("Users() == %+v, %d, %q, want the first two users, no total and the token", users, total, token)
+		}
+		// the next page starts after the last user of the first one and is the last page
+		page.Token = token
+		users, _, token, err = r.Users(ctx, "fn ln email", usersSvc.EmailDes, page)
+		if err != nil {
+			t.Fatalf("Users() error == %v", err)
+		}
+		if !reflect.DeepEqual(users, []*authsvc.User{uu[0]}) || token != "" {
+			t.Errorf("Users() == %+v, %q, want the last user and no token", users, token)
+		}
+		// the token can't be used with another sorting
+		if _, _, _, err := r.Users(ctx, "fn ln email", usersSvc.EmailAsc, page); !errors.Is(err, paging.ErrInvalidToken) {
+			t.Errorf("Users() error == %v, want == %v", err, paging.ErrInvalidToken)
+		}
+	})
 }
 
 const storeUserQ = `
 	insert into users (id, active, activation_token, email, first_name, last_name, password_hash, role)
-	values ($1, $2, $3, $4, $5, $6, $7, $8);
+	values ($1, $2, $3, $4, $5, $6, $7, $8)
+	returning version;
 `
 
+func TestRepository_PasswordReset(t *testing.T) {
+	r := postgres.NewUsersRepo(db)
+	ctx := context.Background()
+	u := &authsvc.User{ID: "someid", Email: "reset@email.com"}
+	storeUser(t, u)
+	defer deleteUserByID(t, u.ID)
+
+	old := &authsvc.PasswordReset{Token: "oldtoken", UserID: u.ID, ExpiresAt: time.Now().Add(time.Hour)}
+	if err := r.StorePasswordReset(ctx, old); err != nil {
+		t.Fatalf("StorePasswordReset() error == %v, wantErr == false", err)
+	}
+	// a new password reset replaces the old one
+	reset := &authsvc.PasswordReset{Token: "newtoken", UserID: u.ID, ExpiresAt: time.Now().Add(time.Hour)}
+	if err := r.StorePasswordReset(ctx, reset); err != nil {
+		t.Fatalf("StorePasswordReset() error == %v, wantErr == false", err)
+	}
+	if _, err := r.DeletePasswordReset(ctx, old.Token); !errors.Is(err, authsvc.ErrInvalidResetToken) {
+		t.Errorf("DeletePasswordReset() error == %v, want == ErrInvalidResetToken", err)
+	}
+	got, err := r.DeletePasswordReset(ctx, reset.Token)
+	if err != nil {
+		t.Fatalf("DeletePasswordReset() error == %v, wantErr == false", err)
+	}
+	if got.Token != reset.Token || got.UserID != reset.UserID || !got.ExpiresAt.Round(time.Second).Equal(
+		reset.ExpiresAt.Round(time.Second)) {
+		t.Errorf("DeletePasswordReset() got == %v, want == %v", got, reset)
+	}
+	// the reset can be used only once
+	if _, err := r.DeletePasswordReset(ctx, reset.Token); !errors.Is(err, authsvc.ErrInvalidResetToken) {
+		t.Errorf("DeletePasswordReset() error == %v, want == ErrInvalidResetToken", err)
+	}
+}
+
+func TestRepository_UsersRepoDeleteUserClients(t *testing.T) {
+	r := postgres.NewUsersRepo(db)
+	ctx := context.Background()
+	u := &authsvc.User{ID: "someid", Email: "clients@email.com"}
+	storeUser(t, u)
+	defer deleteUserByID(t, u.ID)
+	storeClient(t, authent.Client{ID: "current", UserID: u.ID})
+	storeClient(t, authent.Client{ID: "other", UserID: u.ID})
+
+	deleted, err := r.DeleteUserClients(ctx, u.ID, "current")
+	if err != nil {
+		t.Fatalf("DeleteUserClients() error == %v, wantErr == false", err)
+	}
+	if len(deleted) != 1 || deleted[0] != "other" {
+		t.Errorf("DeleteUserClients() deleted == %v, want == [other]", deleted)
+	}
+	if _, err := clientByIDWithErr(t, "other"); err == nil {
+		t.Errorf("DeleteUserClients() other client wasn't deleted")
+	}
+	if _, err := clientByIDWithErr(t, "current"); err != nil {
+		t.Errorf("DeleteUserClients() current client was deleted: %v", err)
+	}
+	deleted, err = r.DeleteUserClients(ctx, u.ID)
+	if err != nil {
+		t.Fatalf("DeleteUserClients() error == %v, wantErr == false", err)
+	}
+	if len(deleted) != 1 || deleted[0] != "current" {
+		t.Errorf("DeleteUserClients() deleted == %v, want == [current]", deleted)
+	}
+	if _, err := clientByIDWithErr(t, "current"); err == nil {
+		t.Errorf("DeleteUserClients() current client wasn't deleted")
+	}
+}
+
+func TestRepository_UsersRepoUserByEmail(t *testing.T) {
+	r := postgres.NewUsersRepo(db)
+	ctx := context.Background()
+	u := &authsvc.User{ID: "someid", Email: "byemail@email.com", Role: authsvc.Member}
+	storeUser(t, u)
+	defer deleteUserByID(t, u.ID)
+	t.Run("known user", func(t *testing.T) {
+		got, err := r.UserByEmail(ctx, "ByEmail@email.com")
+		if err != nil {
+			t.Fatalf("UserByEmail() error == %v, wantErr == false", err)
+		}
+		if got.ID != u.ID {
+			t.Errorf("UserByEmail() got == %v, want == %v", got, u)
+		}
+	})
+	t.Run("unknown user", func(t *testing.T) {
+		if _, err := r.UserByEmail(ctx, "unknown@email.com"); !errors.Is(err, authsvc.ErrUnknownUser) {
+			t.Errorf("UserByEmail() error == %v, want == ErrUnknownUser", err)
+		}
+	})
+}
+
+func TestRepository_UserClasses(t *testing.T) {
+	r := postgres.NewUsersRepo(db)
+	ctx := context.Background()
+	u := &authsvc.User{ID: "someid", Email: "classes@email.com", Role: authsvc.Member}
+	storeUser(t, u)
+	defer deleteUserByID(t, u.ID)
+
+	t.Run("set", func(t *testing.T) {
+		classes := []authsvc.Class{{Letter: "b", YearFormed: 2018}, {Letter: "a", YearFormed: 2019}}
+		if err := r.SetUserClasses(ctx, u.ID, classes); err != nil {
+			t.Fatalf("SetUserClasses() error == %v, wantErr == false", err)
+		}
+		got, err := r.UserClasses(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("UserClasses() error == %v, wantErr == false", err)
+		}
+		if !reflect.DeepEqual(got, classes) {
+			t.Errorf("UserClasses() got == %v, want == %v", got, classes)
+		}
+		// the authent repo sees the same classes
+		scope, err := postgres.NewAuthentRepo(db).UserScope(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("UserScope() error == %v, wantErr == false", err)
+		}
+		if !reflect.DeepEqual(scope.Classes, classes) {
+			t.Errorf("UserScope() got == %v, want == %v", scope.Classes, classes)
+		}
+	})
+	t.Run("replace with none", func(t *testing.T) {
+		if err := r.SetUserClasses(ctx, u.ID, nil); err != nil {
+			t.Fatalf("SetUserClasses() error == %v, wantErr == false", err)
+		}
+		got, err := r.UserClasses(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("UserClasses() error == %v, wantErr == false", err)
+		}
+		if len(got) != 0 {
+			t.Errorf("UserClasses() got == %v, want none", got)
+		}
+	})
+	t.Run("unknown user", func(t *testing.T) {
+		err := r.SetUserClasses(ctx, "unknownuser", []authsvc.Class{{Letter: "b", YearFormed: 2018}})
+		if !errors.Is(err, authsvc.ErrUnknownUser) {
+			t.Errorf("SetUserClasses() error == %v, want == ErrUnknownUser", err)
+		}
+	})
+}
+
+func TestRepository_UserPupils(t *testing.T) {
+	r := postgres.NewUsersRepo(db)
+	ctx := context.Background()
+	u := &authsvc.User{ID: "guardianid", Email: "guardian@email.com", Role: authsvc.Guardian}
+	storeUser(t, u)
+	defer deleteUserByID(t, u.ID)
+
+	t.Run("set", func(t *testing.T) {
+		pupilIDs := []string{"pupil1", "pupil2"}
+		if err := r.SetUserPupils(ctx, u.ID, pupilIDs); err != nil {
+			t.Fatalf("SetUserPupils() error == %v, wantErr == false", err)
+		}
+		got, err := r.UserPupils(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("UserPupils() error == %v, wantErr == false", err)
+		}
+		if !reflect.DeepEqual(got, pupilIDs) {
+			t.Errorf("UserPupils() got == %v, want == %v", got, pupilIDs)
+		}
+		// the authent repo sees the same pupils
+		scope, err := postgres.NewAuthentRepo(db).UserScope(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("UserScope() error == %v, wantErr == false", err)
+		}
+		if !reflect.DeepEqual(scope.PupilIDs, pupilIDs) {
+			t.Errorf("UserScope() got == %v, want == %v", scope.PupilIDs, pupilIDs)
+		}
+	})
+	t.Run("replace with none", func(t *testing.T) {
+		if err := r.SetUserPupils(ctx, u.ID, nil); err != nil {
+			t.Fatalf("SetUserPupils() error == %v, wantErr == false", err)
+		}
+		got, err := r.UserPupils(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("UserPupils() error == %v, wantErr == false", err)
+		}
+		if len(got) != 0 {
+			t.Errorf("UserPupils() got == %v, want none", got)
+		}
+	})
+	t.Run("unknown user", func(t *testing.T) {
+		err := r.SetUserPupils(ctx, "unknownuser", []string{"pupil1"})
+		if !errors.Is(err, authsvc.ErrUnknownUser) {
+			t.Errorf("SetUserPupils() error == %v, want == ErrUnknownUser", err)
+		}
+	})
+}
+
 func storeUser(t *testing.T, u *authsvc.User) {
 	t.Helper()
-	_, err := db.Exec(context.Background(), storeUserQ, u.ID, u.Active, u.ActivationToken, u.Email, u.FirstName,
-		u.LastName, u.PasswordHash, u.Role.String())
+	err := db.QueryRow(context.Background(), storeUserQ, u.ID, u.Active, u.ActivationToken, u.Email, u.FirstName,
+		u.LastName, u.PasswordHash, u.Role.String()).Scan(&u.Version)
 	if err != nil {
 		t.Fatalf("test helper: couldn't store the user: %v", err)
 	}
 }
 
 const userByIDQ = `
-	select id, active, activation_token, email, first_name, last_name, password_hash, role
+	select id, active, activation_token, email, first_name, last_name, password_hash, role, version
 	from users
 	where id = $1;
 `
@@ -315,7 +573,7 @@ func userByID(t *testing.T, id string) *authsvc.User {
 	u := &authsvc.User{}
 	var roleStr string
 	err := db.QueryRow(context.Background(), userByIDQ, id).Scan(&u.ID, &u.Active, &u.ActivationToken, &u.Email,
-		&u.FirstName, &u.LastName, &u.PasswordHash, &roleStr)
+		&u.FirstName, &u.LastName, &u.PasswordHash, &roleStr, &u.Version)
 	if err != nil {
 		t.Fatalf("test helper: couldn't get a user: %v", err)
 	}
@@ -331,7 +589,7 @@ func userByIDWithErr(t *testing.T, id string) (*authsvc.User, error) {
 	u := &authsvc.User{}
 	var roleStr string
 	err := db.QueryRow(context.Background(), userByIDQ, id).Scan(&u.ID, &u.Active, &u.ActivationToken, &u.Email,
-		&u.FirstName, &u.LastName, &u.PasswordHash, &roleStr)
+		&u.FirstName, &u.LastName, &u.PasswordHash, &roleStr, &u.Version)
 	if err != nil {
 		return nil, err
 	}
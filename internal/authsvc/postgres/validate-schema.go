@@ -19,10 +19,13 @@ $$
     end
 $$;
 
+-- the guardian role has been added later, so it's added to the existing type as well
+alter type role add value if not exists 'guardian';
+
 -- create users table
 create table if not exists users
 (
-    activationToken               varchar(50) primary key,
+    id               varchar(50) primary key,
     active           bool        not null,
     activation_token text        not null,
     email            varchar(50) not null,
@@ -31,9 +34,16 @@ create table if not exists users
     password_hash    text        not null,
     role             role        not null,
     text_search      tsvector generated always as (to_tsvector('simple', first_name || ' ' || last_name || ' ' ||
-                                                                         email)) stored
+                                                                         email)) stored,
+    -- version is incremented on every change of the user, so that the user can be changed on condition it hasn't
+    -- been changed by someone else in the meantime
+    version          integer     not null default 1
 );
 
+-- add the version to the users table created before the versions were introduced
+alter table users
+    add column if not exists version integer not null default 1;
+
 create unique index if not exists users_unique_lower_email_idx on users (lower(email));
 create index if not exists users_text_search_idx on users using gin (text_search);
 create index if not exists users_name_asc_index on users (last_name asc, first_name asc);
@@ -53,6 +63,87 @@ create table if not exists clients
 
 create index if not exists clients_user_id_idx on clients (user_id);
 
+-- create user classes table. A user, e.g. a class teacher, can be assigned to the classes, which restricts them to
+-- the pupils of those classes
+create table if not exists user_classes
+(
+    user_id     varchar(50) not null references users (id)
+        on update cascade
+        on delete cascade,
+    letter      char        not null,
+    year_formed int         not null,
+    primary key (user_id, letter, year_formed)
+);
+
+-- create user pupils table. A user, e.g. a guardian, can be linked to the pupils of the event service, which
+-- restricts them to those pupils
+create table if not exists user_pupils
+(
+    user_id  varchar(50) not null references users (id)
+        on update cascade
+        on delete cascade,
+    pupil_id varchar(50) not null,
+    primary key (user_id, pupil_id)
+);
+
+-- create password resets table. Every user can have only one password reset at a time
+create table if not exists password_resets
+(
+    token      varchar(50) primary key,
+    user_id    varchar(50) not null unique references users (id)
+        on update cascade
+        on delete cascade,
+    expires_at timestamptz not null
+);
+
+-- create security events table. The events are kept even if the user or the client is deleted
+create table if not exists security_events
+(
+    id          varchar(50) primary key,
+    kind        varchar(50) not null,
+    user_id     varchar(50) not null,
+    client_id   varchar(50) not null,
+    occurred_at timestamptz not null
+);
+
+create index if not exists security_events_user_id_idx on security_events (user_id);
+
+-- create revocations table. A revocation invalidates the access tokens of the client or the user issued before
+-- not_before
+create table if not exists revocations
+(
+    kind       varchar(10) not null,
+    subject_id varchar(50) not null,
+    not_before timestamptz not null,
+    primary key (kind, subject_id)
+);
+
+-- create policies table. A policy lists the roles allowed to call the protected RPC. The RPCs that aren't listed
+-- are not protected
+create table if not exists policies
+(
+    method varchar(200) primary key,
+    roles  role[]       not null
+);
+
+-- create audit entries table. An entry records a change made by a user. The entries are kept even if the user or the
+-- changed entities are deleted
+create table if not exists audit_entries
+(
+    id          varchar(25)   primary key,
+    actor_id    varchar(50)   not null,
+    client_id   varchar(50)   not null,
+    method      varchar(200)  not null,
+    target_ids  varchar(50)[] not null,
+    before      jsonb,
+    after       jsonb,
+    occurred_at timestamptz   not null
+);
+
+create index if not exists audit_entries_occurred_at_idx on audit_entries (occurred_at desc);
+create index if not exists audit_entries_actor_id_idx on audit_entries (actor_id);
+create index if not exists audit_entries_target_ids_idx on audit_entries using gin (target_ids);
+
 `
 
 // ValidateSchema creates tables and indices if they don't already exist
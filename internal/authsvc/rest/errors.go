@@ -7,6 +7,7 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
@@ -35,8 +36,12 @@ func customHTTPError(_ context.Context, _ *runtime.ServeMux, marshaler runtime.M
 	}
 	// set content-type header
 	w.Header().Set("Content-type", marshaler.ContentType(st.Proto()))
-	// set error code
-	w.WriteHeader(runtime.HTTPStatusFromCode(status.Code(err)))
+	// set error code. The changes made on condition of the versions which don't match fail the If-Match precondition
+	code := runtime.HTTPStatusFromCode(st.Code())
+	if st.Code() == codes.Aborted {
+		code = http.StatusPreconditionFailed
+	}
+	w.WriteHeader(code)
 	// encode the message to json
 	jErr := json.NewEncoder(w).Encode(errBody)
 	// use the fallback on error
@@ -0,0 +1,22 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
+	"github.com/shanvl/garbage/pkg/etag"
+	"google.golang.org/protobuf/proto"
+)
+
+// etagResponseOption sets the ETag header of the responses holding a single user. The tag can then be sent back in
+// the If-Match header to change the user on condition that it hasn't been changed in the meantime
+func etagResponseOption(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+	switch resp := resp.(type) {
+	case *authv1pb.FindUserResponse:
+		etag.SetHeader(w, resp.GetUser().GetVersion())
+	case *authv1pb.UpdateProfileResponse:
+		etag.SetHeader(w, resp.GetUser().GetVersion())
+	}
+	return nil
+}
@@ -11,16 +11,23 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
+	"github.com/shanvl/garbage/pkg/jwks"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
 
+// KeySource provides the public keys used to verify the tokens issued by the service
+type KeySource interface {
+	JWKS() jwks.Set
+}
+
 type Server struct {
-	log *zap.Logger
+	keys KeySource
+	log  *zap.Logger
 }
 
-func NewServer(logger *zap.Logger) *Server {
-	return &Server{logger}
+func NewServer(keys KeySource, logger *zap.Logger) *Server {
+	return &Server{keys, logger}
 }
 
 func (s *Server) Run(port int, grpcAddress string) error {
@@ -29,9 +36,11 @@ func (s *Server) Run(port int, grpcAddress string) error {
 
 	// add custom error handler
 	customErrorsOption := runtime.WithErrorHandler(customHTTPError)
+	// set the ETag header of the users
+	etagOption := runtime.WithForwardResponseOption(etagResponseOption)
 
 	// create new mux
-	mux := runtime.NewServeMux(customErrorsOption)
+	mux := runtime.NewServeMux(customErrorsOption, etagOption)
 
 	// no tls
 	dialOptions := []grpc.DialOption{grpc.WithInsecure()}
@@ -42,10 +51,15 @@ func (s *Server) Run(port int, grpcAddress string) error {
 		return err
 	}
 
+	// the public keys are served along with the gateway, so that the other services could verify the tokens locally
+	rootMux := http.NewServeMux()
+	rootMux.Handle("/.well-known/jwks.json", jwks.Handler(s.keys.JWKS))
+	rootMux.Handle("/", mux)
+
 	// create REST gateway
 	server := &http.Server{
 		Addr:    fmt.Sprintf(":%d", port),
-		Handler: s.logMiddleware(mux),
+		Handler: s.logMiddleware(rootMux),
 	}
 
 	// graceful shutdown on signals
@@ -0,0 +1,67 @@
+package authsvc
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// RevocationKind tells whether the revocation is keyed by the client or by the user
+type RevocationKind int
+
+const (
+	// ClientRevocation invalidates the access tokens issued to a single client of the user
+	ClientRevocation RevocationKind = iota
+	// UserRevocation invalidates the access tokens issued to every client of the user
+	UserRevocation
+)
+
+var revocationKindStringValues = []string{"client", "user"}
+
+// String returns the string value of a revocation kind
+func (k RevocationKind) String() string {
+	if k < 0 || int(k) >= len(revocationKindStringValues) {
+		return "unknown"
+	}
+	return revocationKindStringValues[k]
+}
+
+// Revocation invalidates all the access tokens issued to the client or to the user before NotBefore.
+// Access tokens aren't stored, so a revocation is the only way to stop them from working before they expire
+type Revocation struct {
+	Kind RevocationKind
+	// SubjectID is the id of the client or the id of the user depending on the kind of the revocation
+	SubjectID string
+	NotBefore time.Time
+}
+
+// RevocationStore stores the revocations
+type RevocationStore interface {
+	// StoreRevocation stores the revocation. If the subject has already been revoked, the later not-before
+	// timestamp is kept
+	StoreRevocation(ctx context.Context, revocation Revocation) error
+}
+
+// NewRevocation creates a revocation which invalidates the tokens issued up to now.
+// Tokens carry their issue time in milliseconds, so NotBefore is truncated to milliseconds too. Otherwise, the tokens
+// issued right after the revocation would be revoked as well
+func NewRevocation(kind RevocationKind, subjectID string) (Revocation, error) {
+	if subjectID == "" {
+		return Revocation{}, errors.New("subjectID must be provided")
+	}
+	return Revocation{
+		Kind:      kind,
+		SubjectID: subjectID,
+		NotBefore: time.Now().UTC().Truncate(time.Millisecond),
+	}, nil
+}
+
+// IsRevoked reports whether the token with the given claims has been issued before notBefore. The tokens which only
+// carry their issue time in seconds are revoked if they have been issued in the same second as the revocation, since
+// it can't be told whether they have been issued before it
+func IsRevoked(claims UserClaims, notBefore time.Time) bool {
+	if claims.IssuedAtMs == 0 {
+		return !notBefore.IsZero() && claims.IssuedAt <= notBefore.Unix()
+	}
+	return claims.IssuedAtTime().Before(notBefore)
+}
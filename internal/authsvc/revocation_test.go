@@ -0,0 +1,104 @@
+package authsvc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+func TestIsRevoked(t *testing.T) {
+	t.Parallel()
+	notBefore := time.Now().Truncate(time.Second).Add(500 * time.Millisecond)
+	tests := []struct {
+		name      string
+		issuedAt  time.Time
+		legacy    bool
+		notBefore time.Time
+		want      bool
+	}{
+		{
+			name:      "no revocation",
+			issuedAt:  notBefore,
+			notBefore: time.Time{},
+			want:      false,
+		},
+		{
+			name:      "issued before the revocation",
+			issuedAt:  notBefore.Add(-time.Second),
+			notBefore: notBefore,
+			want:      true,
+		},
+		{
+			name:      "issued earlier in the same second as the revocation",
+			issuedAt:  notBefore.Add(-100 * time.Millisecond),
+			notBefore: notBefore,
+			want:      true,
+		},
+		{
+			name:      "issued in the same millisecond as the revocation",
+			issuedAt:  notBefore,
+			notBefore: notBefore,
+			want:      false,
+		},
+		{
+			name:      "issued later in the same second as the revocation",
+			issuedAt:  notBefore.Add(100 * time.Millisecond),
+			notBefore: notBefore,
+			want:      false,
+		},
+		{
+			name:      "issued after the revocation",
+			issuedAt:  notBefore.Add(time.Minute),
+			notBefore: notBefore,
+			want:      false,
+		},
+		{
+			name:      "legacy token issued in the same second as the revocation",
+			issuedAt:  notBefore.Add(100 * time.Millisecond),
+			legacy:    true,
+			notBefore: notBefore,
+			want:      true,
+		},
+		{
+			name:      "legacy token issued after the revocation",
+			issuedAt:  notBefore.Add(time.Second),
+			legacy:    true,
+			notBefore: notBefore,
+			want:      false,
+		},
+		{
+			name:      "legacy token, no revocation",
+			issuedAt:  notBefore,
+			legacy:    true,
+			notBefore: time.Time{},
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := UserClaims{StandardClaims: jwt.StandardClaims{IssuedAt: tt.issuedAt.Unix()}}
+			if !tt.legacy {
+				claims.IssuedAtMs = tt.issuedAt.UnixNano() / int64(time.Millisecond)
+			}
+			if got := IsRevoked(claims, tt.notBefore); got != tt.want {
+				t.Errorf("IsRevoked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewRevocation(t *testing.T) {
+	t.Parallel()
+	if _, err := NewRevocation(UserRevocation, ""); err == nil {
+		t.Errorf("NewRevocation() no subjectID, error = nil")
+	}
+	r, err := NewRevocation(ClientRevocation, "clientID")
+	if err != nil {
+		t.Fatalf("NewRevocation() error = %v", err)
+	}
+	if r.Kind != ClientRevocation || r.SubjectID != "clientID" || r.NotBefore.After(time.Now()) ||
+		!r.NotBefore.Equal(r.NotBefore.Truncate(time.Millisecond)) {
+		t.Errorf("NewRevocation() got = %v", r)
+	}
+}
@@ -0,0 +1,10 @@
+package authsvc
+
+// Scope restricts the pupils the user has access to in the services which own them. Members are restricted to the
+// pupils of the classes they are assigned to, while guardians are restricted to the pupils they are linked to, e.g.
+// their children. The scope is put into the tokens, so that those services don't have to ask for it
+type Scope struct {
+	Classes []Class
+	// PupilIDs are the ids of the pupils in the event service
+	PupilIDs []string
+}
@@ -0,0 +1,42 @@
+package authsvc
+
+import "time"
+
+// SecurityEventKind is a kind of suspicious activity detected by the service
+type SecurityEventKind int
+
+const (
+	// RefreshTokenReuse means that an already rotated refresh token has been presented. Either the legitimate client
+	// or an attacker holds a stolen token, so the whole family of the tokens is revoked
+	RefreshTokenReuse SecurityEventKind = iota
+)
+
+var securityEventKindStringValues = []string{"refresh_token_reuse"}
+
+// String returns the string value of a security event kind
+func (k SecurityEventKind) String() string {
+	if k < 0 || int(k) >= len(securityEventKindStringValues) {
+		return "unknown"
+	}
+	return securityEventKindStringValues[k]
+}
+
+// SecurityEvent is a record of suspicious activity related to the user's account
+type SecurityEvent struct {
+	ID         string
+	Kind       SecurityEventKind
+	UserID     string
+	ClientID   string
+	OccurredAt time.Time
+}
+
+// NewSecurityEvent creates a security event which has occurred just now
+func NewSecurityEvent(id string, kind SecurityEventKind, userID, clientID string) SecurityEvent {
+	return SecurityEvent{
+		ID:         id,
+		Kind:       kind,
+		UserID:     userID,
+		ClientID:   clientID,
+		OccurredAt: time.Now().UTC(),
+	}
+}
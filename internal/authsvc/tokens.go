@@ -2,6 +2,7 @@ package authsvc
 
 import (
 	"errors"
+	"time"
 
 	"github.com/dgrijalva/jwt-go"
 )
@@ -9,12 +10,13 @@ import (
 var (
 	ErrInvalidAccessToken  = errors.New("invalid access token")
 	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReuse   = errors.New("refresh token reuse")
 	ErrUnknownClient       = errors.New("unknown client")
 )
 
 // TokenManager is used for generation and verification of tokens
 type TokenManager interface {
-	Generate(tokenType TokenType, clientID, userID string, role Role) (string, error)
+	Generate(tokenType TokenType, clientID, userID string, role Role, scope Scope) (string, error)
 	Verify(token string) (UserClaims, error)
 }
 
@@ -37,6 +39,9 @@ func (t TokenType) String() string {
 // UserClaims are a token payload
 type UserClaims struct {
 	jwt.StandardClaims
+	// IssuedAtMs is the issue time in milliseconds. IssuedAt is in seconds, which isn't precise enough to tell whether
+	// the token has been issued before a revocation made in the same second
+	IssuedAtMs int64 `json:"iat_ms,omitempty"`
 	// ClientID is used to distinguish between different user's clients (browsers, apps etc)
 	// in order to have an option to revoke the corresponding refresh token and thus sign the user out of that client
 	ClientID string
@@ -44,4 +49,33 @@ type UserClaims struct {
 	Role string
 	// Type is a string representation of the token's type
 	Type string
+	// Classes are string representations of the classes the user is assigned to. The services which own the pupils
+	// use them to restrict the members to the pupils of their classes
+	Classes []string `json:",omitempty"`
+	// Pupils are the ids of the pupils the user is linked to. The services which own the pupils use them to restrict
+	// the guardians to their pupils
+	Pupils []string `json:",omitempty"`
+}
+
+// IssuedAtTime returns the issue time of the token. The tokens issued before IssuedAtMs was introduced only carry
+// the issue time in seconds
+func (c UserClaims) IssuedAtTime() time.Time {
+	if c.IssuedAtMs != 0 {
+		return time.Unix(0, c.IssuedAtMs*int64(time.Millisecond))
+	}
+	return time.Unix(c.IssuedAt, 0)
+}
+
+// UserScope parses the scope of the user
+func (c UserClaims) UserScope() (Scope, error) {
+	var scope Scope
+	for _, s := range c.Classes {
+		class, err := ParseClass(s)
+		if err != nil {
+			return Scope{}, err
+		}
+		scope.Classes = append(scope.Classes, class)
+	}
+	scope.PupilIDs = c.Pupils
+	return scope, nil
 }
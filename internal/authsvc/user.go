@@ -8,12 +8,16 @@ import (
 )
 
 var (
+	ErrActiveUser             = errors.New("active user")
 	ErrDuplicateEmail         = errors.New("duplicate email")
 	ErrInactiveUser           = errors.New("inactive user")
 	ErrInvalidActivationToken = errors.New("invalid activation token")
 	ErrInvalidPassword        = errors.New("invalid password")
 	ErrUnknownUser            = errors.New("unknown user")
 	ErrUnknownRole            = errors.New("unknown role")
+	// ErrVersionMismatch is used when the user is changed on condition that it's of the version it isn't of anymore,
+	// i.e. someone else has changed it in the meantime
+	ErrVersionMismatch = errors.New("the user has been changed in the meantime")
 )
 
 // User is a user of the app
@@ -26,6 +30,8 @@ type User struct {
 	LastName        string
 	PasswordHash    string
 	Role            Role
+	// Version is incremented on every change of the user
+	Version int
 }
 
 // Role is a role of the user. Different roles grant different permissions to use services
@@ -35,9 +41,11 @@ const (
 	Admin Role = iota
 	Member
 	Root
+	// Guardian is a pupil or a parent, who can only see the pupils linked to their account
+	Guardian
 )
 
-var roleStringValues = []string{"admin", "member", "root"}
+var roleStringValues = []string{"admin", "member", "root", "guardian"}
 
 // String returns the string value of a role
 func (r Role) String() string {
@@ -48,9 +56,10 @@ func (r Role) String() string {
 }
 
 var stringToRoleMap = map[string]Role{
-	"admin":  Admin,
-	"member": Member,
-	"root":   Root,
+	"admin":    Admin,
+	"guardian": Guardian,
+	"member":   Member,
+	"root":     Root,
 }
 
 // StringToRole converts a string to a role
@@ -101,6 +110,15 @@ func (u *User) ChangeRole(role Role) {
 	u.Role = role
 }
 
+// CheckVersion returns ErrVersionMismatch if the user can't be changed on condition that it's of the given version.
+// The version 0 means there's no condition
+func (u *User) CheckVersion(version int) error {
+	if version != 0 && version != u.Version {
+		return fmt.Errorf("%w: the version is %d, not %d", ErrVersionMismatch, u.Version, version)
+	}
+	return nil
+}
+
 // Deactivate changes the user's active state to false.
 // It requires an activation token which can be used later to activate the user
 func (u *User) Deactivate(activationToken string) error {
@@ -112,6 +130,18 @@ func (u *User) Deactivate(activationToken string) error {
 	return nil
 }
 
+// RenewActivationToken replaces the activation token of the inactive user, so that the old one can't be used anymore
+func (u *User) RenewActivationToken(activationToken string) error {
+	if u.Active {
+		return ErrActiveUser
+	}
+	if activationToken == "" {
+		return ErrInvalidActivationToken
+	}
+	u.ActivationToken = activationToken
+	return nil
+}
+
 // IsCorrectPassword compares the proved password with user's password hash
 func (u *User) IsCorrectPassword(password string) bool {
 	return comparePasswordHash(password, u.PasswordHash)
@@ -187,6 +187,50 @@ func TestUser_Deactivate(t *testing.T) {
 	}
 }
 
+func TestUser_RenewActivationToken(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		user            *User
+		activationToken string
+		wantUser        *User
+		wantErr         bool
+	}{
+		{
+			name:            "active user",
+			user:            &User{Active: true},
+			activationToken: "token",
+			wantErr:         true,
+			wantUser:        &User{Active: true},
+		},
+		{
+			name:            "no token",
+			user:            &User{ActivationToken: "old"},
+			activationToken: "",
+			wantErr:         true,
+			wantUser:        &User{ActivationToken: "old"},
+		},
+		{
+			name:            "ok",
+			user:            &User{ActivationToken: "old"},
+			activationToken: "token",
+			wantErr:         false,
+			wantUser:        &User{ActivationToken: "token"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.user.RenewActivationToken(tt.activationToken)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RenewActivationToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.user, tt.wantUser) {
+				t.Errorf("RenewActivationToken() user = %v, wantUser %v", tt.user, tt.wantUser)
+			}
+		})
+	}
+}
+
 func TestUser_IsCorrectPassword(t *testing.T) {
 	t.Parallel()
 	password := "password"
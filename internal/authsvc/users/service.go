@@ -5,52 +5,145 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	gonanoid "github.com/matoous/go-nanoid"
 	"github.com/shanvl/garbage/internal/authsvc"
+	"github.com/shanvl/garbage/pkg/paging"
 	"github.com/shanvl/garbage/pkg/valid"
 )
 
+// ErrActivationNotSent is returned along with the activation token when the user has been stored, but the email with
+// the token couldn't be sent. The token is still valid and can be passed to the user by other means
+var ErrActivationNotSent = errors.New("activation email not sent")
+
+// ErrPasswordResetNotSent is returned when the password reset has been stored, but the email with the token couldn't
+// be sent. It mustn't be passed on to the caller, since it would tell that the user with the email exists
+var ErrPasswordResetNotSent = errors.New("password reset email not sent")
+
+// Mailer sends emails to the users
+type Mailer interface {
+	// SendActivation sends the activation token to the given email
+	SendActivation(ctx context.Context, email, activationToken string) error
+	// SendPasswordReset sends the password reset token to the given email
+	SendPasswordReset(ctx context.Context, email, resetToken string) error
+}
+
 // Repository is a repo required by Service
 type Repository interface {
-	ChangeUserRole(ctx context.Context, id string, role authsvc.Role) error
+	// ChangeUserRole changes the user's role and increments the user's version. If the version isn't 0, the role is
+	// changed only if the user is still of that version, otherwise authsvc.ErrVersionMismatch is returned
+	ChangeUserRole(ctx context.Context, id string, role authsvc.Role, version int) error
+	// DeletePasswordReset deletes the password reset with the given token and returns it, so that it can't be used
+	// twice
+	DeletePasswordReset(ctx context.Context, token string) (*authsvc.PasswordReset, error)
 	DeleteUser(ctx context.Context, id string) error
+	// DeleteUserClients deletes all the clients of the user except the ones with the given ids and returns the ids of
+	// the deleted clients
+	DeleteUserClients(ctx context.Context, userID string, exceptClientIDs ...string) ([]string, error)
+	// SetUserClasses replaces the classes the user is assigned to
+	SetUserClasses(ctx context.Context, userID string, classes []authsvc.Class) error
+	// SetUserPupils replaces the pupils the user is linked to
+	SetUserPupils(ctx context.Context, userID string, pupilIDs []string) error
+	// StorePasswordReset stores the password reset replacing the previous one of the user, if any
+	StorePasswordReset(ctx context.Context, reset *authsvc.PasswordReset) error
+	// StoreRevocation stores the revocation. If the subject has already been revoked, the later not-before
+	// timestamp is kept
+	StoreRevocation(ctx context.Context, revocation authsvc.Revocation) error
+	// StoreUser upserts the user and sets its version to the stored one. If the user's version isn't 0, the existing
+	// user is updated only if it's still of that version, otherwise authsvc.ErrVersionMismatch is returned
 	StoreUser(ctx context.Context, user *authsvc.User) error
 	UserByActivationToken(ctx context.Context, activationToken string) (*authsvc.User, error)
+	UserByEmail(ctx context.Context, email string) (*authsvc.User, error)
 	UserByID(ctx context.Context, id string) (*authsvc.User, error)
-	Users(ctx context.Context, nameAndEmail string, sorting Sorting, amount, skip int) ([]*authsvc.User, int, error)
+	UserClasses(ctx context.Context, userID string) ([]authsvc.Class, error)
+	UserPupils(ctx context.Context, userID string) ([]string, error)
+	Users(ctx context.Context, nameAndEmail string, sorting Sorting, page paging.Page) ([]*authsvc.User, int, string,
+		error)
+}
+
+// revocationRepo is a repo which stores the revocations in a separate store
+type revocationRepo struct {
+	Repository
+	store authsvc.RevocationStore
+}
+
+// WithRevocationStore wraps the repo, so that the revocations are stored in the given store instead. It lets the
+// revocations go through the store which evicts the cached not-before timestamps they affect
+func WithRevocationStore(repo Repository, store authsvc.RevocationStore) Repository {
+	return &revocationRepo{repo, store}
+}
+
+// StoreRevocation stores the revocation in the store
+func (r *revocationRepo) StoreRevocation(ctx context.Context, revocation authsvc.Revocation) error {
+	return r.store.StoreRevocation(ctx, revocation)
 }
 
 // Service manages users
 type Service interface {
 	// ActivateUser changes the active state of the user to active and populates it with the provided additional info
 	ActivateUser(ctx context.Context, activationToken, firstName, lastName, password string) (userID string, err error)
-	// ChangeUserRole changes the user's role to the provided role
-	ChangeUserRole(ctx context.Context, id string, role authsvc.Role) error
-	// CreateUser creates and stores a user, which must then be activated with the returned activation token
+	// ChangeOwnPassword changes the password of the user if the old password is correct. If logoutOtherClients is
+	// true, the user is logged out from all of their clients except the one with the given id
+	ChangeOwnPassword(ctx context.Context, userID, clientID, oldPassword, newPassword string,
+		logoutOtherClients bool) error
+	// ChangeUserRole changes the user's role to the provided role and logs the user out from all of their clients,
+	// so that the tokens with the old role can't be used anymore. If the version isn't 0, the role is changed only if
+	// the user is still of that version
+	ChangeUserRole(ctx context.Context, id string, role authsvc.Role, version int) error
+	// CreateUser creates and stores a user, which must then be activated with the returned activation token.
+	// The token is also emailed to the user
 	// Note, that the user's password is not needed here, it is required on the activation step
 	CreateUser(ctx context.Context, email string) (id string, activationToken string, err error)
-	// DeleteUser deletes the user
+	// DeleteUser deletes the user and revokes their access tokens
 	DeleteUser(ctx context.Context, id string) error
+	// RequestPasswordReset emails a single-use password reset token to the user with the given email.
+	// It doesn't tell whether such a user exists, so if the email can't be sent, ErrPasswordResetNotSent is returned,
+	// which mustn't be passed on to the caller
+	RequestPasswordReset(ctx context.Context, email string) error
+	// ResendActivation issues a fresh activation token for the inactive user and emails it to them. The old token
+	// can't be used anymore
+	ResendActivation(ctx context.Context, id string) (activationToken string, err error)
+	// ResetPassword sets a new password for the user the reset token has been issued to and logs them out from
+	// all of their clients
+	ResetPassword(ctx context.Context, resetToken, password string) error
+	// SetUserClasses assigns the user to the classes, replacing the previous ones, and logs the user out from all of
+	// their clients, so that the tokens with the old classes can't be used anymore
+	SetUserClasses(ctx context.Context, id string, classes []authsvc.Class) error
+	// SetUserPupils links the user, e.g. a guardian, to the pupils with the given ids, replacing the previous ones,
+	// and logs the user out from all of their clients, so that the tokens with the old pupils can't be used anymore
+	SetUserPupils(ctx context.Context, id string, pupilIDs []string) error
+	// UpdateProfile changes the first and the last names of the user. If the version isn't 0, the profile is updated
+	// only if the user is still of that version
+	UpdateProfile(ctx context.Context, userID, firstName, lastName string, version int) (*authsvc.User, error)
 	// UserByID returns the user with the specified id
 	UserByID(ctx context.Context, id string) (*authsvc.User, error)
-	// Users returns a sorted list of users
+	// UserClasses returns the classes the user is assigned to
+	UserClasses(ctx context.Context, id string) ([]authsvc.Class, error)
+	// UserPupils returns the ids of the pupils the user is linked to
+	UserPupils(ctx context.Context, id string) ([]string, error)
+	// Users returns a page of the sorted list of users. The token of the next page is empty if the page is the last one.
 	// "nameAndEmail" may consist of any combination of the email, first name and last name parts
-	Users(ctx context.Context, nameAndEmail string, sorting Sorting, amount, skip int) (users []*authsvc.User,
-		total int, err error)
+	Users(ctx context.Context, nameAndEmail string, sorting Sorting, page paging.Page) (users []*authsvc.User,
+		total int, nextToken string, err error)
 }
 
 const (
 	DefaultAmount = 50
 	MaxAmount     = 1000
+	// PasswordResetTTL is the time the password reset token can be used in
+	PasswordResetTTL = time.Hour
+	// maxPupilIDLen is the max length of the pupil ids the user can be linked to
+	maxPupilIDLen = 50
 )
 
 type service struct {
-	repo Repository
+	repo   Repository
+	mailer Mailer
 }
 
-func NewService(repo Repository) Service {
-	return &service{repo}
+func NewService(repo Repository, mailer Mailer) Service {
+	return &service{repo, mailer}
 }
 
 // ActivateUser changes the active state of the user to active and populates it with the provided additional info
@@ -62,27 +155,8 @@ func (s *service) ActivateUser(ctx context.Context, activationToken, firstName,
 	if activationToken == "" {
 		validErr.Add("activationToken", "activation token is required")
 	}
-	if firstName == "" {
-		validErr.Add("firstName", "first name is required")
-	}
-	if len(firstName) > 35 {
-		validErr.Add("lastName", "length of the first name can't be more than 35")
-	}
-	if lastName == "" {
-		validErr.Add("lastName", "last name is required")
-	}
-	if len(lastName) > 35 {
-		validErr.Add("lastName", "length of the last name can't be more than 35")
-	}
-	if password == "" {
-		validErr.Add("password", "password is required")
-	}
-	if len(password) > 50 {
-		validErr.Add("password", "length of the password can't be more than 50")
-	}
-	if len(password) < 6 {
-		validErr.Add("password", "length of the password can't be less than 6")
-	}
+	validateName(validErr, firstName, lastName)
+	validatePassword(validErr, password)
 	if !validErr.IsEmpty() {
 		return "", validErr
 	}
@@ -115,17 +189,73 @@ func (s *service) ActivateUser(ctx context.Context, activationToken, firstName,
 	return user.ID, nil
 }
 
-// ChangeUserRole changes the user's role to the provided one
-func (s *service) ChangeUserRole(ctx context.Context, id string, role authsvc.Role) error {
+// ChangeOwnPassword changes the password of the user if the old password is correct. If logoutOtherClients is
+// true, the user is logged out from all of their clients except the one with the given id
+func (s *service) ChangeOwnPassword(ctx context.Context, userID, clientID, oldPassword, newPassword string,
+	logoutOtherClients bool) error {
+
+	// validate the arguments
+	validErr := valid.EmptyError()
+	if userID == "" {
+		validErr.Add("userID", "user id is required")
+	}
+	if oldPassword == "" {
+		validErr.Add("oldPassword", "old password is required")
+	}
+	validatePassword(validErr, newPassword)
+	if !validErr.IsEmpty() {
+		return validErr
+	}
+
+	// get the user and check the old password
+	user, err := s.repo.UserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.Active {
+		return authsvc.ErrInactiveUser
+	}
+	if !user.IsCorrectPassword(oldPassword) {
+		return authsvc.ErrInvalidPassword
+	}
+
+	// change the password and store the user
+	err = user.ChangePassword(newPassword)
+	if err != nil {
+		return err
+	}
+	err = s.repo.StoreUser(ctx, user)
+	if err != nil {
+		return err
+	}
+	if !logoutOtherClients {
+		return nil
+	}
+	// the current client stays logged in, so the other clients are revoked one by one instead of the whole user
+	revokedClientIDs, err := s.repo.DeleteUserClients(ctx, user.ID, clientID)
+	if err != nil {
+		return err
+	}
+	return s.revokeClients(ctx, revokedClientIDs)
+}
+
+// ChangeUserRole changes the user's role to the provided one and logs the user out from all of their clients,
+// so that the tokens with the old role can't be used anymore
+func (s *service) ChangeUserRole(ctx context.Context, id string, role authsvc.Role, version int) error {
 	// validate the arguments
 	if id == "" {
 		return valid.NewError("id", "id is required")
 	}
 
-	return s.repo.ChangeUserRole(ctx, id, role)
+	err := s.repo.ChangeUserRole(ctx, id, role, version)
+	if err != nil {
+		return err
+	}
+	return s.logoutEverywhere(ctx, id)
 }
 
-// CreateUser creates and stores a user, which must then be activated with the returned activation token
+// CreateUser creates and stores a user, which must then be activated with the returned activation token.
+// The token is also emailed to the user
 // Note, that the user's password is not needed here, it is required on the activation step
 func (s *service) CreateUser(ctx context.Context, email string) (string, string, error) {
 	if email == "" {
@@ -157,15 +287,237 @@ func (s *service) CreateUser(ctx context.Context, email string) (string, string,
 		}
 		return "", "", err
 	}
+	// send the activation token to the user
+	err = s.mailer.SendActivation(ctx, email, activationToken)
+	if err != nil {
+		return userID, activationToken, fmt.Errorf("%w: %v", ErrActivationNotSent, err)
+	}
 	return userID, activationToken, nil
 }
 
-// DeleteUser deletes the user
+// DeleteUser deletes the user and revokes their access tokens
 func (s *service) DeleteUser(ctx context.Context, id string) error {
 	if id == "" {
 		return valid.NewError("id", "id is required")
 	}
-	return s.repo.DeleteUser(ctx, id)
+	err := s.repo.DeleteUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.revokeUser(ctx, id)
+}
+
+// RequestPasswordReset emails a single-use password reset token to the user with the given email.
+// It doesn't tell whether such a user exists
+func (s *service) RequestPasswordReset(ctx context.Context, email string) error {
+	if email == "" {
+		return valid.NewError("email", "email is required")
+	}
+	// get the user
+	user, err := s.repo.UserByEmail(ctx, email)
+	if err != nil {
+		// the caller mustn't be able to find out which emails are registered
+		if errors.Is(err, authsvc.ErrUnknownUser) {
+			return nil
+		}
+		return err
+	}
+	// inactive users have no password yet, they must be activated instead
+	if !user.Active {
+		return nil
+	}
+	// create a password reset
+	resetToken, err := gonanoid.Nanoid()
+	if err != nil {
+		return err
+	}
+	reset, err := authsvc.NewPasswordReset(resetToken, user.ID, PasswordResetTTL)
+	if err != nil {
+		return err
+	}
+	// store it
+	err = s.repo.StorePasswordReset(ctx, reset)
+	if err != nil {
+		return err
+	}
+	// send the reset token to the user
+	err = s.mailer.SendPasswordReset(ctx, user.Email, resetToken)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPasswordResetNotSent, err)
+	}
+	return nil
+}
+
+// ResendActivation issues a fresh activation token for the inactive user and emails it to them. The old token
+// can't be used anymore
+func (s *service) ResendActivation(ctx context.Context, id string) (string, error) {
+	if id == "" {
+		return "", valid.NewError("id", "id is required")
+	}
+	// get the user
+	user, err := s.repo.UserByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	// create a new activation token and replace the old one with it
+	activationToken, err := gonanoid.Nanoid(14)
+	if err != nil {
+		return "", err
+	}
+	err = user.RenewActivationToken(activationToken)
+	if err != nil {
+		return "", err
+	}
+	// store the user
+	err = s.repo.StoreUser(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	// send the new activation token to the user
+	err = s.mailer.SendActivation(ctx, user.Email, activationToken)
+	if err != nil {
+		return activationToken, fmt.Errorf("%w: %v", ErrActivationNotSent, err)
+	}
+	return activationToken, nil
+}
+
+// ResetPassword sets a new password for the user the reset token has been issued to and logs them out from
+// all of their clients
+func (s *service) ResetPassword(ctx context.Context, resetToken, password string) error {
+	// validate the arguments
+	validErr := valid.EmptyError()
+	if resetToken == "" {
+		validErr.Add("resetToken", "reset token is required")
+	}
+	validatePassword(validErr, password)
+	if !validErr.IsEmpty() {
+		return validErr
+	}
+
+	// get the password reset. It's deleted at the same time, so it can't be used again
+	reset, err := s.repo.DeletePasswordReset(ctx, resetToken)
+	if err != nil {
+		return err
+	}
+	if reset.IsExpired() {
+		return fmt.Errorf("%w: expired", authsvc.ErrInvalidResetToken)
+	}
+
+	// get the user and change their password
+	user, err := s.repo.UserByID(ctx, reset.UserID)
+	if err != nil {
+		return err
+	}
+	err = user.ChangePassword(password)
+	if err != nil {
+		return err
+	}
+	err = s.repo.StoreUser(ctx, user)
+	if err != nil {
+		return err
+	}
+	// the old password might have been compromised, so all the clients logged in with it are logged out
+	return s.logoutEverywhere(ctx, user.ID)
+}
+
+// SetUserClasses assigns the user to the classes, replacing the previous ones, and logs the user out from all of
+// their clients, so that the tokens with the old classes can't be used anymore
+func (s *service) SetUserClasses(ctx context.Context, id string, classes []authsvc.Class) error {
+	// validate the arguments
+	validErr := valid.EmptyError()
+	if id == "" {
+		validErr.Add("id", "id is required")
+	}
+	normalized := make([]authsvc.Class, 0, len(classes))
+	seen := make(map[authsvc.Class]bool, len(classes))
+	for _, c := range classes {
+		class, err := authsvc.NewClass(c.Letter, c.YearFormed)
+		if err != nil {
+			validErr.Add("classes", err.Error())
+			continue
+		}
+		if !seen[class] {
+			seen[class] = true
+			normalized = append(normalized, class)
+		}
+	}
+	if !validErr.IsEmpty() {
+		return validErr
+	}
+
+	err := s.repo.SetUserClasses(ctx, id, normalized)
+	if err != nil {
+		return err
+	}
+	return s.logoutEverywhere(ctx, id)
+}
+
+// SetUserPupils links the user, e.g. a guardian, to the pupils with the given ids, replacing the previous ones,
+// and logs the user out from all of their clients, so that the tokens with the old pupils can't be used anymore.
+// The pupils belong to the event service, so their existence isn't checked here
+func (s *service) SetUserPupils(ctx context.Context, id string, pupilIDs []string) error {
+	// validate the arguments
+	validErr := valid.EmptyError()
+	if id == "" {
+		validErr.Add("id", "id is required")
+	}
+	deduped := make([]string, 0, len(pupilIDs))
+	seen := make(map[string]bool, len(pupilIDs))
+	for _, pupilID := range pupilIDs {
+		if pupilID == "" || len(pupilID) > maxPupilIDLen {
+			validErr.Add("pupilIDs", fmt.Sprintf("invalid pupil id: %q", pupilID))
+			continue
+		}
+		if !seen[pupilID] {
+			seen[pupilID] = true
+			deduped = append(deduped, pupilID)
+		}
+	}
+	if !validErr.IsEmpty() {
+		return validErr
+	}
+
+	err := s.repo.SetUserPupils(ctx, id, deduped)
+	if err != nil {
+		return err
+	}
+	return s.logoutEverywhere(ctx, id)
+}
+
+// UpdateProfile changes the first and the last names of the user. The user is stored on condition of the version it's
+// been read of, so that the concurrent changes aren't lost
+func (s *service) UpdateProfile(ctx context.Context, userID, firstName, lastName string,
+	version int) (*authsvc.User, error) {
+	// validate the arguments
+	validErr := valid.EmptyError()
+	if userID == "" {
+		validErr.Add("userID", "user id is required")
+	}
+	validateName(validErr, firstName, lastName)
+	if !validErr.IsEmpty() {
+		return nil, validErr
+	}
+
+	// get the user
+	user, err := s.repo.UserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.Active {
+		return nil, authsvc.ErrInactiveUser
+	}
+	if err := user.CheckVersion(version); err != nil {
+		return nil, err
+	}
+
+	// change the names and store the user
+	user.FirstName = firstName
+	user.LastName = lastName
+	err = s.repo.StoreUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
 }
 
 // UserByID returns the user with the specified id
@@ -176,23 +528,74 @@ func (s *service) UserByID(ctx context.Context, id string) (*authsvc.User, error
 	return s.repo.UserByID(ctx, id)
 }
 
-// Users returns a sorted list of users
+// UserClasses returns the classes the user is assigned to
+func (s *service) UserClasses(ctx context.Context, id string) ([]authsvc.Class, error) {
+	if id == "" {
+		return nil, valid.NewError("id", "id is required")
+	}
+	// make sure the user exists, since an unknown user isn't assigned to any classes either
+	if _, err := s.repo.UserByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.repo.UserClasses(ctx, id)
+}
+
+// UserPupils returns the ids of the pupils the user is linked to
+func (s *service) UserPupils(ctx context.Context, id string) ([]string, error) {
+	if id == "" {
+		return nil, valid.NewError("id", "id is required")
+	}
+	// make sure the user exists, since an unknown user isn't linked to any pupils either
+	if _, err := s.repo.UserByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.repo.UserPupils(ctx, id)
+}
+
+// Users returns a page of the sorted list of users
 // "nameAndEmail" may consist of any combination of the email, first name and last name parts
-func (s *service) Users(ctx context.Context, nameAndEmail string, sorting Sorting, amount, skip int) ([]*authsvc.User,
-	int, error) {
+func (s *service) Users(ctx context.Context, nameAndEmail string, sorting Sorting, page paging.Page) ([]*authsvc.User,
+	int, string, error) {
 
 	// validate sorting
 	if sorting == Unspecified {
 		sorting = NameDes
 	}
-	// validate amount and skip
-	if amount <= 0 || amount > MaxAmount {
-		amount = DefaultAmount
+	// validate the size and the skip of the page. Its token must have been made for the sorting
+	page = page.Normalize(DefaultAmount, MaxAmount)
+	if _, err := page.Cursor(sorting.String()); err != nil {
+		return nil, 0, "", valid.NewError("pageToken", err.Error())
 	}
-	if skip < 0 {
-		skip = 0
+	return s.repo.Users(ctx, nameAndEmail, sorting, page)
+}
+
+// validateName adds the validation errors of the user's first and last names, if any, to the given error
+func validateName(validErr *valid.ErrValidation, firstName, lastName string) {
+	if firstName == "" {
+		validErr.Add("firstName", "first name is required")
+	}
+	if len(firstName) > 35 {
+		validErr.Add("firstName", "length of the first name can't be more than 35")
+	}
+	if lastName == "" {
+		validErr.Add("lastName", "last name is required")
+	}
+	if len(lastName) > 35 {
+		validErr.Add("lastName", "length of the last name can't be more than 35")
+	}
+}
+
+// validatePassword adds the password's validation errors, if any, to the given error
+func validatePassword(validErr *valid.ErrValidation, password string) {
+	if password == "" {
+		validErr.Add("password", "password is required")
+	}
+	if len(password) > 50 {
+		validErr.Add("password", "length of the password can't be more than 50")
+	}
+	if len(password) < 6 {
+		validErr.Add("password", "length of the password can't be less than 6")
 	}
-	return s.repo.Users(ctx, nameAndEmail, sorting, amount, skip)
 }
 
 type User struct {
@@ -202,3 +605,35 @@ type User struct {
 	LastName  string
 	Role      authsvc.Role
 }
+
+// logoutEverywhere deletes all the clients of the user and revokes their access tokens
+func (s *service) logoutEverywhere(ctx context.Context, userID string) error {
+	_, err := s.repo.DeleteUserClients(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return s.revokeUser(ctx, userID)
+}
+
+// revokeClients invalidates the access tokens issued to the clients up to now
+func (s *service) revokeClients(ctx context.Context, clientIDs []string) error {
+	for _, id := range clientIDs {
+		revocation, err := authsvc.NewRevocation(authsvc.ClientRevocation, id)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.StoreRevocation(ctx, revocation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// revokeUser invalidates the access tokens issued to the user up to now
+func (s *service) revokeUser(ctx context.Context, userID string) error {
+	revocation, err := authsvc.NewRevocation(authsvc.UserRevocation, userID)
+	if err != nil {
+		return err
+	}
+	return s.repo.StoreRevocation(ctx, revocation)
+}
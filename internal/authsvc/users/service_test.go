@@ -3,17 +3,25 @@ package users_test
 import (
 	"context"
 	"errors"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/shanvl/garbage/internal/authsvc"
 	"github.com/shanvl/garbage/internal/authsvc/mock"
 	"github.com/shanvl/garbage/internal/authsvc/users"
+	"github.com/shanvl/garbage/pkg/paging"
+	"github.com/shanvl/garbage/pkg/valid"
 )
 
 func Test_service_CreateUser(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	const repoError = "repo error"
+	const (
+		repoError   = "repo error"
+		mailerError = "mailer error"
+	)
 	repo := &mock.UsersRepo{}
 	repo.StoreUserFn = func(ctx context.Context, user *authsvc.User) error {
 		if user.Email == repoError {
@@ -21,7 +29,14 @@ func Test_service_CreateUser(t *testing.T) {
 		}
 		return nil
 	}
-	s := users.NewService(repo)
+	mailer := &mock.Mailer{}
+	mailer.SendActivationFn = func(ctx context.Context, email, activationToken string) error {
+		if email == mailerError {
+			return errors.New("error")
+		}
+		return nil
+	}
+	s := users.NewService(repo, mailer)
 	type args struct {
 		email string
 	}
@@ -51,6 +66,13 @@ func Test_service_CreateUser(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "mailer's error",
+			args: args{
+				email: mailerError,
+			},
+			wantErr: true,
+		},
 		{
 			name: "ok",
 			args: args{
@@ -65,11 +87,69 @@ func Test_service_CreateUser(t *testing.T) {
 			if (err != nil) != tt.wantErr {
 				t.Errorf("CreateUser() error = %v, wantErr %v", err, tt.wantErr)
 			}
-			if err == nil && id == "" {
-				t.Errorf("CreateUser() err == nil, len(id) == 0")
+			// the user is stored even if the email couldn't be sent
+			if (err == nil || errors.Is(err, users.ErrActivationNotSent)) && id == "" {
+				t.Errorf("CreateUser() err == %v, len(id) == 0", err)
 			}
-			if err == nil && activationToken == "" {
-				t.Errorf("CreateUser() err == nil, len(activationToken) == 0")
+			if (err == nil || errors.Is(err, users.ErrActivationNotSent)) && activationToken == "" {
+				t.Errorf("CreateUser() err == %v, len(activationToken) == 0", err)
+			}
+		})
+	}
+	if !mailer.SendActivationInvoked {
+		t.Errorf("CreateUser() didn't send the activation email")
+	}
+}
+
+func Test_service_ResendActivation(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const (
+		activeUserID  = "active"
+		mailerErrorID = "mailer error"
+		repoErrorID   = "repo error"
+	)
+	repo := &mock.UsersRepo{}
+	repo.UserByIDFn = func(ctx context.Context, id string) (*authsvc.User, error) {
+		if id == repoErrorID {
+			return nil, authsvc.ErrUnknownUser
+		}
+		return &authsvc.User{ID: id, Active: id == activeUserID, ActivationToken: "old", Email: id}, nil
+	}
+	repo.StoreUserFn = func(ctx context.Context, user *authsvc.User) error {
+		if user.ActivationToken == "old" {
+			t.Errorf("ResendActivation() stored the user with the old activation token")
+		}
+		return nil
+	}
+	mailer := &mock.Mailer{}
+	mailer.SendActivationFn = func(ctx context.Context, email, activationToken string) error {
+		if email == mailerErrorID {
+			return errors.New("error")
+		}
+		return nil
+	}
+	s := users.NewService(repo, mailer)
+	tests := []struct {
+		name      string
+		id        string
+		wantErr   bool
+		wantToken bool
+	}{
+		{name: "no id", id: "", wantErr: true},
+		{name: "repo's error", id: repoErrorID, wantErr: true},
+		{name: "active user", id: activeUserID, wantErr: true},
+		{name: "mailer's error", id: mailerErrorID, wantErr: true, wantToken: true},
+		{name: "ok", id: "id", wantErr: false, wantToken: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			activationToken, err := s.ResendActivation(ctx, tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResendActivation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (activationToken != "") != tt.wantToken {
+				t.Errorf("ResendActivation() activationToken = %q, wantToken %v", activationToken, tt.wantToken)
 			}
 		})
 	}
@@ -98,7 +178,7 @@ func Test_service_ActivateUser(t *testing.T) {
 		}
 		return nil
 	}
-	s := users.NewService(repo)
+	s := users.NewService(repo, &mock.Mailer{})
 	type args struct {
 		activationToken string
 		firstName       string
@@ -220,13 +300,21 @@ func Test_service_ChangeUserRole(t *testing.T) {
 	ctx := context.Background()
 	const repoError = "repo error"
 	repo := &mock.UsersRepo{}
-	repo.ChangeUserRoleFn = func(ctx context.Context, id string, role authsvc.Role) error {
+	repo.ChangeUserRoleFn = func(ctx context.Context, id string, role authsvc.Role, version int) error {
 		if id == repoError {
 			return errors.New("error")
 		}
 		return nil
 	}
-	s := users.NewService(repo)
+	repo.DeleteUserClientsFn = func(ctx context.Context, userID string, exceptClientIDs ...string) ([]string, error) {
+		return nil, nil
+	}
+	var revocation authsvc.Revocation
+	repo.StoreRevocationFn = func(ctx context.Context, rev authsvc.Revocation) error {
+		revocation = rev
+		return nil
+	}
+	s := users.NewService(repo, &mock.Mailer{})
 	type args struct {
 		id   string
 		role authsvc.Role
@@ -263,10 +351,21 @@ func Test_service_ChangeUserRole(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := s.ChangeUserRole(ctx, tt.args.id, tt.args.role)
+			revocation = authsvc.Revocation{}
+			repo.DeleteUserClientsInvoked = false
+			err := s.ChangeUserRole(ctx, tt.args.id, tt.args.role, 0)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ChangeUserRole() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if tt.wantErr {
+				return
+			}
+			if !repo.DeleteUserClientsInvoked {
+				t.Errorf("ChangeUserRole() didn't log the user out from their clients")
+			}
+			if revocation.Kind != authsvc.UserRevocation || revocation.SubjectID != tt.args.id {
+				t.Errorf("ChangeUserRole() revocation = %v, want the user revoked", revocation)
+			}
 		})
 	}
 }
@@ -282,7 +381,12 @@ func Test_service_DeleteUser(t *testing.T) {
 		}
 		return nil
 	}
-	s := users.NewService(repo)
+	var revocation authsvc.Revocation
+	repo.StoreRevocationFn = func(ctx context.Context, rev authsvc.Revocation) error {
+		revocation = rev
+		return nil
+	}
+	s := users.NewService(repo, &mock.Mailer{})
 	type args struct {
 		id string
 	}
@@ -315,10 +419,14 @@ func Test_service_DeleteUser(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			revocation = authsvc.Revocation{}
 			err := s.DeleteUser(ctx, tt.args.id)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("DeleteUser() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if !tt.wantErr && (revocation.Kind != authsvc.UserRevocation || revocation.SubjectID != tt.args.id) {
+				t.Errorf("DeleteUser() revocation = %v, want the user revoked", revocation)
+			}
 		})
 	}
 }
@@ -339,7 +447,7 @@ func Test_service_UserByID(t *testing.T) {
 			Role:   authsvc.Member,
 		}, nil
 	}
-	s := users.NewService(repo)
+	s := users.NewService(repo, &mock.Mailer{})
 	type args struct {
 		id string
 	}
@@ -389,18 +497,19 @@ func Test_service_Users(t *testing.T) {
 	const repoError = "repo error"
 	repo := &mock.UsersRepo{}
 	uu := []*authsvc.User{{}, {}, {}}
-	repo.UsersFn = func(ctx context.Context, nameAndEmail string, sorting users.Sorting, amount, skip int) ([]*authsvc.User,
-		int, error) {
+	repo.UsersFn = func(ctx context.Context, nameAndEmail string, sorting users.Sorting,
+		page paging.Page) ([]*authsvc.User, int, string, error) {
 		if nameAndEmail == repoError {
-			return nil, 0, errors.New("error")
+			return nil, 0, "", errors.New("error")
 		}
-		return uu, 3, nil
+		return uu, 3, "", nil
 	}
-	s := users.NewService(repo)
+	s := users.NewService(repo, &mock.Mailer{})
 	type args struct {
 		nameAndEmail string
 		sorting      users.Sorting
 		amount, skip int
+		pageToken    string
 	}
 	tests := []struct {
 		name      string
@@ -452,6 +561,17 @@ func Test_service_Users(t *testing.T) {
 			wantErr:   false,
 			wantTotal: len(uu),
 		},
+		{
+			name: "page token of another sorting",
+			args: args{
+				nameAndEmail: "name",
+				sorting:      users.NameDes,
+				amount:       10,
+				pageToken:    testPageToken(t, users.EmailAsc),
+			},
+			wantErr:   true,
+			wantTotal: 0,
+		},
 		{
 			name: "repo error",
 			args: args{
@@ -465,7 +585,8 @@ func Test_service_Users(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, total, err := s.Users(ctx, tt.args.nameAndEmail, tt.args.sorting, tt.args.amount, tt.args.skip)
+			_, total, _, err := s.Users(ctx, tt.args.nameAndEmail, tt.args.sorting,
+				paging.Page{Size: tt.args.amount, Skip: tt.args.skip, Token: tt.args.pageToken})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Users() error == %v, wantErr == %v", err, tt.wantErr)
 			}
@@ -475,3 +596,608 @@ func Test_service_Users(t *testing.T) {
 		})
 	}
 }
+
+// testPageToken returns the token of a page of the users sorted by the sorting
+func testPageToken(t *testing.T, sorting users.Sorting) string {
+	t.Helper()
+	c, err := paging.NewCursor(sorting.String(), []byte(`["email", "id"]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := c.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func Test_service_RequestPasswordReset(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const (
+		inactiveEmail  = "inactive"
+		mailerErrEmail = "mailer error"
+		repoErrEmail   = "repo error"
+		unknownEmail   = "unknown"
+	)
+	repo := &mock.UsersRepo{}
+	repo.UserByEmailFn = func(ctx context.Context, email string) (*authsvc.User, error) {
+		switch email {
+		case repoErrEmail:
+			return nil, errors.New("error")
+		case unknownEmail:
+			return nil, authsvc.ErrUnknownUser
+		}
+		return &authsvc.User{ID: "id", Email: email, Active: email != inactiveEmail}, nil
+	}
+	var stored *authsvc.PasswordReset
+	repo.StorePasswordResetFn = func(ctx context.Context, reset *authsvc.PasswordReset) error {
+		stored = reset
+		return nil
+	}
+	var sentToken string
+	mailer := &mock.Mailer{}
+	mailer.SendPasswordResetFn = func(ctx context.Context, email, resetToken string) error {
+		if email == mailerErrEmail {
+			return errors.New("error")
+		}
+		sentToken = resetToken
+		return nil
+	}
+	s := users.NewService(repo, mailer)
+	tests := []struct {
+		name        string
+		email       string
+		wantErr     bool
+		wantNotSent bool
+		wantSent    bool
+	}{
+		{name: "no email", email: "", wantErr: true},
+		{name: "repo's error", email: repoErrEmail, wantErr: true},
+		{name: "unknown user", email: unknownEmail, wantErr: false},
+		{name: "inactive user", email: inactiveEmail, wantErr: false},
+		{name: "mailer's error", email: mailerErrEmail, wantErr: true, wantNotSent: true},
+		{name: "ok", email: "email", wantErr: false, wantSent: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored, sentToken = nil, ""
+			err := s.RequestPasswordReset(ctx, tt.email)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RequestPasswordReset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, users.ErrPasswordResetNotSent) != tt.wantNotSent {
+				t.Errorf("RequestPasswordReset() error = %v, wantNotSent %v", err, tt.wantNotSent)
+			}
+			if (sentToken != "") != tt.wantSent {
+				t.Errorf("RequestPasswordReset() sent token = %q, wantSent %v", sentToken, tt.wantSent)
+			}
+			if tt.wantSent && (stored == nil || stored.Token != sentToken || stored.IsExpired()) {
+				t.Errorf("RequestPasswordReset() stored reset = %v, want the one with the sent token", stored)
+			}
+		})
+	}
+}
+
+func Test_service_ResetPassword(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const (
+		expiredToken = "expired"
+		unknownToken = "unknown"
+		userID       = "userID"
+	)
+	repo := &mock.UsersRepo{}
+	repo.DeletePasswordResetFn = func(ctx context.Context, token string) (*authsvc.PasswordReset, error) {
+		switch token {
+		case unknownToken:
+			return nil, authsvc.ErrInvalidResetToken
+		case expiredToken:
+			return &authsvc.PasswordReset{Token: token, UserID: userID, ExpiresAt: time.Now().Add(-time.Minute)}, nil
+		}
+		return &authsvc.PasswordReset{Token: token, UserID: userID, ExpiresAt: time.Now().Add(time.Minute)}, nil
+	}
+	repo.UserByIDFn = func(ctx context.Context, id string) (*authsvc.User, error) {
+		return &authsvc.User{ID: id, Active: true}, nil
+	}
+	var storedUser *authsvc.User
+	repo.StoreUserFn = func(ctx context.Context, user *authsvc.User) error {
+		storedUser = user
+		return nil
+	}
+	repo.DeleteUserClientsFn = func(ctx context.Context, id string, exceptClientIDs ...string) ([]string, error) {
+		if len(exceptClientIDs) > 0 {
+			t.Errorf("ResetPassword() kept the clients %v", exceptClientIDs)
+		}
+		if id != userID {
+			t.Errorf("ResetPassword() deleted the clients of %q, want %q", id, userID)
+		}
+		return nil, nil
+	}
+	repo.StoreRevocationFn = func(ctx context.Context, rev authsvc.Revocation) error {
+		if rev.Kind != authsvc.UserRevocation || rev.SubjectID != userID {
+			t.Errorf("ResetPassword() revocation = %v, want the user revoked", rev)
+		}
+		return nil
+	}
+	s := users.NewService(repo, &mock.Mailer{})
+	tests := []struct {
+		name     string
+		token    string
+		password string
+		wantErr  error
+	}{
+		{name: "no token", token: "", password: "password", wantErr: &valid.ErrValidation{}},
+		{name: "short password", token: "token", password: "pass", wantErr: &valid.ErrValidation{}},
+		{name: "unknown token", token: unknownToken, password: "password", wantErr: authsvc.ErrInvalidResetToken},
+		{name: "expired token", token: expiredToken, password: "password", wantErr: authsvc.ErrInvalidResetToken},
+		{name: "ok", token: "token", password: "password", wantErr: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storedUser = nil
+			repo.DeleteUserClientsInvoked = false
+			repo.StoreRevocationInvoked = false
+			err := s.ResetPassword(ctx, tt.token, tt.password)
+			var validErr *valid.ErrValidation
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Errorf("ResetPassword() error = %v, want nil", err)
+			case tt.wantErr != nil && errors.As(tt.wantErr, &validErr) && !errors.As(err, &validErr):
+				t.Errorf("ResetPassword() error = %v, want validation error", err)
+			case tt.wantErr != nil && !errors.As(tt.wantErr, &validErr) && !errors.Is(err, tt.wantErr):
+				t.Errorf("ResetPassword() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if storedUser == nil || !storedUser.IsCorrectPassword(tt.password) {
+				t.Errorf("ResetPassword() didn't store the new password")
+			}
+			if !repo.DeleteUserClientsInvoked || !repo.StoreRevocationInvoked {
+				t.Errorf("ResetPassword() didn't log the user out from their clients")
+			}
+		})
+	}
+}
+
+func Test_service_ChangeOwnPassword(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const (
+		clientID       = "clientID"
+		otherClientID  = "otherClientID"
+		inactiveUserID = "inactive"
+		oldPassword    = "oldpassword"
+		userID         = "userID"
+	)
+	repo := &mock.UsersRepo{}
+	repo.UserByIDFn = func(ctx context.Context, id string) (*authsvc.User, error) {
+		u := &authsvc.User{ID: id, Active: id != inactiveUserID}
+		if err := u.ChangePassword(oldPassword); err != nil {
+			return nil, err
+		}
+		return u, nil
+	}
+	var storedUser *authsvc.User
+	repo.StoreUserFn = func(ctx context.Context, user *authsvc.User) error {
+		storedUser = user
+		return nil
+	}
+	var keptClients []string
+	repo.DeleteUserClientsFn = func(ctx context.Context, id string, exceptClientIDs ...string) ([]string, error) {
+		keptClients = exceptClientIDs
+		return []string{otherClientID}, nil
+	}
+	var revocations []authsvc.Revocation
+	repo.StoreRevocationFn = func(ctx context.Context, rev authsvc.Revocation) error {
+		revocations = append(revocations, rev)
+		return nil
+	}
+	s := users.NewService(repo, &mock.Mailer{})
+	type args struct {
+		userID             string
+		oldPassword        string
+		newPassword        string
+		logoutOtherClients bool
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     error
+		wantLogout  bool
+		wantInvalid bool
+	}{
+		{
+			name:        "no user id",
+			args:        args{userID: "", oldPassword: oldPassword, newPassword: "newpassword"},
+			wantInvalid: true,
+		},
+		{
+			name:        "no old password",
+			args:        args{userID: userID, oldPassword: "", newPassword: "newpassword"},
+			wantInvalid: true,
+		},
+		{
+			name:        "short new password",
+			args:        args{userID: userID, oldPassword: oldPassword, newPassword: "new"},
+			wantInvalid: true,
+		},
+		{
+			name:    "inactive user",
+			args:    args{userID: inactiveUserID, oldPassword: oldPassword, newPassword: "newpassword"},
+			wantErr: authsvc.ErrInactiveUser,
+		},
+		{
+			name:    "wrong old password",
+			args:    args{userID: userID, oldPassword: "wrongpassword", newPassword: "newpassword"},
+			wantErr: authsvc.ErrInvalidPassword,
+		},
+		{
+			name: "ok",
+			args: args{userID: userID, oldPassword: oldPassword, newPassword: "newpassword"},
+		},
+		{
+			name: "ok with logout",
+			args: args{userID: userID, oldPassword: oldPassword, newPassword: "newpassword",
+				logoutOtherClients: true},
+			wantLogout: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storedUser, keptClients, revocations = nil, nil, nil
+			repo.DeleteUserClientsInvoked = false
+			err := s.ChangeOwnPassword(ctx, tt.args.userID, clientID, tt.args.oldPassword, tt.args.newPassword,
+				tt.args.logoutOtherClients)
+			var validErr *valid.ErrValidation
+			if tt.wantInvalid != errors.As(err, &validErr) {
+				t.Errorf("ChangeOwnPassword() error = %v, wantInvalid %v", err, tt.wantInvalid)
+			}
+			if tt.wantInvalid {
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ChangeOwnPassword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if storedUser == nil || !storedUser.IsCorrectPassword(tt.args.newPassword) {
+				t.Errorf("ChangeOwnPassword() didn't store the new password")
+			}
+			if repo.DeleteUserClientsInvoked != tt.wantLogout {
+				t.Errorf("ChangeOwnPassword() logged out = %v, wantLogout %v", repo.DeleteUserClientsInvoked,
+					tt.wantLogout)
+			}
+			if tt.wantLogout && (len(keptClients) != 1 || keptClients[0] != clientID) {
+				t.Errorf("ChangeOwnPassword() kept the clients %v, want [%s]", keptClients, clientID)
+			}
+			// only the other clients are revoked, the current one stays logged in
+			if tt.wantLogout && (len(revocations) != 1 || revocations[0].Kind != authsvc.ClientRevocation ||
+				revocations[0].SubjectID != otherClientID) {
+				t.Errorf("ChangeOwnPassword() revocations = %v, want the other client revoked", revocations)
+			}
+			if !tt.wantLogout && len(revocations) > 0 {
+				t.Errorf("ChangeOwnPassword() revocations = %v, want none", revocations)
+			}
+		})
+	}
+}
+
+func Test_service_UpdateProfile(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const inactiveUserID = "inactive"
+	repo := &mock.UsersRepo{}
+	repo.UserByIDFn = func(ctx context.Context, id string) (*authsvc.User, error) {
+		return &authsvc.User{ID: id, Active: id != inactiveUserID, FirstName: "old", LastName: "old", Version: 2}, nil
+	}
+	repo.StoreUserFn = func(ctx context.Context, user *authsvc.User) error {
+		return nil
+	}
+	s := users.NewService(repo, &mock.Mailer{})
+	longName := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	tests := []struct {
+		name      string
+		userID    string
+		firstName string
+		lastName  string
+		version   int
+		wantErr   bool
+	}{
+		{name: "no user id", userID: "", firstName: "fn", lastName: "ln", wantErr: true},
+		{name: "no first name", userID: "id", firstName: "", lastName: "ln", wantErr: true},
+		{name: "long last name", userID: "id", firstName: "fn", lastName: longName, wantErr: true},
+		{name: "inactive user", userID: inactiveUserID, firstName: "fn", lastName: "ln", wantErr: true},
+		{name: "changed in the meantime", userID: "id", firstName: "fn", lastName: "ln", version: 1, wantErr: true},
+		{name: "same version", userID: "id", firstName: "fn", lastName: "ln", version: 2, wantErr: false},
+		{name: "ok", userID: "id", firstName: "fn", lastName: "ln", wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.UpdateProfile(ctx, tt.userID, tt.firstName, tt.lastName, tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateProfile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (got.FirstName != tt.firstName || got.LastName != tt.lastName) {
+				t.Errorf("UpdateProfile() got = %v, want the names changed", got)
+			}
+		})
+	}
+}
+
+func Test_service_SetUserClasses(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const repoError = "repo error"
+	repo := &mock.UsersRepo{}
+	var stored []authsvc.Class
+	repo.SetUserClassesFn = func(ctx context.Context, userID string, classes []authsvc.Class) error {
+		if userID == repoError {
+			return errors.New("error")
+		}
+		stored = classes
+		return nil
+	}
+	repo.DeleteUserClientsFn = func(ctx context.Context, userID string, exceptClientIDs ...string) ([]string, error) {
+		return nil, nil
+	}
+	var revocation authsvc.Revocation
+	repo.StoreRevocationFn = func(ctx context.Context, rev authsvc.Revocation) error {
+		revocation = rev
+		return nil
+	}
+	s := users.NewService(repo, &mock.Mailer{})
+	type args struct {
+		id      string
+		classes []authsvc.Class
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []authsvc.Class
+		wantErr bool
+	}{
+		{
+			name:    "no id",
+			args:    args{id: "", classes: []authsvc.Class{{Letter: "b", YearFormed: 2018}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid class",
+			args:    args{id: "id", classes: []authsvc.Class{{Letter: "bb", YearFormed: 2018}}},
+			wantErr: true,
+		},
+		{
+			name:    "repo error",
+			args:    args{id: repoError, classes: []authsvc.Class{{Letter: "b", YearFormed: 2018}}},
+			wantErr: true,
+		},
+		{
+			name: "normalized and deduplicated",
+			args: args{id: "id", classes: []authsvc.Class{
+				{Letter: "B", YearFormed: 2018},
+				{Letter: "b", YearFormed: 2018},
+				{Letter: "a", YearFormed: 2019},
+			}},
+			want:    []authsvc.Class{{Letter: "b", YearFormed: 2018}, {Letter: "a", YearFormed: 2019}},
+			wantErr: false,
+		},
+		{
+			name:    "no classes",
+			args:    args{id: "id", classes: nil},
+			want:    []authsvc.Class{},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revocation = authsvc.Revocation{}
+			err := s.SetUserClasses(ctx, tt.args.id, tt.args.classes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetUserClasses() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(stored, tt.want) {
+				t.Errorf("SetUserClasses() stored = %v, want %v", stored, tt.want)
+			}
+			if revocation.Kind != authsvc.UserRevocation || revocation.SubjectID != tt.args.id {
+				t.Errorf("SetUserClasses() revocation = %v, want the user revoked", revocation)
+			}
+		})
+	}
+}
+
+func Test_service_UserClasses(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const unknownUser = "unknown"
+	classes := []authsvc.Class{{Letter: "b", YearFormed: 2018}}
+	repo := &mock.UsersRepo{}
+	repo.UserByIDFn = func(ctx context.Context, id string) (*authsvc.User, error) {
+		if id == unknownUser {
+			return nil, authsvc.ErrUnknownUser
+		}
+		return &authsvc.User{ID: id}, nil
+	}
+	repo.UserClassesFn = func(ctx context.Context, userID string) ([]authsvc.Class, error) {
+		return classes, nil
+	}
+	s := users.NewService(repo, &mock.Mailer{})
+	tests := []struct {
+		name    string
+		id      string
+		want    []authsvc.Class
+		wantErr bool
+	}{
+		{
+			name:    "no id",
+			id:      "",
+			wantErr: true,
+		},
+		{
+			name:    "unknown user",
+			id:      unknownUser,
+			wantErr: true,
+		},
+		{
+			name:    "ok",
+			id:      "id",
+			want:    classes,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.UserClasses(ctx, tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UserClasses() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UserClasses() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_service_SetUserPupils(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const repoError = "repo error"
+	repo := &mock.UsersRepo{}
+	var stored []string
+	repo.SetUserPupilsFn = func(ctx context.Context, userID string, pupilIDs []string) error {
+		if userID == repoError {
+			return errors.New("error")
+		}
+		stored = pupilIDs
+		return nil
+	}
+	repo.DeleteUserClientsFn = func(ctx context.Context, userID string, exceptClientIDs ...string) ([]string, error) {
+		return nil, nil
+	}
+	var revocation authsvc.Revocation
+	repo.StoreRevocationFn = func(ctx context.Context, rev authsvc.Revocation) error {
+		revocation = rev
+		return nil
+	}
+	s := users.NewService(repo, &mock.Mailer{})
+	type args struct {
+		id       string
+		pupilIDs []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "no id",
+			args:    args{id: "", pupilIDs: []string{"pupil"}},
+			wantErr: true,
+		},
+		{
+			name:    "empty pupil id",
+			args:    args{id: "id", pupilIDs: []string{"pupil", ""}},
+			wantErr: true,
+		},
+		{
+			name:    "too long pupil id",
+			args:    args{id: "id", pupilIDs: []string{strings.Repeat("p", 51)}},
+			wantErr: true,
+		},
+		{
+			name:    "repo error",
+			args:    args{id: repoError, pupilIDs: []string{"pupil"}},
+			wantErr: true,
+		},
+		{
+			name:    "deduplicated",
+			args:    args{id: "id", pupilIDs: []string{"pupil1", "pupil2", "pupil1"}},
+			want:    []string{"pupil1", "pupil2"},
+			wantErr: false,
+		},
+		{
+			name:    "no pupils",
+			args:    args{id: "id", pupilIDs: nil},
+			want:    []string{},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revocation = authsvc.Revocation{}
+			err := s.SetUserPupils(ctx, tt.args.id, tt.args.pupilIDs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetUserPupils() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(stored, tt.want) {
+				t.Errorf("SetUserPupils() stored = %v, want %v", stored, tt.want)
+			}
+			if revocation.Kind != authsvc.UserRevocation || revocation.SubjectID != tt.args.id {
+				t.Errorf("SetUserPupils() revocation = %v, want the user revoked", revocation)
+			}
+		})
+	}
+}
+
+func Test_service_UserPupils(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const unknownUser = "unknown"
+	pupilIDs := []string{"pupil"}
+	repo := &mock.UsersRepo{}
+	repo.UserByIDFn = func(ctx context.Context, id string) (*authsvc.User, error) {
+		if id == unknownUser {
+			return nil, authsvc.ErrUnknownUser
+		}
+		return &authsvc.User{ID: id}, nil
+	}
+	repo.UserPupilsFn = func(ctx context.Context, userID string) ([]string, error) {
+		return pupilIDs, nil
+	}
+	s := users.NewService(repo, &mock.Mailer{})
+	tests := []struct {
+		name    string
+		id      string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "no id",
+			id:      "",
+			wantErr: true,
+		},
+		{
+			name:    "unknown user",
+			id:      unknownUser,
+			wantErr: true,
+		},
+		{
+			name:    "ok",
+			id:      "id",
+			want:    pupilIDs,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.UserPupils(ctx, tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UserPupils() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UserPupils() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
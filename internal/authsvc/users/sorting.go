@@ -9,3 +9,18 @@ const (
 	EmailDes
 	Unspecified
 )
+
+// String returns the name of the sorting. The page tokens are made for the list sorted by the sorting of that name
+func (s Sorting) String() string {
+	switch s {
+	case NameAsc:
+		return "name_asc"
+	case NameDes:
+		return "name_desc"
+	case EmailAsc:
+		return "email_asc"
+	case EmailDes:
+		return "email_desc"
+	}
+	return "unspecified"
+}
@@ -9,36 +9,50 @@ import (
 
 	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/sorting"
+	"github.com/shanvl/garbage/pkg/paging"
 	"github.com/shanvl/garbage/pkg/valid"
 )
 
 // Service is an interface providing methods for obtaining aggregated info on how classes and pupils performed at
 // events, with various filters applied
 type Service interface {
-	// Classes returns a list of sorted classes, each of which has a list of events that passed the given filters
-	Classes(ctx context.Context, filters ClassFilters, classesSorting, eventsSorting sorting.By, amount,
-		skip int) (classes []*Class, total int, err error)
-	// Events returns a list of sorted events that passed the provided filters
-	Events(ctx context.Context, filters EventFilters, sortBy sorting.By, amount, skip int) (events []*Event,
-		total int, err error)
-	// Pupils returns a list of sorted classes, each of which has a list of events that passed the given filters
-	Pupils(ctx context.Context, filters PupilFilters, pupilsSorting, eventsSorting sorting.By, amount,
-		skip int) (pupils []*Pupil, total int, err error)
-	// PupilByID returns a pupil with the given ID and a list of events they has attended
+	// Classes returns a page of sorted classes, each of which has a list of events that passed the given filters.
+	// The token of the next page is empty if the page is the last one
+	Classes(ctx context.Context, filters ClassFilters, classesSorting, eventsSorting sorting.By,
+		page paging.Page) (classes []*Class, total int, nextToken string, err error)
+	// Events returns a page of sorted events that passed the provided filters
+	Events(ctx context.Context, filters EventFilters, sortBy sorting.By, page paging.Page) (events []*Event,
+		total int, nextToken string, err error)
+	// Pupils returns a page of sorted pupils, each of which has a list of events that passed the given filters.
+	// Only the pupils in the scope of the user are returned
+	Pupils(ctx context.Context, filters PupilFilters, pupilsSorting, eventsSorting sorting.By,
+		page paging.Page) (pupils []*Pupil, total int, nextToken string, err error)
+	// StreamPupils calls fn with each of the sorted pupils, along with a list of the events that passed the given
+	// filters, until fn returns an error. Unlike Pupils, it isn't limited by the max amount, so that all the pupils
+	// can be exported. Only the pupils in the scope of the user are passed to fn
+	StreamPupils(ctx context.Context, filters PupilFilters, pupilsSorting, eventsSorting sorting.By,
+		fn func(*Pupil) error) error
+	// PupilByID returns a pupil with the given ID and a list of events they has attended.
+	// The pupil must be in the scope of the user
 	PupilByID(ctx context.Context, id string, filters EventFilters, eventsSorting sorting.By) (*Pupil,
 		error)
 }
 
 // Repository provides methods to work with entities persistence
 type Repository interface {
-	Classes(ctx context.Context, filters ClassFilters, classesSorting, eventsSorting sorting.By, amount,
-		skip int) (classes []*Class, total int, err error)
-	Pupils(ctx context.Context, filters PupilFilters, pupilsSorting, eventsSorting sorting.By, amount,
-		skip int) (pupils []*Pupil, total int, err error)
+	Classes(ctx context.Context, filters ClassFilters, classesSorting, eventsSorting sorting.By,
+		page paging.Page) (classes []*Class, total int, nextToken string, err error)
+	Pupils(ctx context.Context, filters PupilFilters, pupilsSorting, eventsSorting sorting.By,
+		page paging.Page) (pupils []*Pupil, total int, nextToken string, err error)
+	// StreamPupils calls fn with each of the sorted pupils until fn returns an error
+	StreamPupils(ctx context.Context, filters PupilFilters, pupilsSorting, eventsSorting sorting.By,
+		fn func(*Pupil) error) error
 	PupilByID(ctx context.Context, id string, filters EventFilters, eventsSorting sorting.By) (*Pupil,
 		error)
-	Events(ctx context.Context, filters EventFilters, sortBy sorting.By, amount, skip int) (events []*Event,
-		total int, err error)
+	Events(ctx context.Context, filters EventFilters, sortBy sorting.By, page paging.Page) (events []*Event,
+		total int, nextToken string, err error)
+	// ResourceTypes returns the catalogue of the resources, which the units of the resources brought are taken from
+	ResourceTypes(ctx context.Context) ([]*eventsvc.ResourceType, error)
 }
 
 type service struct {
@@ -56,54 +70,121 @@ func NewService(repo Repository) Service {
 	return &service{repo}
 }
 
-// Classes returns a list of sorted classes, each of which has a list of events that passed the given filters
+// Classes returns a page of sorted classes, each of which has a list of events that passed the given filters
 func (s *service) Classes(ctx context.Context, filters ClassFilters, classesSorting, eventsSorting sorting.By,
-	amount, skip int) (classes []*Class, total int, err error) {
+	page paging.Page) (classes []*Class, total int, nextToken string, err error) {
 
 	// there should be no class letter at all or only one letter
 	if len(filters.Letter) > 1 {
 		err := valid.NewError("class letter", fmt.Sprintf("invalid class letter: %s", filters.Letter))
-		return nil, 0, err
+		return nil, 0, "", err
 	}
-	// if provided values are incorrect, use default ones instead
-	amount, skip = validateAmountSkip(amount, skip)
-	// classes can be sorted by resources they brought or by name
-	if !classesSorting.IsResources() && !classesSorting.IsName() {
+	// classes can be sorted by resources they brought, their total, their stats per pupil or by name
+	if !classesSorting.ConsistsOf(sorting.By.IsResources, sorting.By.IsTotal, sorting.By.IsPerPupil,
+		sorting.By.IsParticipation, sorting.By.IsMedian, sorting.By.IsName) {
 		classesSorting = sorting.NameAsc
 	}
 	// if eventsSorting is invalid, use default one instead
 	eventsSorting = validateEventsSorting(eventsSorting)
+	// if the size or the skip are incorrect, use default ones instead. The token must belong to the classes sorting
+	if page, err = validatePage(page, classesSorting); err != nil {
+		return nil, 0, "", err
+	}
 
-	return s.repo.Classes(ctx, filters, classesSorting, eventsSorting, amount, skip)
+	classes, total, nextToken, err = s.repo.Classes(ctx, filters, classesSorting, eventsSorting, page)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	units, err := s.units(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	for _, class := range classes {
+		class.Units = units.Of(class.ResourcesBrought)
+		setEventUnits(class.Events, units)
+	}
+	return classes, total, nextToken, nil
 }
 
-// Events returns a list of sorted events that passed the provided filters
-func (s *service) Events(ctx context.Context, filters EventFilters, sortBy sorting.By, amount,
-	skip int) (events []*Event, total int, err error) {
+// Events returns a page of sorted events that passed the provided filters
+func (s *service) Events(ctx context.Context, filters EventFilters, sortBy sorting.By,
+	page paging.Page) (events []*Event, total int, nextToken string, err error) {
 
-	// if provided values are incorrect, use default ones instead
-	amount, skip = validateAmountSkip(amount, skip)
 	// if eventsSorting is invalid, use default one instead
 	sortBy = validateEventsSorting(sortBy)
+	// if the size or the skip are incorrect, use default ones instead. The token must belong to the sorting
+	if page, err = validatePage(page, sortBy); err != nil {
+		return nil, 0, "", err
+	}
 
-	return s.repo.Events(ctx, filters, sortBy, amount, skip)
+	events, total, nextToken, err = s.repo.Events(ctx, filters, sortBy, page)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	units, err := s.units(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	setEventUnits(events, units)
+	return events, total, nextToken, nil
 }
 
-// Pupils returns a list of sorted classes, each of which has a list of events that passed the given filters
-func (s *service) Pupils(ctx context.Context, filters PupilFilters, pupilsSorting, eventsSorting sorting.By, amount,
-	skip int) (pupils []*Pupil, total int, err error) {
+// Pupils returns a page of sorted pupils, each of which has a list of events that passed the given filters
+func (s *service) Pupils(ctx context.Context, filters PupilFilters, pupilsSorting, eventsSorting sorting.By,
+	page paging.Page) (pupils []*Pupil, total int, nextToken string, err error) {
 
-	// if provided values are incorrect, use default ones instead
-	amount, skip = validateAmountSkip(amount, skip)
+	// pupils can be sorted by resources they brought, their total or by name
+	if !pupilsSorting.ConsistsOf(sorting.By.IsName, sorting.By.IsResources, sorting.By.IsTotal) {
+		pupilsSorting = sorting.NameAsc
+	}
+	// if eventsSorting is invalid, use default one instead
+	eventsSorting = validateEventsSorting(eventsSorting)
+	// if the size or the skip are incorrect, use default ones instead. The token must belong to the pupils sorting
+	if page, err = validatePage(page, pupilsSorting); err != nil {
+		return nil, 0, "", err
+	}
+	// the scope always comes from the user, so that it can't be widened by the caller
+	filters.Scope = eventsvc.ScopeFromContext(ctx)
 
-	// pupils can be sorted by resources they brought or by name
-	if !pupilsSorting.IsName() && !pupilsSorting.IsResources() {
+	pupils, total, nextToken, err = s.repo.Pupils(ctx, filters, pupilsSorting, eventsSorting, page)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	units, err := s.units(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	for _, pupil := range pupils {
+		pupil.Units = units.Of(pupil.ResourcesBrought)
+		setEventUnits(pupil.Events, units)
+	}
+	return pupils, total, nextToken, nil
+}
+
+// StreamPupils calls fn with each of the sorted pupils, each of which has a list of events that passed the given
+// filters, until fn returns an error
+func (s *service) StreamPupils(ctx context.Context, filters PupilFilters, pupilsSorting, eventsSorting sorting.By,
+	fn func(*Pupil) error) error {
+
+	// pupils can be sorted by resources they brought, their total or by name
+	if !pupilsSorting.ConsistsOf(sorting.By.IsName, sorting.By.IsResources, sorting.By.IsTotal) {
 		pupilsSorting = sorting.NameAsc
 	}
 	// if eventsSorting is invalid, use default one instead
 	eventsSorting = validateEventsSorting(eventsSorting)
+	// the scope always comes from the user, so that it can't be widened by the caller
+	filters.Scope = eventsvc.ScopeFromContext(ctx)
 
-	return s.repo.Pupils(ctx, filters, pupilsSorting, eventsSorting, amount, skip)
+	// the units are taken once for all the pupils
+	units, err := s.units(ctx)
+	if err != nil {
+		return err
+	}
+	return s.repo.StreamPupils(ctx, filters, pupilsSorting, eventsSorting, func(pupil *Pupil) error {
+		pupil.Units = units.Of(pupil.ResourcesBrought)
+		setEventUnits(pupil.Events, units)
+		return fn(pupil)
+	})
 }
 
 // PupilByID returns a pupil with the given ID and a list of events they has attended
@@ -120,26 +201,54 @@ func (s *service) PupilByID(ctx context.Context, id string, filters EventFilters
 	// if eventsSorting is invalid, use default one instead
 	eventsSorting = validateEventsSorting(eventsSorting)
 
-	return s.repo.PupilByID(ctx, id, filters, eventsSorting)
+	pupil, err := s.repo.PupilByID(ctx, id, filters, eventsSorting)
+	if err != nil {
+		return nil, err
+	}
+	// a restricted user, e.g. a parent, can see their own pupils only
+	if !eventsvc.ScopeFromContext(ctx).AllowsPupil(pupil.ID, pupil.Class) {
+		return nil, eventsvc.ErrOutOfScope
+	}
+	units, err := s.units(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pupil.Units = units.Of(pupil.ResourcesBrought)
+	setEventUnits(pupil.Events, units)
+	return pupil, nil
+}
+
+// units returns the units the resources of the catalogue are measured in
+func (s *service) units(ctx context.Context) (eventsvc.UnitMap, error) {
+	catalogue, err := s.repo.ResourceTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return eventsvc.NewUnitMap(catalogue), nil
 }
 
-// if the events sorting passed is not set to resources, name or date, sets it to DateDes
+// setEventUnits sets the units of the resources brought to each of the events
+func setEventUnits(events []*Event, units eventsvc.UnitMap) {
+	for _, event := range events {
+		event.Units = units.Of(event.ResourcesBrought)
+	}
+}
+
+// if the events sorting passed is not set to resources, their total, name or date, sets it to DateDes
 func validateEventsSorting(s sorting.By) sorting.By {
-	if !s.IsResources() && !s.IsName() && !s.IsDate() {
+	if !s.ConsistsOf(sorting.By.IsResources, sorting.By.IsTotal, sorting.By.IsName, sorting.By.IsDate) {
 		s = sorting.DateDes
 	}
 	return s
 }
 
-// ensures that amount and skip are valid
-func validateAmountSkip(a, s int) (int, int) {
-	if a <= 0 || a > MaxAmount {
-		a = DefaultAmount
-	}
-	if s < 0 {
-		s = DefaultSkip
+// ensures that the size and the skip of the page are valid and that its token, if any, was made for the sorting
+func validatePage(page paging.Page, sortBy sorting.By) (paging.Page, error) {
+	page = page.Normalize(DefaultAmount, MaxAmount)
+	if _, err := page.Cursor(string(sortBy)); err != nil {
+		return page, valid.NewError("pageToken", err.Error())
 	}
-	return a, s
+	return page, nil
 }
 
 // Class is a model of the class, adapted for this use case
@@ -147,6 +256,10 @@ type Class struct {
 	eventsvc.Class
 	// all the resources the class brought to the events
 	ResourcesBrought eventsvc.ResourceMap
+	// stats of the resources brought to the events per pupil
+	Stats eventsvc.ClassStats
+	// units the resources brought are measured in
+	Units eventsvc.UnitMap
 	// list of events with resources brought by the class to each of them
 	Events []*Event
 }
@@ -156,6 +269,8 @@ type Event struct {
 	eventsvc.Event
 	// resources collected at this event OR resources brought by the parent entity to this event
 	ResourcesBrought eventsvc.ResourceMap
+	// units the resources brought are measured in
+	Units eventsvc.UnitMap
 }
 
 // Pupil is a model of the pupil, adapted for this use case
@@ -164,6 +279,8 @@ type Pupil struct {
 	eventsvc.Class
 	// all the resources the pupil brought to the events
 	ResourcesBrought eventsvc.ResourceMap
+	// units the resources brought are measured in
+	Units eventsvc.UnitMap
 	// list of events with resources brought by the pupil to each of them
 	Events []*Event
 }
@@ -193,4 +310,6 @@ type EventFilters struct {
 type PupilFilters struct {
 	EventFilters
 	NameAndClass string
+	// Scope restricts the pupils to the classes or the pupils of the user. It's set by the service
+	Scope eventsvc.Scope
 }
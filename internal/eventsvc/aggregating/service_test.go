@@ -5,10 +5,14 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/aggregating"
 	"github.com/shanvl/garbage/internal/eventsvc/mock"
 	"github.com/shanvl/garbage/internal/eventsvc/sorting"
+	"github.com/shanvl/garbage/pkg/paging"
+	"github.com/shanvl/garbage/pkg/valid"
 )
 
 func Test_service_Classes(t *testing.T) {
@@ -21,13 +25,14 @@ func Test_service_Classes(t *testing.T) {
 	ctx := context.Background()
 
 	var repo mock.AggregatingRepository
+	repo.ResourceTypesFn = catalogue
 	repo.ClassesFn = func(ctx context.Context, filters aggregating.ClassFilters,
-		classesSorting, eventsSorting sorting.By, amount, skip int) ([]*aggregating.Class, int, error) {
+		classesSorting, eventsSorting sorting.By, page paging.Page) ([]*aggregating.Class, int, string, error) {
 
 		if filters.Letter == repoError {
-			return nil, 0, errors.New("some error")
+			return nil, 0, "", errors.New("some error")
 		}
-		return classes, totalClasses, nil
+		return classes, totalClasses, "", nil
 	}
 	s := aggregating.NewService(&repo)
 
@@ -125,8 +130,8 @@ func Test_service_Classes(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotClasses, gotTotal, err := s.Classes(ctx, tt.args.filters, tt.args.classesSorting,
-				tt.args.eventsSorting, tt.args.amount, tt.args.skip)
+			gotClasses, gotTotal, _, err := s.Classes(ctx, tt.args.filters, tt.args.classesSorting,
+				tt.args.eventsSorting, paging.Page{Size: tt.args.amount, Skip: tt.args.skip})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Classes() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -154,14 +159,15 @@ func Test_service_Events(t *testing.T) {
 	ctx := context.Background()
 
 	var repository mock.AggregatingRepository
-	repository.EventsFn = func(ctx context.Context, filters aggregating.EventFilters, sortBy sorting.By, amount,
-		skip int) ([]*aggregating.Event, int, error) {
+	repository.ResourceTypesFn = catalogue
+	repository.EventsFn = func(ctx context.Context, filters aggregating.EventFilters, sortBy sorting.By,
+		page paging.Page) ([]*aggregating.Event, int, string, error) {
 
 		if filters.Name == repoError {
-			return nil, 0, errors.New("some error")
+			return nil, 0, "", errors.New("some error")
 		}
 
-		return events, totalEvents, nil
+		return events, totalEvents, "", nil
 	}
 	s := aggregating.NewService(&repository)
 
@@ -234,8 +240,8 @@ func Test_service_Events(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotEvents, gotTotal, err := s.Events(tt.args.ctx, tt.args.filters, tt.args.sortBy, tt.args.amount,
-				tt.args.skip)
+			gotEvents, gotTotal, _, err := s.Events(tt.args.ctx, tt.args.filters, tt.args.sortBy,
+				paging.Page{Size: tt.args.amount, Skip: tt.args.skip})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Events() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -260,13 +266,14 @@ func Test_service_Pupils(t *testing.T) {
 	ctx := context.Background()
 
 	var repo mock.AggregatingRepository
+	repo.ResourceTypesFn = catalogue
 	repo.PupilsFn = func(ctx context.Context, filters aggregating.PupilFilters,
-		pupilsSorting, eventsSorting sorting.By, amount, skip int) ([]*aggregating.Pupil, int, error) {
+		pupilsSorting, eventsSorting sorting.By, page paging.Page) ([]*aggregating.Pupil, int, string, error) {
 
 		if filters.NameAndClass == repoError {
-			return nil, 0, errors.New("some error")
+			return nil, 0, "", errors.New("some error")
 		}
-		return classes, totalPupils, nil
+		return classes, totalPupils, "", nil
 	}
 	s := aggregating.NewService(&repo)
 
@@ -351,8 +358,8 @@ func Test_service_Pupils(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotPupils, gotTotal, err := s.Pupils(ctx, tt.args.filters, tt.args.pupilsSorting,
-				tt.args.eventsSorting, tt.args.amount, tt.args.skip)
+			gotPupils, gotTotal, _, err := s.Pupils(ctx, tt.args.filters, tt.args.pupilsSorting,
+				tt.args.eventsSorting, paging.Page{Size: tt.args.amount, Skip: tt.args.skip})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Pupils() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -367,6 +374,60 @@ func Test_service_Pupils(t *testing.T) {
 	}
 }
 
+func Test_service_StreamPupils(t *testing.T) {
+	t.Parallel()
+	ctx := eventsvc.ContextWithScope(context.Background(), eventsvc.PupilScope("linked"))
+	errStop := errors.New("stop")
+
+	var repo mock.AggregatingRepository
+	repo.ResourceTypesFn = catalogue
+	repo.StreamPupilsFn = func(ctx context.Context, filters aggregating.PupilFilters, pupilsSorting,
+		eventsSorting sorting.By, fn func(*aggregating.Pupil) error) error {
+
+		if pupilsSorting != sorting.NameAsc || eventsSorting != sorting.DateDes {
+			t.Errorf("StreamPupils() sortings = %v, %v, want the default ones", pupilsSorting, eventsSorting)
+		}
+		if !reflect.DeepEqual(filters.Scope, eventsvc.ScopeFromContext(ctx)) {
+			t.Errorf("StreamPupils() filters.Scope = %v, want the scope from ctx", filters.Scope)
+		}
+		for _, id := range []string{"1", "2", "3"} {
+			p := &aggregating.Pupil{
+				Pupil:            eventsvc.Pupil{ID: id},
+				ResourcesBrought: eventsvc.ResourceMap{eventsvc.Paper: 1},
+				Events:           []*aggregating.Event{{ResourcesBrought: eventsvc.ResourceMap{eventsvc.Gadgets: 2}}},
+			}
+			if err := fn(p); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	s := aggregating.NewService(&repo)
+
+	var got []string
+	err := s.StreamPupils(ctx, aggregating.PupilFilters{}, sorting.PerPupilDes, sorting.PerPupilDes,
+		func(p *aggregating.Pupil) error {
+			if !reflect.DeepEqual(p.Units, eventsvc.UnitMap{eventsvc.Paper: eventsvc.Kilograms}) {
+				t.Errorf("StreamPupils() Units = %v", p.Units)
+			}
+			if !reflect.DeepEqual(p.Events[0].Units, eventsvc.UnitMap{eventsvc.Gadgets: eventsvc.Pieces}) {
+				t.Errorf("StreamPupils() Events[0].Units = %v", p.Events[0].Units)
+			}
+			got = append(got, p.ID)
+			// stop after the second pupil
+			if len(got) == 2 {
+				return errStop
+			}
+			return nil
+		})
+	if !errors.Is(err, errStop) {
+		t.Errorf("StreamPupils() error = %v, want the error of fn", err)
+	}
+	if !reflect.DeepEqual(got, []string{"1", "2"}) {
+		t.Errorf("StreamPupils() pupils = %v, want [1 2]", got)
+	}
+}
+
 func Test_service_PupilByID(t *testing.T) {
 	t.Parallel()
 	const (
@@ -376,6 +437,7 @@ func Test_service_PupilByID(t *testing.T) {
 	ctx := context.Background()
 
 	var repo mock.AggregatingRepository
+	repo.ResourceTypesFn = catalogue
 	repo.PupilByIDFn = func(ctx context.Context, id string, filters aggregating.EventFilters,
 		eventsSorting sorting.By) (*aggregating.Pupil, error) {
 
@@ -441,3 +503,264 @@ func Test_service_PupilByID(t *testing.T) {
 		})
 	}
 }
+
+func Test_service_Scope(t *testing.T) {
+	t.Parallel()
+	pupil := &aggregating.Pupil{
+		Pupil: eventsvc.Pupil{ID: "linked"},
+		Class: eventsvc.Class{Letter: "b", DateFormed: time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	var repo mock.AggregatingRepository
+	repo.ResourceTypesFn = catalogue
+	repo.PupilByIDFn = func(ctx context.Context, id string, filters aggregating.EventFilters,
+		eventsSorting sorting.By) (*aggregating.Pupil, error) {
+		return &aggregating.Pupil{Pupil: eventsvc.Pupil{ID: id}, Class: pupil.Class}, nil
+	}
+	repo.PupilsFn = func(ctx context.Context, filters aggregating.PupilFilters, pupilsSorting,
+		eventsSorting sorting.By, page paging.Page) (pupils []*aggregating.Pupil, total int, nextToken string,
+		err error) {
+		if !reflect.DeepEqual(filters.Scope, eventsvc.ScopeFromContext(ctx)) {
+			t.Errorf("Pupils() filters.Scope = %v, want the scope from ctx", filters.Scope)
+		}
+		return nil, 0, "", nil
+	}
+	s := aggregating.NewService(&repo)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		pupilID string
+		wantErr error
+	}{
+		{
+			name:    "unrestricted",
+			ctx:     context.Background(),
+			pupilID: "another",
+			wantErr: nil,
+		},
+		{
+			name:    "linked pupil",
+			ctx:     eventsvc.ContextWithScope(context.Background(), eventsvc.PupilScope("linked")),
+			pupilID: "linked",
+			wantErr: nil,
+		},
+		{
+			name:    "pupil isn't linked",
+			ctx:     eventsvc.ContextWithScope(context.Background(), eventsvc.PupilScope("linked")),
+			pupilID: "another",
+			wantErr: eventsvc.ErrOutOfScope,
+		},
+		{
+			name:    "pupil of the assigned class",
+			ctx:     eventsvc.ContextWithScope(context.Background(), eventsvc.RestrictedScope(pupil.Class)),
+			pupilID: "another",
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.PupilByID(tt.ctx, tt.pupilID, aggregating.EventFilters{}, sorting.DateDes)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("PupilByID() error = %v, want %v", err, tt.wantErr)
+			}
+			if _, _, _, err := s.Pupils(tt.ctx, aggregating.PupilFilters{}, sorting.NameAsc, sorting.DateDes,
+				paging.Page{Size: 10}); err != nil {
+				t.Errorf("Pupils() error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_service_Units(t *testing.T) {
+	t.Parallel()
+	pupil := &aggregating.Pupil{
+		ResourcesBrought: eventsvc.ResourceMap{eventsvc.Paper: 1.5, eventsvc.Gadgets: 2},
+		Events: []*aggregating.Event{
+			{ResourcesBrought: eventsvc.ResourceMap{eventsvc.Gadgets: 2}},
+		},
+	}
+	ctx := context.Background()
+
+	var repo mock.AggregatingRepository
+	repo.ResourceTypesFn = catalogue
+	repo.PupilByIDFn = func(ctx context.Context, id string, filters aggregating.EventFilters,
+		eventsSorting sorting.By) (*aggregating.Pupil, error) {
+		return pupil, nil
+	}
+	s := aggregating.NewService(&repo)
+
+	got, err := s.PupilByID(ctx, "id", aggregating.EventFilters{}, sorting.DateDes)
+	if err != nil {
+		t.Fatalf("PupilByID() error = %v", err)
+	}
+	wantUnits := eventsvc.UnitMap{eventsvc.Paper: eventsvc.Kilograms, eventsvc.Gadgets: eventsvc.Pieces}
+	if !reflect.DeepEqual(got.Units, wantUnits) {
+		t.Errorf("PupilByID() Units = %v, want %v", got.Units, wantUnits)
+	}
+	wantEventUnits := eventsvc.UnitMap{eventsvc.Gadgets: eventsvc.Pieces}
+	if !reflect.DeepEqual(got.Events[0].Units, wantEventUnits) {
+		t.Errorf("PupilByID() Events[0].Units = %v, want %v", got.Events[0].Units, wantEventUnits)
+	}
+}
+
+func Test_service_Sorting(t *testing.T) {
+	t.Parallel()
+	var (
+		repo                         mock.AggregatingRepository
+		gotClassesSorting, gotEvents sorting.By
+		gotPupilsSorting             sorting.By
+	)
+	repo.ResourceTypesFn = catalogue
+	repo.ClassesFn = func(ctx context.Context, filters aggregating.ClassFilters, classesSorting,
+		eventsSorting sorting.By, page paging.Page) ([]*aggregating.Class, int, string, error) {
+
+		gotClassesSorting, gotEvents = classesSorting, eventsSorting
+		return nil, 0, "", nil
+	}
+	repo.PupilsFn = func(ctx context.Context, filters aggregating.PupilFilters, pupilsSorting,
+		eventsSorting sorting.By, page paging.Page) ([]*aggregating.Pupil, int, string, error) {
+
+		gotPupilsSorting = pupilsSorting
+		return nil, 0, "", nil
+	}
+	s := aggregating.NewService(&repo)
+	tests := []struct {
+		name                                string
+		sortBy, eventsSortBy                sorting.By
+		wantClasses, wantPupils, wantEvents sorting.By
+	}{
+		{
+			name:         "total then name",
+			sortBy:       sorting.TotalDes.Then(sorting.NameAsc),
+			eventsSortBy: sorting.TotalAsc.Then(sorting.DateDes),
+			wantClasses:  sorting.TotalDes.Then(sorting.NameAsc),
+			wantPupils:   sorting.TotalDes.Then(sorting.NameAsc),
+			wantEvents:   sorting.TotalAsc.Then(sorting.DateDes),
+		},
+		{
+			name:         "per pupil is for classes only",
+			sortBy:       sorting.PerPupilDes.Then(sorting.ByResource(eventsvc.Paper)),
+			eventsSortBy: sorting.PerPupilDes,
+			wantClasses:  sorting.PerPupilDes.Then(sorting.ByResource(eventsvc.Paper)),
+			wantPupils:   sorting.NameAsc,
+			wantEvents:   sorting.DateDes,
+		},
+		{
+			name:         "invalid secondary key",
+			sortBy:       sorting.NameAsc.Then(sorting.DateAsc),
+			eventsSortBy: sorting.DateAsc.Then(sorting.DateAsc),
+			wantClasses:  sorting.NameAsc,
+			wantPupils:   sorting.NameAsc,
+			wantEvents:   sorting.DateDes,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, _, err := s.Classes(context.Background(), aggregating.ClassFilters{}, tt.sortBy, tt.eventsSortBy,
+				paging.Page{}); err != nil {
+				t.Fatalf("Classes() error = %v", err)
+			}
+			if gotClassesSorting != tt.wantClasses || gotEvents != tt.wantEvents {
+				t.Errorf("Classes() sortings = %v, %v, want %v, %v", gotClassesSorting, gotEvents, tt.wantClasses,
+					tt.wantEvents)
+			}
+			if _, _, _, err := s.Pupils(context.Background(), aggregating.PupilFilters{}, tt.sortBy, tt.eventsSortBy,
+				paging.Page{}); err != nil {
+				t.Fatalf("Pupils() error = %v", err)
+			}
+			if gotPupilsSorting != tt.wantPupils {
+				t.Errorf("Pupils() sorting = %v, want %v", gotPupilsSorting, tt.wantPupils)
+			}
+		})
+	}
+}
+
+func Test_service_Page(t *testing.T) {
+	t.Parallel()
+	var (
+		repo    mock.AggregatingRepository
+		gotPage paging.Page
+	)
+	repo.ResourceTypesFn = catalogue
+	repo.EventsFn = func(ctx context.Context, filters aggregating.EventFilters, sortBy sorting.By,
+		page paging.Page) ([]*aggregating.Event, int, string, error) {
+
+		gotPage = page
+		return nil, 0, "next", nil
+	}
+	s := aggregating.NewService(&repo)
+	tests := []struct {
+		name     string
+		sortBy   sorting.By
+		page     paging.Page
+		wantPage paging.Page
+		wantErr  bool
+	}{
+		{
+			name:     "size and skip out of range",
+			sortBy:   sorting.NameAsc,
+			page:     paging.Page{Size: aggregating.MaxAmount + 1, Skip: -1, SkipTotal: true},
+			wantPage: paging.Page{Size: aggregating.DefaultAmount, SkipTotal: true},
+		},
+		{
+			name:     "token of the sorting",
+			sortBy:   sorting.NameAsc,
+			page:     paging.Page{Size: 10, Token: testPageToken(t, sorting.NameAsc)},
+			wantPage: paging.Page{Size: 10, Token: testPageToken(t, sorting.NameAsc)},
+		},
+		{
+			name:    "token of another sorting",
+			sortBy:  sorting.NameDes,
+			page:    paging.Page{Size: 10, Token: testPageToken(t, sorting.NameAsc)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid token",
+			sortBy:  sorting.NameAsc,
+			page:    paging.Page{Size: 10, Token: "invalid"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPage = paging.Page{}
+			_, _, nextToken, err := s.Events(context.Background(), aggregating.EventFilters{}, tt.sortBy, tt.page)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Events() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.As(err, new(*valid.ErrValidation)) {
+					t.Errorf("Events() error = %v, want a validation error", err)
+				}
+				return
+			}
+			if gotPage != tt.wantPage || nextToken != "next" {
+				t.Errorf("Events() page = %+v, next token = %q, want %+v, %q", gotPage, nextToken, tt.wantPage,
+					"next")
+			}
+		})
+	}
+}
+
+// testPageToken returns the token of a page of the events sorted by the sorting
+func testPageToken(t *testing.T, sortBy sorting.By) string {
+	t.Helper()
+	c, err := paging.NewCursor(string(sortBy), []byte(`["name", "2020-01-01", "id"]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := c.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func catalogue(ctx context.Context) ([]*eventsvc.ResourceType, error) {
+	return []*eventsvc.ResourceType{
+		{Name: eventsvc.Gadgets, Unit: eventsvc.Pieces},
+		{Name: eventsvc.Paper, Unit: eventsvc.Kilograms},
+		{Name: eventsvc.Plastic, Unit: eventsvc.Kilograms},
+	}, nil
+}
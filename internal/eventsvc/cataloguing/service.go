@@ -0,0 +1,105 @@
+// Package cataloguing is responsible for the catalogue of the resources, i.e. the types of recyclables which can be
+// brought to the events
+package cataloguing
+
+import (
+	"context"
+	"math"
+
+	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/pkg/valid"
+)
+
+// Service is an interface providing methods to manage the catalogue of the resources
+type Service interface {
+	// CreateResourceType adds the resource type to the catalogue
+	CreateResourceType(ctx context.Context, name eventsvc.Resource, unit eventsvc.Unit, points float32) error
+	// DeleteResourceType removes the resource type from the catalogue. The resource type which has been allowed on
+	// the events or brought to them can't be removed
+	DeleteResourceType(ctx context.Context, name eventsvc.Resource) error
+	// ResourceTypes returns the catalogue sorted by the names of the resources
+	ResourceTypes(ctx context.Context) ([]*eventsvc.ResourceType, error)
+	// UpdateResourceType changes the unit and the points of the resource type. The unit of the resource type which
+	// has been brought to the events can't be changed
+	UpdateResourceType(ctx context.Context, name eventsvc.Resource, unit eventsvc.Unit, points float32) error
+}
+
+// Repository provides methods to work with the catalogue persistence
+type Repository interface {
+	// DeleteResourceType deletes the resource type. If there's no such resource type, eventsvc.ErrUnknownResourceType
+	// is returned. If it has been allowed on the events or brought to them, eventsvc.ErrResourceInUse is returned
+	DeleteResourceType(ctx context.Context, name eventsvc.Resource) error
+	// ResourceTypes returns all the resource types sorted by their names
+	ResourceTypes(ctx context.Context) ([]*eventsvc.ResourceType, error)
+	// StoreResourceType stores the new resource type. If the resource type with the same name already exists,
+	// eventsvc.ErrResourceExists is returned
+	StoreResourceType(ctx context.Context, resourceType *eventsvc.ResourceType) error
+	// UpdateResourceType updates the unit and the points of the resource type. If there's no such resource type,
+	// eventsvc.ErrUnknownResourceType is returned. If its unit is changed after it has been brought to the events,
+	// eventsvc.ErrResourceInUse is returned
+	UpdateResourceType(ctx context.Context, resourceType *eventsvc.ResourceType) error
+}
+
+type service struct {
+	repo Repository
+}
+
+// NewService returns an instance of Service with all its dependencies
+func NewService(repo Repository) Service {
+	return &service{repo}
+}
+
+// CreateResourceType adds the resource type to the catalogue
+func (s *service) CreateResourceType(ctx context.Context, name eventsvc.Resource, unit eventsvc.Unit,
+	points float32) error {
+
+	resourceType := &eventsvc.ResourceType{Name: name, Unit: unit, Points: points}
+	if err := validateResourceType(resourceType); err != nil {
+		return err
+	}
+	return s.repo.StoreResourceType(ctx, resourceType)
+}
+
+// DeleteResourceType removes the resource type which isn't in use from the catalogue
+func (s *service) DeleteResourceType(ctx context.Context, name eventsvc.Resource) error {
+	if len(name) == 0 {
+		return valid.NewError("name", "name must be provided")
+	}
+	return s.repo.DeleteResourceType(ctx, name)
+}
+
+// ResourceTypes returns the catalogue
+func (s *service) ResourceTypes(ctx context.Context) ([]*eventsvc.ResourceType, error) {
+	return s.repo.ResourceTypes(ctx)
+}
+
+// UpdateResourceType changes the unit and the points of the resource type
+func (s *service) UpdateResourceType(ctx context.Context, name eventsvc.Resource, unit eventsvc.Unit,
+	points float32) error {
+
+	resourceType := &eventsvc.ResourceType{Name: name, Unit: unit, Points: points}
+	if err := validateResourceType(resourceType); err != nil {
+		return err
+	}
+	return s.repo.UpdateResourceType(ctx, resourceType)
+}
+
+// validateResourceType returns a validation error if the resource type can't be stored
+func validateResourceType(resourceType *eventsvc.ResourceType) error {
+	errVld := valid.EmptyError()
+	if !resourceType.Name.IsValid() {
+		errVld.Add("name", "name must consist of up to 25 lowercase latin letters, digits and underscores, "+
+			"starting with a letter")
+	}
+	if !resourceType.Unit.IsBase() {
+		errVld.Add("unit", "unit must be either kilograms or pieces")
+	}
+	// NaN isn't less than 0, so it's checked explicitly along with the infinities
+	if p := float64(resourceType.Points); math.IsNaN(p) || math.IsInf(p, 0) || p < 0 {
+		errVld.Add("points", "points must be a finite number not less than 0")
+	}
+	if !errVld.IsEmpty() {
+		return errVld
+	}
+	return nil
+}
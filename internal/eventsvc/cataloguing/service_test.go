@@ -0,0 +1,222 @@
+package cataloguing_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/internal/eventsvc/cataloguing"
+	"github.com/shanvl/garbage/internal/eventsvc/mock"
+	"github.com/shanvl/garbage/pkg/valid"
+)
+
+func Test_service_CreateResourceType(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var repo mock.CataloguingRepository
+	repo.StoreResourceTypeFn = func(ctx context.Context, resourceType *eventsvc.ResourceType) error {
+		if resourceType.Name == eventsvc.Paper {
+			return eventsvc.ErrResourceExists
+		}
+		return nil
+	}
+	s := cataloguing.NewService(&repo)
+
+	type args struct {
+		name   eventsvc.Resource
+		unit   eventsvc.Unit
+		points float32
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantValidErr bool
+		wantErr      error
+	}{
+		{
+			name:         "no name",
+			args:         args{name: "", unit: eventsvc.Kilograms},
+			wantValidErr: true,
+		},
+		{
+			name:         "invalid name",
+			args:         args{name: "Bottle caps", unit: eventsvc.Pieces},
+			wantValidErr: true,
+		},
+		{
+			name:         "unknown unit",
+			args:         args{name: "glass", unit: 10},
+			wantValidErr: true,
+		},
+		{
+			name:         "not a base unit",
+			args:         args{name: "glass", unit: eventsvc.Grams},
+			wantValidErr: true,
+		},
+		{
+			name:         "negative points",
+			args:         args{name: "glass", unit: eventsvc.Kilograms, points: -1},
+			wantValidErr: true,
+		},
+		{
+			name:         "NaN points",
+			args:         args{name: "glass", unit: eventsvc.Kilograms, points: float32(math.NaN())},
+			wantValidErr: true,
+		},
+		{
+			name:         "infinite points",
+			args:         args{name: "glass", unit: eventsvc.Kilograms, points: float32(math.Inf(1))},
+			wantValidErr: true,
+		},
+		{
+			name:         "negative infinite points",
+			args:         args{name: "glass", unit: eventsvc.Kilograms, points: float32(math.Inf(-1))},
+			wantValidErr: true,
+		},
+		{
+			name:    "already exists",
+			args:    args{name: eventsvc.Paper, unit: eventsvc.Kilograms},
+			wantErr: eventsvc.ErrResourceExists,
+		},
+		{
+			name: "ok",
+			args: args{name: "bottle_caps", unit: eventsvc.Pieces, points: 0.5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.CreateResourceType(ctx, tt.args.name, tt.args.unit, tt.args.points)
+			var validErr *valid.ErrValidation
+			if errors.As(err, &validErr) != tt.wantValidErr {
+				t.Errorf("CreateResourceType() error = %v, wantValidErr %v", err, tt.wantValidErr)
+			}
+			if !tt.wantValidErr && !errors.Is(err, tt.wantErr) {
+				t.Errorf("CreateResourceType() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_service_DeleteResourceType(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var repo mock.CataloguingRepository
+	repo.DeleteResourceTypeFn = func(ctx context.Context, name eventsvc.Resource) error {
+		if name == eventsvc.Paper {
+			return eventsvc.ErrResourceInUse
+		}
+		return nil
+	}
+	s := cataloguing.NewService(&repo)
+
+	tests := []struct {
+		name         string
+		resource     eventsvc.Resource
+		wantValidErr bool
+		wantErr      error
+	}{
+		{
+			name:         "no name",
+			resource:     "",
+			wantValidErr: true,
+		},
+		{
+			name:     "in use",
+			resource: eventsvc.Paper,
+			wantErr:  eventsvc.ErrResourceInUse,
+		},
+		{
+			name:     "ok",
+			resource: "glass",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.DeleteResourceType(ctx, tt.resource)
+			var validErr *valid.ErrValidation
+			if errors.As(err, &validErr) != tt.wantValidErr {
+				t.Errorf("DeleteResourceType() error = %v, wantValidErr %v", err, tt.wantValidErr)
+			}
+			if !tt.wantValidErr && !errors.Is(err, tt.wantErr) {
+				t.Errorf("DeleteResourceType() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_service_UpdateResourceType(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var repo mock.CataloguingRepository
+	repo.UpdateResourceTypeFn = func(ctx context.Context, resourceType *eventsvc.ResourceType) error {
+		if resourceType.Name == "unknown" {
+			return eventsvc.ErrUnknownResourceType
+		}
+		return nil
+	}
+	s := cataloguing.NewService(&repo)
+
+	tests := []struct {
+		name         string
+		resource     eventsvc.Resource
+		points       float32
+		wantValidErr bool
+		wantErr      error
+	}{
+		{
+			name:         "negative points",
+			resource:     eventsvc.Paper,
+			points:       -0.1,
+			wantValidErr: true,
+		},
+		{
+			name:         "NaN points",
+			resource:     eventsvc.Paper,
+			points:       float32(math.NaN()),
+			wantValidErr: true,
+		},
+		{
+			name:         "infinite points",
+			resource:     eventsvc.Paper,
+			points:       float32(math.Inf(1)),
+			wantValidErr: true,
+		},
+		{
+			name:         "negative infinite points",
+			resource:     eventsvc.Paper,
+			points:       float32(math.Inf(-1)),
+			wantValidErr: true,
+		},
+		{
+			name:     "unknown resource",
+			resource: "unknown",
+			wantErr:  eventsvc.ErrUnknownResourceType,
+		},
+		{
+			name:     "ok",
+			resource: eventsvc.Paper,
+			points:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo.UpdateResourceTypeInvoked = false
+			err := s.UpdateResourceType(ctx, tt.resource, eventsvc.Kilograms, tt.points)
+			var validErr *valid.ErrValidation
+			if errors.As(err, &validErr) != tt.wantValidErr {
+				t.Errorf("UpdateResourceType() error = %v, wantValidErr %v", err, tt.wantValidErr)
+			}
+			if !tt.wantValidErr && !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateResourceType() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantValidErr && repo.UpdateResourceTypeInvoked {
+				t.Errorf("UpdateResourceType() invoked the repo with the invalid resource type")
+			}
+		})
+	}
+}
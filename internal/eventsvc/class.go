@@ -94,3 +94,43 @@ func ParseClassName(className string, date time.Time) (letter string, dateFormed
 	}
 	return letter, dateFormed, nil
 }
+
+// ClassStats describes the resources brought by a class relative to the number of its pupils, so that the classes of
+// different sizes can be compared
+type ClassStats struct {
+	// Pupils is the number of the pupils the class had on the date of the event
+	Pupils int
+	// Participants is the number of the pupils who brought any of the resources
+	Participants int
+	// Average and Median are the amounts of the resources brought per pupil. The pupils who haven't brought anything
+	// are counted too
+	Average, Median ResourceMap
+	// MedianPoints is the median of the points scored by the pupils
+	MedianPoints float32
+}
+
+// NewClassStats returns the stats of a class which has brought the resources. The average amounts are derived from
+// the resources and the number of the pupils
+func NewClassStats(brought ResourceMap, pupils, participants int, median ResourceMap, medianPoints float32) ClassStats {
+	average := make(ResourceMap, len(brought))
+	for res, amount := range brought {
+		if pupils > 0 {
+			average[res] = amount / float32(pupils)
+		}
+	}
+	return ClassStats{
+		Pupils:       pupils,
+		Participants: participants,
+		Average:      average,
+		Median:       median,
+		MedianPoints: medianPoints,
+	}
+}
+
+// ParticipationRate returns the share of the pupils who brought any of the resources, from 0 to 1
+func (s ClassStats) ParticipationRate() float32 {
+	if s.Pupils == 0 {
+		return 0
+	}
+	return float32(s.Participants) / float32(s.Pupils)
+}
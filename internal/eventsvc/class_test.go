@@ -353,3 +353,42 @@ func TestParseClassName(t *testing.T) {
 		})
 	}
 }
+
+func TestNewClassStats(t *testing.T) {
+	brought := ResourceMap{Paper: 10, Gadgets: 5}
+	median := ResourceMap{Paper: 1, Gadgets: 0}
+	tests := []struct {
+		name         string
+		pupils       int
+		participants int
+		wantAverage  ResourceMap
+		wantRate     float32
+	}{
+		{
+			name:         "ok",
+			pupils:       4,
+			participants: 3,
+			wantAverage:  ResourceMap{Paper: 2.5, Gadgets: 1.25},
+			wantRate:     0.75,
+		},
+		{
+			name:        "no pupils",
+			wantAverage: ResourceMap{},
+			wantRate:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := NewClassStats(brought, tt.pupils, tt.participants, median, 1)
+			if !reflect.DeepEqual(stats.Average, tt.wantAverage) {
+				t.Errorf("NewClassStats() average = %v, want %v", stats.Average, tt.wantAverage)
+			}
+			if !reflect.DeepEqual(stats.Median, median) || stats.MedianPoints != 1 {
+				t.Errorf("NewClassStats() median = %v, %v, want %v, 1", stats.Median, stats.MedianPoints, median)
+			}
+			if got := stats.ParticipationRate(); got != tt.wantRate {
+				t.Errorf("ParticipationRate() = %v, want %v", got, tt.wantRate)
+			}
+		})
+	}
+}
@@ -2,12 +2,67 @@ package eventsvc
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 )
 
 // ErrUnknownPupil is used when the pupil wasn't found
 var ErrUnknownEvent = errors.New("unknown event")
 
+var (
+	// ErrEventNotOpen is used when the resources of an event which isn't open are changed
+	ErrEventNotOpen = errors.New("the event isn't open")
+	// ErrEventFrozen is used when a closed or an archived event is changed
+	ErrEventFrozen = errors.New("the event is frozen")
+	// ErrInvalidStatusTransition is used when the event can't move from its status to the requested one
+	ErrInvalidStatusTransition = errors.New("invalid event status transition")
+	ErrUnknownEventStatus      = errors.New("unknown event status")
+)
+
+// EventStatus is a stage of the event's lifecycle. An event is planned when it's created. It's opened to accept the
+// resources, closed when it's over and archived when it's no longer needed. The resources of a closed event are
+// frozen, so that the reports made from them don't change
+type EventStatus int
+
+const (
+	Planned EventStatus = iota
+	Open
+	Closed
+	Archived
+)
+
+var eventStatusStringValues = [...]string{"planned", "open", "closed", "archived"}
+
+// String returns the string value of the status
+func (s EventStatus) String() string {
+	if s < 0 || int(s) >= len(eventStatusStringValues) {
+		return "unknown"
+	}
+	return eventStatusStringValues[s]
+}
+
+// CanTransitionTo reports whether the event can move from the status to the next one. The statuses can't be
+// skipped or reverted, so a closed event can't be reopened
+func (s EventStatus) CanTransitionTo(next EventStatus) bool {
+	return next == s+1 && next <= Archived
+}
+
+// IsFrozen reports whether the event with the status can't be changed anymore
+func (s EventStatus) IsFrozen() bool {
+	return s >= Closed
+}
+
+// StringToEventStatus converts the string value of the status to EventStatus
+func StringToEventStatus(s string) (EventStatus, error) {
+	for i, v := range eventStatusStringValues {
+		if v == strings.ToLower(s) {
+			return EventStatus(i), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", ErrUnknownEventStatus, s)
+}
+
 // Event is a gathering of pupils who bring in recyclables.
 // The goal of the event is to gather as many recyclable materials (resources) as possible
 // This type is often used by various use cases as a carcass for their own Event type
@@ -17,6 +72,11 @@ type Event struct {
 	Name string
 	// Recyclables permitted to be brought to this event
 	ResourcesAllowed []Resource
+	Status           EventStatus
+	// Version is incremented on every change of the event
+	Version int
+	// Multiplier multiplies the points scored for the resources brought to the event
+	Multiplier float32
 }
 
 // IsResourcesAllowed checks if a given resource is allowed on this event
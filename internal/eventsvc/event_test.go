@@ -1,6 +1,7 @@
 package eventsvc
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -42,3 +43,51 @@ func TestEvent_IsResourceAllowed(t *testing.T) {
 		})
 	}
 }
+
+func TestEventStatus_CanTransitionTo(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		from EventStatus
+		to   EventStatus
+		want bool
+	}{
+		{name: "open planned", from: Planned, to: Open, want: true},
+		{name: "close open", from: Open, to: Closed, want: true},
+		{name: "archive closed", from: Closed, to: Archived, want: true},
+		{name: "close planned", from: Planned, to: Closed, want: false},
+		{name: "reopen closed", from: Closed, to: Open, want: false},
+		{name: "same status", from: Open, to: Open, want: false},
+		{name: "past archived", from: Archived, to: Archived + 1, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+				t.Errorf("CanTransitionTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringToEventStatus(t *testing.T) {
+	t.Parallel()
+	for _, status := range []EventStatus{Planned, Open, Closed, Archived} {
+		got, err := StringToEventStatus(status.String())
+		if err != nil || got != status {
+			t.Errorf("StringToEventStatus(%q) = %v, %v, want %v", status.String(), got, err, status)
+		}
+	}
+	if _, err := StringToEventStatus("cancelled"); !errors.Is(err, ErrUnknownEventStatus) {
+		t.Errorf("StringToEventStatus() error = %v, want %v", err, ErrUnknownEventStatus)
+	}
+}
+
+func TestEventStatus_IsFrozen(t *testing.T) {
+	t.Parallel()
+	want := map[EventStatus]bool{Planned: false, Open: false, Closed: true, Archived: true}
+	for status, frozen := range want {
+		if got := status.IsFrozen(); got != frozen {
+			t.Errorf("%s.IsFrozen() = %v, want %v", status, got, frozen)
+		}
+	}
+}
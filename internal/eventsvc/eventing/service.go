@@ -5,46 +5,127 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	gonanoid "github.com/matoous/go-nanoid"
 	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/sorting"
+	"github.com/shanvl/garbage/pkg/broker"
+	"github.com/shanvl/garbage/pkg/paging"
 	"github.com/shanvl/garbage/pkg/valid"
 )
 
 // Service is an interface providing methods to manage an event.
 // Note that all methods and entities are used in the context of one event.
 type Service interface {
-	// ChangePupilResources changes the amount of resources brought by the pupil to the event
-	ChangePupilResources(ctx context.Context, eventID, pupilID string, resources eventsvc.ResourceMap) error
-	// CreateEvent creates and stores the event
+	// AddPupilResources adds the signed amounts to the resources brought by the pupil to the event and records the
+	// weigh-in in the ledger. The amounts are measured in the given units or, if the unit of a resource isn't given,
+	// in the unit of its type. The event must be open and the pupil must be in the scope of the user
+	AddPupilResources(ctx context.Context, eventID, pupilID string, resources eventsvc.ResourceMap,
+		units eventsvc.UnitMap) error
+	// ArchiveEvent archives the closed event
+	ArchiveEvent(ctx context.Context, eventID string) error
+	// ChangePupilResources sets the amounts of the resources brought by the pupil to the event and records the
+	// difference in the ledger. The amounts are measured as in AddPupilResources. The event must be open and the pupil
+	// must be in the scope of the user. If the version isn't 0, the resources are changed only if they are still of
+	// that version
+	ChangePupilResources(ctx context.Context, eventID, pupilID string, resources eventsvc.ResourceMap,
+		units eventsvc.UnitMap, version int) error
+	// CloseEvent closes the open event, freezing its resources
+	CloseEvent(ctx context.Context, eventID string) error
+	// CorrectResourceEntry voids the entry of the ledger and records the one holding the corrected signed amounts
+	// instead. The amounts are measured as in AddPupilResources. It returns the id of the new entry. The event must be
+	// open and the pupil must be in the scope of the user
+	CorrectResourceEntry(ctx context.Context, eventID, pupilID, entryID string,
+		resources eventsvc.ResourceMap, units eventsvc.UnitMap) (string, error)
+	// CreateEvent creates and stores the planned event
 	CreateEvent(ctx context.Context, date time.Time, name string, resources []eventsvc.Resource) (string, error)
-	// DeleteEvent deletes the event
+	// DeleteEvent deletes the event along with the resources brought to it. The event mustn't be frozen, so that the
+	// ledger of the closed and the archived events is kept
 	DeleteEvent(ctx context.Context, eventID string) error
 	// EventByID returns an event with the given id and all its resources
 	EventByID(ctx context.Context, eventID string) (*Event, error)
-	// EventClasses returns an array of sorted classes with the resources they brought to the specified event
+	// EventClasses returns a page of sorted classes with the resources they brought to the specified event. The token
+	// of the next page is empty if the page is the last one
 	EventClasses(ctx context.Context, eventID string, filters EventClassFilters, sortBy sorting.By,
-		amount, skip int) (classes []*Class, total int, err error)
-	// EventPupils returns an array of sorted pupils with the resources they brought to the specified event
+		page paging.Page) (classes []*Class, total int, nextToken string, err error)
+	// EventPupils returns a page of sorted pupils with the resources they brought to the specified event.
+	// Only the pupils in the scope of the user are returned
 	EventPupils(ctx context.Context, eventID string, filters EventPupilFilters, sortBy sorting.By,
-		amount int, skip int) (pupils []*Pupil, total int, err error)
-	// PupilByID returns a pupil with the given id with the resources they brought to that event
+		page paging.Page) (pupils []*Pupil, total int, nextToken string, err error)
+	// StreamEventPupils calls fn with each of the sorted pupils with the resources they brought to the specified
+	// event, until fn returns an error. Unlike EventPupils, it isn't limited by the max amount, so that all the pupils
+	// can be exported. Only the pupils in the scope of the user are passed to fn
+	StreamEventPupils(ctx context.Context, eventID string, filters EventPupilFilters, sortBy sorting.By,
+		fn func(*Pupil) error) error
+	// OpenEvent opens the planned event, so that the resources can be brought to it
+	OpenEvent(ctx context.Context, eventID string) error
+	// PupilByID returns a pupil with the given id with the resources they brought to that event and the entries of
+	// the ledger the resources add up from. The pupil must be in the scope of the user
 	PupilByID(ctx context.Context, pupilID, eventID string) (*Pupil, error)
+	// PupilResourceEntries returns the entries of the ledger of the resources brought by the pupil to the event in the
+	// order they were recorded, including the voided ones. The pupil must be in the scope of the user
+	PupilResourceEntries(ctx context.Context, eventID, pupilID string) ([]*ResourceEntry, error)
+	// UpdateEvent changes the date, the name and the allowed resources of the event which isn't frozen yet.
+	// It returns the warnings about the side effects of the update, e.g. the changed class names of the pupils.
+	// If the version isn't 0, the event is updated only if it's still of that version
+	UpdateEvent(ctx context.Context, eventID string, date time.Time, name string,
+		resourcesAllowed []eventsvc.Resource, version int) (warnings []string, err error)
+	// VoidResourceEntry voids the entry of the ledger, so that it doesn't count towards the resources brought by the
+	// pupil. The event must be open and the pupil must be in the scope of the user
+	VoidResourceEntry(ctx context.Context, eventID, pupilID, entryID string) error
 }
 
 // Repository provides methods to work with an event's persistence
 type Repository interface {
-	ChangePupilResources(ctx context.Context, eventID, pupilID string, resources eventsvc.ResourceMap) error
+	// AddPupilResources adds the entry's resources to the ones brought by the pupil, stores the entry in the ledger
+	// and writes the message about it to the outbox atomically. If the event isn't open, eventsvc.ErrEventNotOpen is
+	// returned. If the resulting amounts are less than 0, ErrNegativeResources is returned
+	AddPupilResources(ctx context.Context, entry *ResourceEntry, msg broker.Message) error
+	// ChangeEventStatus changes the status of the event from the given one and writes the message about it to the
+	// outbox atomically. If the event's status has been changed by someone else in the meantime,
+	// eventsvc.ErrInvalidStatusTransition is returned
+	ChangeEventStatus(ctx context.Context, eventID string, from, to eventsvc.EventStatus, msg broker.Message) error
+	// ChangePupilResources sets the resources brought by the pupil to the amounts of the entry's resources, stores
+	// the entry holding the difference with the previous amounts in the ledger and writes the message about it to
+	// the outbox atomically. If the event isn't open, eventsvc.ErrEventNotOpen is returned. If the version isn't 0 and
+	// the resources aren't of that version anymore, eventsvc.ErrVersionMismatch is returned
+	ChangePupilResources(ctx context.Context, entry *ResourceEntry, version int, msg broker.Message) error
+	// ClassesWithResources returns the classes whose pupils have brought resources to the event
+	ClassesWithResources(ctx context.Context, eventID string) ([]eventsvc.Class, error)
+	// CorrectResourceEntry voids the entry the correction is of, stores the correction in the ledger and writes the
+	// message about it to the outbox atomically. If the event isn't open, eventsvc.ErrEventNotOpen is returned.
+	// If the entry has already been voided, ErrEntryVoided is returned
+	CorrectResourceEntry(ctx context.Context, correction *ResourceEntry, msg broker.Message) error
+	// DeleteEvent deletes the event along with the resources brought to it. If the event is frozen,
+	// eventsvc.ErrEventFrozen is returned
 	DeleteEvent(ctx context.Context, eventID string) error
 	EventByID(ctx context.Context, eventID string) (*Event, error)
 	EventClasses(ctx context.Context, eventID string, filters EventClassFilters, sortBy sorting.By,
-		amount int, skip int) (classes []*Class, total int, err error)
+		page paging.Page) (classes []*Class, total int, nextToken string, err error)
 	EventPupils(ctx context.Context, eventID string, filters EventPupilFilters, sortBy sorting.By,
-		amount int, skip int) (pupils []*Pupil, total int, err error)
+		page paging.Page) (pupils []*Pupil, total int, nextToken string, err error)
+	// StreamEventPupils calls fn with each of the sorted pupils of the event until fn returns an error
+	StreamEventPupils(ctx context.Context, eventID string, filters EventPupilFilters, sortBy sorting.By,
+		fn func(*Pupil) error) error
 	PupilByID(ctx context.Context, pupilID, eventID string) (*Pupil, error)
-	StoreEvent(ctx context.Context, event *eventsvc.Event) error
+	// PupilResourceEntries returns the entries of the ledger of the resources brought by the pupil to the event in the
+	// order they were recorded
+	PupilResourceEntries(ctx context.Context, eventID, pupilID string) ([]*ResourceEntry, error)
+	// ResourceTypes returns the catalogue of the resources which can be allowed on the events
+	ResourceTypes(ctx context.Context) ([]*eventsvc.ResourceType, error)
+	// StoreEvent stores the event and writes the message about it to the outbox atomically
+	StoreEvent(ctx context.Context, event *eventsvc.Event, msg broker.Message) error
+	// UpdateEvent updates the date, the name and the allowed resources of the event and writes the message about it to
+	// the outbox atomically. If the event is frozen, eventsvc.ErrEventFrozen is returned. If the pupils have brought
+	// the resources which aren't allowed anymore, *ResourcesBroughtError is returned. If the event's version isn't 0
+	// and the event isn't of that version anymore, eventsvc.ErrVersionMismatch is returned
+	UpdateEvent(ctx context.Context, event *eventsvc.Event, msg broker.Message) error
+	// VoidResourceEntry voids the entry with the id, the event and the pupil of the given one, recording who has voided
+	// it and when, and writes the message about it to the outbox atomically. If the entry has already been voided,
+	// ErrEntryVoided is returned. If the resulting amounts are less than 0, ErrNegativeResources is returned
+	VoidResourceEntry(ctx context.Context, entry *ResourceEntry, msg broker.Message) error
 }
 
 type service struct {
@@ -60,15 +141,171 @@ const (
 // ErrNoEventPupil indicates that the pupil didn't participate in the event
 var ErrNoEventPupil = errors.New("pupil didn't participate in the event")
 
+// ErrNegativeResources is used when the resources brought by the pupil would become less than 0
+var ErrNegativeResources = errors.New("the resources brought by the pupil can't be less than 0")
+
+// ErrUnknownEntry is used when the entry of the ledger hasn't been found
+var ErrUnknownEntry = errors.New("unknown resource entry")
+
+// ErrEntryVoided is used when the entry of the ledger has already been voided
+var ErrEntryVoided = errors.New("the resource entry has already been voided")
+
+// ErrResourceNotAllowed is used when the resources not allowed on the event are brought to it. It happens if the
+// allowed resources have been changed while the resources were being added
+var ErrResourceNotAllowed = errors.New("the resource isn't allowed on the event")
+
+// ErrResourcesBrought is used when the resources which have already been brought to the event are disallowed
+var ErrResourcesBrought = errors.New("the resources have already been brought to the event")
+
+// ResourcesBroughtError lists the pupils who have brought the resources which are being disallowed
+type ResourcesBroughtError struct {
+	// Pupils are the pupils with the amounts of the disallowed resources they have brought
+	Pupils []*Pupil
+}
+
+func (e *ResourcesBroughtError) Error() string {
+	return fmt.Sprintf("%s by %d pupil(s)", ErrResourcesBrought, len(e.Pupils))
+}
+
+// Unwrap makes the error match ErrResourcesBrought
+func (e *ResourcesBroughtError) Unwrap() error {
+	return ErrResourcesBrought
+}
+
 // NewService returns an instance of Service with all its dependencies
 func NewService(repo Repository) Service {
 	return &service{repo}
 }
 
-// ChangePupilResources adds/subtracts resources brought by a pupil to/from the event
+// ArchiveEvent archives the closed event
+func (s *service) ArchiveEvent(ctx context.Context, eventID string) error {
+	return s.changeEventStatus(ctx, eventID, eventsvc.Archived)
+}
+
+// ChangePupilResources sets the amounts of the resources brought by a pupil to the event. The resources missing from
+// the map keep their amounts
 func (s *service) ChangePupilResources(ctx context.Context, eventID, pupilID string,
-	resources eventsvc.ResourceMap) error {
+	resources eventsvc.ResourceMap, units eventsvc.UnitMap, version int) error {
+
+	resources, err := s.checkPupilResources(ctx, eventID, pupilID, resources, units, false)
+	if err != nil {
+		return err
+	}
+	entry, err := newResourceEntry(ctx, eventID, pupilID, resources)
+	if err != nil {
+		return err
+	}
+	// create the message about the change
+	msg, err := broker.NewMessage(broker.TopicPupilResourcesChanged, broker.PupilResourcesChanged{
+		EventID:   eventID,
+		PupilID:   pupilID,
+		Resources: resources.ToStringMap(),
+	})
+	if err != nil {
+		return err
+	}
+	err = s.repo.ChangePupilResources(ctx, entry, version, msg)
+	if errors.Is(err, ErrNoEventPupil) {
+		// we already know for sure that the event exists —— we've checked it earlier. Hence,
+		// we can be certain that only the pupil hasn't been found
+		err = eventsvc.ErrUnknownPupil
+	}
+	return err
+}
+
+// AddPupilResources adds the signed amounts to the resources brought by a pupil to the event. Unlike
+// ChangePupilResources, it doesn't overwrite the amounts recorded by others in the meantime
+func (s *service) AddPupilResources(ctx context.Context, eventID, pupilID string,
+	resources eventsvc.ResourceMap, units eventsvc.UnitMap) error {
+
+	resources, err := s.checkPupilResources(ctx, eventID, pupilID, resources, units, true)
+	if err != nil {
+		return err
+	}
+	entry, err := newResourceEntry(ctx, eventID, pupilID, resources)
+	if err != nil {
+		return err
+	}
+	// create the message about the weigh-in
+	msg, err := broker.NewMessage(broker.TopicPupilResourcesAdded, broker.PupilResourcesAdded{
+		EventID:    eventID,
+		PupilID:    pupilID,
+		Resources:  resources.ToStringMap(),
+		RecordedBy: entry.RecordedBy,
+	})
+	if err != nil {
+		return err
+	}
+	err = s.repo.AddPupilResources(ctx, entry, msg)
+	if errors.Is(err, ErrNoEventPupil) {
+		// the event has been checked earlier, so only the pupil hasn't been found
+		err = eventsvc.ErrUnknownPupil
+	}
+	return err
+}
+
+// checkPupilResources checks that the resources of the pupil can be changed by the user and that the resources are
+// allowed on the event. Unless signed, the amounts can't be less than 0. It returns the amounts converted to the units
+// of the resource types
+func (s *service) checkPupilResources(ctx context.Context, eventID, pupilID string, resources eventsvc.ResourceMap,
+	units eventsvc.UnitMap, signed bool) (eventsvc.ResourceMap, error) {
+
+	if len(resources) == 0 || signed && resources.IsZero() {
+		return nil, valid.NewError("resources", "no resources were provided")
+	}
+	event, err := s.checkPupilChange(ctx, eventID, pupilID)
+	if err != nil {
+		return nil, err
+	}
+	// check that provided resources are valid and allowed for this event
+	for res, amount := range resources {
+		if !signed && amount < 0 {
+			return nil, valid.NewError("resources", fmt.Sprintf("%s cannot be less than 0", res.String()))
+		}
+		if amount != 0 && !event.IsResourceAllowed(res) {
+			return nil, valid.NewError("resources", fmt.Sprintf("%s is not allowed", res.String()))
+		}
+	}
+	return s.measureResources(ctx, resources, units)
+}
 
+// measureResources converts the amounts measured in the given units to the units of the resource types. The amounts
+// of the countable resources must be whole
+func (s *service) measureResources(ctx context.Context, resources eventsvc.ResourceMap,
+	units eventsvc.UnitMap) (eventsvc.ResourceMap, error) {
+
+	catalogue, err := s.repo.ResourceTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typeUnits := eventsvc.NewUnitMap(catalogue)
+	measured := make(eventsvc.ResourceMap, len(resources))
+	for res, amount := range resources {
+		typeUnit, ok := typeUnits[res]
+		if !ok {
+			// only zero amounts of the resources which aren't allowed on the event get here
+			measured[res] = amount
+			continue
+		}
+		unit, ok := units[res]
+		if !ok {
+			unit = typeUnit
+		}
+		converted, err := unit.Convert(amount, typeUnit)
+		if err != nil {
+			return nil, valid.NewError("units", fmt.Sprintf("%s can't be measured in %s", res, unit))
+		}
+		if typeUnit.IsCountable() && converted != float32(math.Trunc(float64(converted))) {
+			return nil, valid.NewError("resources", fmt.Sprintf("%s must be a whole number of %s", res, typeUnit))
+		}
+		measured[res] = converted
+	}
+	return measured, nil
+}
+
+// checkPupilChange checks that the resources of the pupil can be changed by the user and returns the event. The event
+// must be open and the pupil must be in the scope of the user
+func (s *service) checkPupilChange(ctx context.Context, eventID, pupilID string) (*Event, error) {
 	errVld := valid.EmptyError()
 	if len(pupilID) == 0 {
 		errVld.Add("pupilID", "pupilID must be provided")
@@ -76,33 +313,90 @@ func (s *service) ChangePupilResources(ctx context.Context, eventID, pupilID str
 	if len(eventID) == 0 {
 		errVld.Add("eventID", "eventID must be provided")
 	}
-	if len(resources) == 0 {
-		errVld.Add("resources", "no resources were provided")
-	}
 	if !errVld.IsEmpty() {
-		return errVld
+		return nil, errVld
 	}
 	// find an event by its id
 	event, err := s.repo.EventByID(ctx, eventID)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	// check that provided resources are valid and allowed for this event
-	for res, amount := range resources {
-		if amount < 0 {
-			return valid.NewError("resources", fmt.Sprintf("%s cannot be less than 0", res.String()))
+	// the resources can be brought to the open event only
+	if event.Status != eventsvc.Open {
+		return nil, fmt.Errorf("%w: the event is %s", eventsvc.ErrEventNotOpen, event.Status)
+	}
+	// a restricted user, e.g. a class teacher, can change the resources of the pupils of their classes only
+	if scope := eventsvc.ScopeFromContext(ctx); scope.IsRestricted() {
+		pupil, err := s.repo.PupilByID(ctx, pupilID, eventID)
+		if err != nil {
+			if errors.Is(err, ErrNoEventPupil) {
+				err = eventsvc.ErrUnknownPupil
+			}
+			return nil, err
 		}
-		if amount > 0 && !event.IsResourceAllowed(res) {
-			return valid.NewError("resources", fmt.Sprintf("%s is not allowed", res.String()))
+		if err := checkScope(scope, pupil, event.Date); err != nil {
+			return nil, err
 		}
 	}
-	err = s.repo.ChangePupilResources(ctx, eventID, pupilID, resources)
-	if errors.Is(err, ErrNoEventPupil) {
-		// we already know for sure that the event exists —— we've checked it earlier. Hence,
-		// we can be certain that only the pupil hasn't been found
-		err = eventsvc.ErrUnknownPupil
+	return event, nil
+}
+
+// newResourceEntry creates an entry of the ledger recorded by the user who has made the request
+func newResourceEntry(ctx context.Context, eventID, pupilID string, resources eventsvc.ResourceMap) (*ResourceEntry,
+	error) {
+
+	id, err := gonanoid.Nanoid()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate entry id: %w", err)
 	}
-	return err
+	return &ResourceEntry{
+		ID:         id,
+		EventID:    eventID,
+		PupilID:    pupilID,
+		Resources:  resources,
+		RecordedBy: eventsvc.UserIDFromContext(ctx),
+		RecordedAt: time.Now().UTC(),
+	}, nil
+}
+
+// CloseEvent closes the open event. The resources of the closed event can't be changed
+func (s *service) CloseEvent(ctx context.Context, eventID string) error {
+	return s.changeEventStatus(ctx, eventID, eventsvc.Closed)
+}
+
+// CorrectResourceEntry voids the entry of the ledger and records the one holding the corrected amounts instead.
+// It returns the id of the new entry
+func (s *service) CorrectResourceEntry(ctx context.Context, eventID, pupilID, entryID string,
+	resources eventsvc.ResourceMap, units eventsvc.UnitMap) (string, error) {
+
+	if len(entryID) == 0 {
+		return "", valid.NewError("entryID", "entryID must be provided")
+	}
+	resources, err := s.checkPupilResources(ctx, eventID, pupilID, resources, units, true)
+	if err != nil {
+		return "", err
+	}
+	correction, err := newResourceEntry(ctx, eventID, pupilID, resources)
+	if err != nil {
+		return "", err
+	}
+	correction.CorrectionOf = entryID
+	// create the message about the correction
+	msg, err := broker.NewMessage(broker.TopicResourceEntryCorrected, broker.ResourceEntryCorrected{
+		EventID:      eventID,
+		PupilID:      pupilID,
+		EntryID:      entryID,
+		CorrectionID: correction.ID,
+		Resources:    resources.ToStringMap(),
+		RecordedBy:   correction.RecordedBy,
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := s.repo.CorrectResourceEntry(ctx, correction, msg); err != nil {
+		return "", err
+	}
+	return correction.ID, nil
 }
 
 // CreateEvent creates and stores an event
@@ -114,12 +408,9 @@ func (s *service) CreateEvent(ctx context.Context, date time.Time, name string,
 	if time.Now().After(date) {
 		errVld.Add("date", "event's date must be in the future")
 	}
-	// check that provided resourcesAllowed exist and are known
-	if len(resourcesAllowed) == 0 {
-		errVld.Add("resourcesAllowed", "at least one resource must be specified")
-	}
-	if len(name) > 25 {
-		errVld.Add("name", "length of the name can't be more than 25")
+	validateEvent(errVld, name, resourcesAllowed)
+	if err := s.validateResourcesAllowed(ctx, errVld, resourcesAllowed); err != nil {
+		return "", err
 	}
 	// if there are validation errors, return them
 	if !errVld.IsEmpty() {
@@ -127,8 +418,7 @@ func (s *service) CreateEvent(ctx context.Context, date time.Time, name string,
 	}
 	// If no name was provided, create it from the event's date
 	if len(name) == 0 {
-		year, month, day := date.Date()
-		name = fmt.Sprintf("%02d-%02d-%d", day, month, year)
+		name = defaultEventName(date)
 	}
 	// generate eventID
 	id, err := gonanoid.Nanoid(14)
@@ -141,9 +431,20 @@ func (s *service) CreateEvent(ctx context.Context, date time.Time, name string,
 		Date:             date,
 		Name:             name,
 		ResourcesAllowed: resourcesAllowed,
+		Status:           eventsvc.Planned,
+	}
+	// create the message about the new event
+	msg, err := broker.NewMessage(broker.TopicEventCreated, broker.EventCreated{
+		EventID:          event.ID,
+		Date:             event.Date,
+		Name:             event.Name,
+		ResourcesAllowed: eventsvc.ResourceSliceToStringSlice(event.ResourcesAllowed),
+	})
+	if err != nil {
+		return "", err
 	}
 	// store the event
-	err = s.repo.StoreEvent(ctx, event)
+	err = s.repo.StoreEvent(ctx, event, msg)
 	if err != nil {
 		return "", err
 	}
@@ -169,9 +470,9 @@ func (s *service) EventByID(ctx context.Context, eventID string) (*Event, error)
 	return s.repo.EventByID(ctx, eventID)
 }
 
-// EventClasses returns an array of sorted classes for the specified event
+// EventClasses returns a page of sorted classes for the specified event
 func (s *service) EventClasses(ctx context.Context, eventID string, filters EventClassFilters,
-	sortBy sorting.By, amount, skip int) (classes []*Class, total int, err error) {
+	sortBy sorting.By, page paging.Page) (classes []*Class, total int, nextToken string, err error) {
 
 	// check if eventID was provided
 	errVld := valid.EmptyError()
@@ -179,22 +480,25 @@ func (s *service) EventClasses(ctx context.Context, eventID string, filters Even
 		errVld.Add("eventID", "eventID must be provided")
 	}
 	if !errVld.IsEmpty() {
-		return nil, 0, errVld
+		return nil, 0, "", errVld
 	}
 
-	// if provided values are incorrect, use default values instead
-	amount, skip = validateAmountSkip(amount, skip)
-
-	if !sortBy.IsName() && !sortBy.IsResources() {
+	// classes can be sorted by resources they brought, their total, their stats per pupil or by name
+	if !sortBy.ConsistsOf(sorting.By.IsName, sorting.By.IsResources, sorting.By.IsTotal, sorting.By.IsPerPupil,
+		sorting.By.IsParticipation, sorting.By.IsMedian) {
 		sortBy = sorting.NameAsc
 	}
+	// if the size or the skip are incorrect, use default values instead. The token must belong to the sorting
+	if page, err = validatePage(page, sortBy); err != nil {
+		return nil, 0, "", err
+	}
 
-	return s.repo.EventClasses(ctx, eventID, filters, sortBy, amount, skip)
+	return s.repo.EventClasses(ctx, eventID, filters, sortBy, page)
 }
 
-// EventPupils returns an array of sorted pupils for the specified event
+// EventPupils returns a page of sorted pupils for the specified event
 func (s *service) EventPupils(ctx context.Context, eventID string, filters EventPupilFilters,
-	sortBy sorting.By, amount int, skip int) (pupils []*Pupil, total int, err error) {
+	sortBy sorting.By, page paging.Page) (pupils []*Pupil, total int, nextToken string, err error) {
 
 	// check if eventID was provided
 	errVld := valid.EmptyError()
@@ -202,21 +506,166 @@ func (s *service) EventPupils(ctx context.Context, eventID string, filters Event
 		errVld.Add("eventID", "eventID must be provided")
 	}
 	if !errVld.IsEmpty() {
-		return nil, 0, errVld
+		return nil, 0, "", errVld
+	}
+
+	// pupils can be sorted by resources they brought, their total or by name
+	if !sortBy.ConsistsOf(sorting.By.IsName, sorting.By.IsResources, sorting.By.IsTotal) {
+		sortBy = sorting.NameAsc
 	}
+	// if the size or the skip are incorrect, use default values instead. The token must belong to the sorting
+	if page, err = validatePage(page, sortBy); err != nil {
+		return nil, 0, "", err
+	}
+	// the scope always comes from the user, so that it can't be widened by the caller
+	filters.Scope = eventsvc.ScopeFromContext(ctx)
+
+	return s.repo.EventPupils(ctx, eventID, filters, sortBy, page)
+}
 
-	// if provided values are incorrect, use default values instead
-	amount, skip = validateAmountSkip(amount, skip)
+// StreamEventPupils calls fn with each of the sorted pupils for the specified event until fn returns an error
+func (s *service) StreamEventPupils(ctx context.Context, eventID string, filters EventPupilFilters,
+	sortBy sorting.By, fn func(*Pupil) error) error {
 
-	if !sortBy.IsName() && !sortBy.IsResources() {
+	// check if eventID was provided
+	if len(eventID) == 0 {
+		return valid.NewError("eventID", "eventID must be provided")
+	}
+	// pupils can be sorted by resources they brought, their total or by name
+	if !sortBy.ConsistsOf(sorting.By.IsName, sorting.By.IsResources, sorting.By.IsTotal) {
 		sortBy = sorting.NameAsc
 	}
+	// the scope always comes from the user, so that it can't be widened by the caller
+	filters.Scope = eventsvc.ScopeFromContext(ctx)
+
+	return s.repo.StreamEventPupils(ctx, eventID, filters, sortBy, fn)
+}
 
-	return s.repo.EventPupils(ctx, eventID, filters, sortBy, amount, skip)
+// OpenEvent opens the planned event
+func (s *service) OpenEvent(ctx context.Context, eventID string) error {
+	return s.changeEventStatus(ctx, eventID, eventsvc.Open)
 }
 
-// PupilByID returns a pupil with a given id w/ resources for a specified event
+// PupilByID returns a pupil with a given id w/ resources for a specified event and the entries of the ledger they add
+// up from
 func (s *service) PupilByID(ctx context.Context, pupilID string, eventID string) (*Pupil, error) {
+	pupil, err := s.pupilInScope(ctx, pupilID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	pupil.ResourceEntries, err = s.repo.PupilResourceEntries(ctx, eventID, pupilID)
+	if err != nil {
+		return nil, err
+	}
+	return pupil, nil
+}
+
+// PupilResourceEntries returns the entries of the ledger of the resources brought by the pupil to the event,
+// including the voided ones
+func (s *service) PupilResourceEntries(ctx context.Context, eventID, pupilID string) ([]*ResourceEntry, error) {
+	if _, err := s.pupilInScope(ctx, pupilID, eventID); err != nil {
+		return nil, err
+	}
+	return s.repo.PupilResourceEntries(ctx, eventID, pupilID)
+}
+
+// UpdateEvent changes the date, the name and the allowed resources of the event. The event is updated only if it
+// hasn't been changed since it was read, so that the warnings are made for the date it's moved from
+func (s *service) UpdateEvent(ctx context.Context, eventID string, date time.Time, name string,
+	resourcesAllowed []eventsvc.Resource, version int) ([]string, error) {
+
+	errVld := valid.EmptyError()
+	if len(eventID) == 0 {
+		errVld.Add("eventID", "eventID must be provided")
+	}
+	validateEvent(errVld, name, resourcesAllowed)
+	if err := s.validateResourcesAllowed(ctx, errVld, resourcesAllowed); err != nil {
+		return nil, err
+	}
+	if !errVld.IsEmpty() {
+		return nil, errVld
+	}
+	event, err := s.repo.EventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := eventsvc.CheckVersion(version, event.Version); err != nil {
+		return nil, err
+	}
+	if event.Status.IsFrozen() {
+		return nil, fmt.Errorf("%w: the event is %s", eventsvc.ErrEventFrozen, event.Status)
+	}
+	var warnings []string
+	if !isSameDay(date, event.Date) {
+		// the event can't be moved to the past
+		if time.Now().After(date) {
+			return nil, valid.NewError("date", "event's date must be in the future")
+		}
+		// the resources brought by the pupils are attributed to the classes they were in on the date of the event
+		classes, err := s.repo.ClassesWithResources(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		warnings = classNameWarnings(classes, event.Date, date)
+	}
+	if len(name) == 0 {
+		name = defaultEventName(date)
+	}
+	updated := &eventsvc.Event{
+		ID:               eventID,
+		Date:             date,
+		Name:             name,
+		ResourcesAllowed: resourcesAllowed,
+		Status:           event.Status,
+		Version:          event.Version,
+	}
+	// create the message about the change
+	msg, err := broker.NewMessage(broker.TopicEventUpdated, broker.EventUpdated{
+		EventID:          updated.ID,
+		Date:             updated.Date,
+		Name:             updated.Name,
+		ResourcesAllowed: eventsvc.ResourceSliceToStringSlice(updated.ResourcesAllowed),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateEvent(ctx, updated, msg); err != nil {
+		return nil, err
+	}
+	return warnings, nil
+}
+
+// VoidResourceEntry voids the entry of the ledger, so that it doesn't count towards the resources brought by the pupil
+func (s *service) VoidResourceEntry(ctx context.Context, eventID, pupilID, entryID string) error {
+	if len(entryID) == 0 {
+		return valid.NewError("entryID", "entryID must be provided")
+	}
+	if _, err := s.checkPupilChange(ctx, eventID, pupilID); err != nil {
+		return err
+	}
+	entry := &ResourceEntry{
+		ID:       entryID,
+		EventID:  eventID,
+		PupilID:  pupilID,
+		VoidedBy: eventsvc.UserIDFromContext(ctx),
+		VoidedAt: time.Now().UTC(),
+	}
+	// create the message about the void
+	msg, err := broker.NewMessage(broker.TopicResourceEntryVoided, broker.ResourceEntryVoided{
+		EventID:  eventID,
+		PupilID:  pupilID,
+		EntryID:  entryID,
+		VoidedBy: entry.VoidedBy,
+	})
+	if err != nil {
+		return err
+	}
+	return s.repo.VoidResourceEntry(ctx, entry, msg)
+}
+
+// pupilInScope returns a pupil with a given id w/ resources for a specified event. The pupil must be in the scope
+// of the user
+func (s *service) pupilInScope(ctx context.Context, pupilID string, eventID string) (*Pupil, error) {
 	// check if eventID and pupilID are provided
 	errVld := valid.EmptyError()
 	if len(eventID) == 0 {
@@ -230,18 +679,133 @@ func (s *service) PupilByID(ctx context.Context, pupilID string, eventID string)
 	}
 
 	// get the pupil
-	return s.repo.PupilByID(ctx, pupilID, eventID)
+	pupil, err := s.repo.PupilByID(ctx, pupilID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if scope := eventsvc.ScopeFromContext(ctx); scope.IsRestricted() {
+		// the name of the pupil's class depends on the date of the event
+		event, err := s.repo.EventByID(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkScope(scope, pupil, event.Date); err != nil {
+			return nil, err
+		}
+	}
+	return pupil, nil
+}
+
+// changeEventStatus moves the event to the given status if it's allowed by the event's current status
+func (s *service) changeEventStatus(ctx context.Context, eventID string, status eventsvc.EventStatus) error {
+	if len(eventID) == 0 {
+		return valid.NewError("eventID", "eventID must be provided")
+	}
+	event, err := s.repo.EventByID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if !event.Status.CanTransitionTo(status) {
+		return fmt.Errorf("%w: the event is %s, it can't be %s", eventsvc.ErrInvalidStatusTransition, event.Status,
+			status)
+	}
+	// create the message about the change
+	msg, err := broker.NewMessage(broker.TopicEventStatusChanged, broker.EventStatusChanged{
+		EventID: eventID,
+		Status:  status.String(),
+	})
+	if err != nil {
+		return err
+	}
+	return s.repo.ChangeEventStatus(ctx, eventID, event.Status, status, msg)
+}
+
+// checkScope returns ErrOutOfScope if neither the pupil nor the class they were in on the date of the event is in
+// the scope
+func checkScope(scope eventsvc.Scope, pupil *Pupil, eventDate time.Time) error {
+	class, err := eventsvc.ClassFromClassName(pupil.Class, eventDate)
+	if err != nil {
+		return err
+	}
+	if !scope.AllowsPupil(pupil.ID, class) {
+		return eventsvc.ErrOutOfScope
+	}
+	return nil
+}
+
+// validateEvent adds the errors of the event's name and allowed resources to errVld
+func validateEvent(errVld *valid.ErrValidation, name string, resourcesAllowed []eventsvc.Resource) {
+	// check that provided resourcesAllowed exist and are known
+	if len(resourcesAllowed) == 0 {
+		errVld.Add("resourcesAllowed", "at least one resource must be specified")
+	}
+	if len(name) > 25 {
+		errVld.Add("name", "length of the name can't be more than 25")
+	}
+}
+
+// validateResourcesAllowed adds an error to errVld if any of the resources allowed on the event isn't in the catalogue
+func (s *service) validateResourcesAllowed(ctx context.Context, errVld *valid.ErrValidation,
+	resourcesAllowed []eventsvc.Resource) error {
+
+	if len(resourcesAllowed) == 0 {
+		return nil
+	}
+	catalogue, err := s.repo.ResourceTypes(ctx)
+	if err != nil {
+		return err
+	}
+	known := make(map[eventsvc.Resource]bool, len(catalogue))
+	for _, resourceType := range catalogue {
+		known[resourceType.Name] = true
+	}
+	for _, res := range resourcesAllowed {
+		if !known[res] {
+			errVld.Add("resourcesAllowed", fmt.Sprintf("%s is not in the catalogue", res))
+		}
+	}
+	return nil
+}
+
+// defaultEventName creates the name of the event from its date
+func defaultEventName(date time.Time) string {
+	year, month, day := date.Date()
+	return fmt.Sprintf("%02d-%02d-%d", day, month, year)
 }
 
-// ensures that amount and skip are valid
-func validateAmountSkip(a, s int) (int, int) {
-	if a <= 0 || a > MaxAmount {
-		a = DefaultAmount
+// isSameDay reports whether the dates fall on the same day. The events are stored with their dates only
+func isSameDay(a, b time.Time) bool {
+	aYear, aMonth, aDay := a.Date()
+	bYear, bMonth, bDay := b.Date()
+	return aYear == bYear && aMonth == bMonth && aDay == bDay
+}
+
+// classNameWarnings describes how the names of the classes change if the event is moved to another date
+func classNameWarnings(classes []eventsvc.Class, oldDate, newDate time.Time) []string {
+	var warnings []string
+	for _, class := range classes {
+		oldName, err := class.NameOnDate(oldDate)
+		if err != nil {
+			continue
+		}
+		newName, err := class.NameOnDate(newDate)
+		switch {
+		case err != nil:
+			warnings = append(warnings, fmt.Sprintf("class %s won't exist on the new date", oldName))
+		case newName != oldName:
+			warnings = append(warnings, fmt.Sprintf("class %s will be %s on the new date", oldName, newName))
+		}
 	}
-	if s < 0 {
-		s = DefaultSkip
+	return warnings
+}
+
+// ensures that the size and the skip of the page are valid and that its token, if any, was made for the sorting
+func validatePage(page paging.Page, sortBy sorting.By) (paging.Page, error) {
+	page = page.Normalize(DefaultAmount, MaxAmount)
+	if _, err := page.Cursor(string(sortBy)); err != nil {
+		return page, valid.NewError("pageToken", err.Error())
 	}
-	return a, s
+	return page, nil
 }
 
 // Class is a model of the class, adapted for this use case.
@@ -250,6 +814,8 @@ type Class struct {
 	Name string
 	// Resources brought by the class to the event
 	ResourcesBrought eventsvc.ResourceMap
+	// Stats of the resources brought to the event per pupil
+	Stats eventsvc.ClassStats
 }
 
 // Event is a model of the event, adapted for this use case.
@@ -268,6 +834,35 @@ type Pupil struct {
 	Class string
 	// Resources brought by the pupil to the event
 	ResourcesBrought eventsvc.ResourceMap
+	// ResourcesVersion is incremented on every change of the resources brought by the pupil to the event. It's
+	// returned by PupilByID only
+	ResourcesVersion int
+	// ResourceEntries are the entries of the ledger the resources add up from. They are returned by PupilByID only
+	ResourceEntries []*ResourceEntry
+}
+
+// ResourceEntry is a single weigh-in of the resources brought by the pupil to the event. The entries make up the
+// ledger the amounts of the resources can be rebuilt and corrected from
+type ResourceEntry struct {
+	ID      string
+	EventID string
+	PupilID string
+	// Resources are the signed amounts the entry adds to the resources brought by the pupil
+	Resources eventsvc.ResourceMap
+	// RecordedBy is the id of the user who has recorded the entry
+	RecordedBy string
+	RecordedAt time.Time
+	// CorrectionOf is the id of the entry corrected by this one, if any
+	CorrectionOf string
+	// VoidedBy and VoidedAt are set when the entry is voided. The voided entries don't count towards the resources
+	// brought by the pupil
+	VoidedBy string
+	VoidedAt time.Time
+}
+
+// IsVoided reports whether the entry has been voided
+func (e *ResourceEntry) IsVoided() bool {
+	return !e.VoidedAt.IsZero()
 }
 
 // EventClassFilters are used to filter classes participating in an event
@@ -278,4 +873,6 @@ type EventClassFilters struct {
 // EventPupilFilters are used to filter pupils participating in an event
 type EventPupilFilters struct {
 	NameAndClass string
+	// Scope restricts the pupils to the classes of the user. It's set by the service
+	Scope eventsvc.Scope
 }
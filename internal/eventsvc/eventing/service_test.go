@@ -11,14 +11,21 @@ import (
 	"github.com/shanvl/garbage/internal/eventsvc/eventing"
 	"github.com/shanvl/garbage/internal/eventsvc/mock"
 	"github.com/shanvl/garbage/internal/eventsvc/sorting"
+	"github.com/shanvl/garbage/pkg/broker"
+	"github.com/shanvl/garbage/pkg/paging"
+	"github.com/shanvl/garbage/pkg/valid"
 )
 
 func Test_service_CreateEvent(t *testing.T) {
 	t.Parallel()
 	var repository mock.EventingRepository
-	repository.StoreEventFn = func(ctx context.Context, e *eventsvc.Event) error {
+	repository.StoreEventFn = func(ctx context.Context, e *eventsvc.Event, msg broker.Message) error {
+		if msg.Topic != broker.TopicEventCreated {
+			t.Errorf("StoreEvent() msg.Topic = %v, want %v", msg.Topic, broker.TopicEventCreated)
+		}
 		return nil
 	}
+	repository.ResourceTypesFn = catalogue
 	s := eventing.NewService(&repository)
 
 	ctx := context.Background()
@@ -69,6 +76,17 @@ func Test_service_CreateEvent(t *testing.T) {
 			idLenGT0: false,
 			wantErr:  true,
 		},
+		{
+			name: "resource isn't in the catalogue",
+			args: args{
+				ctx:              ctx,
+				date:             time.Now().AddDate(0, 0, 1),
+				name:             "some name",
+				resourcesAllowed: []eventsvc.Resource{eventsvc.Plastic, "glass"},
+			},
+			idLenGT0: false,
+			wantErr:  true,
+		},
 		{
 			name: "no name but that's ok",
 			args: args{
@@ -247,6 +265,7 @@ func Test_service_ChangePupilResources(t *testing.T) {
 	t.Parallel()
 	const (
 		eventID                = "123"
+		eventIDClosed          = "closed"
 		eventIDErrNoEventPupil = "errornoeventpupil"
 		pupilID                = "123"
 	)
@@ -255,16 +274,31 @@ func Test_service_ChangePupilResources(t *testing.T) {
 	ctx := context.Background()
 
 	var repository mock.EventingRepository
-	repository.ChangePupilResourcesFn = func(ctx context.Context, eventID string, pupilID string,
-		resources eventsvc.ResourceMap) error {
-		if eventID == eventIDErrNoEventPupil {
+	repository.ChangePupilResourcesFn = func(ctx context.Context, entry *eventing.ResourceEntry, version int,
+		msg broker.Message) error {
+		if msg.Topic != broker.TopicPupilResourcesChanged {
+			t.Errorf("ChangePupilResources() msg.Topic = %v, want %v", msg.Topic, broker.TopicPupilResourcesChanged)
+		}
+		if entry.ID == "" || entry.RecordedAt.IsZero() {
+			t.Errorf("ChangePupilResources() entry has no id or time: %+v", entry)
+		}
+		if entry.EventID == eventIDErrNoEventPupil {
 			return eventing.ErrNoEventPupil
 		}
+		// the resources are of version 3
+		if version != 0 && version != 3 {
+			return eventsvc.ErrVersionMismatch
+		}
 		return nil
 	}
+	repository.ResourceTypesFn = catalogue
 	repository.EventByIDFn = func(ctx context.Context, id string) (event *eventing.Event, err error) {
+		status := eventsvc.Open
+		if id == eventIDClosed {
+			status = eventsvc.Closed
+		}
 		return &eventing.Event{
-				Event: eventsvc.Event{ID: id, ResourcesAllowed: resourcesAllowed},
+				Event: eventsvc.Event{ID: id, ResourcesAllowed: resourcesAllowed, Status: status},
 			},
 			nil
 	}
@@ -275,6 +309,7 @@ func Test_service_ChangePupilResources(t *testing.T) {
 		eventID   string
 		pupilID   string
 		resources eventsvc.ResourceMap
+		version   int
 	}
 	tests := []struct {
 		name    string
@@ -341,6 +376,16 @@ func Test_service_ChangePupilResources(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "event is closed",
+			args: args{
+				ctx:       ctx,
+				eventID:   eventIDClosed,
+				pupilID:   pupilID,
+				resources: eventsvc.ResourceMap{eventsvc.Plastic: 11},
+			},
+			wantErr: true,
+		},
 		{
 			name: "ErrNoEventPupil",
 			args: args{
@@ -351,6 +396,17 @@ func Test_service_ChangePupilResources(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "resources have been changed in the meantime",
+			args: args{
+				ctx:       ctx,
+				eventID:   eventID,
+				pupilID:   pupilID,
+				resources: eventsvc.ResourceMap{eventsvc.Plastic: 11},
+				version:   1,
+			},
+			wantErr: true,
+		},
 		{
 			name: "add 2 resources",
 			args: args{
@@ -361,19 +417,505 @@ func Test_service_ChangePupilResources(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "add 2 resources to the resources of the given version",
+			args: args{
+				ctx:       ctx,
+				eventID:   eventID,
+				pupilID:   pupilID,
+				resources: eventsvc.ResourceMap{eventsvc.Plastic: 11, eventsvc.Gadgets: 33},
+				version:   3,
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := s.ChangePupilResources(tt.args.ctx, tt.args.eventID, tt.args.pupilID, tt.args.resources)
+			err := s.ChangePupilResources(tt.args.ctx, tt.args.eventID, tt.args.pupilID, tt.args.resources, nil,
+				tt.args.version)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ChangePupilResources() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.args.version == 1 && !errors.Is(err, eventsvc.ErrVersionMismatch) {
+				t.Errorf("ChangePupilResources() of the changed resources didn't return eventsvc."+
+					"ErrVersionMismatch: %v", err)
+				return
+			}
 			if tt.args.eventID == eventIDErrNoEventPupil && !errors.Is(err, eventsvc.ErrUnknownPupil) {
 				t.Errorf("ChangePupilResources() on repo's ErrNoEventPupil didn't return eventsvc."+
 					"ErrUnknownPupil: %v", err)
 				return
 			}
+			if tt.args.eventID == eventIDClosed && !errors.Is(err, eventsvc.ErrEventNotOpen) {
+				t.Errorf("ChangePupilResources() on the closed event didn't return eventsvc.ErrEventNotOpen: %v", err)
+			}
+		})
+	}
+}
+
+func Test_service_AddPupilResources(t *testing.T) {
+	t.Parallel()
+	const (
+		eventID                = "123"
+		eventIDClosed          = "closed"
+		eventIDErrNoEventPupil = "errornoeventpupil"
+		eventIDErrNegative     = "errnegative"
+		eventIDGrams           = "grams"
+		pupilID                = "123"
+		userID                 = "user"
+	)
+	resourcesAllowed := []eventsvc.Resource{eventsvc.Plastic, eventsvc.Gadgets}
+	ctx := eventsvc.ContextWithUserID(context.Background(), userID)
+
+	var repository mock.EventingRepository
+	repository.AddPupilResourcesFn = func(ctx context.Context, entry *eventing.ResourceEntry,
+		msg broker.Message) error {
+		if msg.Topic != broker.TopicPupilResourcesAdded {
+			t.Errorf("AddPupilResources() msg.Topic = %v, want %v", msg.Topic, broker.TopicPupilResourcesAdded)
+		}
+		if entry.ID == "" || entry.RecordedAt.IsZero() {
+			t.Errorf("AddPupilResources() entry has no id or time: %+v", entry)
+		}
+		if entry.RecordedBy != userID {
+			t.Errorf("AddPupilResources() entry.RecordedBy = %v, want %v", entry.RecordedBy, userID)
+		}
+		switch entry.EventID {
+		case eventIDErrNoEventPupil:
+			return eventing.ErrNoEventPupil
+		case eventIDErrNegative:
+			return eventing.ErrNegativeResources
+		case eventIDGrams:
+			// 1500 grams of plastic are stored as 1.5 kilograms
+			if entry.Resources[eventsvc.Plastic] != 1.5 {
+				t.Errorf("AddPupilResources() entry.Resources = %v, want 1.5 kg of plastic", entry.Resources)
+			}
+		}
+		return nil
+	}
+	repository.ResourceTypesFn = catalogue
+	repository.EventByIDFn = func(ctx context.Context, id string) (event *eventing.Event, err error) {
+		status := eventsvc.Open
+		if id == eventIDClosed {
+			status = eventsvc.Closed
+		}
+		return &eventing.Event{Event: eventsvc.Event{ID: id, ResourcesAllowed: resourcesAllowed, Status: status}}, nil
+	}
+	s := eventing.NewService(&repository)
+
+	type args struct {
+		eventID   string
+		pupilID   string
+		resources eventsvc.ResourceMap
+		units     eventsvc.UnitMap
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantValidErr bool
+		wantErr      error
+	}{
+		{
+			name:         "no eventID",
+			args:         args{eventID: "", pupilID: pupilID, resources: eventsvc.ResourceMap{eventsvc.Plastic: 1}},
+			wantValidErr: true,
+		},
+		{
+			name:         "no resources",
+			args:         args{eventID: eventID, pupilID: pupilID},
+			wantValidErr: true,
+		},
+		{
+			name:         "zero resources",
+			args:         args{eventID: eventID, pupilID: pupilID, resources: eventsvc.ResourceMap{eventsvc.Paper: 0}},
+			wantValidErr: true,
+		},
+		{
+			name:         "resource is not allowed",
+			args:         args{eventID: eventID, pupilID: pupilID, resources: eventsvc.ResourceMap{eventsvc.Paper: -1}},
+			wantValidErr: true,
+		},
+		{
+			name:    "event is closed",
+			args:    args{eventID: eventIDClosed, pupilID: pupilID, resources: eventsvc.ResourceMap{eventsvc.Plastic: 1}},
+			wantErr: eventsvc.ErrEventNotOpen,
+		},
+		{
+			name: "ErrNoEventPupil",
+			args: args{eventID: eventIDErrNoEventPupil, pupilID: pupilID,
+				resources: eventsvc.ResourceMap{eventsvc.Plastic: 1}},
+			wantErr: eventsvc.ErrUnknownPupil,
+		},
+		{
+			name: "amounts become negative",
+			args: args{eventID: eventIDErrNegative, pupilID: pupilID,
+				resources: eventsvc.ResourceMap{eventsvc.Plastic: -100}},
+			wantErr: eventing.ErrNegativeResources,
+		},
+		{
+			name: "not allowed resource is zero",
+			args: args{eventID: eventID, pupilID: pupilID,
+				resources: eventsvc.ResourceMap{eventsvc.Plastic: 1.5, eventsvc.Paper: 0}},
+		},
+		{
+			name: "subtract resources",
+			args: args{eventID: eventID, pupilID: pupilID,
+				resources: eventsvc.ResourceMap{eventsvc.Plastic: -1.5, eventsvc.Gadgets: 2}},
+		},
+		{
+			name: "fractional pieces",
+			args: args{eventID: eventID, pupilID: pupilID,
+				resources: eventsvc.ResourceMap{eventsvc.Gadgets: 2.5}},
+			wantValidErr: true,
+		},
+		{
+			name: "pieces in pounds",
+			args: args{eventID: eventID, pupilID: pupilID, resources: eventsvc.ResourceMap{eventsvc.Gadgets: 2},
+				units: eventsvc.UnitMap{eventsvc.Gadgets: eventsvc.Pounds}},
+			wantValidErr: true,
+		},
+		{
+			name: "grams are converted",
+			args: args{eventID: eventIDGrams, pupilID: pupilID, resources: eventsvc.ResourceMap{eventsvc.Plastic: 1500},
+				units: eventsvc.UnitMap{eventsvc.Plastic: eventsvc.Grams}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.AddPupilResources(ctx, tt.args.eventID, tt.args.pupilID, tt.args.resources, tt.args.units)
+			var validErr *valid.ErrValidation
+			if errors.As(err, &validErr) != tt.wantValidErr {
+				t.Errorf("AddPupilResources() error = %v, wantValidErr %v", err, tt.wantValidErr)
+				return
+			}
+			if !tt.wantValidErr && !errors.Is(err, tt.wantErr) {
+				t.Errorf("AddPupilResources() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_service_ResourceEntries(t *testing.T) {
+	t.Parallel()
+	const (
+		eventID       = "open"
+		closedEventID = "closed"
+		pupilID       = "pupil"
+		entryID       = "entry"
+		voidedEntryID = "voided"
+		userID        = "user"
+	)
+	ctx := eventsvc.ContextWithUserID(context.Background(), userID)
+	entries := []*eventing.ResourceEntry{{ID: entryID, EventID: eventID, PupilID: pupilID}}
+
+	var repository mock.EventingRepository
+	repository.EventByIDFn = func(ctx context.Context, id string) (*eventing.Event, error) {
+		status := eventsvc.Open
+		if id == closedEventID {
+			status = eventsvc.Closed
+		}
+		return &eventing.Event{Event: eventsvc.Event{ID: id, Status: status,
+			ResourcesAllowed: []eventsvc.Resource{eventsvc.Paper}}}, nil
+	}
+	repository.ResourceTypesFn = catalogue
+	repository.PupilByIDFn = func(ctx context.Context, pupilID string, eventID string) (*eventing.Pupil, error) {
+		return &eventing.Pupil{Pupil: eventsvc.Pupil{ID: pupilID}}, nil
+	}
+	repository.PupilResourceEntriesFn = func(ctx context.Context, eventID,
+		pupilID string) ([]*eventing.ResourceEntry, error) {
+		return entries, nil
+	}
+	repository.VoidResourceEntryFn = func(ctx context.Context, entry *eventing.ResourceEntry,
+		msg broker.Message) error {
+		if msg.Topic != broker.TopicResourceEntryVoided {
+			t.Errorf("VoidResourceEntry() msg.Topic = %v, want %v", msg.Topic, broker.TopicResourceEntryVoided)
+		}
+		if entry.VoidedBy != userID || !entry.IsVoided() {
+			t.Errorf("VoidResourceEntry() entry = %+v, want voided by %v", entry, userID)
+		}
+		if entry.ID == voidedEntryID {
+			return eventing.ErrEntryVoided
+		}
+		return nil
+	}
+	repository.CorrectResourceEntryFn = func(ctx context.Context, correction *eventing.ResourceEntry,
+		msg broker.Message) error {
+		if msg.Topic != broker.TopicResourceEntryCorrected {
+			t.Errorf("CorrectResourceEntry() msg.Topic = %v, want %v", msg.Topic, broker.TopicResourceEntryCorrected)
+		}
+		if correction.ID == "" || correction.RecordedBy != userID {
+			t.Errorf("CorrectResourceEntry() correction = %+v, want an id and recorded by %v", correction, userID)
+		}
+		if correction.CorrectionOf == voidedEntryID {
+			return eventing.ErrEntryVoided
+		}
+		return nil
+	}
+	s := eventing.NewService(&repository)
+
+	t.Run("entries", func(t *testing.T) {
+		got, err := s.PupilResourceEntries(ctx, eventID, pupilID)
+		if err != nil || !reflect.DeepEqual(got, entries) {
+			t.Errorf("PupilResourceEntries() = %v, %v, want %v", got, err, entries)
+		}
+		var validErr *valid.ErrValidation
+		if _, err := s.PupilResourceEntries(ctx, eventID, ""); !errors.As(err, &validErr) {
+			t.Errorf("PupilResourceEntries() error = %v, want a validation error", err)
+		}
+	})
+
+	voidTests := []struct {
+		name         string
+		eventID      string
+		entryID      string
+		wantValidErr bool
+		wantErr      error
+	}{
+		{name: "no entryID", eventID: eventID, wantValidErr: true},
+		{name: "event is closed", eventID: closedEventID, entryID: entryID, wantErr: eventsvc.ErrEventNotOpen},
+		{name: "already voided", eventID: eventID, entryID: voidedEntryID, wantErr: eventing.ErrEntryVoided},
+		{name: "voided", eventID: eventID, entryID: entryID},
+	}
+	for _, tt := range voidTests {
+		t.Run("void "+tt.name, func(t *testing.T) {
+			err := s.VoidResourceEntry(ctx, tt.eventID, pupilID, tt.entryID)
+			var validErr *valid.ErrValidation
+			if errors.As(err, &validErr) != tt.wantValidErr {
+				t.Errorf("VoidResourceEntry() error = %v, wantValidErr %v", err, tt.wantValidErr)
+				return
+			}
+			if !tt.wantValidErr && !errors.Is(err, tt.wantErr) {
+				t.Errorf("VoidResourceEntry() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	correctTests := []struct {
+		name         string
+		eventID      string
+		entryID      string
+		resources    eventsvc.ResourceMap
+		wantValidErr bool
+		wantErr      error
+	}{
+		{name: "no entryID", eventID: eventID, resources: eventsvc.ResourceMap{eventsvc.Paper: 1},
+			wantValidErr: true},
+		{name: "zero resources", eventID: eventID, entryID: entryID, resources: eventsvc.ResourceMap{eventsvc.Paper: 0},
+			wantValidErr: true},
+		{name: "resource is not allowed", eventID: eventID, entryID: entryID,
+			resources: eventsvc.ResourceMap{eventsvc.Plastic: 1}, wantValidErr: true},
+		{name: "event is closed", eventID: closedEventID, entryID: entryID,
+			resources: eventsvc.ResourceMap{eventsvc.Paper: 1}, wantErr: eventsvc.ErrEventNotOpen},
+		{name: "already voided", eventID: eventID, entryID: voidedEntryID,
+			resources: eventsvc.ResourceMap{eventsvc.Paper: 1}, wantErr: eventing.ErrEntryVoided},
+		{name: "corrected", eventID: eventID, entryID: entryID, resources: eventsvc.ResourceMap{eventsvc.Paper: -1}},
+	}
+	for _, tt := range correctTests {
+		t.Run("correct "+tt.name, func(t *testing.T) {
+			id, err := s.CorrectResourceEntry(ctx, tt.eventID, pupilID, tt.entryID, tt.resources, nil)
+			var validErr *valid.ErrValidation
+			if errors.As(err, &validErr) != tt.wantValidErr {
+				t.Errorf("CorrectResourceEntry() error = %v, wantValidErr %v", err, tt.wantValidErr)
+				return
+			}
+			if !tt.wantValidErr && !errors.Is(err, tt.wantErr) {
+				t.Errorf("CorrectResourceEntry() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if (err == nil) != (id != "") {
+				t.Errorf("CorrectResourceEntry() id = %q, error = %v", id, err)
+			}
+		})
+	}
+}
+
+func Test_service_ChangeEventStatus(t *testing.T) {
+	t.Parallel()
+	const (
+		plannedEventID = "planned"
+		openEventID    = "open"
+		closedEventID  = "closed"
+		unknownEventID = "unknown"
+	)
+	statuses := map[string]eventsvc.EventStatus{
+		plannedEventID: eventsvc.Planned,
+		openEventID:    eventsvc.Open,
+		closedEventID:  eventsvc.Closed,
+	}
+	var repository mock.EventingRepository
+	repository.EventByIDFn = func(ctx context.Context, id string) (*eventing.Event, error) {
+		status, ok := statuses[id]
+		if !ok {
+			return nil, eventsvc.ErrUnknownEvent
+		}
+		return &eventing.Event{Event: eventsvc.Event{ID: id, Status: status}}, nil
+	}
+	repository.ChangeEventStatusFn = func(ctx context.Context, eventID string, from, to eventsvc.EventStatus,
+		msg broker.Message) error {
+		if msg.Topic != broker.TopicEventStatusChanged {
+			t.Errorf("ChangeEventStatus() msg.Topic = %v, want %v", msg.Topic, broker.TopicEventStatusChanged)
+		}
+		if from != statuses[eventID] {
+			t.Errorf("ChangeEventStatus() from = %v, want %v", from, statuses[eventID])
+		}
+		return nil
+	}
+	s := eventing.NewService(&repository)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		change  func(ctx context.Context, eventID string) error
+		eventID string
+		wantErr error
+	}{
+		{name: "open planned", change: s.OpenEvent, eventID: plannedEventID},
+		{name: "close open", change: s.CloseEvent, eventID: openEventID},
+		{name: "archive closed", change: s.ArchiveEvent, eventID: closedEventID},
+		{name: "close planned", change: s.CloseEvent, eventID: plannedEventID,
+			wantErr: eventsvc.ErrInvalidStatusTransition},
+		{name: "reopen closed", change: s.OpenEvent, eventID: closedEventID,
+			wantErr: eventsvc.ErrInvalidStatusTransition},
+		{name: "open open", change: s.OpenEvent, eventID: openEventID, wantErr: eventsvc.ErrInvalidStatusTransition},
+		{name: "unknown event", change: s.OpenEvent, eventID: unknownEventID, wantErr: eventsvc.ErrUnknownEvent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.change(ctx, tt.eventID); !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+	t.Run("no eventID", func(t *testing.T) {
+		var validErr *valid.ErrValidation
+		if err := s.OpenEvent(ctx, ""); !errors.As(err, &validErr) {
+			t.Errorf("OpenEvent() error = %v, want a validation error", err)
+		}
+	})
+}
+
+func Test_service_UpdateEvent(t *testing.T) {
+	t.Parallel()
+	const (
+		eventID       = "open"
+		closedEventID = "closed"
+		broughtID     = "brought"
+	)
+	// the class was formed a bit more than two years before the event, so it's 3b on the date of the event
+	eventDate := time.Now().AddDate(0, 1, 0)
+	class := eventsvc.Class{Letter: "b", DateFormed: eventDate.AddDate(-2, -1, 0)}
+	resources := []eventsvc.Resource{eventsvc.Paper}
+
+	var repository mock.EventingRepository
+	repository.EventByIDFn = func(ctx context.Context, id string) (*eventing.Event, error) {
+		status := eventsvc.Open
+		if id == closedEventID {
+			status = eventsvc.Closed
+		}
+		return &eventing.Event{Event: eventsvc.Event{ID: id, Date: eventDate, ResourcesAllowed: resources,
+			Status: status, Version: 2}}, nil
+	}
+	repository.ResourceTypesFn = catalogue
+	repository.ClassesWithResourcesFn = func(ctx context.Context, eventID string) ([]eventsvc.Class, error) {
+		return []eventsvc.Class{class}, nil
+	}
+	repository.UpdateEventFn = func(ctx context.Context, e *eventsvc.Event, msg broker.Message) error {
+		if msg.Topic != broker.TopicEventUpdated {
+			t.Errorf("UpdateEvent() msg.Topic = %v, want %v", msg.Topic, broker.TopicEventUpdated)
+		}
+		if e.Status != eventsvc.Open {
+			t.Errorf("UpdateEvent() e.Status = %v, want %v", e.Status, eventsvc.Open)
+		}
+		// the event is updated only if it hasn't been changed since it was read
+		if e.Version != 2 {
+			t.Errorf("UpdateEvent() e.Version = %v, want %v", e.Version, 2)
+		}
+		if e.ID == broughtID {
+			return &eventing.ResourcesBroughtError{Pupils: []*eventing.Pupil{{Pupil: eventsvc.Pupil{ID: "1"}}}}
+		}
+		return nil
+	}
+	s := eventing.NewService(&repository)
+	ctx := context.Background()
+
+	type args struct {
+		eventID   string
+		date      time.Time
+		name      string
+		resources []eventsvc.Resource
+		version   int
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantWarnings int
+		wantErr      error
+		wantValidErr bool
+	}{
+		{
+			name:         "no eventID",
+			args:         args{eventID: "", date: eventDate, name: "name", resources: resources},
+			wantValidErr: true,
+		},
+		{
+			name:         "no resources",
+			args:         args{eventID: eventID, date: eventDate, name: "name", resources: nil},
+			wantValidErr: true,
+		},
+		{
+			name:         "moved to the past",
+			args:         args{eventID: eventID, date: time.Now().AddDate(0, 0, -1), resources: resources},
+			wantValidErr: true,
+		},
+		{
+			name:    "closed event",
+			args:    args{eventID: closedEventID, date: eventDate, name: "name", resources: resources},
+			wantErr: eventsvc.ErrEventFrozen,
+		},
+		{
+			name:    "brought resource disallowed",
+			args:    args{eventID: broughtID, date: eventDate, resources: []eventsvc.Resource{eventsvc.Plastic}},
+			wantErr: eventing.ErrResourcesBrought,
+		},
+		{
+			name:    "changed in the meantime",
+			args:    args{eventID: eventID, date: eventDate, name: "new name", resources: resources, version: 1},
+			wantErr: eventsvc.ErrVersionMismatch,
+		},
+		{
+			name: "renamed",
+			args: args{eventID: eventID, date: eventDate, name: "new name", resources: resources},
+		},
+		{
+			name: "renamed the event of the given version",
+			args: args{eventID: eventID, date: eventDate, name: "new name", resources: resources, version: 2},
+		},
+		{
+			name: "moved within the school year",
+			args: args{eventID: eventID, date: eventDate.AddDate(0, 0, 7), resources: resources},
+		},
+		{
+			name:         "moved to the next school year",
+			args:         args{eventID: eventID, date: eventDate.AddDate(1, 0, 0), resources: resources},
+			wantWarnings: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := s.UpdateEvent(ctx, tt.args.eventID, tt.args.date, tt.args.name, tt.args.resources,
+				tt.args.version)
+			var validErr *valid.ErrValidation
+			if errors.As(err, &validErr) != tt.wantValidErr {
+				t.Errorf("UpdateEvent() error = %v, wantValidErr %v", err, tt.wantValidErr)
+				return
+			}
+			if !tt.wantValidErr && !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateEvent() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("UpdateEvent() warnings = %v, want %d warning(s)", warnings, tt.wantWarnings)
+			}
 		})
 	}
 }
@@ -391,20 +933,20 @@ func Test_service_EventPupils(t *testing.T) {
 
 	var repository mock.EventingRepository
 	repository.EventPupilsFn = func(ctx context.Context, eventID string,
-		filters eventing.EventPupilFilters, sortBy sorting.By, amount int, skip int) (pupils []*eventing.Pupil,
-		total int, err error) {
+		filters eventing.EventPupilFilters, sortBy sorting.By, page paging.Page) (pupils []*eventing.Pupil,
+		total int, nextToken string, err error) {
 
 		if eventID == "not_found" {
-			return nil, 0, errors.New("not found error")
+			return nil, 0, "", errors.New("not found error")
 		}
 		if eventID == "error" {
-			return nil, 0, errors.New("some error")
+			return nil, 0, "", errors.New("some error")
 		}
-		if amount < 0 {
-			return make([]*eventing.Pupil, 0), totalPupils, nil
+		if page.Size < 0 {
+			return make([]*eventing.Pupil, 0), totalPupils, "", nil
 		}
-		pupils = make([]*eventing.Pupil, amount)
-		return pupils, totalPupils, nil
+		pupils = make([]*eventing.Pupil, page.Size)
+		return pupils, totalPupils, "", nil
 	}
 	s := eventing.NewService(&repository)
 
@@ -415,6 +957,7 @@ func Test_service_EventPupils(t *testing.T) {
 		sortBy  sorting.By
 		amount  int
 		skip    int
+		token   string
 	}
 	tests := []struct {
 		name          string
@@ -475,6 +1018,19 @@ func Test_service_EventPupils(t *testing.T) {
 			wantTotal:     totalPupils,
 			wantErr:       false,
 		},
+		{
+			name: "page token of another sorting",
+			args: args{
+				ctx:     ctx,
+				eventID: eventID,
+				sortBy:  sorting.NameDes,
+				amount:  amount,
+				token:   testPageToken(t, sorting.NameAsc),
+			},
+			wantPupilsLen: 0,
+			wantTotal:     0,
+			wantErr:       true,
+		},
 		{
 			name: "repo's internal error",
 			args: args{
@@ -517,8 +1073,8 @@ func Test_service_EventPupils(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotPupils, gotTotal, err := s.EventPupils(tt.args.ctx, tt.args.eventID, tt.args.filters, tt.args.sortBy,
-				tt.args.amount, tt.args.skip)
+			gotPupils, gotTotal, _, err := s.EventPupils(tt.args.ctx, tt.args.eventID, tt.args.filters, tt.args.sortBy,
+				paging.Page{Size: tt.args.amount, Skip: tt.args.skip, Token: tt.args.token})
 			gotPupilsLen := len(gotPupils)
 
 			if (err != nil) != tt.wantErr {
@@ -535,6 +1091,74 @@ func Test_service_EventPupils(t *testing.T) {
 	}
 }
 
+func Test_service_StreamEventPupils(t *testing.T) {
+	t.Parallel()
+	ctx := eventsvc.ContextWithScope(context.Background(), eventsvc.PupilScope("linked"))
+
+	var repository mock.EventingRepository
+	repository.StreamEventPupilsFn = func(ctx context.Context, eventID string, filters eventing.EventPupilFilters,
+		sortBy sorting.By, fn func(*eventing.Pupil) error) error {
+
+		if sortBy != sorting.NameAsc {
+			t.Errorf("StreamEventPupils() sortBy = %v, want %v", sortBy, sorting.NameAsc)
+		}
+		if !reflect.DeepEqual(filters.Scope, eventsvc.ScopeFromContext(ctx)) {
+			t.Errorf("StreamEventPupils() filters.Scope = %v, want the scope from ctx", filters.Scope)
+		}
+		for _, id := range []string{"1", "2"} {
+			if err := fn(&eventing.Pupil{Pupil: eventsvc.Pupil{ID: id}}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	s := eventing.NewService(&repository)
+
+	tests := []struct {
+		name        string
+		eventID     string
+		wantPupils  []string
+		wantErr     bool
+		wantInvoked bool
+	}{
+		{
+			name:        "ok",
+			eventID:     "123",
+			wantPupils:  []string{"1", "2"},
+			wantInvoked: true,
+		},
+		{
+			name:    "no event id",
+			eventID: "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository.StreamEventPupilsInvoked = false
+			var got []string
+			err := s.StreamEventPupils(ctx, tt.eventID, eventing.EventPupilFilters{}, sorting.PerPupilDes,
+				func(p *eventing.Pupil) error {
+					got = append(got, p.ID)
+					return nil
+				})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StreamEventPupils() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.As(err, new(*valid.ErrValidation)) {
+				t.Errorf("StreamEventPupils() error = %v, want a validation error", err)
+			}
+			if repository.StreamEventPupilsInvoked != tt.wantInvoked {
+				t.Errorf("StreamEventPupils() invoked the repo = %v, want %v", repository.StreamEventPupilsInvoked,
+					tt.wantInvoked)
+			}
+			if !reflect.DeepEqual(got, tt.wantPupils) {
+				t.Errorf("StreamEventPupils() pupils = %v, want %v", got, tt.wantPupils)
+			}
+		})
+	}
+}
+
 func Test_service_EventClasses(t *testing.T) {
 	t.Parallel()
 	const (
@@ -548,20 +1172,20 @@ func Test_service_EventClasses(t *testing.T) {
 
 	var repository mock.EventingRepository
 	repository.EventClassesFn = func(ctx context.Context, eventID string,
-		filters eventing.EventClassFilters, sortBy sorting.By, amount int, skip int) (classes []*eventing.Class,
-		total int, err error) {
+		filters eventing.EventClassFilters, sortBy sorting.By, page paging.Page) (classes []*eventing.Class,
+		total int, nextToken string, err error) {
 
 		if eventID == "not_found" {
-			return nil, 0, errors.New("not found error")
+			return nil, 0, "", errors.New("not found error")
 		}
 		if eventID == "error" {
-			return nil, 0, errors.New("some error")
+			return nil, 0, "", errors.New("some error")
 		}
-		if amount < 0 {
-			return make([]*eventing.Class, 0), totalClasses, nil
+		if page.Size < 0 {
+			return make([]*eventing.Class, 0), totalClasses, "", nil
 		}
-		classes = make([]*eventing.Class, amount)
-		return classes, totalClasses, nil
+		classes = make([]*eventing.Class, page.Size)
+		return classes, totalClasses, "", nil
 	}
 	s := eventing.NewService(&repository)
 
@@ -674,8 +1298,8 @@ func Test_service_EventClasses(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotClasses, gotTotal, err := s.EventClasses(tt.args.ctx, tt.args.eventID, tt.args.filters,
-				tt.args.sortBy, tt.args.amount, tt.args.skip)
+			gotClasses, gotTotal, _, err := s.EventClasses(tt.args.ctx, tt.args.eventID, tt.args.filters,
+				tt.args.sortBy, paging.Page{Size: tt.args.amount, Skip: tt.args.skip})
 			gotClassesLen := len(gotClasses)
 
 			if (err != nil) != tt.wantErr {
@@ -714,6 +1338,11 @@ func Test_service_PupilByID(t *testing.T) {
 		}
 		return foundPupil, nil
 	}
+	entries := []*eventing.ResourceEntry{{ID: "1", Resources: eventsvc.ResourceMap{eventsvc.Paper: 2}}}
+	repository.PupilResourceEntriesFn = func(ctx context.Context, eventID,
+		pupilID string) ([]*eventing.ResourceEntry, error) {
+		return entries, nil
+	}
 	s := eventing.NewService(&repository)
 
 	type args struct {
@@ -773,6 +1402,116 @@ func Test_service_PupilByID(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("PupilByID() got = %v, want %v", got, tt.want)
 			}
+			if got != nil && !reflect.DeepEqual(got.ResourceEntries, entries) {
+				t.Errorf("PupilByID() entries = %v, want %v", got.ResourceEntries, entries)
+			}
 		})
 	}
 }
+
+func Test_service_Scope(t *testing.T) {
+	t.Parallel()
+	const (
+		eventID = "123"
+		pupilID = "456"
+	)
+	eventDate := time.Date(2020, 10, 10, 0, 0, 0, 0, time.UTC)
+	// the pupil was in 3B on the date of the event, so the class was formed in 2018
+	pupil := &eventing.Pupil{Pupil: eventsvc.Pupil{ID: pupilID}, Class: "3b"}
+	ownClass := eventsvc.Class{Letter: "b", DateFormed: time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC)}
+	anotherClass := eventsvc.Class{Letter: "b", DateFormed: time.Date(2017, 9, 1, 0, 0, 0, 0, time.UTC)}
+
+	var repository mock.EventingRepository
+	repository.ChangePupilResourcesFn = func(ctx context.Context, entry *eventing.ResourceEntry, version int,
+		msg broker.Message) error {
+		return nil
+	}
+	repository.EventByIDFn = func(ctx context.Context, id string) (event *eventing.Event, err error) {
+		return &eventing.Event{
+			Event: eventsvc.Event{ID: id, Date: eventDate, ResourcesAllowed: []eventsvc.Resource{eventsvc.Paper},
+				Status: eventsvc.Open},
+		}, nil
+	}
+	repository.ResourceTypesFn = catalogue
+	repository.EventPupilsFn = func(ctx context.Context, eventID string, filters eventing.EventPupilFilters,
+		sortBy sorting.By, page paging.Page) (pupils []*eventing.Pupil, total int, nextToken string, err error) {
+		if !reflect.DeepEqual(filters.Scope, eventsvc.ScopeFromContext(ctx)) {
+			t.Errorf("EventPupils() filters.Scope = %v, want the scope from ctx", filters.Scope)
+		}
+		return nil, 0, "", nil
+	}
+	repository.PupilByIDFn = func(ctx context.Context, pupilID string, eventID string) (*eventing.Pupil, error) {
+		return pupil, nil
+	}
+	repository.PupilResourceEntriesFn = func(ctx context.Context, eventID,
+		pupilID string) ([]*eventing.ResourceEntry, error) {
+		return nil, nil
+	}
+	s := eventing.NewService(&repository)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{
+			name:    "unrestricted",
+			ctx:     context.Background(),
+			wantErr: nil,
+		},
+		{
+			name:    "pupil of the user's class",
+			ctx:     eventsvc.ContextWithScope(context.Background(), eventsvc.RestrictedScope(anotherClass, ownClass)),
+			wantErr: nil,
+		},
+		{
+			name:    "pupil of another class",
+			ctx:     eventsvc.ContextWithScope(context.Background(), eventsvc.RestrictedScope(anotherClass)),
+			wantErr: eventsvc.ErrOutOfScope,
+		},
+		{
+			name:    "no classes",
+			ctx:     eventsvc.ContextWithScope(context.Background(), eventsvc.RestrictedScope()),
+			wantErr: eventsvc.ErrOutOfScope,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.ChangePupilResources(tt.ctx, eventID, pupilID, eventsvc.ResourceMap{eventsvc.Paper: 5}, nil, 0)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ChangePupilResources() error = %v, want %v", err, tt.wantErr)
+			}
+			_, err = s.PupilByID(tt.ctx, pupilID, eventID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("PupilByID() error = %v, want %v", err, tt.wantErr)
+			}
+			if _, _, _, err := s.EventPupils(tt.ctx, eventID, eventing.EventPupilFilters{}, sorting.NameAsc,
+				paging.Page{Size: 10}); err != nil {
+				t.Errorf("EventPupils() error = %v", err)
+			}
+		})
+	}
+}
+
+// catalogue returns the catalogue of the resources the db is seeded with
+func catalogue(ctx context.Context) ([]*eventsvc.ResourceType, error) {
+	return []*eventsvc.ResourceType{
+		{Name: eventsvc.Gadgets, Unit: eventsvc.Pieces},
+		{Name: eventsvc.Paper, Unit: eventsvc.Kilograms},
+		{Name: eventsvc.Plastic, Unit: eventsvc.Kilograms},
+	}, nil
+}
+
+// testPageToken returns the token of a page of the pupils sorted by the sorting
+func testPageToken(t *testing.T, sortBy sorting.By) string {
+	t.Helper()
+	c, err := paging.NewCursor(string(sortBy), []byte(`["Smith", "John", "id"]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := c.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
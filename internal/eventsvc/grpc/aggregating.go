@@ -8,8 +8,8 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
-	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/aggregating"
+	"github.com/shanvl/garbage/pkg/paging"
 )
 
 // FindClasses returns a list of sorted classes, each of which has a list of events that passed the given filters
@@ -26,17 +26,16 @@ func (s *Server) FindClasses(ctx context.Context, req *eventsv1pb.FindClassesReq
 		return nil, s.handleError(err)
 	}
 	// call the svc
-	classes, total, err := s.aggrSvc.Classes(
+	classes, total, nextToken, err := s.aggrSvc.Classes(
 		ctx,
 		aggregating.ClassFilters{
 			EventFilters: eventFilters,
 			Letter:       req.GetLetter(),
 			DateFormed:   classDateFormed,
 		},
-		protoClassSortingMap[req.GetSorting()],
-		protoEventSortingMap[req.GetEventSorting()],
-		int(req.GetAmount()),
-		int(req.GetSkip()),
+		protoToClassSorting(req.GetSorting(), req.GetThenSorting(), req.GetSortingResource()),
+		protoToEventSorting(req.GetEventSorting(), req.GetThenEventSorting(), req.GetEventSortingResource()),
+		paging.FromRequest(req),
 	)
 	if err != nil {
 		return nil, s.handleError(err)
@@ -51,8 +50,9 @@ func (s *Server) FindClasses(ctx context.Context, req *eventsv1pb.FindClassesReq
 		pbClasses[i] = pbClass
 	}
 	return &eventsv1pb.FindClassesResponse{
-		Classes: pbClasses,
-		Total:   uint32(total),
+		Classes:       pbClasses,
+		Total:         uint32(total),
+		NextPageToken: nextToken,
 	}, nil
 }
 
@@ -66,8 +66,8 @@ func (s *Server) FindEvents(ctx context.Context, req *eventsv1pb.FindEventsReque
 		return nil, s.handleError(err)
 	}
 	// call the svc
-	events, total, err := s.aggrSvc.Events(ctx, eventFilters, protoEventSortingMap[req.GetSorting()], int(req.GetAmount()),
-		int(req.GetSkip()))
+	events, total, nextToken, err := s.aggrSvc.Events(ctx, eventFilters,
+		protoToEventSorting(req.GetSorting(), req.GetThenSorting(), req.GetSortingResource()), paging.FromRequest(req))
 	if err != nil {
 		return nil, s.handleError(err)
 	}
@@ -81,8 +81,9 @@ func (s *Server) FindEvents(ctx context.Context, req *eventsv1pb.FindEventsReque
 		pbEvents[i] = pbEvent
 	}
 	return &eventsv1pb.FindEventsResponse{
-		Events: pbEvents,
-		Total:  uint32(total),
+		Events:        pbEvents,
+		Total:         uint32(total),
+		NextPageToken: nextToken,
 	}, nil
 }
 
@@ -96,14 +97,13 @@ func (s *Server) FindPupils(ctx context.Context, req *eventsv1pb.FindPupilsReque
 		return nil, s.handleError(err)
 	}
 	// call the svc
-	pupils, total, err := s.aggrSvc.Pupils(ctx,
+	pupils, total, nextToken, err := s.aggrSvc.Pupils(ctx,
 		aggregating.PupilFilters{
 			EventFilters: eventFilters,
 			NameAndClass: req.GetNameAndClass(),
-		}, protoPupilSortingMap[req.GetSorting()],
-		protoEventSortingMap[req.GetEventSorting()],
-		int(req.GetAmount()),
-		int(req.GetSkip()),
+		}, protoToPupilSorting(req.GetSorting(), req.GetThenSorting(), req.GetSortingResource()),
+		protoToEventSorting(req.GetEventSorting(), req.GetThenEventSorting(), req.GetEventSortingResource()),
+		paging.FromRequest(req),
 	)
 	if err != nil {
 		return nil, s.handleError(err)
@@ -117,7 +117,40 @@ func (s *Server) FindPupils(ctx context.Context, req *eventsv1pb.FindPupilsReque
 		}
 		pbPupils[i] = pbPupil
 	}
-	return &eventsv1pb.FindPupilsResponse{Pupils: pbPupils, Total: uint32(total)}, nil
+	return &eventsv1pb.FindPupilsResponse{Pupils: pbPupils, Total: uint32(total), NextPageToken: nextToken}, nil
+}
+
+// StreamPupils sends all the sorted pupils, each of which has a list of events that passed the given filters, one
+// pupil per message. Sending blocks while the client falls behind, so the pupils aren't read from the db faster than
+// the client receives them
+func (s *Server) StreamPupils(req *eventsv1pb.StreamPupilsRequest, stream eventsv1pb.
+	EventsService_StreamPupilsServer) error {
+
+	// proto to args
+	eventFilters, err := protoToEventFilters(req.GetEventFilters())
+	if err != nil {
+		return s.handleError(err)
+	}
+	// call the svc, which calls back with each of the pupils
+	err = s.aggrSvc.StreamPupils(stream.Context(),
+		aggregating.PupilFilters{
+			EventFilters: eventFilters,
+			NameAndClass: req.GetNameAndClass(),
+		}, protoToPupilSorting(req.GetSorting(), req.GetThenSorting(), req.GetSortingResource()),
+		protoToEventSorting(req.GetEventSorting(), req.GetThenEventSorting(), req.GetEventSortingResource()),
+		func(pupil *aggregating.Pupil) error {
+			// result to proto
+			pbPupil, err := pupilAggrToProto(pupil)
+			if err != nil {
+				return err
+			}
+			return stream.Send(&eventsv1pb.StreamPupilsResponse{Pupil: pbPupil})
+		},
+	)
+	if err != nil {
+		return s.handleStreamError(stream.Context(), err)
+	}
+	return nil
 }
 
 // FindPupilByID returns a pupil with the given ID and a list of events they has attended
@@ -130,7 +163,8 @@ func (s *Server) FindPupilByID(ctx context.Context, req *eventsv1pb.FindPupilByI
 		return nil, s.handleError(err)
 	}
 	// call the svc
-	pupil, err := s.aggrSvc.PupilByID(ctx, req.GetId(), eventFilters, protoEventSortingMap[req.GetEventSorting()])
+	pupil, err := s.aggrSvc.PupilByID(ctx, req.GetId(), eventFilters,
+		protoToEventSorting(req.GetEventSorting(), req.GetThenEventSorting(), req.GetEventSortingResource()))
 	if err != nil {
 		return nil, s.handleError(err)
 	}
@@ -186,8 +220,9 @@ func classAggrToProto(class *aggregating.Class) (*eventsv1pb.ClassAggr, error) {
 	return &eventsv1pb.ClassAggr{
 		Letter:           class.Letter,
 		DateFormed:       pbDateFormed,
-		ResourcesBrought: resourceMapToProto(class.ResourcesBrought),
+		ResourcesBrought: resourceMapToProto(class.ResourcesBrought, class.Units),
 		Events:           pbEvents,
+		Stats:            classStatsToProto(class.Stats, class.Units),
 	}, nil
 }
 
@@ -205,11 +240,8 @@ func eventAggrToProto(event *aggregating.Event) (*eventsv1pb.Event, error) {
 		Date:             date,
 		Name:             event.Name,
 		ResourcesAllowed: resourcesToProto(event.ResourcesAllowed),
-		ResourcesBrought: &eventsv1pb.ResourcesBrought{
-			Gadgets: event.ResourcesBrought[eventsvc.Gadgets],
-			Paper:   event.ResourcesBrought[eventsvc.Paper],
-			Plastic: event.ResourcesBrought[eventsvc.Plastic],
-		},
+		ResourcesBrought: resourceMapToProto(event.ResourcesBrought, event.Units),
+		Status:           eventStatusProtoMap[event.Status],
 	}, nil
 }
 
@@ -235,8 +267,9 @@ func pupilAggrToProto(pupil *aggregating.Pupil) (*eventsv1pb.PupilAggr, error) {
 		LastName:         pupil.LastName,
 		ClassLetter:      pupil.Letter,
 		ClassDateFormed:  pbClassDateFormed,
-		ResourcesBrought: resourceMapToProto(pupil.ResourcesBrought),
+		ResourcesBrought: resourceMapToProto(pupil.ResourcesBrought, pupil.Units),
 		Events:           pbEvents,
+		Version:          uint32(pupil.Version),
 	}, nil
 }
 
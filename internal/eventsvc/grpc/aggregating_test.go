@@ -11,6 +11,8 @@ import (
 	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/aggregating"
 	"github.com/shanvl/garbage/internal/eventsvc/sorting"
+	"github.com/shanvl/garbage/pkg/paging"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -59,10 +61,12 @@ func TestServer_FindClasses(t *testing.T) {
 					Name:             "ev",
 					ResourcesAllowed: resourcesToProto([]eventsvc.Resource{eventsvc.Gadgets, eventsvc.Plastic}),
 				},
-				Sorting:      eventsv1pb.ClassSorting_CLASS_SORTING_PAPER,
-				EventSorting: eventsv1pb.EventSorting_EVENT_SORTING_PAPER,
-				Amount:       50,
-				Skip:         10,
+				Sorting:              eventsv1pb.ClassSorting_CLASS_SORTING_RESOURCE,
+				SortingResource:      "paper",
+				EventSorting:         eventsv1pb.EventSorting_EVENT_SORTING_RESOURCE,
+				EventSortingResource: "paper",
+				Amount:               50,
+				Skip:                 10,
 			},
 			code: codes.OK,
 		},
@@ -88,7 +92,7 @@ func TestServer_FindClasses(t *testing.T) {
 					From:             nil,
 					To:               nil,
 					Name:             "",
-					ResourcesAllowed: []eventsv1pb.Resource{eventsv1pb.Resource_RESOURCE_UNKNOWN},
+					ResourcesAllowed: []string{"unknown resource"},
 				},
 				Sorting:      0,
 				EventSorting: 0,
@@ -166,9 +170,10 @@ func TestServer_FindEvents(t *testing.T) {
 					Name:             "ev",
 					ResourcesAllowed: resourcesToProto([]eventsvc.Resource{eventsvc.Gadgets, eventsvc.Plastic}),
 				},
-				Sorting: eventsv1pb.EventSorting_EVENT_SORTING_GADGETS,
-				Amount:  50,
-				Skip:    10,
+				Sorting:         eventsv1pb.EventSorting_EVENT_SORTING_RESOURCE,
+				SortingResource: "gadgets",
+				Amount:          50,
+				Skip:            10,
 			},
 			code: codes.OK,
 		},
@@ -181,9 +186,10 @@ func TestServer_FindEvents(t *testing.T) {
 					Name:             "deedee megadoodoo",
 					ResourcesAllowed: resourcesToProto([]eventsvc.Resource{eventsvc.Gadgets, eventsvc.Plastic}),
 				},
-				Sorting: eventsv1pb.EventSorting_EVENT_SORTING_GADGETS,
-				Amount:  50,
-				Skip:    10,
+				Sorting:         eventsv1pb.EventSorting_EVENT_SORTING_RESOURCE,
+				SortingResource: "gadgets",
+				Amount:          50,
+				Skip:            10,
 			},
 			code: codes.OK,
 		},
@@ -194,7 +200,7 @@ func TestServer_FindEvents(t *testing.T) {
 					From:             testTimeToProto(t, time.Now().AddDate(-5, 0, 0)),
 					To:               testTimeToProto(t, time.Now().AddDate(5, 0, 0)),
 					Name:             "ev",
-					ResourcesAllowed: []eventsv1pb.Resource{eventsv1pb.Resource_RESOURCE_UNKNOWN},
+					ResourcesAllowed: []string{"unknown resource"},
 				},
 				Sorting: 0,
 				Amount:  0,
@@ -202,6 +208,14 @@ func TestServer_FindEvents(t *testing.T) {
 			},
 			code: codes.InvalidArgument,
 		},
+		{
+			name: "invalid page token",
+			req: &eventsv1pb.FindEventsRequest{
+				PageSize:  10,
+				PageToken: "invalid",
+			},
+			code: codes.InvalidArgument,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -233,6 +247,33 @@ func TestServer_FindEvents(t *testing.T) {
 			}
 		})
 	}
+	t.Run("page token", func(t *testing.T) {
+		req := &eventsv1pb.FindEventsRequest{
+			Sorting:   eventsv1pb.EventSorting_EVENT_SORTING_NAME_DESC,
+			PageSize:  1,
+			SkipTotal: true,
+		}
+		first, err := server.FindEvents(ctx, req)
+		if err != nil {
+			t.Fatalf("FindEvents() error == %v", err)
+		}
+		if len(first.GetEvents()) != 1 || first.GetNextPageToken() == "" || first.GetTotal() != 0 {
+			t.Fatalf("FindEvents() == %v, want one event, the next page token and no total", first)
+		}
+		req.PageToken = first.GetNextPageToken()
+		next, err := server.FindEvents(ctx, req)
+		if err != nil {
+			t.Fatalf("FindEvents() error == %v", err)
+		}
+		if len(next.GetEvents()) == 1 && next.GetEvents()[0].GetId() == first.GetEvents()[0].GetId() {
+			t.Errorf("FindEvents() next page == %v, want it to follow the first one", next)
+		}
+		// the token can't be used with another sorting
+		req.Sorting = eventsv1pb.EventSorting_EVENT_SORTING_NAME_ASC
+		if _, err := server.FindEvents(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("FindEvents() code == %v, want == %v", status.Code(err), codes.InvalidArgument)
+		}
+	})
 }
 
 func TestServer_FindPupils(t *testing.T) {
@@ -288,10 +329,12 @@ func TestServer_FindPupils(t *testing.T) {
 					Name:             "ev",
 					ResourcesAllowed: resourcesToProto([]eventsvc.Resource{eventsvc.Gadgets, eventsvc.Plastic}),
 				},
-				Sorting:      eventsv1pb.PupilSorting_PUPIL_SORTING_GADGETS,
-				EventSorting: eventsv1pb.EventSorting_EVENT_SORTING_GADGETS,
-				Amount:       50,
-				Skip:         10,
+				Sorting:              eventsv1pb.PupilSorting_PUPIL_SORTING_RESOURCE,
+				SortingResource:      "gadgets",
+				EventSorting:         eventsv1pb.EventSorting_EVENT_SORTING_RESOURCE,
+				EventSortingResource: "gadgets",
+				Amount:               50,
+				Skip:                 10,
 			},
 			code: codes.OK,
 		},
@@ -303,12 +346,14 @@ func TestServer_FindPupils(t *testing.T) {
 					From:             testTimeToProto(t, time.Now().AddDate(-5, 0, 0)),
 					To:               testTimeToProto(t, time.Now().AddDate(5, 0, 0)),
 					Name:             "ev",
-					ResourcesAllowed: []eventsv1pb.Resource{eventsv1pb.Resource_RESOURCE_UNKNOWN},
+					ResourcesAllowed: []string{"unknown resource"},
 				},
-				Sorting:      eventsv1pb.PupilSorting_PUPIL_SORTING_GADGETS,
-				EventSorting: eventsv1pb.EventSorting_EVENT_SORTING_GADGETS,
-				Amount:       50,
-				Skip:         10,
+				Sorting:              eventsv1pb.PupilSorting_PUPIL_SORTING_RESOURCE,
+				SortingResource:      "gadgets",
+				EventSorting:         eventsv1pb.EventSorting_EVENT_SORTING_RESOURCE,
+				EventSortingResource: "gadgets",
+				Amount:               50,
+				Skip:                 10,
 			},
 			code: codes.InvalidArgument,
 		},
@@ -345,6 +390,74 @@ func TestServer_FindPupils(t *testing.T) {
 	}
 }
 
+func TestServer_StreamPupils(t *testing.T) {
+	testCases := []struct {
+		name string
+		req  *eventsv1pb.StreamPupilsRequest
+		code codes.Code
+	}{
+		{
+			name: "all filters are set",
+			req: &eventsv1pb.StreamPupilsRequest{
+				NameAndClass: "an a",
+				EventFilters: &eventsv1pb.EventFilters{
+					From:             testTimeToProto(t, time.Now().AddDate(-5, 0, 0)),
+					To:               testTimeToProto(t, time.Now().AddDate(5, 0, 0)),
+					Name:             "ev",
+					ResourcesAllowed: resourcesToProto([]eventsvc.Resource{eventsvc.Gadgets, eventsvc.Plastic}),
+				},
+				Sorting:              eventsv1pb.PupilSorting_PUPIL_SORTING_RESOURCE,
+				SortingResource:      "gadgets",
+				EventSorting:         eventsv1pb.EventSorting_EVENT_SORTING_RESOURCE,
+				EventSortingResource: "gadgets",
+			},
+			code: codes.OK,
+		},
+		{
+			name: "testUnknown resource",
+			req: &eventsv1pb.StreamPupilsRequest{
+				EventFilters: &eventsv1pb.EventFilters{ResourcesAllowed: []string{"unknown resource"}},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "no filters",
+			req:  &eventsv1pb.StreamPupilsRequest{},
+			code: codes.OK,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stream := &testPupilsStream{ctx: context.Background()}
+			err := server.StreamPupils(tc.req, stream)
+			if st, _ := status.FromError(err); st.Code() != tc.code {
+				t.Fatalf("StreamPupils() err codes mismatch: code == %v, want == %v", st.Code(), tc.code)
+			}
+			for i, res := range stream.sent {
+				if res.GetPupil() == nil {
+					t.Errorf("StreamPupils() [%d] pupil == nil, want != nil", i)
+				}
+			}
+		})
+	}
+}
+
+// testPupilsStream is a stream of the pupils which keeps the messages sent
+type testPupilsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*eventsv1pb.StreamPupilsResponse
+}
+
+func (s *testPupilsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testPupilsStream) Send(res *eventsv1pb.StreamPupilsResponse) error {
+	s.sent = append(s.sent, res)
+	return nil
+}
+
 func TestServer_FindPupilByID(t *testing.T) {
 	ctx := context.Background()
 	pupilID := testGetPupilID(t)
@@ -402,7 +515,7 @@ func TestServer_FindPupilByID(t *testing.T) {
 					From:             nil,
 					To:               nil,
 					Name:             "",
-					ResourcesAllowed: []eventsv1pb.Resource{eventsv1pb.Resource_RESOURCE_UNKNOWN},
+					ResourcesAllowed: []string{"unknown resource"},
 				},
 				EventSorting: 0,
 			},
@@ -443,8 +556,8 @@ func TestServer_FindPupilByID(t *testing.T) {
 
 func testGetPupilID(t *testing.T) string {
 	t.Helper()
-	pupils, _, err := aggregatingRepo.Pupils(context.Background(), aggregating.PupilFilters{}, sorting.NameDes,
-		sorting.NameDes, 1, 0)
+	pupils, _, _, err := aggregatingRepo.Pupils(context.Background(), aggregating.PupilFilters{}, sorting.NameDes,
+		sorting.NameDes, paging.Page{Size: 1})
 	if err != nil || len(pupils) == 0 {
 		t.Fatalf("could't find a pupil: %v", err)
 	}
@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
+	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/pkg/audit"
+	"google.golang.org/grpc"
+)
+
+// FindAuditEntries returns the records of the changes made by calling the mutating RPCs of the service
+func (s *Server) FindAuditEntries(ctx context.Context, req *eventsv1pb.FindAuditEntriesRequest) (*eventsv1pb.
+	FindAuditEntriesResponse, error) {
+
+	filters, err := protoToAuditFilters(req)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	entries, total, err := s.auditSvc.Entries(ctx, filters, int(req.GetAmount()), int(req.GetSkip()))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	pbEntries := make([]*eventsv1pb.AuditEntry, len(entries))
+	for i, entry := range entries {
+		pbEntry, err := auditEntryToProto(entry)
+		if err != nil {
+			return nil, s.handleError(err)
+		}
+		pbEntries[i] = pbEntry
+	}
+	return &eventsv1pb.FindAuditEntriesResponse{Entries: pbEntries, Total: uint32(total)}, nil
+}
+
+// auditUnaryInterceptor records the successful calls of the mutating RPCs. It relies on the claims put to ctx by
+// the auth interceptor
+func (s *Server) auditUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return audit.UnaryServerInterceptor(s.auditSvc, s.auditSpecs(), func(ctx context.Context) (string, string) {
+		claims, err := authClaimsFromCtx(ctx)
+		if err != nil {
+			return "", ""
+		}
+		return claims.UserID, claims.ClientID
+	}, s.log)
+}
+
+// auditSpecs lists the mutating RPCs of the service. The snapshots are taken with the finders of the service, so
+// that the states of the targets are recorded the way the clients see them
+func (s *Server) auditSpecs() map[string]audit.Spec {
+	eventSnapshot := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.FindEventByID(ctx, &eventsv1pb.FindEventByIDRequest{Id: req.(interface{ GetId() string }).GetId()})
+	}
+	eventPupilSnapshot := func(ctx context.Context, req interface{}) (interface{}, error) {
+		r := req.(interface {
+			GetEventId() string
+			GetPupilId() string
+		})
+		return s.FindEventPupilByID(ctx, &eventsv1pb.FindEventPupilByIDRequest{
+			EventId: r.GetEventId(),
+			PupilId: r.GetPupilId(),
+		})
+	}
+	resourceTypeSnapshot := func(ctx context.Context, req interface{}) (interface{}, error) {
+		resp, err := s.FindResourceTypes(ctx, &eventsv1pb.FindResourceTypesRequest{})
+		if err != nil {
+			return nil, err
+		}
+		name := req.(interface{ GetName() string }).GetName()
+		for _, resourceType := range resp.GetResourceTypes() {
+			if resourceType.GetName() == name {
+				return resourceType, nil
+			}
+		}
+		return nil, eventsvc.ErrUnknownResourceType
+	}
+	// the resource types are identified by their names
+	resourceTypeTargets := func(ctx context.Context, req, resp interface{}) []string {
+		return []string{req.(interface{ GetName() string }).GetName()}
+	}
+	return map[string]audit.Spec{
+		eventsSvcPrefix + "AddPupilResources": {Snapshot: eventPupilSnapshot},
+		eventsSvcPrefix + "AddPupils":         {},
+		eventsSvcPrefix + "ArchiveEvent":      {Snapshot: eventSnapshot},
+		eventsSvcPrefix + "ChangePupilClass": {Snapshot: func(ctx context.Context, req interface{}) (interface{},
+			error) {
+
+			resp, err := s.FindPupilByID(ctx, &eventsv1pb.FindPupilByIDRequest{
+				Id: req.(*eventsv1pb.ChangePupilClassRequest).GetPupilId(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			// only the class is changed by the call
+			pupil := resp.GetPupil()
+			pupil.Events, pupil.ResourcesBrought = nil, nil
+			return pupil, nil
+		}},
+		eventsSvcPrefix + "ChangePupilResources": {Snapshot: eventPupilSnapshot},
+		eventsSvcPrefix + "CloseEvent":           {Snapshot: eventSnapshot},
+		eventsSvcPrefix + "CorrectResourceEntry": {Snapshot: eventPupilSnapshot},
+		eventsSvcPrefix + "CreateEvent":          {},
+		eventsSvcPrefix + "CreateResourceType": {Targets: func(ctx context.Context, req, resp interface{}) []string {
+			return []string{req.(*eventsv1pb.CreateResourceTypeRequest).GetResourceType().GetName()}
+		}},
+		eventsSvcPrefix + "DeleteEvent":        {Snapshot: eventSnapshot},
+		eventsSvcPrefix + "DeleteResourceType": {Snapshot: resourceTypeSnapshot, Targets: resourceTypeTargets},
+		eventsSvcPrefix + "OpenEvent":          {Snapshot: eventSnapshot},
+		eventsSvcPrefix + "RemovePupils":       {},
+		eventsSvcPrefix + "SetEventMultiplier": {Snapshot: eventSnapshot},
+		eventsSvcPrefix + "UpdateEvent":        {Snapshot: eventSnapshot},
+		eventsSvcPrefix + "UpdateResourceType": {Snapshot: resourceTypeSnapshot, Targets: resourceTypeTargets},
+		eventsSvcPrefix + "VoidResourceEntry":  {Snapshot: eventPupilSnapshot},
+	}
+}
+
+// protoToAuditFilters transforms the request to audit.Filters
+func protoToAuditFilters(req *eventsv1pb.FindAuditEntriesRequest) (audit.Filters, error) {
+	filters := audit.Filters{
+		ActorID:  req.GetActorId(),
+		Method:   req.GetMethod(),
+		TargetID: req.GetTargetId(),
+	}
+	var err error
+	if req.GetFrom() != nil {
+		if filters.From, err = ptypes.Timestamp(req.GetFrom()); err != nil {
+			return audit.Filters{}, ErrInvalidTimestamp
+		}
+	}
+	if req.GetTo() != nil {
+		if filters.To, err = ptypes.Timestamp(req.GetTo()); err != nil {
+			return audit.Filters{}, ErrInvalidTimestamp
+		}
+	}
+	return filters, nil
+}
+
+// auditEntryToProto transforms audit.Entry to *eventsv1pb.AuditEntry
+func auditEntryToProto(entry audit.Entry) (*eventsv1pb.AuditEntry, error) {
+	occurredAt, err := ptypes.TimestampProto(entry.OccurredAt)
+	if err != nil {
+		return nil, ErrInvalidTimestamp
+	}
+	return &eventsv1pb.AuditEntry{
+		Id:         entry.ID,
+		ActorId:    entry.ActorID,
+		ClientId:   entry.ClientID,
+		Method:     entry.Method,
+		TargetIds:  entry.TargetIDs,
+		Before:     string(entry.Before),
+		After:      string(entry.After),
+		OccurredAt: occurredAt,
+	}, nil
+}
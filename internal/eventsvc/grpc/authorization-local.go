@@ -0,0 +1,319 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes"
+	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
+	"github.com/shanvl/garbage/internal/eventsvc"
+)
+
+// KeySource provides the public keys of the auth service the access tokens are verified with
+type KeySource interface {
+	PublicKey(ctx context.Context, kid string) (*rsa.PublicKey, error)
+}
+
+// staticKey is a KeySource consisting of a single key, which verifies the tokens regardless of their key ids
+type staticKey struct {
+	key *rsa.PublicKey
+}
+
+// NewStaticKey returns a KeySource consisting of a single key
+func NewStaticKey(key *rsa.PublicKey) KeySource {
+	return staticKey{key}
+}
+
+func (s staticKey) PublicKey(_ context.Context, _ string) (*rsa.PublicKey, error) {
+	return s.key, nil
+}
+
+// localAuthService is an implementation of AuthorizationService which verifies the access tokens with the public keys
+// of the auth service and checks the roles against a cached copy of the auth service's protected RPCs.
+// It saves a call to the auth service per request. The not-before timestamps of the revocations are cached for
+// rpcsTTL as well, so a revoked access token keeps working here no longer than rpcsTTL
+type localAuthService struct {
+	keys KeySource
+	svc  authv1pb.AuthServiceClient
+	// time to wait for the auth svc response
+	timeout time.Duration
+	// how long the protected RPCs and the not-before timestamps are cached
+	rpcsTTL time.Duration
+
+	// refreshMu makes sure only one request fetches the protected RPCs at a time
+	refreshMu sync.Mutex
+	mu        sync.RWMutex
+	// rpcs are the protected RPCs of the service along with the roles allowed to call them
+	rpcs      map[string]map[string]bool
+	fetchedAt time.Time
+
+	notBeforeMu sync.Mutex
+	// notBefore are the not-before timestamps of the clients along with the time they have been fetched at
+	notBefore map[notBeforeKey]notBeforeEntry
+	lastSweep time.Time
+}
+
+type notBeforeKey struct {
+	clientID string
+	userID   string
+}
+
+type notBeforeEntry struct {
+	notBefore time.Time
+	fetchedAt time.Time
+}
+
+// NewLocalAuthService returns localAuthService
+func NewLocalAuthService(keys KeySource, pbAuthSvc authv1pb.AuthServiceClient, timeout,
+	rpcsTTL time.Duration) AuthorizationService {
+
+	return &localAuthService{
+		keys:      keys,
+		svc:       pbAuthSvc,
+		timeout:   timeout,
+		rpcsTTL:   rpcsTTL,
+		notBefore: make(map[notBeforeKey]notBeforeEntry),
+		lastSweep: time.Now(),
+	}
+}
+
+// accessTokenClaims mirror the claims of the tokens issued by the auth service
+type accessTokenClaims struct {
+	jwt.StandardClaims
+	// IssuedAtMs is the issue time in milliseconds. The tokens issued by the older versions of the auth service don't
+	// have it
+	IssuedAtMs int64 `json:"iat_ms,omitempty"`
+	ClientID   string
+	Role       string
+	Type       string
+	// Classes are the classes the user is assigned to, e.g. "2018b"
+	Classes []string
+	// Pupils are the ids of the pupils the user is linked to
+	Pupils []string
+}
+
+// eventsSvcPrefix is the prefix of the full method names of the service's RPCs
+const eventsSvcPrefix = "/shanvl.garbage.events.v1.EventsService/"
+
+// protoRoleClaims maps the roles returned by the auth service to the roles in the token claims
+var protoRoleClaims = map[authv1pb.Role]string{
+	authv1pb.Role_ROLE_ADMIN:    "admin",
+	authv1pb.Role_ROLE_GUARDIAN: "guardian",
+	authv1pb.Role_ROLE_MEMBER:   "member",
+	authv1pb.Role_ROLE_ROOT:     "root",
+}
+
+// Authorize verifies the token and checks whether the user's role is allowed to call the method
+func (a *localAuthService) Authorize(ctx context.Context, token, method string) (*AuthClaims, error) {
+	if method == "" {
+		return nil, fmt.Errorf("%w: method is required", ErrInvalidAccessToken)
+	}
+	rpcs, err := a.protectedRPCs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// if the method is not protected, it doesn't need an access token
+	roles, ok := rpcs[method]
+	if !ok {
+		return &AuthClaims{}, nil
+	}
+	claims, err := a.verify(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAccessToken, err)
+	}
+	if !roles[claims.Role] {
+		return nil, ErrUnauthorized
+	}
+	// the token mustn't be issued before the client or the user has been revoked
+	notBefore, err := a.clientNotBefore(ctx, claims.ClientID, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if claims.issuedBefore(notBefore) {
+		return nil, fmt.Errorf("%w: token has been revoked", ErrInvalidAccessToken)
+	}
+	authClaims := &AuthClaims{UserID: claims.Subject, ClientID: claims.ClientID}
+	switch claims.Role {
+	// members who haven't been assigned to any class yet aren't restricted
+	case protoRoleClaims[authv1pb.Role_ROLE_MEMBER]:
+		if len(claims.Classes) == 0 {
+			break
+		}
+		classes := make([]eventsvc.Class, 0, len(claims.Classes))
+		for _, c := range claims.Classes {
+			class, err := parseClassClaim(c)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidAccessToken, err)
+			}
+			classes = append(classes, class)
+		}
+		authClaims.Scope = eventsvc.RestrictedScope(classes...)
+	case protoRoleClaims[authv1pb.Role_ROLE_GUARDIAN]:
+		authClaims.Scope = eventsvc.PupilScope(claims.Pupils...)
+	}
+	return authClaims, nil
+}
+
+// parseClassClaim parses a class claim consisting of the year the class was formed in and its letter, e.g. "2018b"
+func parseClassClaim(claim string) (eventsvc.Class, error) {
+	if len(claim) < 5 {
+		return eventsvc.Class{}, fmt.Errorf("invalid class: %q", claim)
+	}
+	year, err := strconv.Atoi(claim[:4])
+	if err != nil {
+		return eventsvc.Class{}, fmt.Errorf("invalid class: %q", claim)
+	}
+	letter := strings.ToLower(claim[4:])
+	if utf8.RuneCountInString(letter) != 1 {
+		return eventsvc.Class{}, fmt.Errorf("invalid class: %q", claim)
+	}
+	return classFormedIn(letter, year), nil
+}
+
+// verify checks the signature and the expiration of the access token and returns its claims
+func (a *localAuthService) verify(ctx context.Context, token string) (*accessTokenClaims, error) {
+	if token == "" {
+		return nil, errors.New("no token has been provided")
+	}
+	t, err := jwt.ParseWithClaims(token, &accessTokenClaims{}, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, errors.New("unexpected signing algorithm")
+		}
+		kid, _ := t.Header["kid"].(string)
+		return a.keys.PublicKey(ctx, kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	claims, ok := t.Claims.(*accessTokenClaims)
+	if !ok {
+		return nil, errors.New("invalid claims")
+	}
+	if claims.Type != "access" {
+		return nil, errors.New("not an access token")
+	}
+	return claims, nil
+}
+
+// issuedBefore reports whether the token has been issued before notBefore. The tokens which only carry their issue
+// time in seconds are considered issued before notBefore if they have been issued in the same second
+func (c *accessTokenClaims) issuedBefore(notBefore time.Time) bool {
+	if notBefore.IsZero() {
+		return false
+	}
+	if c.IssuedAtMs == 0 {
+		return c.IssuedAt <= notBefore.Unix()
+	}
+	return time.Unix(0, c.IssuedAtMs*int64(time.Millisecond)).Before(notBefore)
+}
+
+// clientNotBefore returns the latest not-before timestamp of the revocations of the client and of the user. It's
+// fetched from the auth service when the cached one gets older than rpcsTTL. If the auth service isn't available, the
+// stale one is used for another rpcsTTL
+func (a *localAuthService) clientNotBefore(ctx context.Context, clientID, userID string) (time.Time, error) {
+	key := notBeforeKey{clientID, userID}
+	now := time.Now()
+
+	a.notBeforeMu.Lock()
+	entry, ok := a.notBefore[key]
+	a.notBeforeMu.Unlock()
+	if ok && now.Sub(entry.fetchedAt) < a.rpcsTTL {
+		return entry.notBefore, nil
+	}
+
+	ctxWithDeadline, cancel := context.WithDeadline(ctx, now.Add(a.timeout))
+	defer cancel()
+	resp, err := a.svc.FindNotBefore(ctxWithDeadline, &authv1pb.FindNotBeforeRequest{
+		ClientId: clientID,
+		UserId:   userID,
+	})
+	switch {
+	case err != nil && !ok:
+		return time.Time{}, err
+	case err != nil:
+		// keep using the stale timestamp for a while instead of making every request wait for the unavailable service
+	case resp.GetNotBefore() == nil:
+		// neither the client nor the user has been revoked
+		entry.notBefore = time.Time{}
+	default:
+		if entry.notBefore, err = ptypes.Timestamp(resp.GetNotBefore()); err != nil {
+			return time.Time{}, err
+		}
+	}
+	entry.fetchedAt = now
+
+	a.notBeforeMu.Lock()
+	defer a.notBeforeMu.Unlock()
+	a.notBefore[key] = entry
+	// remove the expired entries from time to time, so that the cache doesn't keep the clients that are gone
+	if now.Sub(a.lastSweep) > a.rpcsTTL {
+		for k, e := range a.notBefore {
+			if now.Sub(e.fetchedAt) >= a.rpcsTTL {
+				delete(a.notBefore, k)
+			}
+		}
+		a.lastSweep = now
+	}
+	return entry.notBefore, nil
+}
+
+// protectedRPCs returns the cached copy of the service's protected RPCs. The copy is fetched from the auth service
+// when it gets older than rpcsTTL. If the auth service isn't available, the stale copy is used for another rpcsTTL
+func (a *localAuthService) protectedRPCs(ctx context.Context) (map[string]map[string]bool, error) {
+	if rpcs, fresh := a.cachedRPCs(); fresh {
+		return rpcs, nil
+	}
+
+	a.refreshMu.Lock()
+	defer a.refreshMu.Unlock()
+	// the rpcs might have been fetched while waiting for the lock
+	rpcs, fresh := a.cachedRPCs()
+	if fresh {
+		return rpcs, nil
+	}
+
+	ctxWithDeadline, cancel := context.WithDeadline(ctx, time.Now().Add(a.timeout))
+	defer cancel()
+	resp, err := a.svc.FindProtectedRPCs(ctxWithDeadline, &authv1pb.FindProtectedRPCsRequest{
+		MethodPrefix: eventsSvcPrefix,
+	})
+	if err != nil {
+		if rpcs == nil {
+			return nil, err
+		}
+		// keep using the stale copy for a while instead of making every request wait for the unavailable service
+		a.mu.Lock()
+		a.fetchedAt = time.Now()
+		a.mu.Unlock()
+		return rpcs, nil
+	}
+	rpcs = make(map[string]map[string]bool, len(resp.GetRpcs()))
+	for _, rpc := range resp.GetRpcs() {
+		roles := make(map[string]bool, len(rpc.GetRoles()))
+		for _, role := range rpc.GetRoles() {
+			if claim, ok := protoRoleClaims[role]; ok {
+				roles[claim] = true
+			}
+		}
+		rpcs[rpc.GetMethod()] = roles
+	}
+	a.mu.Lock()
+	a.rpcs, a.fetchedAt = rpcs, time.Now()
+	a.mu.Unlock()
+	return rpcs, nil
+}
+
+// cachedRPCs returns the cached protected RPCs and tells whether they are younger than rpcsTTL
+func (a *localAuthService) cachedRPCs() (map[string]map[string]bool, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.rpcs, a.rpcs != nil && time.Since(a.fetchedAt) < a.rpcsTTL
+}
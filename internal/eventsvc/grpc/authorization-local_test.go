@@ -0,0 +1,225 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/shanvl/garbage/internal/eventsvc"
+)
+
+func TestLocalAuthorization_Authorize(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("couldn't generate a key: %v", err)
+	}
+	anotherKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("couldn't generate a key: %v", err)
+	}
+	// the scope is passed as classes to the members and as pupils to the guardians
+	newToken := func(key *rsa.PrivateKey, role, tokenType string, expiresAt time.Time, scope ...string) string {
+		claims := accessTokenClaims{
+			StandardClaims: jwt.StandardClaims{Subject: testUserID, ExpiresAt: expiresAt.Unix()},
+			ClientID:       "clientID",
+			Role:           role,
+			Type:           tokenType,
+		}
+		if role == "guardian" {
+			claims.Pupils = scope
+		} else {
+			claims.Classes = scope
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "kid"
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatalf("couldn't sign a token: %v", err)
+		}
+		return signed
+	}
+	// the tokens of the revoked client are revoked if they have been issued a minute before FindNotBefore is called
+	newClientToken := func(clientID string, issuedAt time.Time) string {
+		claims := accessTokenClaims{
+			StandardClaims: jwt.StandardClaims{
+				Subject:   testUserID,
+				IssuedAt:  issuedAt.Unix(),
+				ExpiresAt: issuedAt.Add(time.Hour).Unix(),
+			},
+			IssuedAtMs: issuedAt.UnixNano() / int64(time.Millisecond),
+			ClientID:   clientID,
+			Role:       "admin",
+			Type:       "access",
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatalf("couldn't sign a token: %v", err)
+		}
+		return signed
+	}
+	expiresAt := time.Now().Add(time.Minute)
+	tests := []struct {
+		name       string
+		method     string
+		token      string
+		userID     string
+		restricted bool
+		err        error
+	}{
+		{
+			name:   "no method",
+			method: "",
+			token:  newToken(key, "admin", "access", expiresAt),
+			err:    ErrInvalidAccessToken,
+		},
+		{
+			name:   "unprotected method",
+			method: eventsSvcPrefix + "FindEvents",
+			token:  "",
+			userID: "",
+			err:    nil,
+		},
+		{
+			name:   "no token",
+			method: testProtectedMethod,
+			token:  "",
+			err:    ErrInvalidAccessToken,
+		},
+		{
+			name:   "signed with another key",
+			method: testProtectedMethod,
+			token:  newToken(anotherKey, "admin", "access", expiresAt),
+			err:    ErrInvalidAccessToken,
+		},
+		{
+			name:   "expired",
+			method: testProtectedMethod,
+			token:  newToken(key, "admin", "access", time.Now().Add(-time.Minute)),
+			err:    ErrInvalidAccessToken,
+		},
+		{
+			name:   "refresh token",
+			method: testProtectedMethod,
+			token:  newToken(key, "admin", "refresh", expiresAt),
+			err:    ErrInvalidAccessToken,
+		},
+		{
+			name:   "role not allowed",
+			method: testProtectedMethod,
+			token:  newToken(key, "member", "access", expiresAt),
+			err:    ErrUnauthorized,
+		},
+		{
+			name:   "invalid class",
+			method: eventsSvcPrefix + "ChangePupilResources",
+			token:  newToken(key, "member", "access", expiresAt, "b2018"),
+			err:    ErrInvalidAccessToken,
+		},
+		{
+			name:       "member",
+			method:     eventsSvcPrefix + "ChangePupilResources",
+			token:      newToken(key, "member", "access", expiresAt, "2018b"),
+			userID:     testUserID,
+			restricted: true,
+			err:        nil,
+		},
+		{
+			name:       "unassigned member",
+			method:     eventsSvcPrefix + "ChangePupilResources",
+			token:      newToken(key, "member", "access", expiresAt),
+			userID:     testUserID,
+			restricted: false,
+			err:        nil,
+		},
+		{
+			name:       "guardian",
+			method:     eventsSvcPrefix + "FindPupilByID",
+			token:      newToken(key, "guardian", "access", expiresAt, "pupil"),
+			userID:     testUserID,
+			restricted: true,
+			err:        nil,
+		},
+		{
+			name:   "guardian calls a method not allowed to them",
+			method: testProtectedMethod,
+			token:  newToken(key, "guardian", "access", expiresAt, "pupil"),
+			err:    ErrUnauthorized,
+		},
+		{
+			name:   "revoked",
+			method: testProtectedMethod,
+			token:  newClientToken(testRevokedClientID, time.Now().Add(-2*time.Minute)),
+			err:    ErrInvalidAccessToken,
+		},
+		{
+			name:   "issued after the revocation",
+			method: testProtectedMethod,
+			token:  newClientToken(testRevokedClientID, time.Now()),
+			userID: testUserID,
+			err:    nil,
+		},
+		{
+			name:   "ok",
+			method: testProtectedMethod,
+			token:  newToken(key, "admin", "access", expiresAt),
+			userID: testUserID,
+			err:    nil,
+		},
+	}
+	srvAddr := startTestAuthServer(t)
+	authClient := newTestAuthClient(t, srvAddr)
+	authSvc := NewLocalAuthService(NewStaticKey(&key.PublicKey), authClient, 100*time.Millisecond, time.Minute)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authClaims, err := authSvc.Authorize(context.Background(), tt.token, tt.method)
+			if tt.err == nil && err != nil {
+				t.Fatalf("Authorize() error = %v, want nil", err)
+			}
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Errorf("Authorize() want err: %v, got: %v", tt.err, err)
+				}
+				return
+			}
+			if authClaims.UserID != tt.userID {
+				t.Errorf("Authorize() userID == %v, want: %v", authClaims.UserID, tt.userID)
+			}
+			if authClaims.Scope.IsRestricted() != tt.restricted {
+				t.Errorf("Authorize() restricted == %v, want: %v", authClaims.Scope.IsRestricted(), tt.restricted)
+			}
+		})
+	}
+}
+
+func Test_parseClassClaim(t *testing.T) {
+	tests := []struct {
+		name    string
+		claim   string
+		want    eventsvc.Class
+		wantErr bool
+	}{
+		{name: "ok", claim: "2018b", want: classFormedIn("b", 2018)},
+		{name: "upper case letter", claim: "2018B", want: classFormedIn("b", 2018)},
+		{name: "empty", claim: "", wantErr: true},
+		{name: "no letter", claim: "2018", wantErr: true},
+		{name: "two letters", claim: "2018bb", wantErr: true},
+		{name: "letter first", claim: "b2018", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseClassClaim(tt.claim)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseClassClaim() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseClassClaim() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
+	"github.com/shanvl/garbage/internal/eventsvc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -55,11 +56,35 @@ func (a *authService) Authorize(ctx context.Context, token, method string) (*Aut
 			return nil, err
 		}
 	}
-	return &AuthClaims{UserID: resp.GetUserId(), ClientID: resp.GetClientId()}, nil
+	claims := &AuthClaims{UserID: resp.GetUserId(), ClientID: resp.GetClientId()}
+	switch resp.GetRole() {
+	// members who haven't been assigned to any class yet aren't restricted
+	case authv1pb.Role_ROLE_MEMBER:
+		if len(resp.GetClasses()) == 0 {
+			break
+		}
+		classes := make([]eventsvc.Class, 0, len(resp.GetClasses()))
+		for _, c := range resp.GetClasses() {
+			classes = append(classes, classFormedIn(c.GetLetter(), int(c.GetYearFormed())))
+		}
+		claims.Scope = eventsvc.RestrictedScope(classes...)
+	case authv1pb.Role_ROLE_GUARDIAN:
+		claims.Scope = eventsvc.PupilScope(resp.GetPupilIds()...)
+	}
+	return claims, nil
 }
 
 // AuthClaims contain some info about the request
 type AuthClaims struct {
 	ClientID string
 	UserID   string
+	// Scope restricts the pupils the user can see and change. Members are restricted to the classes they are
+	// assigned to, if there are any, guardians are restricted to the pupils they are linked to, while admins and roots
+	// aren't restricted
+	Scope eventsvc.Scope
+}
+
+// classFormedIn returns the class with the given letter formed in the given year. Classes are formed on 1st September
+func classFormedIn(letter string, year int) eventsvc.Class {
+	return eventsvc.Class{Letter: letter, DateFormed: time.Date(year, 9, 1, 0, 0, 0, 0, time.UTC)}
 }
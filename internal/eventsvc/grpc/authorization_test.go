@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"net"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
+	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -17,10 +20,18 @@ import (
 
 const (
 	testInvalidToken = "invalid token"
-	testTimeout      = "timeout"
-	testUnauthorized = "unauthorized"
-	testUnknown      = "testUnknown"
-	testUserID       = "some user id"
+	testMember       = "member"
+	// testUnassignedMember is a member who isn't assigned to any class
+	testUnassignedMember = "unassigned member"
+	testGuardian         = "guardian"
+	testTimeout          = "timeout"
+	testUnauthorized     = "unauthorized"
+	testUnknown          = "testUnknown"
+	testUserID           = "some user id"
+	// testRevokedClientID is the id of the client revoked a minute before FindNotBefore is called
+	testRevokedClientID = "revoked client id"
+	// testProtectedMethod is the only method protected by the test auth server
+	testProtectedMethod = eventsSvcPrefix + "CreateEvent"
 )
 
 var testUnknownError = status.Error(codes.Unknown, "unknown error")
@@ -89,6 +100,52 @@ func TestAuthorization_Authorize(t *testing.T) {
 	}
 }
 
+func TestAuthorization_AuthorizeScope(t *testing.T) {
+	srvAddr := startTestAuthServer(t)
+	authClient := newTestAuthClient(t, srvAddr)
+	authSvc := NewAuthService(authClient, 100*time.Millisecond)
+
+	t.Run("member", func(t *testing.T) {
+		authClaims, err := authSvc.Authorize(context.Background(), "some token", testMember)
+		if err != nil {
+			t.Fatalf("Authorize() error = %v", err)
+		}
+		if !authClaims.Scope.IsRestricted() {
+			t.Fatalf("Authorize() scope isn't restricted")
+		}
+		if !authClaims.Scope.Allows(classFormedIn("b", 2018)) || authClaims.Scope.Allows(classFormedIn("a", 2018)) {
+			t.Errorf("Authorize() scope classes == %v, want 2018b", authClaims.Scope.Classes())
+		}
+	})
+	t.Run("unassigned member", func(t *testing.T) {
+		authClaims, err := authSvc.Authorize(context.Background(), "some token", testUnassignedMember)
+		if err != nil {
+			t.Fatalf("Authorize() error = %v", err)
+		}
+		if authClaims.Scope.IsRestricted() {
+			t.Errorf("Authorize() unassigned member's scope is restricted")
+		}
+	})
+	t.Run("guardian", func(t *testing.T) {
+		authClaims, err := authSvc.Authorize(context.Background(), "some token", testGuardian)
+		if err != nil {
+			t.Fatalf("Authorize() error = %v", err)
+		}
+		if !reflect.DeepEqual(authClaims.Scope, eventsvc.PupilScope("pupil")) {
+			t.Errorf("Authorize() scope == %v, want the linked pupils", authClaims.Scope)
+		}
+	})
+	t.Run("admin", func(t *testing.T) {
+		authClaims, err := authSvc.Authorize(context.Background(), "some token", "some allowed method")
+		if err != nil {
+			t.Fatalf("Authorize() error = %v", err)
+		}
+		if authClaims.Scope.IsRestricted() {
+			t.Errorf("Authorize() admin's scope is restricted")
+		}
+	})
+}
+
 func newTestAuthClient(t *testing.T, srvAddress string) authv1pb.AuthServiceClient {
 	cc, err := grpc.Dial(srvAddress, grpc.WithInsecure())
 	require.NoError(t, err)
@@ -134,13 +191,29 @@ func (t testAuthSvc) Authorize(_ context.Context, req *authv1pb.AuthorizeRequest
 		time.Sleep(500 * time.Millisecond)
 		return nil, testUnknownError
 	}
-	return &authv1pb.AuthorizeResponse{UserId: testUserID}, nil
+	if req.GetMethod() == testGuardian {
+		return &authv1pb.AuthorizeResponse{UserId: testUserID, Role: authv1pb.Role_ROLE_GUARDIAN,
+			PupilIds: []string{"pupil"}}, nil
+	}
+	if req.GetMethod() == testMember {
+		return &authv1pb.AuthorizeResponse{UserId: testUserID, Role: authv1pb.Role_ROLE_MEMBER,
+			Classes: []*authv1pb.Class{{Letter: "b", YearFormed: 2018}}}, nil
+	}
+	if req.GetMethod() == testUnassignedMember {
+		return &authv1pb.AuthorizeResponse{UserId: testUserID, Role: authv1pb.Role_ROLE_MEMBER}, nil
+	}
+	return &authv1pb.AuthorizeResponse{UserId: testUserID, Role: authv1pb.Role_ROLE_ADMIN}, nil
 }
 
 func (t testAuthSvc) ActivateUser(_ context.Context, _ *authv1pb.ActivateUserRequest) (*empty.Empty, error) {
 	return nil, nil
 }
 
+func (t testAuthSvc) ChangeOwnPassword(_ context.Context, _ *authv1pb.ChangeOwnPasswordRequest) (*empty.Empty,
+	error) {
+	return nil, nil
+}
+
 func (t testAuthSvc) ChangeUserRole(_ context.Context, _ *authv1pb.ChangeUserRoleRequest) (*empty.Empty, error) {
 	return nil, nil
 }
@@ -154,16 +227,58 @@ func (t testAuthSvc) DeleteUser(_ context.Context, _ *authv1pb.DeleteUserRequest
 	return nil, nil
 }
 
+func (t testAuthSvc) FindAuditEntries(_ context.Context, _ *authv1pb.FindAuditEntriesRequest) (*authv1pb.
+	FindAuditEntriesResponse, error) {
+	return nil, nil
+}
+
+func (t testAuthSvc) FindNotBefore(_ context.Context, req *authv1pb.FindNotBeforeRequest) (*authv1pb.
+	FindNotBeforeResponse, error) {
+	if req.GetClientId() != testRevokedClientID {
+		return &authv1pb.FindNotBeforeResponse{}, nil
+	}
+	notBefore, err := ptypes.TimestampProto(time.Now().Add(-time.Minute))
+	if err != nil {
+		return nil, err
+	}
+	return &authv1pb.FindNotBeforeResponse{NotBefore: notBefore}, nil
+}
+
+func (t testAuthSvc) FindProtectedRPCs(_ context.Context, _ *authv1pb.FindProtectedRPCsRequest) (*authv1pb.
+	FindProtectedRPCsResponse, error) {
+	return &authv1pb.FindProtectedRPCsResponse{Rpcs: []*authv1pb.FindProtectedRPCsResponse_ProtectedRPC{
+		{Method: testProtectedMethod, Roles: []authv1pb.Role{authv1pb.Role_ROLE_ADMIN, authv1pb.Role_ROLE_ROOT}},
+		{Method: eventsSvcPrefix + "ChangePupilResources", Roles: []authv1pb.Role{authv1pb.Role_ROLE_ADMIN,
+			authv1pb.Role_ROLE_MEMBER, authv1pb.Role_ROLE_ROOT}},
+		{Method: eventsSvcPrefix + "FindPupilByID", Roles: []authv1pb.Role{authv1pb.Role_ROLE_ADMIN,
+			authv1pb.Role_ROLE_GUARDIAN, authv1pb.Role_ROLE_MEMBER, authv1pb.Role_ROLE_ROOT}},
+	}}, nil
+}
+
 func (t testAuthSvc) FindUser(_ context.Context, _ *authv1pb.FindUserRequest) (*authv1pb.FindUserResponse,
 	error) {
 	return nil, nil
 }
 
+func (t testAuthSvc) FindUserClasses(_ context.Context, _ *authv1pb.FindUserClassesRequest) (*authv1pb.
+	FindUserClassesResponse, error) {
+	return nil, nil
+}
+
+func (t testAuthSvc) FindUserPupils(_ context.Context, _ *authv1pb.FindUserPupilsRequest) (*authv1pb.
+	FindUserPupilsResponse, error) {
+	return nil, nil
+}
+
 func (t testAuthSvc) FindUsers(_ context.Context, _ *authv1pb.FindUsersRequest) (*authv1pb.FindUsersResponse,
 	error) {
 	return nil, nil
 }
 
+func (t testAuthSvc) ListPolicies(_ context.Context, _ *empty.Empty) (*authv1pb.ListPoliciesResponse, error) {
+	return nil, nil
+}
+
 func (t testAuthSvc) Login(_ context.Context, _ *authv1pb.LoginRequest) (*authv1pb.LoginResponse, error) {
 	return nil, nil
 }
@@ -181,6 +296,41 @@ func (t testAuthSvc) RefreshTokens(_ context.Context, _ *authv1pb.RefreshTokensR
 	return nil, nil
 }
 
+func (t testAuthSvc) RequestPasswordReset(_ context.Context, _ *authv1pb.RequestPasswordResetRequest) (*empty.Empty,
+	error) {
+	return nil, nil
+}
+
+func (t testAuthSvc) ResendActivation(_ context.Context, _ *authv1pb.ResendActivationRequest) (*authv1pb.
+	ResendActivationResponse, error) {
+	return nil, nil
+}
+
+func (t testAuthSvc) ResetPassword(_ context.Context, _ *authv1pb.ResetPasswordRequest) (*empty.Empty, error) {
+	return nil, nil
+}
+
+func (t testAuthSvc) ResetPolicies(_ context.Context, _ *empty.Empty) (*empty.Empty, error) {
+	return nil, nil
+}
+
+func (t testAuthSvc) SetMethodRoles(_ context.Context, _ *authv1pb.SetMethodRolesRequest) (*empty.Empty, error) {
+	return nil, nil
+}
+
+func (t testAuthSvc) SetUserClasses(_ context.Context, _ *authv1pb.SetUserClassesRequest) (*empty.Empty, error) {
+	return nil, nil
+}
+
+func (t testAuthSvc) SetUserPupils(_ context.Context, _ *authv1pb.SetUserPupilsRequest) (*empty.Empty, error) {
+	return nil, nil
+}
+
+func (t testAuthSvc) UpdateProfile(_ context.Context, _ *authv1pb.UpdateProfileRequest) (*authv1pb.
+	UpdateProfileResponse, error) {
+	return nil, nil
+}
+
 func newTestAuthService() AuthorizationService {
 	return &testAuthService{}
 }
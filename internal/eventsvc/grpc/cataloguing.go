@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
+	"github.com/shanvl/garbage/internal/eventsvc"
+)
+
+// CreateResourceType adds the resource type to the catalogue of the resources
+func (s *Server) CreateResourceType(ctx context.Context, req *eventsv1pb.CreateResourceTypeRequest) (*empty.Empty,
+	error) {
+
+	resourceType := req.GetResourceType()
+	unit, err := protoToUnit(resourceType.GetUnit())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	err = s.catSvc.CreateResourceType(ctx, eventsvc.Resource(resourceType.GetName()), unit, resourceType.GetPoints())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteResourceType removes the resource type which isn't in use from the catalogue of the resources
+func (s *Server) DeleteResourceType(ctx context.Context, req *eventsv1pb.DeleteResourceTypeRequest) (*empty.Empty,
+	error) {
+
+	if err := s.catSvc.DeleteResourceType(ctx, eventsvc.Resource(req.GetName())); err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// FindResourceTypes returns the catalogue of the resources
+func (s *Server) FindResourceTypes(ctx context.Context, _ *eventsv1pb.FindResourceTypesRequest) (*eventsv1pb.
+	FindResourceTypesResponse, error) {
+
+	resourceTypes, err := s.catSvc.ResourceTypes(ctx)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	pbResourceTypes := make([]*eventsv1pb.ResourceType, len(resourceTypes))
+	for i, resourceType := range resourceTypes {
+		pbResourceTypes[i] = resourceTypeToProto(resourceType)
+	}
+	return &eventsv1pb.FindResourceTypesResponse{ResourceTypes: pbResourceTypes}, nil
+}
+
+// UpdateResourceType changes the unit and the points of the resource type
+func (s *Server) UpdateResourceType(ctx context.Context, req *eventsv1pb.UpdateResourceTypeRequest) (*empty.Empty,
+	error) {
+
+	unit, err := protoToUnit(req.GetUnit())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	err = s.catSvc.UpdateResourceType(ctx, eventsvc.Resource(req.GetName()), unit, req.GetPoints())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
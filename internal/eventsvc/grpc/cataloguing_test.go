@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_ResourceTypes(t *testing.T) {
+	ctx := context.Background()
+	const (
+		name   = "grpc_bottle_caps"
+		pieces = eventsv1pb.ResourceUnit_RESOURCE_UNIT_PIECES
+	)
+	defer server.DeleteResourceType(ctx, &eventsv1pb.DeleteResourceTypeRequest{Name: name})
+
+	t.Run("create", func(t *testing.T) {
+		testCases := []struct {
+			name         string
+			resourceType *eventsv1pb.ResourceType
+			code         codes.Code
+		}{
+			{
+				name:         "invalid name",
+				resourceType: &eventsv1pb.ResourceType{Name: "bottle caps", Unit: pieces},
+				code:         codes.InvalidArgument,
+			},
+			{
+				name:         "unknown unit",
+				resourceType: &eventsv1pb.ResourceType{Name: name},
+				code:         codes.InvalidArgument,
+			},
+			{
+				name:         "ok",
+				resourceType: &eventsv1pb.ResourceType{Name: name, Unit: pieces},
+				code:         codes.OK,
+			},
+			{
+				name:         "already exists",
+				resourceType: &eventsv1pb.ResourceType{Name: name, Unit: pieces},
+				code:         codes.AlreadyExists,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := server.CreateResourceType(ctx, &eventsv1pb.CreateResourceTypeRequest{
+					ResourceType: tc.resourceType,
+				})
+				if st, _ := status.FromError(err); st.Code() != tc.code {
+					t.Errorf("CreateResourceType() code == %v, want == %v", st.Code(), tc.code)
+				}
+			})
+		}
+	})
+	t.Run("update", func(t *testing.T) {
+		_, err := server.UpdateResourceType(ctx, &eventsv1pb.UpdateResourceTypeRequest{Name: "unknown",
+			Unit: eventsv1pb.ResourceUnit_RESOURCE_UNIT_KILOGRAMS})
+		if st, _ := status.FromError(err); st.Code() != codes.NotFound {
+			t.Errorf("UpdateResourceType() of the unknown resource code == %v, want == %v", st.Code(),
+				codes.NotFound)
+		}
+		_, err = server.UpdateResourceType(ctx, &eventsv1pb.UpdateResourceTypeRequest{Name: name,
+			Unit: pieces, Points: 0.5})
+		if err != nil {
+			t.Fatalf("UpdateResourceType() error == %v", err)
+		}
+		res, err := server.FindResourceTypes(ctx, &eventsv1pb.FindResourceTypesRequest{})
+		if err != nil {
+			t.Fatalf("FindResourceTypes() error == %v", err)
+		}
+		var points float32
+		for _, resourceType := range res.GetResourceTypes() {
+			if resourceType.GetName() == name {
+				points = resourceType.GetPoints()
+			}
+		}
+		if points != 0.5 {
+			t.Errorf("FindResourceTypes() points == %v, want == 0.5", points)
+		}
+	})
+	t.Run("delete", func(t *testing.T) {
+		if _, err := server.DeleteResourceType(ctx, &eventsv1pb.DeleteResourceTypeRequest{Name: name}); err != nil {
+			t.Fatalf("DeleteResourceType() error == %v", err)
+		}
+		_, err := server.DeleteResourceType(ctx, &eventsv1pb.DeleteResourceTypeRequest{Name: name})
+		if st, _ := status.FromError(err); st.Code() != codes.NotFound {
+			t.Errorf("DeleteResourceType() of the deleted resource code == %v, want == %v", st.Code(),
+				codes.NotFound)
+		}
+	})
+}
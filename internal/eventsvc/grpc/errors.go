@@ -1,11 +1,15 @@
 package grpc
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/eventing"
+	"github.com/shanvl/garbage/pkg/etag"
 	"github.com/shanvl/garbage/pkg/valid"
 	"go.uber.org/zap"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -19,16 +23,27 @@ var ErrInvalidTimestamp = errors.New("invalid timestamp")
 
 // handle error transforms a svc's error into appropriate grpc error. It also logs all unrecognized errors
 func (s *Server) handleError(err error) error {
-	var validErr *valid.ErrValidation
+	var (
+		validErr   *valid.ErrValidation
+		broughtErr *eventing.ResourcesBroughtError
+	)
 	switch {
 	case errors.As(err, &validErr):
 		return errWithDetails(codes.InvalidArgument, validErr.Error(), validErr.Fields())
+	case errors.As(err, &broughtErr):
+		return errWithBroughtResources(broughtErr)
 	case errors.Is(err, eventsvc.ErrInvalidClassName):
 		fallthrough
+	case errors.Is(err, etag.ErrInvalid):
+		fallthrough
 	case errors.Is(err, ErrInvalidTimestamp):
 		fallthrough
 	case errors.Is(err, eventsvc.ErrNoClassOnDate):
 		fallthrough
+	case errors.Is(err, eventsvc.ErrUnknownUnit):
+		fallthrough
+	case errors.Is(err, eventsvc.ErrIncompatibleUnits):
+		fallthrough
 	case errors.Is(err, eventsvc.ErrUnknownResource):
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, eventsvc.ErrUnknownPupil):
@@ -36,10 +51,34 @@ func (s *Server) handleError(err error) error {
 	case errors.Is(err, eventing.ErrNoEventPupil):
 		fallthrough
 	case errors.Is(err, eventsvc.ErrUnknownEvent):
+		fallthrough
+	case errors.Is(err, eventing.ErrUnknownEntry):
+		fallthrough
+	case errors.Is(err, eventsvc.ErrUnknownResourceType):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, eventsvc.ErrResourceExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, eventsvc.ErrResourceInUse):
+		fallthrough
+	case errors.Is(err, eventsvc.ErrEventNotOpen):
+		fallthrough
+	case errors.Is(err, eventsvc.ErrEventFrozen):
+		fallthrough
+	case errors.Is(err, eventsvc.ErrInvalidStatusTransition):
+		fallthrough
+	case errors.Is(err, eventing.ErrNegativeResources):
+		fallthrough
+	case errors.Is(err, eventing.ErrResourceNotAllowed):
+		fallthrough
+	case errors.Is(err, eventing.ErrEntryVoided):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, eventsvc.ErrVersionMismatch):
+		return status.Error(codes.Aborted, err.Error())
 	case errors.Is(err, ErrInvalidAccessToken):
 		return status.Error(codes.Unauthenticated, err.Error())
 	case errors.Is(err, ErrUnauthorized):
+		fallthrough
+	case errors.Is(err, eventsvc.ErrOutOfScope):
 		return status.Error(codes.PermissionDenied, err.Error())
 	default:
 		s.log.Error("internal error", zap.Error(err))
@@ -47,6 +86,18 @@ func (s *Server) handleError(err error) error {
 	}
 }
 
+// handleStreamError transforms an error of a streaming rpc into appropriate grpc error. If the client has gone away or
+// the deadline has passed while the stream was being sent, the error is caused by that, so it isn't logged
+func (s *Server) handleStreamError(ctx context.Context, err error) error {
+	switch ctx.Err() {
+	case context.Canceled:
+		return status.Error(codes.Canceled, ctx.Err().Error())
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+	}
+	return s.handleError(err)
+}
+
 // errWithDetails takes a map[string]string and appends it as the details to grpc error
 func errWithDetails(code codes.Code, message string, details map[string]string) error {
 	grpcErr := status.New(code, message)
@@ -66,3 +117,28 @@ func errWithDetails(code codes.Code, message string, details map[string]string)
 	}
 	return e.Err()
 }
+
+// errWithBroughtResources returns FailedPrecondition error with the pupils who have brought the disallowed resources
+// as its details
+func errWithBroughtResources(broughtErr *eventing.ResourcesBroughtError) error {
+	grpcErr := status.New(codes.FailedPrecondition, broughtErr.Error())
+	pf := &errdetails.PreconditionFailure{}
+	for _, pupil := range broughtErr.Pupils {
+		resources := make([]string, 0, len(pupil.ResourcesBrought))
+		for res, amount := range pupil.ResourcesBrought {
+			resources = append(resources, fmt.Sprintf("%s: %g", res, amount))
+		}
+		sort.Strings(resources)
+		pf.Violations = append(pf.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:    "RESOURCES_BROUGHT",
+			Subject: pupil.ID,
+			Description: fmt.Sprintf("%s %s (%s) has brought %s", pupil.FirstName, pupil.LastName, pupil.Class,
+				strings.Join(resources, ", ")),
+		})
+	}
+	e, err := grpcErr.WithDetails(pf)
+	if err != nil {
+		panic(fmt.Sprintf("unexpected error attaching metadata: %v", err))
+	}
+	return e.Err()
+}
@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/internal/eventsvc/eventing"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -87,3 +89,37 @@ func Test_errWithDetails(t *testing.T) {
 		})
 	}
 }
+
+func Test_errWithBroughtResources(t *testing.T) {
+	err := errWithBroughtResources(&eventing.ResourcesBroughtError{Pupils: []*eventing.Pupil{
+		{
+			Pupil:            eventsvc.Pupil{ID: "1", FirstName: "Ivan", LastName: "Ivanov"},
+			Class:            "3b",
+			ResourcesBrought: eventsvc.ResourceMap{eventsvc.Plastic: 2, eventsvc.Paper: 1.5},
+		},
+		{
+			Pupil:            eventsvc.Pupil{ID: "2", FirstName: "Petr", LastName: "Petrov"},
+			Class:            "4a",
+			ResourcesBrought: eventsvc.ResourceMap{eventsvc.Paper: 3},
+		},
+	}})
+	st := status.Convert(err)
+	if st.Code() != codes.FailedPrecondition {
+		t.Errorf("errWithBroughtResources() code = %v, want %v", st.Code(), codes.FailedPrecondition)
+	}
+	got := map[string]string{}
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*errdetails.PreconditionFailure); ok {
+			for _, violation := range d.GetViolations() {
+				got[violation.GetSubject()] = violation.GetDescription()
+			}
+		}
+	}
+	want := map[string]string{
+		"1": "Ivan Ivanov (3b) has brought paper: 1.5, plastic: 2",
+		"2": "Petr Petrov (4a) has brought paper: 3",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("errWithBroughtResources() details = %v, want %v", got, want)
+	}
+}
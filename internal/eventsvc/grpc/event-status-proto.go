@@ -0,0 +1,13 @@
+package grpc
+
+import (
+	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
+	"github.com/shanvl/garbage/internal/eventsvc"
+)
+
+var eventStatusProtoMap = map[eventsvc.EventStatus]eventsv1pb.EventStatus{
+	eventsvc.Planned:  eventsv1pb.EventStatus_EVENT_STATUS_PLANNED,
+	eventsvc.Open:     eventsv1pb.EventStatus_EVENT_STATUS_OPEN,
+	eventsvc.Closed:   eventsv1pb.EventStatus_EVENT_STATUS_CLOSED,
+	eventsvc.Archived: eventsv1pb.EventStatus_EVENT_STATUS_ARCHIVED,
+}
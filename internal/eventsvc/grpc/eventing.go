@@ -8,14 +8,54 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
 	"github.com/shanvl/garbage/internal/eventsvc/eventing"
+	"github.com/shanvl/garbage/pkg/etag"
+	"github.com/shanvl/garbage/pkg/paging"
 )
 
-// ChangePupilResources changes the amount of resources brought by the pupil to the event
+// AddPupilResources adds the signed amounts to the resources brought by the pupil to the event
+func (s *Server) AddPupilResources(ctx context.Context, req *eventsv1pb.AddPupilResourcesRequest) (*empty.Empty,
+	error) {
+
+	resources, err := protoToResourcesMap(req.GetResourcesBrought())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	units, err := protoToUnitMap(req.GetResourcesBrought())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	err = s.evSvc.AddPupilResources(ctx, req.GetEventId(), req.GetPupilId(), resources, units)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// ArchiveEvent archives the closed event
+func (s *Server) ArchiveEvent(ctx context.Context, req *eventsv1pb.ArchiveEventRequest) (*empty.Empty, error) {
+	if err := s.evSvc.ArchiveEvent(ctx, req.GetId()); err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// ChangePupilResources sets the amount of resources brought by the pupil to the event
 func (s *Server) ChangePupilResources(ctx context.Context, req *eventsv1pb.ChangePupilResourcesRequest) (*empty.
 	Empty, error) {
 
-	err := s.evSvc.ChangePupilResources(ctx, req.GetEventId(), req.GetPupilId(),
-		protoToResourcesMap(req.GetResourcesBrought()))
+	version, err := etag.Precondition(ctx, req.GetResourcesVersion())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	resources, err := protoToResourcesMap(req.GetResourcesBrought())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	units, err := protoToUnitMap(req.GetResourcesBrought())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	err = s.evSvc.ChangePupilResources(ctx, req.GetEventId(), req.GetPupilId(), resources, units, version)
 	if err != nil {
 		return nil, s.handleError(err)
 	}
@@ -23,6 +63,33 @@ func (s *Server) ChangePupilResources(ctx context.Context, req *eventsv1pb.Chang
 	return &empty.Empty{}, nil
 }
 
+// CloseEvent closes the open event
+func (s *Server) CloseEvent(ctx context.Context, req *eventsv1pb.CloseEventRequest) (*empty.Empty, error) {
+	if err := s.evSvc.CloseEvent(ctx, req.GetId()); err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// CorrectResourceEntry voids the weigh-in and records the one holding the corrected amounts instead
+func (s *Server) CorrectResourceEntry(ctx context.Context, req *eventsv1pb.CorrectResourceEntryRequest) (*eventsv1pb.
+	CorrectResourceEntryResponse, error) {
+
+	resources, err := protoToResourcesMap(req.GetResourcesBrought())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	units, err := protoToUnitMap(req.GetResourcesBrought())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	id, err := s.evSvc.CorrectResourceEntry(ctx, req.GetEventId(), req.GetPupilId(), req.GetId(), resources, units)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &eventsv1pb.CorrectResourceEntryResponse{Id: id}, nil
+}
+
 // CreateEvent creates and stores the event
 func (s *Server) CreateEvent(ctx context.Context, req *eventsv1pb.CreateEventRequest) (*eventsv1pb.
 	CreateEventResponse, error) {
@@ -76,13 +143,12 @@ func (s *Server) FindEventClasses(ctx context.Context, req *eventsv1pb.FindEvent
 	FindEventClassesResponse, error) {
 
 	// call the svc
-	classes, total, err := s.evSvc.EventClasses(
+	classes, total, nextToken, err := s.evSvc.EventClasses(
 		ctx,
 		req.GetEventId(),
 		eventing.EventClassFilters{Name: req.GetClassName()},
-		protoClassSortingMap[req.GetSorting()],
-		int(req.GetAmount()),
-		int(req.GetSkip()),
+		protoToClassSorting(req.GetSorting(), req.GetThenSorting(), req.GetSortingResource()),
+		paging.FromRequest(req),
 	)
 	if err != nil {
 		return nil, s.handleError(err)
@@ -94,8 +160,9 @@ func (s *Server) FindEventClasses(ctx context.Context, req *eventsv1pb.FindEvent
 		pbClasses[i] = classToProto(class)
 	}
 	return &eventsv1pb.FindEventClassesResponse{
-		Classes: pbClasses,
-		Total:   uint32(total),
+		Classes:       pbClasses,
+		Total:         uint32(total),
+		NextPageToken: nextToken,
 	}, err
 }
 
@@ -104,13 +171,12 @@ func (s *Server) FindEventPupils(ctx context.Context, req *eventsv1pb.FindEventP
 	FindEventPupilsResponse, error) {
 
 	// call the svc
-	pupils, total, err := s.evSvc.EventPupils(
+	pupils, total, nextToken, err := s.evSvc.EventPupils(
 		ctx,
 		req.GetEventId(),
 		eventing.EventPupilFilters{NameAndClass: req.GetNameAndClass()},
-		protoPupilSortingMap[req.GetSorting()],
-		int(req.GetAmount()),
-		int(req.GetSkip()),
+		protoToPupilSorting(req.GetSorting(), req.GetThenSorting(), req.GetSortingResource()),
+		paging.FromRequest(req),
 	)
 	if err != nil {
 		return nil, s.handleError(err)
@@ -122,11 +188,33 @@ func (s *Server) FindEventPupils(ctx context.Context, req *eventsv1pb.FindEventP
 		pbPupils[i] = pupilToProto(pupil)
 	}
 	return &eventsv1pb.FindEventPupilsResponse{
-		Pupils: pbPupils,
-		Total:  uint32(total),
+		Pupils:        pbPupils,
+		Total:         uint32(total),
+		NextPageToken: nextToken,
 	}, nil
 }
 
+// StreamEventPupils sends all the sorted pupils with the resources they brought to the specified event, one pupil per
+// message. Sending blocks while the client falls behind, so the pupils aren't read from the db faster than the client
+// receives them
+func (s *Server) StreamEventPupils(req *eventsv1pb.StreamEventPupilsRequest, stream eventsv1pb.
+	EventsService_StreamEventPupilsServer) error {
+
+	err := s.evSvc.StreamEventPupils(
+		stream.Context(),
+		req.GetEventId(),
+		eventing.EventPupilFilters{NameAndClass: req.GetNameAndClass()},
+		protoToPupilSorting(req.GetSorting(), req.GetThenSorting(), req.GetSortingResource()),
+		func(pupil *eventing.Pupil) error {
+			return stream.Send(&eventsv1pb.StreamEventPupilsResponse{Pupil: pupilToProto(pupil)})
+		},
+	)
+	if err != nil {
+		return s.handleStreamError(stream.Context(), err)
+	}
+	return nil
+}
+
 // FindEventByID returns an event with the given id and all resources collected at that event
 func (s *Server) FindEventPupilByID(ctx context.Context, req *eventsv1pb.FindEventPupilByIDRequest) (*eventsv1pb.
 	FindEventPupilByIDResponse, error) {
@@ -136,7 +224,71 @@ func (s *Server) FindEventPupilByID(ctx context.Context, req *eventsv1pb.FindEve
 		return nil, s.handleError(err)
 	}
 
-	return &eventsv1pb.FindEventPupilByIDResponse{Pupil: pupilToProto(pupil)}, nil
+	entries, err := resourceEntriesToProto(pupil.ResourceEntries)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &eventsv1pb.FindEventPupilByIDResponse{Pupil: pupilToProto(pupil), ResourceEntries: entries}, nil
+}
+
+// FindResourceEntries returns the weigh-ins of the resources brought by the pupil to the event
+func (s *Server) FindResourceEntries(ctx context.Context, req *eventsv1pb.FindResourceEntriesRequest) (*eventsv1pb.
+	FindResourceEntriesResponse, error) {
+
+	entries, err := s.evSvc.PupilResourceEntries(ctx, req.GetEventId(), req.GetPupilId())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	entriesProto, err := resourceEntriesToProto(entries)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &eventsv1pb.FindResourceEntriesResponse{Entries: entriesProto}, nil
+}
+
+// OpenEvent opens the planned event
+func (s *Server) OpenEvent(ctx context.Context, req *eventsv1pb.OpenEventRequest) (*empty.Empty, error) {
+	if err := s.evSvc.OpenEvent(ctx, req.GetId()); err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// UpdateEvent changes the date, the name and the allowed resources of the event
+func (s *Server) UpdateEvent(ctx context.Context, req *eventsv1pb.UpdateEventRequest) (*eventsv1pb.
+	UpdateEventResponse, error) {
+
+	// proto to args
+	eventDate, err := protoTimeToTimestamp(req.GetDate())
+	if err != nil {
+		return nil, s.handleError(fmt.Errorf("event date: %w", err))
+	}
+	resourcesAllowed, err := protoToResources(req.GetResourcesAllowed())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	version, err := etag.Precondition(ctx, req.GetVersion())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	// call the svc
+	warnings, err := s.evSvc.UpdateEvent(ctx, req.GetId(), eventDate, req.GetName(), resourcesAllowed, version)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &eventsv1pb.UpdateEventResponse{Warnings: warnings}, nil
+}
+
+// VoidResourceEntry voids the weigh-in, so that it doesn't count towards the resources brought by the pupil
+func (s *Server) VoidResourceEntry(ctx context.Context, req *eventsv1pb.VoidResourceEntryRequest) (*empty.Empty,
+	error) {
+
+	if err := s.evSvc.VoidResourceEntry(ctx, req.GetEventId(), req.GetPupilId(), req.GetId()); err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
 }
 
 // converts *eventsvc.Class to *eventsv1pb.Class
@@ -146,7 +298,8 @@ func classToProto(class *eventing.Class) *eventsv1pb.Class {
 	}
 	return &eventsv1pb.Class{
 		Name:             class.Name,
-		ResourcesBrought: resourceMapToProto(class.ResourcesBrought),
+		ResourcesBrought: resourceMapToProto(class.ResourcesBrought, nil),
+		Stats:            classStatsToProto(class.Stats, nil),
 	}
 }
 
@@ -164,7 +317,10 @@ func eventToProto(event *eventing.Event) (*eventsv1pb.Event, error) {
 		Date:             date,
 		Name:             event.Name,
 		ResourcesAllowed: resourcesToProto(event.ResourcesAllowed),
-		ResourcesBrought: resourceMapToProto(event.ResourcesBrought),
+		ResourcesBrought: resourceMapToProto(event.ResourcesBrought, nil),
+		Status:           eventStatusProtoMap[event.Status],
+		Version:          uint32(event.Version),
+		Multiplier:       event.Multiplier,
 	}, nil
 }
 
@@ -178,6 +334,33 @@ func pupilToProto(pupil *eventing.Pupil) *eventsv1pb.Pupil {
 		FirstName:        pupil.FirstName,
 		LastName:         pupil.LastName,
 		Class:            pupil.Class,
-		ResourcesBrought: resourceMapToProto(pupil.ResourcesBrought),
+		ResourcesBrought: resourceMapToProto(pupil.ResourcesBrought, nil),
+		ResourcesVersion: uint32(pupil.ResourcesVersion),
+	}
+}
+
+// converts []*eventing.ResourceEntry to []*eventsv1pb.ResourceEntry
+func resourceEntriesToProto(entries []*eventing.ResourceEntry) ([]*eventsv1pb.ResourceEntry, error) {
+	entriesProto := make([]*eventsv1pb.ResourceEntry, len(entries))
+	for i, entry := range entries {
+		recordedAt, err := ptypes.TimestampProto(entry.RecordedAt)
+		if err != nil {
+			return nil, fmt.Errorf("entry recorded at: %w", ErrInvalidTimestamp)
+		}
+		entryProto := &eventsv1pb.ResourceEntry{
+			Id:           entry.ID,
+			Resources:    resourceMapToProto(entry.Resources, nil),
+			RecordedBy:   entry.RecordedBy,
+			RecordedAt:   recordedAt,
+			CorrectionOf: entry.CorrectionOf,
+			VoidedBy:     entry.VoidedBy,
+		}
+		if entry.IsVoided() {
+			if entryProto.VoidedAt, err = ptypes.TimestampProto(entry.VoidedAt); err != nil {
+				return nil, fmt.Errorf("entry voided at: %w", ErrInvalidTimestamp)
+			}
+		}
+		entriesProto[i] = entryProto
 	}
+	return entriesProto, nil
 }
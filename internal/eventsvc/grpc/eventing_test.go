@@ -5,12 +5,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/ptypes/empty"
+	gonanoid "github.com/matoous/go-nanoid"
 	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
 	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/aggregating"
 	"github.com/shanvl/garbage/internal/eventsvc/eventing"
+	"github.com/shanvl/garbage/internal/eventsvc/mock"
 	"github.com/shanvl/garbage/internal/eventsvc/sorting"
+	"github.com/shanvl/garbage/pkg/broker"
+	"github.com/shanvl/garbage/pkg/etag"
+	"github.com/shanvl/garbage/pkg/paging"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -31,9 +39,7 @@ func TestServer_ChangePupilResources(t *testing.T) {
 				EventId: "",
 				PupilId: pupilID,
 				ResourcesBrought: &eventsv1pb.ResourcesBrought{
-					Gadgets: 50,
-					Paper:   50,
-					Plastic: 50,
+					Amounts: map[string]float32{"gadgets": 50, "paper": 50, "plastic": 50},
 				},
 			},
 			code: codes.InvalidArgument,
@@ -44,9 +50,7 @@ func TestServer_ChangePupilResources(t *testing.T) {
 				EventId: eventID,
 				PupilId: "",
 				ResourcesBrought: &eventsv1pb.ResourcesBrought{
-					Gadgets: 50,
-					Paper:   50,
-					Plastic: 50,
+					Amounts: map[string]float32{"gadgets": 50, "paper": 50, "plastic": 50},
 				},
 			},
 			code: codes.InvalidArgument,
@@ -57,9 +61,7 @@ func TestServer_ChangePupilResources(t *testing.T) {
 				EventId: "somerandomid",
 				PupilId: pupilID,
 				ResourcesBrought: &eventsv1pb.ResourcesBrought{
-					Gadgets: 50,
-					Paper:   50,
-					Plastic: 50,
+					Amounts: map[string]float32{"gadgets": 50, "paper": 50, "plastic": 50},
 				},
 			},
 			code: codes.NotFound,
@@ -70,9 +72,7 @@ func TestServer_ChangePupilResources(t *testing.T) {
 				EventId: eventID,
 				PupilId: "somerandomid",
 				ResourcesBrought: &eventsv1pb.ResourcesBrought{
-					Gadgets: 50,
-					Paper:   50,
-					Plastic: 50,
+					Amounts: map[string]float32{"gadgets": 50, "paper": 50, "plastic": 50},
 				},
 			},
 			code: codes.NotFound,
@@ -92,9 +92,7 @@ func TestServer_ChangePupilResources(t *testing.T) {
 				EventId: eventID,
 				PupilId: pupilID,
 				ResourcesBrought: &eventsv1pb.ResourcesBrought{
-					Gadgets: 50,
-					Paper:   0,
-					Plastic: -50,
+					Amounts: map[string]float32{"gadgets": 50, "paper": 0, "plastic": -50},
 				},
 			},
 			code: codes.InvalidArgument,
@@ -105,9 +103,7 @@ func TestServer_ChangePupilResources(t *testing.T) {
 				EventId: eventID,
 				PupilId: pupilID,
 				ResourcesBrought: &eventsv1pb.ResourcesBrought{
-					Gadgets: 50.09,
-					Paper:   0,
-					Plastic: 5.15,
+					Amounts: map[string]float32{"gadgets": 50.09, "paper": 0, "plastic": 5.15},
 				},
 			},
 			code: codes.OK,
@@ -127,10 +123,10 @@ func TestServer_ChangePupilResources(t *testing.T) {
 				reqResourcesBrought := tc.req.ResourcesBrought
 				pupilInRepo := testGetEventPupilByID(t, tc.req.PupilId, tc.req.EventId)
 				repoResBrough := pupilInRepo.ResourcesBrought
-				if reqResourcesBrought.Gadgets != repoResBrough[eventsvc.Gadgets] || reqResourcesBrought.
-					Paper != repoResBrough[eventsvc.Paper] || reqResourcesBrought.
-					Plastic != repoResBrough[eventsvc.Plastic] {
-					t.Errorf("ChangePupilResources() resources don't match")
+				for res, amount := range reqResourcesBrought.GetAmounts() {
+					if amount != repoResBrough[eventsvc.Resource(res)] {
+						t.Errorf("ChangePupilResources() resources don't match")
+					}
 				}
 				// change repo's pupil's resources to some other value in order not to break the next test run
 				testChangePupilResources(t, tc.req.EventId, tc.req.PupilId,
@@ -154,6 +150,179 @@ func TestServer_ChangePupilResources(t *testing.T) {
 	}
 }
 
+func TestServer_AddPupilResources(t *testing.T) {
+	ctx := context.Background()
+	eventID := testGetEventIDWithResourcesAllowed(t, []eventsvc.Resource{eventsvc.Gadgets, eventsvc.Paper,
+		eventsvc.Plastic})
+	pupilID := testGetPupilID(t)
+	testChangePupilResources(t, eventID, pupilID,
+		eventsvc.ResourceMap{eventsvc.Gadgets: 10, eventsvc.Paper: 10, eventsvc.Plastic: 10})
+	testCases := []struct {
+		name      string
+		req       *eventsv1pb.AddPupilResourcesRequest
+		code      codes.Code
+		wantAfter eventsvc.ResourceMap
+	}{
+		{
+			name: "empty event id",
+			req: &eventsv1pb.AddPupilResourcesRequest{PupilId: pupilID,
+				ResourcesBrought: &eventsv1pb.ResourcesBrought{Amounts: map[string]float32{"paper": 1}}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "no ResourcesBrought",
+			req:  &eventsv1pb.AddPupilResourcesRequest{EventId: eventID, PupilId: pupilID},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "no such pupil",
+			req: &eventsv1pb.AddPupilResourcesRequest{EventId: eventID, PupilId: "somerandomid",
+				ResourcesBrought: &eventsv1pb.ResourcesBrought{Amounts: map[string]float32{"paper": 1}}},
+			code: codes.NotFound,
+		},
+		{
+			name: "resources become less than 0",
+			req: &eventsv1pb.AddPupilResourcesRequest{EventId: eventID, PupilId: pupilID,
+				ResourcesBrought: &eventsv1pb.ResourcesBrought{Amounts: map[string]float32{"plastic": -11}}},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "add paper, subtract plastic",
+			req: &eventsv1pb.AddPupilResourcesRequest{EventId: eventID, PupilId: pupilID,
+				ResourcesBrought: &eventsv1pb.ResourcesBrought{
+					Amounts: map[string]float32{"paper": 2.5, "plastic": -4},
+				}},
+			code:      codes.OK,
+			wantAfter: eventsvc.ResourceMap{eventsvc.Gadgets: 10, eventsvc.Paper: 12.5, eventsvc.Plastic: 6},
+		},
+		{
+			name: "fractional gadgets",
+			req: &eventsv1pb.AddPupilResourcesRequest{EventId: eventID, PupilId: pupilID,
+				ResourcesBrought: &eventsv1pb.ResourcesBrought{Amounts: map[string]float32{"gadgets": 1.5}}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "gadgets in pounds",
+			req: &eventsv1pb.AddPupilResourcesRequest{EventId: eventID, PupilId: pupilID,
+				ResourcesBrought: &eventsv1pb.ResourcesBrought{
+					Amounts: map[string]float32{"gadgets": 1},
+					Units: map[string]eventsv1pb.ResourceUnit{
+						"gadgets": eventsv1pb.ResourceUnit_RESOURCE_UNIT_POUNDS,
+					},
+				}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "paper in grams",
+			req: &eventsv1pb.AddPupilResourcesRequest{EventId: eventID, PupilId: pupilID,
+				ResourcesBrought: &eventsv1pb.ResourcesBrought{
+					Amounts: map[string]float32{"paper": 500},
+					Units: map[string]eventsv1pb.ResourceUnit{
+						"paper": eventsv1pb.ResourceUnit_RESOURCE_UNIT_GRAMS,
+					},
+				}},
+			code:      codes.OK,
+			wantAfter: eventsvc.ResourceMap{eventsvc.Gadgets: 10, eventsvc.Paper: 13, eventsvc.Plastic: 6},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := server.AddPupilResources(ctx, tc.req)
+			if st, _ := status.FromError(err); st.Code() != tc.code {
+				t.Errorf("AddPupilResources() err codes mismatch: code == %v, want == %v", st.Code(), tc.code)
+				return
+			}
+			if tc.wantAfter == nil {
+				return
+			}
+			got := testGetEventPupilByID(t, pupilID, eventID).ResourcesBrought
+			for res, amount := range tc.wantAfter {
+				if got[res] != amount {
+					t.Errorf("AddPupilResources() %s == %v, want == %v", res, got[res], amount)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_ResourceEntries(t *testing.T) {
+	ctx := context.Background()
+	eventID := testGetEventIDWithResourcesAllowed(t, []eventsvc.Resource{eventsvc.Gadgets, eventsvc.Paper,
+		eventsvc.Plastic})
+	pupilID := testGetPupilID(t)
+	testChangePupilResources(t, eventID, pupilID, eventsvc.ResourceMap{eventsvc.Gadgets: 0, eventsvc.Paper: 0,
+		eventsvc.Plastic: 0})
+	_, err := server.AddPupilResources(ctx, &eventsv1pb.AddPupilResourcesRequest{EventId: eventID, PupilId: pupilID,
+		ResourcesBrought: &eventsv1pb.ResourcesBrought{Amounts: map[string]float32{"paper": 7}}})
+	if err != nil {
+		t.Fatalf("prepare db: AddPupilResources() error == %v", err)
+	}
+	findEntries := func(t *testing.T) []*eventsv1pb.ResourceEntry {
+		t.Helper()
+		res, err := server.FindResourceEntries(ctx, &eventsv1pb.FindResourceEntriesRequest{EventId: eventID,
+			PupilId: pupilID})
+		if err != nil {
+			t.Fatalf("FindResourceEntries() error == %v", err)
+		}
+		return res.GetEntries()
+	}
+	entries := findEntries(t)
+	added := entries[len(entries)-1]
+	if added.GetResources().GetAmounts()["paper"] != 7 || added.GetVoidedAt() != nil {
+		t.Fatalf("FindResourceEntries() last entry == %v, want the added one", added)
+	}
+
+	var correctionID string
+	t.Run("correct", func(t *testing.T) {
+		res, err := server.CorrectResourceEntry(ctx, &eventsv1pb.CorrectResourceEntryRequest{EventId: eventID,
+			PupilId: pupilID, Id: added.GetId(), ResourcesBrought: &eventsv1pb.ResourcesBrought{
+				Amounts: map[string]float32{"paper": 6},
+			}})
+		if err != nil {
+			t.Fatalf("CorrectResourceEntry() error == %v", err)
+		}
+		correctionID = res.GetId()
+		pupil := testGetEventPupilByID(t, pupilID, eventID)
+		if pupil.ResourcesBrought[eventsvc.Paper] != 6 {
+			t.Errorf("CorrectResourceEntry() paper == %v, want == 6", pupil.ResourcesBrought[eventsvc.Paper])
+		}
+		_, err = server.CorrectResourceEntry(ctx, &eventsv1pb.CorrectResourceEntryRequest{EventId: eventID,
+			PupilId: pupilID, Id: added.GetId(), ResourcesBrought: &eventsv1pb.ResourcesBrought{
+				Amounts: map[string]float32{"paper": 6},
+			}})
+		if st, _ := status.FromError(err); st.Code() != codes.FailedPrecondition {
+			t.Errorf("CorrectResourceEntry() of the voided entry code == %v, want == %v", st.Code(),
+				codes.FailedPrecondition)
+		}
+	})
+	t.Run("void", func(t *testing.T) {
+		_, err := server.VoidResourceEntry(ctx, &eventsv1pb.VoidResourceEntryRequest{EventId: eventID,
+			PupilId: pupilID, Id: "somerandomid"})
+		if st, _ := status.FromError(err); st.Code() != codes.NotFound {
+			t.Errorf("VoidResourceEntry() of the unknown entry code == %v, want == %v", st.Code(), codes.NotFound)
+		}
+		_, err = server.VoidResourceEntry(ctx, &eventsv1pb.VoidResourceEntryRequest{EventId: eventID,
+			PupilId: pupilID, Id: correctionID})
+		if err != nil {
+			t.Fatalf("VoidResourceEntry() error == %v", err)
+		}
+		res, err := server.FindEventPupilByID(ctx, &eventsv1pb.FindEventPupilByIDRequest{EventId: eventID,
+			PupilId: pupilID})
+		if err != nil {
+			t.Fatalf("FindEventPupilByID() error == %v", err)
+		}
+		if res.GetPupil().GetResourcesBrought().GetAmounts()["paper"] != 0 {
+			t.Errorf("VoidResourceEntry() paper == %v, want == 0", res.GetPupil().GetResourcesBrought().GetAmounts()["paper"])
+		}
+		// the history behind the resources shows both the corrected entry and the voided correction
+		history := res.GetResourceEntries()
+		if len(history) < 2 || history[len(history)-1].GetId() != correctionID ||
+			history[len(history)-1].GetVoidedAt() == nil || history[len(history)-2].GetVoidedAt() == nil {
+			t.Errorf("FindEventPupilByID() resource entries == %v, want the voided entries", history)
+		}
+	})
+}
+
 func TestServer_CreateEvent(t *testing.T) {
 	ctx := context.Background()
 	testCases := []struct {
@@ -175,7 +344,7 @@ func TestServer_CreateEvent(t *testing.T) {
 			req: &eventsv1pb.CreateEventRequest{
 				Date:             testTimeToProto(t, time.Now().AddDate(1, 0, 0)),
 				Name:             "event name",
-				ResourcesAllowed: []eventsv1pb.Resource{eventsv1pb.Resource_RESOURCE_UNKNOWN},
+				ResourcesAllowed: []string{"unknown resource"},
 			},
 			code: codes.InvalidArgument,
 		},
@@ -184,37 +353,34 @@ func TestServer_CreateEvent(t *testing.T) {
 			req: &eventsv1pb.CreateEventRequest{
 				Date:             testTimeToProto(t, time.Now().AddDate(-1, 0, 0)),
 				Name:             "event name",
-				ResourcesAllowed: []eventsv1pb.Resource{eventsv1pb.Resource_RESOURCE_PLASTIC},
+				ResourcesAllowed: []string{"plastic"},
 			},
 			code: codes.InvalidArgument,
 		},
 		{
 			name: "has no date",
 			req: &eventsv1pb.CreateEventRequest{
-				Date: nil,
-				Name: "event name",
-				ResourcesAllowed: []eventsv1pb.Resource{eventsv1pb.Resource_RESOURCE_PLASTIC,
-					eventsv1pb.Resource_RESOURCE_GADGETS},
+				Date:             nil,
+				Name:             "event name",
+				ResourcesAllowed: []string{"plastic", "gadgets"},
 			},
 			code: codes.InvalidArgument,
 		},
 		{
 			name: "no name",
 			req: &eventsv1pb.CreateEventRequest{
-				Date: testTimeToProto(t, time.Now().AddDate(1, 0, 0)),
-				Name: "",
-				ResourcesAllowed: []eventsv1pb.Resource{eventsv1pb.Resource_RESOURCE_PLASTIC,
-					eventsv1pb.Resource_RESOURCE_GADGETS},
+				Date:             testTimeToProto(t, time.Now().AddDate(1, 0, 0)),
+				Name:             "",
+				ResourcesAllowed: []string{"plastic", "gadgets"},
 			},
 			code: codes.OK,
 		},
 		{
 			name: "has name, has date, has resources",
 			req: &eventsv1pb.CreateEventRequest{
-				Date: testTimeToProto(t, time.Now().AddDate(1, 0, 0)),
-				Name: "event name",
-				ResourcesAllowed: []eventsv1pb.Resource{eventsv1pb.Resource_RESOURCE_PLASTIC,
-					eventsv1pb.Resource_RESOURCE_GADGETS},
+				Date:             testTimeToProto(t, time.Now().AddDate(1, 0, 0)),
+				Name:             "event name",
+				ResourcesAllowed: []string{"plastic", "gadgets"},
 			},
 			code: codes.OK,
 		},
@@ -315,6 +481,150 @@ func TestServer_DeleteEvent(t *testing.T) {
 	}
 }
 
+func TestServer_ChangeEventStatus(t *testing.T) {
+	ctx := context.Background()
+	eventID := testCreateEvent(t)
+	defer testDeleteEvent(t, eventID)
+	pupilID := testGetPupilID(t)
+
+	changePupilResources := func(ctx context.Context, id string) (*empty.Empty, error) {
+		return server.ChangePupilResources(ctx, &eventsv1pb.ChangePupilResourcesRequest{
+			EventId:          id,
+			PupilId:          pupilID,
+			ResourcesBrought: &eventsv1pb.ResourcesBrought{Amounts: map[string]float32{"plastic": 1}},
+		})
+	}
+	openEvent := func(ctx context.Context, id string) (*empty.Empty, error) {
+		return server.OpenEvent(ctx, &eventsv1pb.OpenEventRequest{Id: id})
+	}
+	closeEvent := func(ctx context.Context, id string) (*empty.Empty, error) {
+		return server.CloseEvent(ctx, &eventsv1pb.CloseEventRequest{Id: id})
+	}
+	archiveEvent := func(ctx context.Context, id string) (*empty.Empty, error) {
+		return server.ArchiveEvent(ctx, &eventsv1pb.ArchiveEventRequest{Id: id})
+	}
+	// the steps depend on each other, so their order matters
+	steps := []struct {
+		name string
+		call func(ctx context.Context, id string) (*empty.Empty, error)
+		id   string
+		code codes.Code
+	}{
+		{name: "no id", call: openEvent, id: "", code: codes.InvalidArgument},
+		{name: "no event with that id", call: openEvent, id: "somerandomid", code: codes.NotFound},
+		{name: "resources of the planned event", call: changePupilResources, id: eventID,
+			code: codes.FailedPrecondition},
+		{name: "close the planned event", call: closeEvent, id: eventID, code: codes.FailedPrecondition},
+		{name: "open the planned event", call: openEvent, id: eventID, code: codes.OK},
+		{name: "close the open event", call: closeEvent, id: eventID, code: codes.OK},
+		{name: "resources of the closed event", call: changePupilResources, id: eventID,
+			code: codes.FailedPrecondition},
+		{name: "reopen the closed event", call: openEvent, id: eventID, code: codes.FailedPrecondition},
+		{name: "archive the closed event", call: archiveEvent, id: eventID, code: codes.OK},
+	}
+	for _, step := range steps {
+		_, err := step.call(ctx, step.id)
+		if code := status.Code(err); code != step.code {
+			t.Fatalf("%s: code == %v, want == %v, err == %v", step.name, code, step.code, err)
+		}
+	}
+	res, err := server.FindEventByID(ctx, &eventsv1pb.FindEventByIDRequest{Id: eventID})
+	if err != nil {
+		t.Fatalf("FindEventByID() error == %v", err)
+	}
+	if res.GetEvent().GetStatus() != eventsv1pb.EventStatus_EVENT_STATUS_ARCHIVED {
+		t.Errorf("FindEventByID() status == %v, want == %v", res.GetEvent().GetStatus(),
+			eventsv1pb.EventStatus_EVENT_STATUS_ARCHIVED)
+	}
+}
+
+func TestServer_UpdateEvent(t *testing.T) {
+	ctx := context.Background()
+	eventID := testCreateEvent(t)
+	defer testDeleteEvent(t, eventID)
+	date := testTimeToProto(t, time.Now().AddDate(1, 0, 0))
+	testCases := []struct {
+		name string
+		req  *eventsv1pb.UpdateEventRequest
+		code codes.Code
+	}{
+		{
+			name: "no id",
+			req: &eventsv1pb.UpdateEventRequest{
+				Date:             date,
+				ResourcesAllowed: []string{"plastic"},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "no event with that id",
+			req: &eventsv1pb.UpdateEventRequest{
+				Id:               "somerandomid",
+				Date:             date,
+				ResourcesAllowed: []string{"plastic"},
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "unknown resource",
+			req: &eventsv1pb.UpdateEventRequest{
+				Id:               eventID,
+				Date:             date,
+				ResourcesAllowed: []string{"unknown resource"},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "valid request",
+			req: &eventsv1pb.UpdateEventRequest{
+				Id:               eventID,
+				Date:             date,
+				Name:             "updated",
+				ResourcesAllowed: []string{"paper", "plastic"},
+			},
+			code: codes.OK,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := server.UpdateEvent(ctx, tc.req)
+			if code := status.Code(err); code != tc.code {
+				t.Errorf("UpdateEvent() code == %v, want == %v, err == %v", code, tc.code, err)
+			}
+		})
+	}
+	res, err := server.FindEventByID(ctx, &eventsv1pb.FindEventByIDRequest{Id: eventID})
+	if err != nil {
+		t.Fatalf("FindEventByID() error == %v", err)
+	}
+	if res.GetEvent().GetName() != "updated" || len(res.GetEvent().GetResourcesAllowed()) != 2 {
+		t.Errorf("FindEventByID() event == %v, want the updated event", res.GetEvent())
+	}
+
+	t.Run("version", func(t *testing.T) {
+		version := res.GetEvent().GetVersion()
+		req := &eventsv1pb.UpdateEventRequest{
+			Id:               eventID,
+			Date:             date,
+			Name:             "updated again",
+			ResourcesAllowed: []string{"paper"},
+			Version:          version - 1,
+		}
+		if _, err := server.UpdateEvent(ctx, req); status.Code(err) != codes.Aborted {
+			t.Errorf("UpdateEvent() of the previous version code == %v, want == %v", status.Code(err), codes.Aborted)
+		}
+		// the REST gateway forwards the If-Match header as the metadata
+		req.Version = 0
+		ifMatchCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("grpcgateway-if-match", etag.Format(version)))
+		if _, err := server.UpdateEvent(ifMatchCtx, req); err != nil {
+			t.Fatalf("UpdateEvent() with If-Match of the current version error == %v", err)
+		}
+		if _, err := server.UpdateEvent(ifMatchCtx, req); status.Code(err) != codes.Aborted {
+			t.Errorf("UpdateEvent() with stale If-Match code == %v, want == %v", status.Code(err), codes.Aborted)
+		}
+	})
+}
+
 func TestServer_FindEventByID(t *testing.T) {
 	ctx := context.Background()
 	eventID := testGetEventID(t)
@@ -388,22 +698,24 @@ func TestServer_FindEventClasses(t *testing.T) {
 		{
 			name: "no event id",
 			req: &eventsv1pb.FindEventClassesRequest{
-				EventId:   "",
-				ClassName: "1b",
-				Sorting:   eventsv1pb.ClassSorting_CLASS_SORTING_PAPER,
-				Amount:    10,
-				Skip:      0,
+				EventId:         "",
+				ClassName:       "1b",
+				Sorting:         eventsv1pb.ClassSorting_CLASS_SORTING_RESOURCE,
+				SortingResource: "paper",
+				Amount:          10,
+				Skip:            0,
 			},
 			code: codes.InvalidArgument,
 		},
 		{
 			name: "no such event",
 			req: &eventsv1pb.FindEventClassesRequest{
-				EventId:   "somerandomeventid",
-				ClassName: "1b",
-				Sorting:   eventsv1pb.ClassSorting_CLASS_SORTING_PAPER,
-				Amount:    10,
-				Skip:      0,
+				EventId:         "somerandomeventid",
+				ClassName:       "1b",
+				Sorting:         eventsv1pb.ClassSorting_CLASS_SORTING_RESOURCE,
+				SortingResource: "paper",
+				Amount:          10,
+				Skip:            0,
 			},
 			code: codes.NotFound,
 		},
@@ -421,22 +733,24 @@ func TestServer_FindEventClasses(t *testing.T) {
 		{
 			name: "skip > amount of records",
 			req: &eventsv1pb.FindEventClassesRequest{
-				EventId:   eventID,
-				ClassName: "1b",
-				Sorting:   eventsv1pb.ClassSorting_CLASS_SORTING_PAPER,
-				Amount:    10,
-				Skip:      9999,
+				EventId:         eventID,
+				ClassName:       "1b",
+				Sorting:         eventsv1pb.ClassSorting_CLASS_SORTING_RESOURCE,
+				SortingResource: "paper",
+				Amount:          10,
+				Skip:            9999,
 			},
 			code: codes.OK,
 		},
 		{
 			name: "invalid class name",
 			req: &eventsv1pb.FindEventClassesRequest{
-				EventId:   eventID,
-				ClassName: "12bb",
-				Sorting:   eventsv1pb.ClassSorting_CLASS_SORTING_PAPER,
-				Amount:    10,
-				Skip:      0,
+				EventId:         eventID,
+				ClassName:       "12bb",
+				Sorting:         eventsv1pb.ClassSorting_CLASS_SORTING_RESOURCE,
+				SortingResource: "paper",
+				Amount:          10,
+				Skip:            0,
 			},
 			code: codes.OK,
 		},
@@ -495,22 +809,24 @@ func TestServer_FindEventPupils(t *testing.T) {
 		{
 			name: "no event id",
 			req: &eventsv1pb.FindEventPupilsRequest{
-				EventId:      "",
-				NameAndClass: "a 1a",
-				Sorting:      eventsv1pb.PupilSorting_PUPIL_SORTING_GADGETS,
-				Amount:       10,
-				Skip:         0,
+				EventId:         "",
+				NameAndClass:    "a 1a",
+				Sorting:         eventsv1pb.PupilSorting_PUPIL_SORTING_RESOURCE,
+				SortingResource: "gadgets",
+				Amount:          10,
+				Skip:            0,
 			},
 			code: codes.InvalidArgument,
 		},
 		{
 			name: "no such event",
 			req: &eventsv1pb.FindEventPupilsRequest{
-				EventId:      "somerandomeventid",
-				NameAndClass: "a 1a",
-				Sorting:      eventsv1pb.PupilSorting_PUPIL_SORTING_GADGETS,
-				Amount:       10,
-				Skip:         0,
+				EventId:         "somerandomeventid",
+				NameAndClass:    "a 1a",
+				Sorting:         eventsv1pb.PupilSorting_PUPIL_SORTING_RESOURCE,
+				SortingResource: "gadgets",
+				Amount:          10,
+				Skip:            0,
 			},
 			code: codes.NotFound,
 		},
@@ -528,22 +844,24 @@ func TestServer_FindEventPupils(t *testing.T) {
 		{
 			name: "all filters are set",
 			req: &eventsv1pb.FindEventPupilsRequest{
-				EventId:      eventID,
-				NameAndClass: "a 1a",
-				Sorting:      eventsv1pb.PupilSorting_PUPIL_SORTING_GADGETS,
-				Amount:       10,
-				Skip:         0,
+				EventId:         eventID,
+				NameAndClass:    "a 1a",
+				Sorting:         eventsv1pb.PupilSorting_PUPIL_SORTING_RESOURCE,
+				SortingResource: "gadgets",
+				Amount:          10,
+				Skip:            0,
 			},
 			code: codes.OK,
 		},
 		{
 			name: "skip > amount of records",
 			req: &eventsv1pb.FindEventPupilsRequest{
-				EventId:      eventID,
-				NameAndClass: "a 1a",
-				Sorting:      eventsv1pb.PupilSorting_PUPIL_SORTING_GADGETS,
-				Amount:       9999,
-				Skip:         0,
+				EventId:         eventID,
+				NameAndClass:    "a 1a",
+				Sorting:         eventsv1pb.PupilSorting_PUPIL_SORTING_RESOURCE,
+				SortingResource: "gadgets",
+				Amount:          9999,
+				Skip:            0,
 			},
 			code: codes.OK,
 		},
@@ -591,6 +909,97 @@ func TestServer_FindEventPupils(t *testing.T) {
 	}
 }
 
+func TestServer_StreamEventPupils(t *testing.T) {
+	eventID := testGetEventID(t)
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name string
+		ctx  context.Context
+		req  *eventsv1pb.StreamEventPupilsRequest
+		code codes.Code
+	}{
+		{
+			name: "no event id",
+			ctx:  context.Background(),
+			req:  &eventsv1pb.StreamEventPupilsRequest{EventId: ""},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "no such event",
+			ctx:  context.Background(),
+			req:  &eventsv1pb.StreamEventPupilsRequest{EventId: "somerandomeventid"},
+			code: codes.NotFound,
+		},
+		{
+			name: "all filters are set",
+			ctx:  context.Background(),
+			req: &eventsv1pb.StreamEventPupilsRequest{
+				EventId:         eventID,
+				NameAndClass:    "a 1a",
+				Sorting:         eventsv1pb.PupilSorting_PUPIL_SORTING_RESOURCE,
+				SortingResource: "gadgets",
+			},
+			code: codes.OK,
+		},
+		{
+			name: "client has gone away",
+			ctx:  canceledCtx,
+			req:  &eventsv1pb.StreamEventPupilsRequest{EventId: eventID},
+			code: codes.Canceled,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stream := &testEventPupilsStream{ctx: tc.ctx}
+			err := server.StreamEventPupils(tc.req, stream)
+			if st, _ := status.FromError(err); st.Code() != tc.code {
+				t.Fatalf("StreamEventPupils() err codes mismatch: code == %v, want == %v", st.Code(), tc.code)
+			}
+			if tc.code != codes.OK {
+				return
+			}
+			// the stream has the same pupils as the page
+			res, err := server.FindEventPupils(tc.ctx, &eventsv1pb.FindEventPupilsRequest{
+				EventId:         tc.req.GetEventId(),
+				NameAndClass:    tc.req.GetNameAndClass(),
+				Sorting:         tc.req.GetSorting(),
+				SortingResource: tc.req.GetSortingResource(),
+				Amount:          eventing.MaxAmount,
+			})
+			if err != nil {
+				t.Fatalf("FindEventPupils() error == %v", err)
+			}
+			if len(stream.sent) != int(res.GetTotal()) {
+				t.Fatalf("StreamEventPupils() sent %d pupils, want %d", len(stream.sent), res.GetTotal())
+			}
+			for i, pupil := range res.GetPupils() {
+				if stream.sent[i].GetPupil().GetId() != pupil.GetId() {
+					t.Errorf("StreamEventPupils() [%d] == %s, want %s", i, stream.sent[i].GetPupil().GetId(),
+						pupil.GetId())
+				}
+			}
+		})
+	}
+}
+
+// testEventPupilsStream is a stream of the pupils of the event which keeps the messages sent
+type testEventPupilsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*eventsv1pb.StreamEventPupilsResponse
+}
+
+func (s *testEventPupilsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testEventPupilsStream) Send(res *eventsv1pb.StreamEventPupilsResponse) error {
+	s.sent = append(s.sent, res)
+	return nil
+}
+
 func TestServer_FindEventPupilByID(t *testing.T) {
 	ctx := context.Background()
 	eventID := testGetEventID(t)
@@ -675,22 +1084,31 @@ func TestServer_FindEventPupilByID(t *testing.T) {
 
 func testGetEventID(t *testing.T) string {
 	t.Helper()
-	events, _, err := aggregatingRepo.Events(context.Background(), aggregating.EventFilters{}, sorting.NameDes, 1, 0)
+	events, _, _, err := aggregatingRepo.Events(context.Background(), aggregating.EventFilters{}, sorting.NameDes,
+		paging.Page{Size: 1})
 	if err != nil || len(events) == 0 {
 		t.Fatalf("couldn't find an event: %v", err)
 	}
 	return events[0].ID
 }
 
+// testGetEventIDWithResourcesAllowed returns the id of an open event, since the resources can be brought to the open
+// events only
 func testGetEventIDWithResourcesAllowed(t *testing.T, resourcesAllowed []eventsvc.Resource) string {
 	t.Helper()
-	events, _, err := aggregatingRepo.Events(context.Background(), aggregating.EventFilters{
+	events, _, _, err := aggregatingRepo.Events(context.Background(), aggregating.EventFilters{
 		ResourcesAllowed: resourcesAllowed,
-	}, sorting.NameDes, 1, 0)
-	if err != nil || len(events) == 0 {
+	}, sorting.NameDes, paging.Page{Size: aggregating.MaxAmount})
+	if err != nil {
 		t.Fatalf("couldn't find an event: %v", err)
 	}
-	return events[0].ID
+	for _, event := range events {
+		if event.Status == eventsvc.Open {
+			return event.ID
+		}
+	}
+	t.Fatalf("couldn't find an open event")
+	return ""
 }
 
 func testGetEventPupilByID(t *testing.T, pupilID, eventID string) *eventing.Pupil {
@@ -704,7 +1122,17 @@ func testGetEventPupilByID(t *testing.T, pupilID, eventID string) *eventing.Pupi
 
 func testChangePupilResources(t *testing.T, eventID, pupilID string, resources eventsvc.ResourceMap) {
 	t.Helper()
-	err := eventingRepo.ChangePupilResources(context.Background(), eventID, pupilID, resources)
+	id, err := gonanoid.Nanoid()
+	if err != nil {
+		t.Fatalf("couldn't generate entry id: %v", err)
+	}
+	err = eventingRepo.ChangePupilResources(context.Background(), &eventing.ResourceEntry{
+		ID:         id,
+		EventID:    eventID,
+		PupilID:    pupilID,
+		Resources:  resources,
+		RecordedAt: time.Now().UTC(),
+	}, 0, mock.NewMessage(t, broker.TopicPupilResourcesChanged))
 	if err != nil {
 		t.Fatalf("wasn't able to change pupil's resources back: %v", err)
 	}
@@ -726,7 +1154,7 @@ func testCreateEvent(t *testing.T) string {
 		Date:             time.Now().AddDate(1, 0, 0),
 		Name:             "",
 		ResourcesAllowed: []eventsvc.Resource{eventsvc.Plastic},
-	})
+	}, mock.NewMessage(t, broker.TopicEventCreated))
 	if err != nil {
 		t.Fatalf("couldn't create an event: %v", err)
 	}
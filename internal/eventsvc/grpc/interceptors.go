@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/shanvl/garbage/internal/eventsvc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -32,12 +33,13 @@ func (s *Server) authUnaryInterceptor() grpc.UnaryServerInterceptor {
 		token := getAccessTokenFromAuthHeader(ctx, "bearer")
 
 		// call the authorization service
-		_, err := s.authSvc.Authorize(ctx, token, info.FullMethod)
+		claims, err := s.authSvc.Authorize(ctx, token, info.FullMethod)
 		if err != nil {
 			return nil, s.handleError(err)
 		}
 
-		return handler(ctx, req)
+		// add the claims to the ctx
+		return handler(authClaimsToCtx(ctx, claims), req)
 	}
 }
 
@@ -116,7 +118,9 @@ func authClaimsFromCtx(ctx context.Context) (*AuthClaims, error) {
 	return creds, nil
 }
 
-// authClaimsToCtx adds auth claims to ctx
+// authClaimsToCtx adds auth claims to ctx along with the id and the scope of the user, which are used by the use cases
 func authClaimsToCtx(ctx context.Context, claims *AuthClaims) context.Context {
+	ctx = eventsvc.ContextWithScope(ctx, claims.Scope)
+	ctx = eventsvc.ContextWithUserID(ctx, claims.UserID)
 	return context.WithValue(ctx, AuthCtxKey, claims)
 }
@@ -7,9 +7,12 @@ import (
 	"time"
 
 	"github.com/shanvl/garbage/internal/eventsvc/aggregating"
+	"github.com/shanvl/garbage/internal/eventsvc/cataloguing"
 	"github.com/shanvl/garbage/internal/eventsvc/eventing"
 	"github.com/shanvl/garbage/internal/eventsvc/postgres"
 	"github.com/shanvl/garbage/internal/eventsvc/schooling"
+	"github.com/shanvl/garbage/internal/eventsvc/scoring"
+	"github.com/shanvl/garbage/pkg/audit"
 	"go.uber.org/zap"
 )
 
@@ -50,8 +53,11 @@ func testMain(m *testing.M) int {
 	// create services
 	authService := newTestAuthService()
 	aggregatingService := aggregating.NewService(aggregatingRepo)
+	auditService := audit.NewService(audit.NewPostgresRepo(db))
+	cataloguingService := cataloguing.NewService(postgres.NewCataloguingRepo(db))
 	eventingService := eventing.NewService(eventingRepo)
 	schoolingService := schooling.NewService(schoolingRepo)
+	scoringService := scoring.NewService(postgres.NewScoringRepo(db))
 	// logger
 	logger, err := zap.NewProduction()
 	if err != nil {
@@ -59,6 +65,7 @@ func testMain(m *testing.M) int {
 		return 1
 	}
 	// create gRPC server
-	server = NewServer(authService, aggregatingService, eventingService, schoolingService, logger)
+	server = NewServer(authService, aggregatingService, auditService, cataloguingService, eventingService,
+		schoolingService, scoringService, logger)
 	return m.Run()
 }
@@ -5,56 +5,103 @@ import (
 	"github.com/shanvl/garbage/internal/eventsvc"
 )
 
-var resourceProtoMap = map[eventsvc.Resource]eventsv1pb.Resource{
-	eventsvc.Gadgets: eventsv1pb.Resource_RESOURCE_GADGETS,
-	eventsvc.Paper:   eventsv1pb.Resource_RESOURCE_PAPER,
-	eventsvc.Plastic: eventsv1pb.Resource_RESOURCE_PLASTIC,
+var unitProtoMap = map[eventsvc.Unit]eventsv1pb.ResourceUnit{
+	eventsvc.Kilograms: eventsv1pb.ResourceUnit_RESOURCE_UNIT_KILOGRAMS,
+	eventsvc.Pieces:    eventsv1pb.ResourceUnit_RESOURCE_UNIT_PIECES,
+	eventsvc.Grams:     eventsv1pb.ResourceUnit_RESOURCE_UNIT_GRAMS,
+	eventsvc.Pounds:    eventsv1pb.ResourceUnit_RESOURCE_UNIT_POUNDS,
 }
 
-var protoResourceMap = map[eventsv1pb.Resource]eventsvc.Resource{
-	eventsv1pb.Resource_RESOURCE_GADGETS: eventsvc.Gadgets,
-	eventsv1pb.Resource_RESOURCE_PAPER:   eventsvc.Paper,
-	eventsv1pb.Resource_RESOURCE_PLASTIC: eventsvc.Plastic,
+var protoUnitMap = map[eventsv1pb.ResourceUnit]eventsvc.Unit{
+	eventsv1pb.ResourceUnit_RESOURCE_UNIT_KILOGRAMS: eventsvc.Kilograms,
+	eventsv1pb.ResourceUnit_RESOURCE_UNIT_PIECES:    eventsvc.Pieces,
+	eventsv1pb.ResourceUnit_RESOURCE_UNIT_GRAMS:     eventsvc.Grams,
+	eventsv1pb.ResourceUnit_RESOURCE_UNIT_POUNDS:    eventsvc.Pounds,
 }
 
-// converts []eventsvc.Resource to []eventsv1pb.Resource
-func resourcesToProto(resources []eventsvc.Resource) []eventsv1pb.Resource {
-	proto := make([]eventsv1pb.Resource, len(resources))
-	for i, res := range resources {
-		proto[i] = resourceProtoMap[res]
+// converts []eventsvc.Resource to the names of the resources
+func resourcesToProto(resources []eventsvc.Resource) []string {
+	return eventsvc.ResourceSliceToStringSlice(resources)
+}
+
+// converts the names of the resources to []eventsvc.Resource
+func protoToResources(proto []string) ([]eventsvc.Resource, error) {
+	return eventsvc.StringSliceToResourceSlice(proto)
+}
+
+// converts *eventsv1pb.ResourcesBrought to eventsvc.ResourceMap
+func protoToResourcesMap(proto *eventsv1pb.ResourcesBrought) (eventsvc.ResourceMap, error) {
+	m := make(eventsvc.ResourceMap, len(proto.GetAmounts()))
+	for name, amount := range proto.GetAmounts() {
+		resources, err := eventsvc.StringSliceToResourceSlice([]string{name})
+		if err != nil {
+			return nil, err
+		}
+		m[resources[0]] = amount
 	}
-	return proto
+	return m, nil
 }
 
-// converts []eventsv1pb.Resource to []eventsvc.Resource
-func protoToResources(proto []eventsv1pb.Resource) ([]eventsvc.Resource, error) {
-	resources := make([]eventsvc.Resource, len(proto))
-	for i, res := range proto {
-		if res == eventsv1pb.Resource_RESOURCE_UNKNOWN {
-			return nil, eventsvc.ErrUnknownResource
+// converts the units of *eventsv1pb.ResourcesBrought to eventsvc.UnitMap
+func protoToUnitMap(proto *eventsv1pb.ResourcesBrought) (eventsvc.UnitMap, error) {
+	m := make(eventsvc.UnitMap, len(proto.GetUnits()))
+	for name, protoUnit := range proto.GetUnits() {
+		resources, err := eventsvc.StringSliceToResourceSlice([]string{name})
+		if err != nil {
+			return nil, err
 		}
-		resources[i] = protoResourceMap[res]
+		unit, err := protoToUnit(protoUnit)
+		if err != nil {
+			return nil, err
+		}
+		m[resources[0]] = unit
 	}
-	return resources, nil
+	return m, nil
 }
 
-// converts *eventsv1pb.ResourcesBrought to eventsvc.ResourceMap
-func protoToResourcesMap(proto *eventsv1pb.ResourcesBrought) eventsvc.ResourceMap {
-	if proto == nil {
-		return eventsvc.ResourceMap{}
+// converts eventsvc.ResourceMap and the units of the resources, if any, to *eventsv1pb.ResourcesBrought
+func resourceMapToProto(m eventsvc.ResourceMap, units eventsvc.UnitMap) *eventsv1pb.ResourcesBrought {
+	proto := &eventsv1pb.ResourcesBrought{Amounts: m.ToStringMap()}
+	if len(units) > 0 {
+		proto.Units = make(map[string]eventsv1pb.ResourceUnit, len(units))
+		for res, unit := range units {
+			proto.Units[res.String()] = unitToProto(unit)
+		}
+	}
+	return proto
+}
+
+// converts eventsvc.ClassStats to *eventsv1pb.ClassStats
+func classStatsToProto(stats eventsvc.ClassStats, units eventsvc.UnitMap) *eventsv1pb.ClassStats {
+	return &eventsv1pb.ClassStats{
+		Pupils:            uint32(stats.Pupils),
+		Participants:      uint32(stats.Participants),
+		ParticipationRate: stats.ParticipationRate(),
+		Average:           resourceMapToProto(stats.Average, units),
+		Median:            resourceMapToProto(stats.Median, units),
+		MedianPoints:      stats.MedianPoints,
 	}
-	return eventsvc.ResourceMap{
-		eventsvc.Gadgets: proto.Gadgets,
-		eventsvc.Paper:   proto.Paper,
-		eventsvc.Plastic: proto.Plastic,
+}
+
+// converts eventsvc.Unit to eventsv1pb.ResourceUnit
+func unitToProto(unit eventsvc.Unit) eventsv1pb.ResourceUnit {
+	return unitProtoMap[unit]
+}
+
+// converts eventsv1pb.ResourceUnit to eventsvc.Unit
+func protoToUnit(proto eventsv1pb.ResourceUnit) (eventsvc.Unit, error) {
+	unit, ok := protoUnitMap[proto]
+	if !ok {
+		return 0, eventsvc.ErrUnknownUnit
 	}
+	return unit, nil
 }
 
-// converts eventsvc.ResourceMap to *eventsv1pb.ResourcesBrought
-func resourceMapToProto(m eventsvc.ResourceMap) *eventsv1pb.ResourcesBrought {
-	return &eventsv1pb.ResourcesBrought{
-		Gadgets: m[eventsvc.Gadgets],
-		Paper:   m[eventsvc.Paper],
-		Plastic: m[eventsvc.Plastic],
+// converts *eventsvc.ResourceType to *eventsv1pb.ResourceType
+func resourceTypeToProto(resourceType *eventsvc.ResourceType) *eventsv1pb.ResourceType {
+	return &eventsv1pb.ResourceType{
+		Name:   resourceType.Name.String(),
+		Unit:   unitToProto(resourceType.Unit),
+		Points: resourceType.Points,
 	}
 }
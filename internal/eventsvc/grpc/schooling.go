@@ -6,6 +6,7 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
 	"github.com/shanvl/garbage/internal/eventsvc/schooling"
+	"github.com/shanvl/garbage/pkg/etag"
 )
 
 // AddPupils adds the given pupils and returns the ids of the added
@@ -34,7 +35,11 @@ func (s *Server) AddPupils(ctx context.Context, req *eventsv1pb.AddPupilsRequest
 // ChangePupilClass changes the class of the pupil
 func (s *Server) ChangePupilClass(ctx context.Context, req *eventsv1pb.ChangePupilClassRequest) (*empty.Empty, error) {
 
-	err := s.scSvc.ChangePupilClass(ctx, req.GetPupilId(), req.GetClass())
+	version, err := etag.Precondition(ctx, req.GetVersion())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	err = s.scSvc.ChangePupilClass(ctx, req.GetPupilId(), req.GetClass(), version)
 	if err != nil {
 		return nil, s.handleError(err)
 	}
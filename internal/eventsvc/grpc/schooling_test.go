@@ -6,7 +6,9 @@ import (
 
 	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
 	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/internal/eventsvc/mock"
 	"github.com/shanvl/garbage/internal/eventsvc/schooling"
+	"github.com/shanvl/garbage/pkg/broker"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -178,6 +180,15 @@ func TestServer_ChangePupilClass(t *testing.T) {
 			},
 			code: codes.InvalidArgument,
 		},
+		{
+			name: "pupil has been changed in the meantime",
+			req: &eventsv1pb.ChangePupilClassRequest{
+				PupilId: pupilID,
+				Class:   "1b",
+				Version: 1000,
+			},
+			code: codes.Aborted,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -312,7 +323,7 @@ func testAddPupils(t *testing.T) []string {
 				DateFormed: date(2012, 9, 1),
 			},
 		},
-	})
+	}, mock.NewMessage(t, broker.TopicPupilsAdded))
 	if err != nil {
 		t.Fatalf("couldn't add pupils %v", err)
 	}
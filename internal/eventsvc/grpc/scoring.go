@@ -0,0 +1,111 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
+	"github.com/shanvl/garbage/internal/eventsvc/scoring"
+	"github.com/shanvl/garbage/pkg/etag"
+)
+
+// FindClassLeaderboard returns the classes ranked by the points they scored at the events of the period
+func (s *Server) FindClassLeaderboard(ctx context.Context, req *eventsv1pb.FindClassLeaderboardRequest) (*eventsv1pb.
+	FindClassLeaderboardResponse, error) {
+
+	period, err := protoToPeriod(req)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	classes, total, err := s.scoreSvc.ClassLeaderboard(ctx, period, int(req.GetAmount()), int(req.GetSkip()))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	pbClasses := make([]*eventsv1pb.ClassStanding, len(classes))
+	for i, class := range classes {
+		pbDateFormed, err := ptypes.TimestampProto(class.DateFormed)
+		if err != nil {
+			return nil, s.handleError(fmt.Errorf("class date formed: %w", ErrInvalidTimestamp))
+		}
+		pbClasses[i] = &eventsv1pb.ClassStanding{
+			Letter:     class.Letter,
+			DateFormed: pbDateFormed,
+			Standing:   standingToProto(class.Standing),
+		}
+	}
+	return &eventsv1pb.FindClassLeaderboardResponse{Classes: pbClasses, Total: uint32(total)}, nil
+}
+
+// FindPupilLeaderboard returns the pupils ranked by the points they scored at the events of the period
+func (s *Server) FindPupilLeaderboard(ctx context.Context, req *eventsv1pb.FindPupilLeaderboardRequest) (*eventsv1pb.
+	FindPupilLeaderboardResponse, error) {
+
+	period, err := protoToPeriod(req)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	pupils, total, err := s.scoreSvc.PupilLeaderboard(ctx, period, int(req.GetAmount()), int(req.GetSkip()))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	pbPupils := make([]*eventsv1pb.PupilStanding, len(pupils))
+	for i, pupil := range pupils {
+		pbClassDateFormed, err := ptypes.TimestampProto(pupil.DateFormed)
+		if err != nil {
+			return nil, s.handleError(fmt.Errorf("class date formed: %w", ErrInvalidTimestamp))
+		}
+		pbPupils[i] = &eventsv1pb.PupilStanding{
+			Id:              pupil.ID,
+			FirstName:       pupil.FirstName,
+			LastName:        pupil.LastName,
+			ClassLetter:     pupil.Letter,
+			ClassDateFormed: pbClassDateFormed,
+			Standing:        standingToProto(pupil.Standing),
+		}
+	}
+	return &eventsv1pb.FindPupilLeaderboardResponse{Pupils: pbPupils, Total: uint32(total)}, nil
+}
+
+// SetEventMultiplier sets the multiplier of the points scored at the event
+func (s *Server) SetEventMultiplier(ctx context.Context, req *eventsv1pb.SetEventMultiplierRequest) (*empty.Empty,
+	error) {
+
+	version, err := etag.Precondition(ctx, req.GetVersion())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	if err := s.scoreSvc.SetEventMultiplier(ctx, req.GetId(), req.GetMultiplier(), version); err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// protoToPeriod transforms the dates of the leaderboard request to scoring.Period
+func protoToPeriod(req interface {
+	GetFrom() *timestamp.Timestamp
+	GetTo() *timestamp.Timestamp
+}) (scoring.Period, error) {
+
+	from, err := protoTimeToTimestamp(req.GetFrom())
+	if err != nil {
+		return scoring.Period{}, fmt.Errorf("from: %w", err)
+	}
+	to, err := protoTimeToTimestamp(req.GetTo())
+	if err != nil {
+		return scoring.Period{}, fmt.Errorf("to: %w", err)
+	}
+	return scoring.Period{From: from, To: to}, nil
+}
+
+// standingToProto transforms scoring.Standing to *eventsv1pb.Standing
+func standingToProto(standing scoring.Standing) *eventsv1pb.Standing {
+	return &eventsv1pb.Standing{
+		Score:        standing.Score,
+		Rank:         uint32(standing.Rank),
+		PreviousRank: uint32(standing.PreviousRank),
+		RankDelta:    int32(standing.RankDelta()),
+	}
+}
@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
+	"github.com/shanvl/garbage/internal/eventsvc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_FindLeaderboards(t *testing.T) {
+	ctx := context.Background()
+	testCases := []struct {
+		name     string
+		from, to int
+		code     codes.Code
+	}{
+		{
+			name: "no period",
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "to before from",
+			from: 2020,
+			to:   2019,
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "ok",
+			from: 2019,
+			to:   2020,
+			code: codes.OK,
+		},
+	}
+	for _, tc := range testCases {
+		req := &eventsv1pb.FindClassLeaderboardRequest{}
+		pupilsReq := &eventsv1pb.FindPupilLeaderboardRequest{}
+		if tc.from != 0 {
+			req.From, pupilsReq.From = testTimeToProto(t, date(tc.from, 1, 1)), testTimeToProto(t, date(tc.from, 1, 1))
+			req.To, pupilsReq.To = testTimeToProto(t, date(tc.to, 1, 1)), testTimeToProto(t, date(tc.to, 1, 1))
+		}
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := server.FindClassLeaderboard(ctx, req)
+			if st, _ := status.FromError(err); st.Code() != tc.code {
+				t.Errorf("FindClassLeaderboard() code == %v, want == %v", st.Code(), tc.code)
+			}
+			if tc.code == codes.OK && res == nil {
+				t.Errorf("FindClassLeaderboard() response == nil")
+			}
+			pupilsRes, err := server.FindPupilLeaderboard(ctx, pupilsReq)
+			if st, _ := status.FromError(err); st.Code() != tc.code {
+				t.Errorf("FindPupilLeaderboard() code == %v, want == %v", st.Code(), tc.code)
+			}
+			if tc.code == codes.OK && pupilsRes == nil {
+				t.Errorf("FindPupilLeaderboard() response == nil")
+			}
+		})
+	}
+}
+
+func TestServer_SetEventMultiplier(t *testing.T) {
+	ctx := context.Background()
+	eventID := testGetEventIDWithResourcesAllowed(t, []eventsvc.Resource{"paper"})
+	defer server.SetEventMultiplier(ctx, &eventsv1pb.SetEventMultiplierRequest{Id: eventID, Multiplier: 1})
+
+	testCases := []struct {
+		name       string
+		eventID    string
+		multiplier float32
+		code       codes.Code
+	}{
+		{
+			name:       "no id",
+			multiplier: 2,
+			code:       codes.InvalidArgument,
+		},
+		{
+			name:    "no multiplier",
+			eventID: eventID,
+			code:    codes.InvalidArgument,
+		},
+		{
+			name:       "too large multiplier",
+			eventID:    eventID,
+			multiplier: 100,
+			code:       codes.InvalidArgument,
+		},
+		{
+			name:       "unknown event",
+			eventID:    "unknown",
+			multiplier: 2,
+			code:       codes.NotFound,
+		},
+		{
+			name:       "ok",
+			eventID:    eventID,
+			multiplier: 1.5,
+			code:       codes.OK,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := server.SetEventMultiplier(ctx, &eventsv1pb.SetEventMultiplierRequest{
+				Id:         tc.eventID,
+				Multiplier: tc.multiplier,
+			})
+			if st, _ := status.FromError(err); st.Code() != tc.code {
+				t.Errorf("SetEventMultiplier() code == %v, want == %v", st.Code(), tc.code)
+			}
+		})
+	}
+	t.Run("found by id", func(t *testing.T) {
+		res, err := server.FindEventByID(ctx, &eventsv1pb.FindEventByIDRequest{Id: eventID})
+		if err != nil {
+			t.Fatalf("FindEventByID() error == %v", err)
+		}
+		if res.GetEvent().GetMultiplier() != 1.5 {
+			t.Errorf("FindEventByID() multiplier == %v, want == 1.5", res.GetEvent().GetMultiplier())
+		}
+	})
+}
@@ -13,8 +13,11 @@ import (
 	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
 	healthv1pb "github.com/shanvl/garbage/api/health/v1/pb"
 	"github.com/shanvl/garbage/internal/eventsvc/aggregating"
+	"github.com/shanvl/garbage/internal/eventsvc/cataloguing"
 	"github.com/shanvl/garbage/internal/eventsvc/eventing"
 	"github.com/shanvl/garbage/internal/eventsvc/schooling"
+	"github.com/shanvl/garbage/internal/eventsvc/scoring"
+	"github.com/shanvl/garbage/pkg/audit"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -23,24 +26,34 @@ import (
 type Server struct {
 	authSvc AuthorizationService
 	aggrSvc aggregating.Service
-	evSvc   eventing.Service
-	scSvc   schooling.Service
-	log     *zap.Logger
+	// auditSvc records the changes made by calling the mutating RPCs
+	auditSvc audit.Service
+	catSvc   cataloguing.Service
+	evSvc    eventing.Service
+	scSvc    schooling.Service
+	scoreSvc scoring.Service
+	log      *zap.Logger
 }
 
 func NewServer(
 	authSvc AuthorizationService,
 	agSvc aggregating.Service,
+	auditSvc audit.Service,
+	catSvc cataloguing.Service,
 	evSvc eventing.Service,
 	scSvc schooling.Service,
+	scoreSvc scoring.Service,
 	log *zap.Logger,
 ) *Server {
 	server := &Server{
-		authSvc: authSvc,
-		aggrSvc: agSvc,
-		evSvc:   evSvc,
-		scSvc:   scSvc,
-		log:     log,
+		authSvc:  authSvc,
+		aggrSvc:  agSvc,
+		auditSvc: auditSvc,
+		catSvc:   catSvc,
+		evSvc:    evSvc,
+		scSvc:    scSvc,
+		scoreSvc: scoreSvc,
+		log:      log,
 	}
 	return server
 }
@@ -59,6 +72,8 @@ func (s *Server) Run(port int) error {
 			grpc_zap.UnaryServerInterceptor(s.log),
 			// authorization interceptor
 			s.authUnaryInterceptor(),
+			// audit interceptor, it needs the claims put to ctx by the authorization one
+			s.auditUnaryInterceptor(),
 			// panic recovery
 			grpcRecovery.UnaryServerInterceptor(grpcRecovery.WithRecoveryHandler(s.handleRecovery)),
 		)),
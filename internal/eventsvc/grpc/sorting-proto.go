@@ -1,35 +1,101 @@
 package grpc
 
 import (
+	"strings"
+
 	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
+	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/sorting"
 )
 
 var protoClassSortingMap = map[eventsv1pb.ClassSorting]sorting.By{
-	eventsv1pb.ClassSorting_CLASS_SORTING_GADGETS:   sorting.Gadgets,
-	eventsv1pb.ClassSorting_CLASS_SORTING_NAME_ASC:  sorting.NameAsc,
-	eventsv1pb.ClassSorting_CLASS_SORTING_NAME_DESC: sorting.NameDes,
-	eventsv1pb.ClassSorting_CLASS_SORTING_PAPER:     sorting.Paper,
-	eventsv1pb.ClassSorting_CLASS_SORTING_PLASTIC:   sorting.Plastic,
-	eventsv1pb.ClassSorting_CLASS_SORTING_UNKNOWN:   sorting.Unspecified,
+	eventsv1pb.ClassSorting_CLASS_SORTING_NAME_ASC:           sorting.NameAsc,
+	eventsv1pb.ClassSorting_CLASS_SORTING_NAME_DESC:          sorting.NameDes,
+	eventsv1pb.ClassSorting_CLASS_SORTING_TOTAL_ASC:          sorting.TotalAsc,
+	eventsv1pb.ClassSorting_CLASS_SORTING_TOTAL_DESC:         sorting.TotalDes,
+	eventsv1pb.ClassSorting_CLASS_SORTING_PER_PUPIL_ASC:      sorting.PerPupilAsc,
+	eventsv1pb.ClassSorting_CLASS_SORTING_PER_PUPIL_DESC:     sorting.PerPupilDes,
+	eventsv1pb.ClassSorting_CLASS_SORTING_PARTICIPATION_ASC:  sorting.ParticipationAsc,
+	eventsv1pb.ClassSorting_CLASS_SORTING_PARTICIPATION_DESC: sorting.ParticipationDes,
+	eventsv1pb.ClassSorting_CLASS_SORTING_MEDIAN_ASC:         sorting.MedianAsc,
+	eventsv1pb.ClassSorting_CLASS_SORTING_MEDIAN_DESC:        sorting.MedianDes,
+	eventsv1pb.ClassSorting_CLASS_SORTING_UNKNOWN:            sorting.Unspecified,
 }
 
 var protoPupilSortingMap = map[eventsv1pb.PupilSorting]sorting.By{
-	eventsv1pb.PupilSorting_PUPIL_SORTING_GADGETS:   sorting.Gadgets,
-	eventsv1pb.PupilSorting_PUPIL_SORTING_NAME_ASC:  sorting.NameAsc,
-	eventsv1pb.PupilSorting_PUPIL_SORTING_NAME_DESC: sorting.NameDes,
-	eventsv1pb.PupilSorting_PUPIL_SORTING_PAPER:     sorting.Paper,
-	eventsv1pb.PupilSorting_PUPIL_SORTING_PLASTIC:   sorting.Plastic,
-	eventsv1pb.PupilSorting_PUPIL_SORTING_UNKNOWN:   sorting.Unspecified,
+	eventsv1pb.PupilSorting_PUPIL_SORTING_NAME_ASC:   sorting.NameAsc,
+	eventsv1pb.PupilSorting_PUPIL_SORTING_NAME_DESC:  sorting.NameDes,
+	eventsv1pb.PupilSorting_PUPIL_SORTING_TOTAL_ASC:  sorting.TotalAsc,
+	eventsv1pb.PupilSorting_PUPIL_SORTING_TOTAL_DESC: sorting.TotalDes,
+	eventsv1pb.PupilSorting_PUPIL_SORTING_UNKNOWN:    sorting.Unspecified,
 }
 
 var protoEventSortingMap = map[eventsv1pb.EventSorting]sorting.By{
-	eventsv1pb.EventSorting_EVENT_SORTING_DATE_ASC:  sorting.DateAsc,
-	eventsv1pb.EventSorting_EVENT_SORTING_DATE_DESC: sorting.DateDes,
-	eventsv1pb.EventSorting_EVENT_SORTING_GADGETS:   sorting.Gadgets,
-	eventsv1pb.EventSorting_EVENT_SORTING_NAME_ASC:  sorting.NameAsc,
-	eventsv1pb.EventSorting_EVENT_SORTING_NAME_DESC: sorting.NameDes,
-	eventsv1pb.EventSorting_EVENT_SORTING_PAPER:     sorting.Paper,
-	eventsv1pb.EventSorting_EVENT_SORTING_PLASTIC:   sorting.Plastic,
-	eventsv1pb.EventSorting_EVENT_SORTING_UNKNOWN:   sorting.Unspecified,
+	eventsv1pb.EventSorting_EVENT_SORTING_DATE_ASC:   sorting.DateAsc,
+	eventsv1pb.EventSorting_EVENT_SORTING_DATE_DESC:  sorting.DateDes,
+	eventsv1pb.EventSorting_EVENT_SORTING_NAME_ASC:   sorting.NameAsc,
+	eventsv1pb.EventSorting_EVENT_SORTING_NAME_DESC:  sorting.NameDes,
+	eventsv1pb.EventSorting_EVENT_SORTING_TOTAL_ASC:  sorting.TotalAsc,
+	eventsv1pb.EventSorting_EVENT_SORTING_TOTAL_DESC: sorting.TotalDes,
+	eventsv1pb.EventSorting_EVENT_SORTING_UNKNOWN:    sorting.Unspecified,
+}
+
+// converts eventsv1pb.ClassSorting followed by the sortings applied next to sorting.By. The sortings by a resource are
+// made with the name of the resource
+func protoToClassSorting(proto eventsv1pb.ClassSorting, then []eventsv1pb.ClassSorting, resource string) sorting.By {
+	next := make([]sorting.By, len(then))
+	for i, t := range then {
+		next[i] = protoToClassSortingKey(t, resource)
+	}
+	return protoToClassSortingKey(proto, resource).Then(next...)
+}
+
+// converts eventsv1pb.ClassSorting to a key of sorting.By
+func protoToClassSortingKey(proto eventsv1pb.ClassSorting, resource string) sorting.By {
+	if proto == eventsv1pb.ClassSorting_CLASS_SORTING_RESOURCE {
+		return byResource(resource)
+	}
+	return protoClassSortingMap[proto]
+}
+
+// converts eventsv1pb.PupilSorting followed by the sortings applied next to sorting.By. The sortings by a resource are
+// made with the name of the resource
+func protoToPupilSorting(proto eventsv1pb.PupilSorting, then []eventsv1pb.PupilSorting, resource string) sorting.By {
+	next := make([]sorting.By, len(then))
+	for i, t := range then {
+		next[i] = protoToPupilSortingKey(t, resource)
+	}
+	return protoToPupilSortingKey(proto, resource).Then(next...)
+}
+
+// converts eventsv1pb.PupilSorting to a key of sorting.By
+func protoToPupilSortingKey(proto eventsv1pb.PupilSorting, resource string) sorting.By {
+	if proto == eventsv1pb.PupilSorting_PUPIL_SORTING_RESOURCE {
+		return byResource(resource)
+	}
+	return protoPupilSortingMap[proto]
+}
+
+// converts eventsv1pb.EventSorting followed by the sortings applied next to sorting.By. The sortings by a resource are
+// made with the name of the resource
+func protoToEventSorting(proto eventsv1pb.EventSorting, then []eventsv1pb.EventSorting, resource string) sorting.By {
+	next := make([]sorting.By, len(then))
+	for i, t := range then {
+		next[i] = protoToEventSortingKey(t, resource)
+	}
+	return protoToEventSortingKey(proto, resource).Then(next...)
+}
+
+// converts eventsv1pb.EventSorting to a key of sorting.By
+func protoToEventSortingKey(proto eventsv1pb.EventSorting, resource string) sorting.By {
+	if proto == eventsv1pb.EventSorting_EVENT_SORTING_RESOURCE {
+		return byResource(resource)
+	}
+	return protoEventSortingMap[proto]
+}
+
+// byResource returns the sorting by the resource with the given name. The services replace the sorting by an invalid
+// name with the default one
+func byResource(resource string) sorting.By {
+	return sorting.ByResource(eventsvc.Resource(strings.ToLower(resource)))
 }
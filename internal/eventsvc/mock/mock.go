@@ -2,52 +2,72 @@ package mock
 
 import (
 	"context"
+	"testing"
 
 	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/aggregating"
 	"github.com/shanvl/garbage/internal/eventsvc/eventing"
 	"github.com/shanvl/garbage/internal/eventsvc/schooling"
+	"github.com/shanvl/garbage/internal/eventsvc/scoring"
 	"github.com/shanvl/garbage/internal/eventsvc/sorting"
+	"github.com/shanvl/garbage/pkg/broker"
+	"github.com/shanvl/garbage/pkg/paging"
 )
 
 // AggregatingRepository is a mock repository for aggregating use case
 type AggregatingRepository struct {
 	ClassesFn func(ctx context.Context, filters aggregating.ClassFilters, classesSorting, eventsSorting sorting.By,
-		amount, skip int) (classes []*aggregating.Class, total int, err error)
+		page paging.Page) (classes []*aggregating.Class, total int, nextToken string, err error)
 	ClassesInvoked bool
 
-	EventsFn func(ctx context.Context, filters aggregating.EventFilters, sortBy sorting.By, amount,
-		skip int) (events []*aggregating.Event, total int, err error)
+	EventsFn func(ctx context.Context, filters aggregating.EventFilters, sortBy sorting.By,
+		page paging.Page) (events []*aggregating.Event, total int, nextToken string, err error)
 	EventsInvoked bool
 
-	PupilsFn func(ctx context.Context, filters aggregating.PupilFilters, pupilsSorting, eventsSorting sorting.By, amount,
-		skip int) (pupils []*aggregating.Pupil, total int, err error)
+	PupilsFn func(ctx context.Context, filters aggregating.PupilFilters, pupilsSorting, eventsSorting sorting.By,
+		page paging.Page) (pupils []*aggregating.Pupil, total int, nextToken string, err error)
 	PupilsInvoked bool
 
+	StreamPupilsFn func(ctx context.Context, filters aggregating.PupilFilters, pupilsSorting,
+		eventsSorting sorting.By, fn func(*aggregating.Pupil) error) error
+	StreamPupilsInvoked bool
+
 	PupilByIDFn func(ctx context.Context, id string, filters aggregating.EventFilters,
 		eventsSorting sorting.By) (*aggregating.Pupil, error)
 	PupilByIDInvoked bool
+
+	ResourceTypesFn      func(ctx context.Context) ([]*eventsvc.ResourceType, error)
+	ResourceTypesInvoked bool
 }
 
 func (r *AggregatingRepository) Classes(ctx context.Context, filters aggregating.ClassFilters, classesSorting,
-	eventsSorting sorting.By, amount, skip int) (classes []*aggregating.Class, total int, err error) {
+	eventsSorting sorting.By, page paging.Page) (classes []*aggregating.Class, total int, nextToken string,
+	err error) {
 
 	r.ClassesInvoked = true
-	return r.ClassesFn(ctx, filters, classesSorting, eventsSorting, amount, skip)
+	return r.ClassesFn(ctx, filters, classesSorting, eventsSorting, page)
 }
 
 func (r *AggregatingRepository) Events(ctx context.Context, filters aggregating.EventFilters, sortBy sorting.By,
-	amount, skip int) (events []*aggregating.Event, total int, err error) {
+	page paging.Page) (events []*aggregating.Event, total int, nextToken string, err error) {
 
 	r.EventsInvoked = true
-	return r.EventsFn(ctx, filters, sortBy, amount, skip)
+	return r.EventsFn(ctx, filters, sortBy, page)
 }
 
 func (r *AggregatingRepository) Pupils(ctx context.Context, filters aggregating.PupilFilters, pupilsSorting,
-	eventsSorting sorting.By, amount, skip int) (pupils []*aggregating.Pupil, total int, err error) {
+	eventsSorting sorting.By, page paging.Page) (pupils []*aggregating.Pupil, total int, nextToken string,
+	err error) {
 
 	r.PupilsInvoked = true
-	return r.PupilsFn(ctx, filters, pupilsSorting, eventsSorting, amount, skip)
+	return r.PupilsFn(ctx, filters, pupilsSorting, eventsSorting, page)
+}
+
+func (r *AggregatingRepository) StreamPupils(ctx context.Context, filters aggregating.PupilFilters, pupilsSorting,
+	eventsSorting sorting.By, fn func(*aggregating.Pupil) error) error {
+
+	r.StreamPupilsInvoked = true
+	return r.StreamPupilsFn(ctx, filters, pupilsSorting, eventsSorting, fn)
 }
 
 func (r *AggregatingRepository) PupilByID(ctx context.Context, id string,
@@ -57,12 +77,69 @@ func (r *AggregatingRepository) PupilByID(ctx context.Context, id string,
 	return r.PupilByIDFn(ctx, id, filters, eventsSorting)
 }
 
+func (r *AggregatingRepository) ResourceTypes(ctx context.Context) ([]*eventsvc.ResourceType, error) {
+	r.ResourceTypesInvoked = true
+	return r.ResourceTypesFn(ctx)
+}
+
+// CataloguingRepository is a mock repository for cataloguing use case
+type CataloguingRepository struct {
+	DeleteResourceTypeFn      func(ctx context.Context, name eventsvc.Resource) error
+	DeleteResourceTypeInvoked bool
+
+	ResourceTypesFn      func(ctx context.Context) ([]*eventsvc.ResourceType, error)
+	ResourceTypesInvoked bool
+
+	StoreResourceTypeFn      func(ctx context.Context, resourceType *eventsvc.ResourceType) error
+	StoreResourceTypeInvoked bool
+
+	UpdateResourceTypeFn      func(ctx context.Context, resourceType *eventsvc.ResourceType) error
+	UpdateResourceTypeInvoked bool
+}
+
+// DeleteResourceType calls DeleteResourceTypeFn
+func (r *CataloguingRepository) DeleteResourceType(ctx context.Context, name eventsvc.Resource) error {
+	r.DeleteResourceTypeInvoked = true
+	return r.DeleteResourceTypeFn(ctx, name)
+}
+
+// ResourceTypes calls ResourceTypesFn
+func (r *CataloguingRepository) ResourceTypes(ctx context.Context) ([]*eventsvc.ResourceType, error) {
+	r.ResourceTypesInvoked = true
+	return r.ResourceTypesFn(ctx)
+}
+
+// StoreResourceType calls StoreResourceTypeFn
+func (r *CataloguingRepository) StoreResourceType(ctx context.Context, resourceType *eventsvc.ResourceType) error {
+	r.StoreResourceTypeInvoked = true
+	return r.StoreResourceTypeFn(ctx, resourceType)
+}
+
+// UpdateResourceType calls UpdateResourceTypeFn
+func (r *CataloguingRepository) UpdateResourceType(ctx context.Context, resourceType *eventsvc.ResourceType) error {
+	r.UpdateResourceTypeInvoked = true
+	return r.UpdateResourceTypeFn(ctx, resourceType)
+}
+
 // EventingRepository is a mock repository for eventing use case
 type EventingRepository struct {
-	ChangePupilResourcesFn func(ctx context.Context, eventID string,
-		pupilID string, resources eventsvc.ResourceMap) error
+	AddPupilResourcesFn      func(ctx context.Context, entry *eventing.ResourceEntry, msg broker.Message) error
+	AddPupilResourcesInvoked bool
+
+	ChangeEventStatusFn func(ctx context.Context, eventID string, from, to eventsvc.EventStatus,
+		msg broker.Message) error
+	ChangeEventStatusInvoked bool
+
+	ChangePupilResourcesFn func(ctx context.Context, entry *eventing.ResourceEntry, version int,
+		msg broker.Message) error
 	ChangePupilResourcesInvoked bool
 
+	ClassesWithResourcesFn      func(ctx context.Context, eventID string) ([]eventsvc.Class, error)
+	ClassesWithResourcesInvoked bool
+
+	CorrectResourceEntryFn      func(ctx context.Context, correction *eventing.ResourceEntry, msg broker.Message) error
+	CorrectResourceEntryInvoked bool
+
 	DeleteEventFn      func(ctx context.Context, id string) error
 	DeleteEventInvoked bool
 
@@ -70,25 +147,68 @@ type EventingRepository struct {
 	EventByIDInvoked bool
 
 	EventPupilsFn func(ctx context.Context, eventID string, filters eventing.EventPupilFilters,
-		sortBy sorting.By, amount int, skip int) ([]*eventing.Pupil, int, error)
+		sortBy sorting.By, page paging.Page) ([]*eventing.Pupil, int, string, error)
 	EventPupilsInvoked bool
 
 	EventClassesFn func(ctx context.Context, eventID string, filters eventing.EventClassFilters,
-		sortBy sorting.By, amount int, skip int) ([]*eventing.Class, int, error)
+		sortBy sorting.By, page paging.Page) ([]*eventing.Class, int, string, error)
 	EventClassesInvoked bool
 
 	PupilByIDFn      func(ctx context.Context, pupilID string, eventID string) (*eventing.Pupil, error)
 	PupilByIDInvoked bool
 
-	StoreEventFn      func(ctx context.Context, e *eventsvc.Event) error
+	PupilResourceEntriesFn      func(ctx context.Context, eventID, pupilID string) ([]*eventing.ResourceEntry, error)
+	PupilResourceEntriesInvoked bool
+
+	ResourceTypesFn      func(ctx context.Context) ([]*eventsvc.ResourceType, error)
+	ResourceTypesInvoked bool
+
+	StoreEventFn      func(ctx context.Context, e *eventsvc.Event, msg broker.Message) error
 	StoreEventInvoked bool
+
+	StreamEventPupilsFn func(ctx context.Context, eventID string, filters eventing.EventPupilFilters,
+		sortBy sorting.By, fn func(*eventing.Pupil) error) error
+	StreamEventPupilsInvoked bool
+
+	UpdateEventFn      func(ctx context.Context, e *eventsvc.Event, msg broker.Message) error
+	UpdateEventInvoked bool
+
+	VoidResourceEntryFn      func(ctx context.Context, entry *eventing.ResourceEntry, msg broker.Message) error
+	VoidResourceEntryInvoked bool
 }
 
-// ChangePupilResources calls ChangeEventResourcesFn
-func (r *EventingRepository) ChangePupilResources(ctx context.Context, eventID string,
-	pupilID string, resources eventsvc.ResourceMap) error {
+// AddPupilResources calls AddPupilResourcesFn
+func (r *EventingRepository) AddPupilResources(ctx context.Context, entry *eventing.ResourceEntry,
+	msg broker.Message) error {
+	r.AddPupilResourcesInvoked = true
+	return r.AddPupilResourcesFn(ctx, entry, msg)
+}
+
+// ChangeEventStatus calls ChangeEventStatusFn
+func (r *EventingRepository) ChangeEventStatus(ctx context.Context, eventID string, from,
+	to eventsvc.EventStatus, msg broker.Message) error {
+	r.ChangeEventStatusInvoked = true
+	return r.ChangeEventStatusFn(ctx, eventID, from, to, msg)
+}
+
+// ChangePupilResources calls ChangePupilResourcesFn
+func (r *EventingRepository) ChangePupilResources(ctx context.Context, entry *eventing.ResourceEntry, version int,
+	msg broker.Message) error {
 	r.ChangePupilResourcesInvoked = true
-	return r.ChangePupilResourcesFn(ctx, eventID, pupilID, resources)
+	return r.ChangePupilResourcesFn(ctx, entry, version, msg)
+}
+
+// ClassesWithResources calls ClassesWithResourcesFn
+func (r *EventingRepository) ClassesWithResources(ctx context.Context, eventID string) ([]eventsvc.Class, error) {
+	r.ClassesWithResourcesInvoked = true
+	return r.ClassesWithResourcesFn(ctx, eventID)
+}
+
+// CorrectResourceEntry calls CorrectResourceEntryFn
+func (r *EventingRepository) CorrectResourceEntry(ctx context.Context, correction *eventing.ResourceEntry,
+	msg broker.Message) error {
+	r.CorrectResourceEntryInvoked = true
+	return r.CorrectResourceEntryFn(ctx, correction, msg)
 }
 
 // DeleteEvent calls DeleteEventFn
@@ -105,16 +225,16 @@ func (r *EventingRepository) EventByID(ctx context.Context, id string) (*eventin
 
 // EventClasses calls EventClassesFn
 func (r *EventingRepository) EventClasses(ctx context.Context, eventID string,
-	filters eventing.EventClassFilters, sortBy sorting.By, amount int, skip int) ([]*eventing.Class, int, error) {
+	filters eventing.EventClassFilters, sortBy sorting.By, page paging.Page) ([]*eventing.Class, int, string, error) {
 	r.EventClassesInvoked = true
-	return r.EventClassesFn(ctx, eventID, filters, sortBy, amount, skip)
+	return r.EventClassesFn(ctx, eventID, filters, sortBy, page)
 }
 
 // EventPupils calls EventPupilsFn
 func (r *EventingRepository) EventPupils(ctx context.Context, eventID string,
-	filters eventing.EventPupilFilters, sortBy sorting.By, amount int, skip int) ([]*eventing.Pupil, int, error) {
+	filters eventing.EventPupilFilters, sortBy sorting.By, page paging.Page) ([]*eventing.Pupil, int, string, error) {
 	r.EventPupilsInvoked = true
-	return r.EventPupilsFn(ctx, eventID, filters, sortBy, amount, skip)
+	return r.EventPupilsFn(ctx, eventID, filters, sortBy, page)
 }
 
 // PupilByID calls PupilByIDFn
@@ -125,10 +245,43 @@ func (r *EventingRepository) PupilByID(ctx context.Context, pupilID string,
 	return r.PupilByIDFn(ctx, pupilID, eventID)
 }
 
+// PupilResourceEntries calls PupilResourceEntriesFn
+func (r *EventingRepository) PupilResourceEntries(ctx context.Context, eventID,
+	pupilID string) ([]*eventing.ResourceEntry, error) {
+	r.PupilResourceEntriesInvoked = true
+	return r.PupilResourceEntriesFn(ctx, eventID, pupilID)
+}
+
+// ResourceTypes calls ResourceTypesFn
+func (r *EventingRepository) ResourceTypes(ctx context.Context) ([]*eventsvc.ResourceType, error) {
+	r.ResourceTypesInvoked = true
+	return r.ResourceTypesFn(ctx)
+}
+
 // StoreEvent calls StoreEventFn
-func (r *EventingRepository) StoreEvent(ctx context.Context, e *eventsvc.Event) error {
+func (r *EventingRepository) StoreEvent(ctx context.Context, e *eventsvc.Event, msg broker.Message) error {
 	r.StoreEventInvoked = true
-	return r.StoreEventFn(ctx, e)
+	return r.StoreEventFn(ctx, e, msg)
+}
+
+// StreamEventPupils calls StreamEventPupilsFn
+func (r *EventingRepository) StreamEventPupils(ctx context.Context, eventID string,
+	filters eventing.EventPupilFilters, sortBy sorting.By, fn func(*eventing.Pupil) error) error {
+	r.StreamEventPupilsInvoked = true
+	return r.StreamEventPupilsFn(ctx, eventID, filters, sortBy, fn)
+}
+
+// UpdateEvent calls UpdateEventFn
+func (r *EventingRepository) UpdateEvent(ctx context.Context, e *eventsvc.Event, msg broker.Message) error {
+	r.UpdateEventInvoked = true
+	return r.UpdateEventFn(ctx, e, msg)
+}
+
+// VoidResourceEntry calls VoidResourceEntryFn
+func (r *EventingRepository) VoidResourceEntry(ctx context.Context, entry *eventing.ResourceEntry,
+	msg broker.Message) error {
+	r.VoidResourceEntryInvoked = true
+	return r.VoidResourceEntryFn(ctx, entry, msg)
 }
 
 // SchoolingRepository is mock repository for schooling use case
@@ -142,7 +295,7 @@ type SchoolingRepository struct {
 	UpdatePupilFn     func(ctx context.Context, pupils *schooling.Pupil) error
 	StorePupilInvoked bool
 
-	StorePupilsFn      func(ctx context.Context, pupils []*schooling.Pupil) error
+	StorePupilsFn      func(ctx context.Context, pupils []*schooling.Pupil, msg broker.Message) error
 	StorePupilsInvoked bool
 }
 
@@ -165,7 +318,53 @@ func (r *SchoolingRepository) UpdatePupil(ctx context.Context, pupil *schooling.
 }
 
 // StorePupils calls StorePupilsFn
-func (r *SchoolingRepository) StorePupils(ctx context.Context, pupils []*schooling.Pupil) error {
+func (r *SchoolingRepository) StorePupils(ctx context.Context, pupils []*schooling.Pupil,
+	msg broker.Message) error {
 	r.StorePupilsInvoked = true
-	return r.StorePupilsFn(ctx, pupils)
+	return r.StorePupilsFn(ctx, pupils, msg)
+}
+
+// ScoringRepository is a mock repository for scoring use case
+type ScoringRepository struct {
+	ChangeEventMultiplierFn      func(ctx context.Context, eventID string, multiplier float32, version int) error
+	ChangeEventMultiplierInvoked bool
+
+	ClassLeaderboardFn func(ctx context.Context, period, previous scoring.Period, amount,
+		skip int) (classes []*scoring.Class, total int, err error)
+	ClassLeaderboardInvoked bool
+
+	PupilLeaderboardFn func(ctx context.Context, period, previous scoring.Period, scope eventsvc.Scope, amount,
+		skip int) (pupils []*scoring.Pupil, total int, err error)
+	PupilLeaderboardInvoked bool
+}
+
+// ChangeEventMultiplier calls ChangeEventMultiplierFn
+func (r *ScoringRepository) ChangeEventMultiplier(ctx context.Context, eventID string, multiplier float32,
+	version int) error {
+	r.ChangeEventMultiplierInvoked = true
+	return r.ChangeEventMultiplierFn(ctx, eventID, multiplier, version)
+}
+
+// ClassLeaderboard calls ClassLeaderboardFn
+func (r *ScoringRepository) ClassLeaderboard(ctx context.Context, period, previous scoring.Period, amount,
+	skip int) (classes []*scoring.Class, total int, err error) {
+	r.ClassLeaderboardInvoked = true
+	return r.ClassLeaderboardFn(ctx, period, previous, amount, skip)
+}
+
+// PupilLeaderboard calls PupilLeaderboardFn
+func (r *ScoringRepository) PupilLeaderboard(ctx context.Context, period, previous scoring.Period,
+	scope eventsvc.Scope, amount, skip int) (pupils []*scoring.Pupil, total int, err error) {
+	r.PupilLeaderboardInvoked = true
+	return r.PupilLeaderboardFn(ctx, period, previous, scope, amount, skip)
+}
+
+// NewMessage returns a broker message to be stored alongside the data in the tests
+func NewMessage(t *testing.T, topic string) broker.Message {
+	t.Helper()
+	msg, err := broker.NewMessage(topic, struct{}{})
+	if err != nil {
+		t.Fatalf("couldn't create a message: %v", err)
+	}
+	return msg
 }
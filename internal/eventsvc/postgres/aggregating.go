@@ -7,11 +7,13 @@ import (
 	"time"
 
 	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/jmoiron/sqlx"
 	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/aggregating"
 	"github.com/shanvl/garbage/internal/eventsvc/sorting"
+	"github.com/shanvl/garbage/pkg/paging"
 	pgtextsearch "github.com/shanvl/garbage/pkg/pg-text-search"
 )
 
@@ -26,66 +28,108 @@ func NewAggregatingRepo(db *pgxpool.Pool) aggregating.Repository {
 }
 
 const classesQuery = `
-	with query as (
-		select class_date_formed,
+	with pupils as (
+		select p.id                                                                     as pupil_id,
+			   class_date_formed,
 			   class_letter,
 			   e.id,
 			   e.date,
 			   e.name,
                e.resources_allowed,
-			   sum(coalesce(gadgets, 0)) as gadgets,
-			   sum(coalesce(paper, 0))   as paper,
-			   sum(coalesce(plastic, 0)) as plastic
+			   e.status,
+			   coalesce(r.amounts, '{}')                                                 as amounts,
+			   exists(select 1 from jsonb_each_text(r.amounts) where value::float4 > 0) as brought
 		from pupil p
 				 cross join event e
 				 left join resources r on r.event_id = e.id and r.pupil_id = p.id
 		where e.date between symmetric greatest(p.class_date_formed, ?) and least(
 				p.class_date_formed + (365.25 * 11)::integer, ?) %s
-		group by class_date_formed, class_letter, e.id
 	),
+		 query as (
+			 select class_date_formed,
+					class_letter,
+					id,
+					date,
+					name,
+					resources_allowed,
+					status,
+					resource_amounts_sum(amounts) as amounts
+			 from pupils
+			 group by class_date_formed, class_letter, id, date, name, resources_allowed, status
+		 ),
+		 pupil_totals as (
+			 select class_date_formed,
+					class_letter,
+					resource_amounts_sum(amounts) as amounts,
+					bool_or(brought)              as brought
+			 from pupils
+			 group by class_date_formed, class_letter, pupil_id
+		 ),
 		 aggr as (
 			 select class_date_formed,
 					class_letter,
-					sum(coalesce(gadgets, 0)) as gadgets_aggr,
-					sum(coalesce(paper, 0))   as paper_aggr,
-					sum(coalesce(plastic, 0)) as plastic_aggr
-			 from query
+					resource_amounts_sum(amounts)   as amounts_aggr,
+					count(*)                        as class_pupils,
+					count(*) filter (where brought) as class_participants,
+					percentile_cont(0.5) within group (order by resource_amounts_points(amounts))::float4 as class_median_points
+			 from pupil_totals
 			 group by class_date_formed, class_letter
 		 ),
 		 pagination as (
-			 select *
+			 select *, %s as page_cursor
 			 from aggr
+			 where %s
 			 order by %s
 			 limit ? offset ?
+		 ),
+		 medians as (
+			 -- the medians of the resources brought are found for the paginated classes only
+			 select class_date_formed, class_letter, jsonb_object_agg(res, median) as amounts_median
+			 from (select t.class_date_formed,
+						  t.class_letter,
+						  res,
+						  percentile_cont(0.5) within group (order by coalesce((t.amounts ->> res)::float4, 0)) as median
+				   from pupil_totals t
+							inner join pagination pg using (class_date_formed, class_letter)
+							cross join jsonb_object_keys(pg.amounts_aggr) as res
+				   group by t.class_date_formed, t.class_letter, res) m
+			 group by class_date_formed, class_letter
 		 )
 	select class_date_formed,
 		   class_letter,
-		   gadgets_aggr,
-		   paper_aggr,
-		   plastic_aggr,
+		   amounts_aggr,
+		   class_pupils,
+		   class_participants,
+		   class_median_points,
+		   coalesce(amounts_median, '{}'),
 		   id,
 		   date,
 		   name,
            resources_allowed::text[],
-		   gadgets,
-		   paper,
-		   plastic,
+		   status::text,
+		   amounts,
+		   page_cursor,
 		   total
 	from query
 			 inner join pagination using (class_date_formed, class_letter)
-			 right join (select count(*) from aggr) as c(total) on true
+			 left join medians using (class_date_formed, class_letter)
+			 right join (%s) as c(total) on true
 	order by %s
 `
 
 // Classes returns a sorted list of the classes that passed the classes filters with a sorted list of the events that
-// passed the event filters
+// passed the event filters. The page of the classes either skips a number of them or follows the page token
 func (a *aggregatingRepo) Classes(ctx context.Context, filters aggregating.ClassFilters, classesSorting,
-	eventsSorting sorting.By, amount, skip int) (classes []*aggregating.Class, total int, err error) {
+	eventsSorting sorting.By, page paging.Page) (classes []*aggregating.Class, total int, nextToken string,
+	err error) {
 
-	// create the "order by" parts of the query
-	classOrderBy := classAggrOrderMap[classesSorting]
-	eventOrderBy := eventOrderMap[eventsSorting]
-	orderBy := fmt.Sprintf("%s, class_date_formed desc, class_letter, %s", classOrderBy, eventOrderBy)
+	// create the parts of the query which page through the classes
+	pq, err := newPageQuery(page, classesSorting, classAggrOrdering.pageColumns(classesSorting), "aggr")
+	if err != nil {
+		return nil, 0, "", err
+	}
+	// the events of each class are sorted after the classes
+	orderBy := fmt.Sprintf("%s, %s", pq.orderBy, eventOrdering.orderBy(eventsSorting))
 
 	// create the "where" part of the query
 	where := strings.Builder{}
@@ -96,7 +140,7 @@ func (a *aggregatingRepo) Classes(ctx context.Context, filters aggregating.Class
 	}
 	args = append(args, filters.From, filters.To)
 	if len(filters.ResourcesAllowed) > 0 {
-		where.WriteString("and e.resources_allowed @> ?::text[]::resource[] ")
+		where.WriteString("and e.resources_allowed @> ?::varchar[] ")
 		args = append(args, eventsvc.ResourceSliceToStringSlice(filters.ResourcesAllowed))
 	}
 	// event's name text search
@@ -114,44 +158,45 @@ func (a *aggregatingRepo) Classes(ctx context.Context, filters aggregating.Class
 		args = append(args, filters.DateFormed)
 	}
 
-	// add limit and offset to the query
-	args = append(args, amount, skip)
-	q := fmt.Sprintf(classesQuery, where.String(), classOrderBy, orderBy)
+	// add the page's condition, limit and offset to the query
+	args = append(args, pq.args...)
+	args = append(args, page.Size, page.Offset())
+	q := fmt.Sprintf(classesQuery, where.String(), pq.cursor, pq.after, pq.orderBy, pq.total, orderBy)
 	// change "?" to "$"
 	q = sqlx.Rebind(sqlx.BindType("pgx"), q)
 
 	rows, err := a.db.Query(ctx, q, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 	defer rows.Close()
 
 	// "total" column will always be returned, so other columns might be null
 	var (
-		cDate       pgtype.Date
-		cLetter     pgtype.Varchar
-		gadgetsAggr pgtype.Float4
-		paperAggr   pgtype.Float4
-		plasticAggr pgtype.Float4
-		eID         pgtype.Varchar
-		eDate       pgtype.Date
-		eName       pgtype.Varchar
-		eResAllowed []string
-		gadgets     pgtype.Float4
-		paper       pgtype.Float4
-		plastic     pgtype.Float4
+		cDate                pgtype.Date
+		cLetter              pgtype.Varchar
+		pupils, participants pgtype.Int8
+		medianPoints         pgtype.Float4
+		cursor               []byte
+		eID                  pgtype.Varchar
+		eDate                pgtype.Date
+		eName                pgtype.Varchar
+		eResAllowed          []string
+		eStatus              pgtype.Text
 	)
 	// map to fast search the class in the classes slice. class_year+class_letter -> index in the slice
 	classSliceIndex := map[string]int{}
 	for rows.Next() {
-		if err = rows.Scan(&cDate, &cLetter, &gadgetsAggr, &paperAggr, &plasticAggr, &eID, &eDate, &eName,
-			&eResAllowed, &gadgets, &paper, &plastic, &total); err != nil {
-			return nil, 0, err
+		// the amounts are unmarshalled from json, which adds them to the map if it isn't empty
+		var amountsAggr, median, amounts eventsvc.ResourceMap
+		if err = rows.Scan(&cDate, &cLetter, &amountsAggr, &pupils, &participants, &medianPoints, &median, &eID,
+			&eDate, &eName, &eResAllowed, &eStatus, &amounts, &cursor, &total); err != nil {
+			return nil, 0, "", err
 		}
 		// next will happen if the offset >= total rows found or no classes matching the provided criteria have been
 		// found. In that case we simply return total w/o additional work
 		if cDate.Status != pgtype.Present {
-			return nil, total, nil
+			return nil, total, "", nil
 		}
 		classID := createClassID(cDate.Time, cLetter.String)
 		var c *aggregating.Class
@@ -165,11 +210,9 @@ func (a *aggregatingRepo) Classes(ctx context.Context, filters aggregating.Class
 					Letter:     cLetter.String,
 					DateFormed: cDate.Time,
 				},
-				ResourcesBrought: eventsvc.ResourceMap{
-					eventsvc.Gadgets: gadgetsAggr.Float,
-					eventsvc.Paper:   paperAggr.Float,
-					eventsvc.Plastic: plasticAggr.Float,
-				},
+				ResourcesBrought: amountsAggr,
+				Stats: eventsvc.NewClassStats(amountsAggr, int(pupils.Int), int(participants.Int), median,
+					medianPoints.Float),
 			}
 			// append the class to the slice and put its index to the map
 			classes = append(classes, c)
@@ -177,10 +220,14 @@ func (a *aggregatingRepo) Classes(ctx context.Context, filters aggregating.Class
 		}
 		resAllowed, err := eventsvc.StringSliceToResourceSlice(eResAllowed)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, "", err
+		}
+		status, err := eventsvc.StringToEventStatus(eStatus.String)
+		if err != nil {
+			return nil, 0, "", err
 		}
 		// create a map of the resources brought by the class to the event
-		resBrought := newResourceMap(resAllowed, gadgets.Float, paper.Float, plastic.Float)
+		resBrought := newResourceMap(resAllowed, amounts)
 		// create an event and append it to the pupil's slice of events
 		e := &aggregating.Event{
 			Event: eventsvc.Event{
@@ -188,18 +235,26 @@ func (a *aggregatingRepo) Classes(ctx context.Context, filters aggregating.Class
 				Date:             eDate.Time,
 				Name:             eName.String,
 				ResourcesAllowed: resAllowed,
+				Status:           status,
 			},
 			ResourcesBrought: resBrought,
 		}
 		c.Events = append(c.Events, e)
 	}
 	if err = rows.Err(); err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+	// the rows are sorted by the classes, so the last row holds the cursor of the last class
+	nextToken, err = page.NextToken(string(classesSorting), len(classes), cursor)
+	if err != nil {
+		return nil, 0, "", err
 	}
-	return classes, total, nil
+	return classes, total, nextToken, nil
 }
 
-const pupilsQuery = `
+// pupilsCTE selects the events of the pupils which passed the filters along with the resources the pupils brought to
+// them ("query"), and the resources brought by each of the pupils to all the events ("aggr")
+const pupilsCTE = `
 	with query as (
     select p.id,
            first_name,
@@ -210,10 +265,8 @@ const pupilsQuery = `
            e.date,
            e.name,
            e.resources_allowed,
-           coalesce(gadgets, 0) as gadgets,
-           coalesce(paper, 0)   as paper,
-           coalesce(plastic, 0) as plastic
-
+           e.status,
+           coalesce(r.amounts, '{}') as amounts
     from pupil p
              cross join event e
              left join resources r on r.event_id = e.id and r.pupil_id = p.id
@@ -226,15 +279,16 @@ const pupilsQuery = `
                 last_name,
                 class_date_formed,
                 class_letter,
-                sum(coalesce(gadgets, 0)) as gadgets_aggr,
-                sum(coalesce(paper, 0))   as paper_aggr,
-                sum(coalesce(plastic, 0)) as plastic_aggr
+                resource_amounts_sum(amounts) as amounts_aggr
          from query
          group by id, class_date_formed, class_letter, first_name, last_name
-     ),
+     )`
+
+const pupilsQuery = pupilsCTE + `,
      pagination as (
-         select *
+         select *, %s as page_cursor
          from aggr
+         where %s
          order by %s
          limit ? offset ?
      )
@@ -243,67 +297,47 @@ select id,
        query.last_name,
        query.class_date_formed,
        query.class_letter,
-       gadgets_aggr,
-       paper_aggr,
-       plastic_aggr,
+       amounts_aggr,
        event_id,
        date,
        name,
        resources_allowed::text[],
-       gadgets,
-       paper,
-       plastic,
+       status::text,
+       amounts,
+       page_cursor,
        total
 from query
          inner join pagination using (id)
-         right join (select count(*) from aggr) as c(total) on true
+         right join (%s) as c(total) on true
 order by %s;
 `
 
 // Pupils returns a sorted list of the pupils that passed the pupil filters with a sorted list of the events that
-// passed the event filters
+// passed the event filters. The page of the pupils either skips a number of them or follows the page token
 func (a *aggregatingRepo) Pupils(ctx context.Context, filters aggregating.PupilFilters, pupilsSorting,
-	eventsSorting sorting.By, amount, skip int) (pupils []*aggregating.Pupil, total int, err error) {
-
-	// create the "order by" parts of the query
-	pupilOrderBy := pupilAggrOrderMap[pupilsSorting]
-	eventOrderBy := eventOrderMap[eventsSorting]
-	orderBy := fmt.Sprintf("%s, id, %s", pupilOrderBy, eventOrderBy)
+	eventsSorting sorting.By, page paging.Page) (pupils []*aggregating.Pupil, total int, nextToken string,
+	err error) {
 
-	// create the "where" part of the query
-	where := strings.Builder{}
-	var args []interface{}
-	// if filters.To is not set, set it to some date in the distant future
-	if filters.To.IsZero() {
-		filters.To = filters.To.AddDate(2222, 0, 0)
-	}
-	args = append(args, filters.From, filters.To)
-	if len(filters.ResourcesAllowed) > 0 {
-		where.WriteString("and e.resources_allowed @> ?::text[]::resource[] ")
-		args = append(args, eventsvc.ResourceSliceToStringSlice(filters.ResourcesAllowed))
-	}
-	// event's name text search
-	if filters.Name != "" {
-		eventTextSearch := pgtextsearch.PrepareQuery(filters.Name)
-		where.WriteString("and e.text_search @@ to_tsquery('simple', ?) ")
-		args = append(args, eventTextSearch)
-	}
-	// pupil's name and class text search
-	if filters.NameAndClass != "" {
-		pupilTextSearch := pgtextsearch.PrepareQuery(filters.NameAndClass)
-		where.WriteString("and p.text_search @@ to_tsquery('simple', ?)")
-		args = append(args, pupilTextSearch)
+	// create the parts of the query which page through the pupils
+	pq, err := newPageQuery(page, pupilsSorting, pupilAggrOrdering.pageColumns(pupilsSorting), "aggr")
+	if err != nil {
+		return nil, 0, "", err
 	}
+	// the events of each pupil are sorted after the pupils
+	orderBy := fmt.Sprintf("%s, %s", pq.orderBy, eventOrdering.orderBy(eventsSorting))
+	// create the "where" part of the query
+	where, args := pupilsCondition(filters)
 
-	// add limit and offset to the query
-	args = append(args, amount, skip)
-	q := fmt.Sprintf(pupilsQuery, where.String(), pupilOrderBy, orderBy)
+	// add the page's condition, limit and offset to the query
+	args = append(args, pq.args...)
+	args = append(args, page.Size, page.Offset())
+	q := fmt.Sprintf(pupilsQuery, where, pq.cursor, pq.after, pq.orderBy, pq.total, orderBy)
 	// change "?" to "$"
 	q = sqlx.Rebind(sqlx.BindType("pgx"), q)
 
 	rows, err := a.db.Query(ctx, q, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 	defer rows.Close()
 	// "total" column will always be returned, so other columns might be null
@@ -313,29 +347,27 @@ func (a *aggregatingRepo) Pupils(ctx context.Context, filters aggregating.PupilF
 		pLName      pgtype.Varchar
 		cDate       pgtype.Date
 		cLetter     pgtype.Varchar
-		gadgetsAggr pgtype.Float4
-		paperAggr   pgtype.Float4
-		plasticAggr pgtype.Float4
 		eID         pgtype.Varchar
 		eDate       pgtype.Date
 		eName       pgtype.Varchar
 		eResAllowed []string
-		gadgets     pgtype.Float4
-		paper       pgtype.Float4
-		plastic     pgtype.Float4
+		eStatus     pgtype.Text
+		cursor      []byte
 	)
 	// map to fast search the pupil in the pupils slice. ID -> index in the slice
 	pupilSliceIndex := map[string]int{}
 	for rows.Next() {
-		err := rows.Scan(&pID, &pFName, &pLName, &cDate, &cLetter, &gadgetsAggr, &paperAggr, &plasticAggr, &eID, &eDate,
-			&eName, &eResAllowed, &gadgets, &paper, &plastic, &total)
+		// the amounts are unmarshalled from json, which adds them to the map if it isn't empty
+		var amountsAggr, amounts eventsvc.ResourceMap
+		err := rows.Scan(&pID, &pFName, &pLName, &cDate, &cLetter, &amountsAggr, &eID, &eDate, &eName, &eResAllowed,
+			&eStatus, &amounts, &cursor, &total)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, "", err
 		}
 		// next will happen if the offset >= total rows found or no pupils matching the provided criteria have been
 		// found. In that case we simply return total w/o additional work
 		if pID.Status != pgtype.Present {
-			return nil, total, nil
+			return nil, total, "", nil
 		}
 		var p *aggregating.Pupil
 		if i, ok := pupilSliceIndex[string(pID.String)]; ok {
@@ -353,11 +385,7 @@ func (a *aggregatingRepo) Pupils(ctx context.Context, filters aggregating.PupilF
 					Letter:     cLetter.String,
 					DateFormed: cDate.Time,
 				},
-				ResourcesBrought: eventsvc.ResourceMap{
-					eventsvc.Gadgets: gadgetsAggr.Float,
-					eventsvc.Paper:   paperAggr.Float,
-					eventsvc.Plastic: plasticAggr.Float,
-				},
+				ResourcesBrought: amountsAggr,
 			}
 			// append the pupil to the slice and put its index to the map
 			pupils = append(pupils, p)
@@ -365,10 +393,14 @@ func (a *aggregatingRepo) Pupils(ctx context.Context, filters aggregating.PupilF
 		}
 		resAllowed, err := eventsvc.StringSliceToResourceSlice(eResAllowed)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, "", err
+		}
+		status, err := eventsvc.StringToEventStatus(eStatus.String)
+		if err != nil {
+			return nil, 0, "", err
 		}
 		// create a map of the resources brought by the pupil to the event
-		resBrought := newResourceMap(resAllowed, gadgets.Float, paper.Float, plastic.Float)
+		resBrought := newResourceMap(resAllowed, amounts)
 		// create an event and append it to the pupil's slice of events
 		e := &aggregating.Event{
 			Event: eventsvc.Event{
@@ -376,15 +408,149 @@ func (a *aggregatingRepo) Pupils(ctx context.Context, filters aggregating.PupilF
 				Date:             eDate.Time,
 				Name:             eName.String,
 				ResourcesAllowed: resAllowed,
+				Status:           status,
 			},
 			ResourcesBrought: resBrought,
 		}
 		p.Events = append(p.Events, e)
 	}
 	if err = rows.Err(); err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+	// the rows are sorted by the pupils, so the last row holds the cursor of the last pupil
+	nextToken, err = page.NextToken(string(pupilsSorting), len(pupils), cursor)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	return pupils, total, nextToken, nil
+}
+
+// pupilsCondition returns the conditional "where" part of the pupils query built from the filters, along with its
+// arguments, which start with the dates the events are between
+func pupilsCondition(filters aggregating.PupilFilters) (string, []interface{}) {
+	where := strings.Builder{}
+	var args []interface{}
+	// if filters.To is not set, set it to some date in the distant future
+	if filters.To.IsZero() {
+		filters.To = filters.To.AddDate(2222, 0, 0)
+	}
+	args = append(args, filters.From, filters.To)
+	if len(filters.ResourcesAllowed) > 0 {
+		where.WriteString("and e.resources_allowed @> ?::varchar[] ")
+		args = append(args, eventsvc.ResourceSliceToStringSlice(filters.ResourcesAllowed))
 	}
-	return pupils, total, nil
+	// event's name text search
+	if filters.Name != "" {
+		eventTextSearch := pgtextsearch.PrepareQuery(filters.Name)
+		where.WriteString("and e.text_search @@ to_tsquery('simple', ?) ")
+		args = append(args, eventTextSearch)
+	}
+	// pupil's name and class text search
+	if filters.NameAndClass != "" {
+		pupilTextSearch := pgtextsearch.PrepareQuery(filters.NameAndClass)
+		where.WriteString("and p.text_search @@ to_tsquery('simple', ?)")
+		args = append(args, pupilTextSearch)
+	}
+	// if the user is restricted to some classes or pupils, leave only those pupils
+	scopeCond, scopeArgs := scopeCondition(filters.Scope)
+	where.WriteString(scopeCond)
+	args = append(args, scopeArgs...)
+	return where.String(), args
+}
+
+const streamPupilsQuery = pupilsCTE + `
+select id,
+       query.first_name,
+       query.last_name,
+       query.class_date_formed,
+       query.class_letter,
+       amounts_aggr,
+       event_id,
+       date,
+       name,
+       resources_allowed::text[],
+       status::text,
+       amounts
+from query
+         inner join aggr using (id)
+order by %s;
+`
+
+// StreamPupils calls fn with each of the sorted pupils that passed the pupil filters, along with a sorted list of the
+// events that passed the event filters, until fn returns an error. The pupils are read with a db cursor, so that all
+// of them can be walked through without being held in the memory at once
+func (a *aggregatingRepo) StreamPupils(ctx context.Context, filters aggregating.PupilFilters, pupilsSorting,
+	eventsSorting sorting.By, fn func(*aggregating.Pupil) error) error {
+
+	// the pupils are sorted so that each of them has a place of its own, their events follow them
+	orderBy := fmt.Sprintf("%s, %s", paging.OrderBy(pupilAggrOrdering.pageColumns(pupilsSorting)),
+		eventOrdering.orderBy(eventsSorting))
+	where, args := pupilsCondition(filters)
+	q := fmt.Sprintf(streamPupilsQuery, where, orderBy)
+	// change "?" to "$"
+	q = sqlx.Rebind(sqlx.BindType("pgx"), q)
+
+	// the pupil whose events are being read. It's passed to fn once the rows of the next pupil begin
+	var p *aggregating.Pupil
+	err := walkCursor(ctx, a.db, q, args, func(rows pgx.Rows) error {
+		var (
+			pID, pFName, pLName, cLetter, eID, eName, eStatus string
+			cDate, eDate                                      time.Time
+			eResAllowed                                       []string
+			amountsAggr, amounts                              eventsvc.ResourceMap
+		)
+		err := rows.Scan(&pID, &pFName, &pLName, &cDate, &cLetter, &amountsAggr, &eID, &eDate, &eName, &eResAllowed,
+			&eStatus, &amounts)
+		if err != nil {
+			return err
+		}
+		if p == nil || p.ID != pID {
+			if p != nil {
+				if err := fn(p); err != nil {
+					return err
+				}
+			}
+			p = &aggregating.Pupil{
+				Pupil: eventsvc.Pupil{
+					ID:        pID,
+					FirstName: pFName,
+					LastName:  pLName,
+				},
+				Class: eventsvc.Class{
+					Letter:     cLetter,
+					DateFormed: cDate,
+				},
+				ResourcesBrought: amountsAggr,
+			}
+		}
+		resAllowed, err := eventsvc.StringSliceToResourceSlice(eResAllowed)
+		if err != nil {
+			return err
+		}
+		status, err := eventsvc.StringToEventStatus(eStatus)
+		if err != nil {
+			return err
+		}
+		p.Events = append(p.Events, &aggregating.Event{
+			Event: eventsvc.Event{
+				ID:               eID,
+				Date:             eDate,
+				Name:             eName,
+				ResourcesAllowed: resAllowed,
+				Status:           status,
+			},
+			ResourcesBrought: newResourceMap(resAllowed, amounts),
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// the last pupil
+	if p != nil {
+		return fn(p)
+	}
+	return nil
 }
 
 const pupilByIDQueryA = `
@@ -392,17 +558,15 @@ const pupilByIDQueryA = `
 		   e.date,
 		   e.name,
 		   e.resources_allowed::text[],
-		   coalesce(gadgets, 0)              as gadgets,
-		   coalesce(paper, 0)                as paper,
-		   coalesce(plastic, 0)              as plastic,
+		   e.status::text,
+		   r.amounts,
 		   p.id,
 		   p.first_name,
 		   p.last_name,
 		   p.class_date_formed,
 		   p.class_letter,
-		   sum(coalesce(gadgets, 0)) over () as gadgets_aggr,
-		   sum(coalesce(paper, 0)) over ()   as paper_aggr,
-		   sum(coalesce(plastic, 0)) over () as plastic_aggr
+		   p.version,
+		   resource_amounts_sum(r.amounts) over () as amounts_aggr
 	from pupil p
 			 left join event e on e.date between symmetric greatest(p.class_date_formed, ?) and least(
 				p.class_date_formed + (365.25 * 11)::integer, ?) %s
@@ -425,7 +589,7 @@ func (a *aggregatingRepo) PupilByID(ctx context.Context, id string, filters aggr
 	}
 	args = append(args, filters.From, filters.To)
 	if len(filters.ResourcesAllowed) > 0 {
-		joinOn.WriteString("and e.resources_allowed @> ?::text[]::resource[] ")
+		joinOn.WriteString("and e.resources_allowed @> ?::varchar[] ")
 		args = append(args, eventsvc.ResourceSliceToStringSlice(filters.ResourcesAllowed))
 	}
 	if filters.Name != "" {
@@ -436,7 +600,7 @@ func (a *aggregatingRepo) PupilByID(ctx context.Context, id string, filters aggr
 	args = append(args, id)
 
 	// get and add "order by" to the query
-	orderBy := eventOrderMap[eventsSorting]
+	orderBy := eventOrdering.orderBy(eventsSorting)
 	// create the query
 	q := fmt.Sprintf(pupilByIDQueryA, joinOn.String(), orderBy)
 	// change all the "?" in the query to "$"
@@ -455,19 +619,14 @@ func (a *aggregatingRepo) PupilByID(ctx context.Context, id string, filters aggr
 		eDate             pgtype.Date
 		eName             pgtype.Varchar
 		eResourcesAllowed []string
-		eGadgets          pgtype.Float4
-		ePaper            pgtype.Float4
-		ePlastic          pgtype.Float4
-		pGadgets          pgtype.Float4
-		pPaper            pgtype.Float4
-		pPlastic          pgtype.Float4
+		eStatus           pgtype.Text
 	)
 	p := &aggregating.Pupil{}
 	for rows.Next() {
 		e := &aggregating.Event{}
-		if err := rows.Scan(&eID, &eDate, &eName, &eResourcesAllowed, &eGadgets, &ePaper, &ePlastic, &p.ID,
-			&p.FirstName, &p.LastName, &p.Class.DateFormed, &p.Class.Letter, &pGadgets, &pPaper,
-			&pPlastic); err != nil {
+		var eAmounts eventsvc.ResourceMap
+		if err := rows.Scan(&eID, &eDate, &eName, &eResourcesAllowed, &eStatus, &eAmounts, &p.ID, &p.FirstName,
+			&p.LastName, &p.Class.DateFormed, &p.Class.Letter, &p.Version, &p.ResourcesBrought); err != nil {
 
 			return nil, err
 		}
@@ -481,12 +640,15 @@ func (a *aggregatingRepo) PupilByID(ctx context.Context, id string, filters aggr
 		e.Date = eDate.Time
 		e.Name = eName.String
 		resAllowed, err := eventsvc.StringSliceToResourceSlice(eResourcesAllowed)
-		resBrought := newResourceMap(resAllowed, eGadgets.Float, ePaper.Float, ePlastic.Float)
 		if err != nil {
 			return nil, err
 		}
+		resBrought := newResourceMap(resAllowed, eAmounts)
 		e.ResourcesAllowed = resAllowed
 		e.ResourcesBrought = resBrought
+		if e.Status, err = eventsvc.StringToEventStatus(eStatus.String); err != nil {
+			return nil, err
+		}
 		// append the event to the pupil's slice of events
 		p.Events = append(p.Events, e)
 	}
@@ -497,9 +659,6 @@ func (a *aggregatingRepo) PupilByID(ctx context.Context, id string, filters aggr
 	if p.ID == "" {
 		return nil, eventsvc.ErrUnknownPupil
 	}
-	// add the resources the pupil brought to the event
-	p.ResourcesBrought = eventsvc.ResourceMap{eventsvc.Gadgets: pGadgets.Float, eventsvc.Paper: pPaper.Float,
-		eventsvc.Plastic: pPlastic.Float}
 	return p, nil
 }
 
@@ -509,30 +668,35 @@ const eventsQuery = `
 			   e.date,
 			   e.name,
 			   e.resources_allowed::text[],
-			   coalesce(sum(gadgets), 0) as gadgets,
-			   coalesce(sum(paper), 0)   as paper,
-			   coalesce(sum(plastic), 0) as plastic
+			   e.status::text,
+			   resource_amounts_sum(r.amounts) as amounts
 		from event e
 				 left join resources r on r.event_id = e.id
 		where 1=1 %s
 		group by e.id
 	), pagination as (
-		select *
+		select *, %s as page_cursor
 		from query
+		where %s
 		order by %s
 		limit ? offset ?
 	)
 	select *
 		from pagination
-			right join (select count(*) from query) as c(total) on true;
+			right join (%s) as c(total) on true
+	order by %s;
 `
 
-// Events returns a list of sorted events that passed the provided filters
-func (a *aggregatingRepo) Events(ctx context.Context, filters aggregating.EventFilters, sortBy sorting.By, amount,
-	skip int) (events []*aggregating.Event, total int, err error) {
+// Events returns a list of sorted events that passed the provided filters. The page of the events either skips a
+// number of them or follows the page token
+func (a *aggregatingRepo) Events(ctx context.Context, filters aggregating.EventFilters, sortBy sorting.By,
+	page paging.Page) (events []*aggregating.Event, total int, nextToken string, err error) {
 
-	// get the "order by" part of the query
-	orderBy := eventOrderMap[sortBy]
+	// create the parts of the query which page through the events
+	pq, err := newPageQuery(page, sortBy, eventOrdering.pageColumns(sortBy), "query")
+	if err != nil {
+		return nil, 0, "", err
+	}
 	// create the "where" part of the query
 	where := strings.Builder{}
 	var args []interface{}
@@ -545,7 +709,7 @@ func (a *aggregatingRepo) Events(ctx context.Context, filters aggregating.EventF
 		args = append(args, filters.From, filters.To)
 	}
 	if len(filters.ResourcesAllowed) > 0 {
-		where.WriteString("and e.resources_allowed @> ?::text[]::resource[] ")
+		where.WriteString("and e.resources_allowed @> ?::varchar[] ")
 		args = append(args, eventsvc.ResourceSliceToStringSlice(filters.ResourcesAllowed))
 	}
 	if filters.Name != "" {
@@ -553,15 +717,16 @@ func (a *aggregatingRepo) Events(ctx context.Context, filters aggregating.EventF
 		where.WriteString("and e.text_search @@ to_tsquery('simple', ?) ")
 		args = append(args, textSearch)
 	}
-	args = append(args, amount, skip)
-	// embed the "where" and the "order by" parts to the query
-	q := fmt.Sprintf(eventsQuery, where.String(), orderBy)
+	args = append(args, pq.args...)
+	args = append(args, page.Size, page.Offset())
+	// embed the "where", the paging and the "order by" parts to the query
+	q := fmt.Sprintf(eventsQuery, where.String(), pq.cursor, pq.after, pq.orderBy, pq.total, pq.orderBy)
 	// change "?" to "$" in the query
 	q = sqlx.Rebind(sqlx.BindType("pgx"), q)
 
 	rows, err := a.db.Query(ctx, q, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 	defer rows.Close()
 	// "total" column will always be returned, so other columns might be null
@@ -570,25 +735,29 @@ func (a *aggregatingRepo) Events(ctx context.Context, filters aggregating.EventF
 		date          pgtype.Date
 		name          pgtype.Varchar
 		resAllowedStr []string
-		gadgets       pgtype.Float4
-		paper         pgtype.Float4
-		plastic       pgtype.Float4
+		status        pgtype.Text
+		cursor        []byte
 	)
 	for rows.Next() {
-		err := rows.Scan(&id, &date, &name, &resAllowedStr, &gadgets, &paper, &plastic, &total)
+		var amounts eventsvc.ResourceMap
+		err := rows.Scan(&id, &date, &name, &resAllowedStr, &status, &amounts, &cursor, &total)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, "", err
 		}
 		// next will happen if the offset >= total rows found or no events matching the provided criteria have been
 		// found. In that case we simply return total w/o additional work
 		if id.Status != pgtype.Present {
-			return nil, total, nil
+			return nil, total, "", nil
 		}
 		// convert []string to []eventsvc.Resource
 		resAllowed, err := eventsvc.StringSliceToResourceSlice(resAllowedStr)
-		resBrought := newResourceMap(resAllowed, gadgets.Float, paper.Float, plastic.Float)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, "", err
+		}
+		resBrought := newResourceMap(resAllowed, amounts)
+		eStatus, err := eventsvc.StringToEventStatus(status.String)
+		if err != nil {
+			return nil, 0, "", err
 		}
 		e := &aggregating.Event{
 			Event: eventsvc.Event{
@@ -596,34 +765,36 @@ func (a *aggregatingRepo) Events(ctx context.Context, filters aggregating.EventF
 				Date:             date.Time,
 				Name:             name.String,
 				ResourcesAllowed: resAllowed,
+				Status:           eStatus,
 			},
 			ResourcesBrought: resBrought,
 		}
 		events = append(events, e)
 	}
 	if err = rows.Err(); err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+	nextToken, err = page.NextToken(string(sortBy), len(events), cursor)
+	if err != nil {
+		return nil, 0, "", err
 	}
-	return events, total, nil
+	return events, total, nextToken, nil
+}
+
+// ResourceTypes returns the catalogue of the resources
+func (a *aggregatingRepo) ResourceTypes(ctx context.Context) ([]*eventsvc.ResourceType, error) {
+	return resourceTypes(ctx, a.db)
 }
 
 func createClassID(date time.Time, letter string) string {
 	return fmt.Sprintf("%d%s", date.Year(), letter)
 }
 
-func newResourceMap(resAllowed []eventsvc.Resource, gadgets float32, paper float32,
-	plastic float32) eventsvc.ResourceMap {
-
-	resBrought := eventsvc.ResourceMap{}
+// newResourceMap returns the amounts of the allowed resources. The allowed resources which haven't been brought are 0
+func newResourceMap(resAllowed []eventsvc.Resource, amounts eventsvc.ResourceMap) eventsvc.ResourceMap {
+	resBrought := make(eventsvc.ResourceMap, len(resAllowed))
 	for _, res := range resAllowed {
-		switch res {
-		case eventsvc.Gadgets:
-			resBrought[res] = gadgets
-		case eventsvc.Paper:
-			resBrought[res] = paper
-		case eventsvc.Plastic:
-			resBrought[res] = plastic
-		}
+		resBrought[res] = amounts[res]
 	}
 	return resBrought
 }
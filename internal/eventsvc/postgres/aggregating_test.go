@@ -2,13 +2,19 @@ package postgres_test
 
 import (
 	"context"
+	"errors"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/aggregating"
+	"github.com/shanvl/garbage/internal/eventsvc/eventing"
+	"github.com/shanvl/garbage/internal/eventsvc/mock"
 	"github.com/shanvl/garbage/internal/eventsvc/postgres"
 	"github.com/shanvl/garbage/internal/eventsvc/sorting"
+	"github.com/shanvl/garbage/pkg/broker"
+	"github.com/shanvl/garbage/pkg/paging"
 )
 
 func TestAggregatingRepo_PupilByID(t *testing.T) {
@@ -31,7 +37,7 @@ func TestAggregatingRepo_PupilByID(t *testing.T) {
 			args: args{
 				id:            pID,
 				filters:       aggregating.EventFilters{},
-				eventsSorting: sorting.Plastic,
+				eventsSorting: sorting.ByResource(eventsvc.Plastic),
 			},
 			wantErr: false,
 		},
@@ -43,7 +49,7 @@ func TestAggregatingRepo_PupilByID(t *testing.T) {
 					From: newDate(2018, 12, 12),
 					To:   time.Time{},
 				},
-				eventsSorting: sorting.Plastic,
+				eventsSorting: sorting.ByResource(eventsvc.Plastic),
 			},
 			wantErr: false,
 		},
@@ -55,7 +61,7 @@ func TestAggregatingRepo_PupilByID(t *testing.T) {
 					From: time.Time{},
 					To:   newDate(2018, 12, 12),
 				},
-				eventsSorting: sorting.Plastic,
+				eventsSorting: sorting.ByResource(eventsvc.Plastic),
 			},
 			wantErr: false,
 		},
@@ -67,7 +73,7 @@ func TestAggregatingRepo_PupilByID(t *testing.T) {
 					From: newDate(2018, 12, 12),
 					To:   newDate(2020, 12, 12),
 				},
-				eventsSorting: sorting.Plastic,
+				eventsSorting: sorting.ByResource(eventsvc.Plastic),
 			},
 			wantErr: false,
 		},
@@ -79,7 +85,7 @@ func TestAggregatingRepo_PupilByID(t *testing.T) {
 					From: time.Now().AddDate(50, 50, 50),
 					To:   time.Now().AddDate(51, 51, 51),
 				},
-				eventsSorting: sorting.Plastic,
+				eventsSorting: sorting.ByResource(eventsvc.Plastic),
 			},
 			wantErr: false,
 		},
@@ -90,7 +96,7 @@ func TestAggregatingRepo_PupilByID(t *testing.T) {
 				filters: aggregating.EventFilters{
 					Name: "p",
 				},
-				eventsSorting: sorting.Plastic,
+				eventsSorting: sorting.ByResource(eventsvc.Plastic),
 			},
 			wantErr: false,
 		},
@@ -101,7 +107,7 @@ func TestAggregatingRepo_PupilByID(t *testing.T) {
 				filters: aggregating.EventFilters{
 					ResourcesAllowed: []eventsvc.Resource{eventsvc.Gadgets, eventsvc.Paper, eventsvc.Plastic},
 				},
-				eventsSorting: sorting.Plastic,
+				eventsSorting: sorting.ByResource(eventsvc.Plastic),
 			},
 			wantErr: false,
 		},
@@ -115,7 +121,7 @@ func TestAggregatingRepo_PupilByID(t *testing.T) {
 					Name:             "p",
 					ResourcesAllowed: []eventsvc.Resource{eventsvc.Gadgets, eventsvc.Paper, eventsvc.Plastic},
 				},
-				eventsSorting: sorting.Gadgets,
+				eventsSorting: sorting.ByResource(eventsvc.Gadgets),
 			},
 			wantErr: false,
 		},
@@ -124,7 +130,7 @@ func TestAggregatingRepo_PupilByID(t *testing.T) {
 			args: args{
 				id:            "wrongid",
 				filters:       aggregating.EventFilters{},
-				eventsSorting: sorting.Plastic,
+				eventsSorting: sorting.ByResource(eventsvc.Plastic),
 			},
 			wantErr: true,
 		},
@@ -160,7 +166,7 @@ func TestAggregatingRepo_Events(t *testing.T) {
 			name: "no filters",
 			args: args{
 				filters: aggregating.EventFilters{},
-				sortBy:  sorting.Plastic,
+				sortBy:  sorting.ByResource(eventsvc.Plastic),
 				amount:  150,
 				skip:    0,
 			},
@@ -172,7 +178,7 @@ func TestAggregatingRepo_Events(t *testing.T) {
 				filters: aggregating.EventFilters{
 					Name: "a b",
 				},
-				sortBy: sorting.Plastic,
+				sortBy: sorting.ByResource(eventsvc.Plastic),
 				amount: 150,
 				skip:   0,
 			},
@@ -184,7 +190,7 @@ func TestAggregatingRepo_Events(t *testing.T) {
 				filters: aggregating.EventFilters{
 					Name: "a&b",
 				},
-				sortBy: sorting.Plastic,
+				sortBy: sorting.ByResource(eventsvc.Plastic),
 				amount: 150,
 				skip:   0,
 			},
@@ -196,7 +202,7 @@ func TestAggregatingRepo_Events(t *testing.T) {
 				filters: aggregating.EventFilters{
 					From: newDate(2018, 1, 1),
 				},
-				sortBy: sorting.Plastic,
+				sortBy: sorting.ByResource(eventsvc.Plastic),
 				amount: 150,
 				skip:   0,
 			},
@@ -208,7 +214,7 @@ func TestAggregatingRepo_Events(t *testing.T) {
 				filters: aggregating.EventFilters{
 					To: newDate(2020, 1, 1),
 				},
-				sortBy: sorting.Plastic,
+				sortBy: sorting.ByResource(eventsvc.Plastic),
 				amount: 150,
 				skip:   0,
 			},
@@ -221,7 +227,7 @@ func TestAggregatingRepo_Events(t *testing.T) {
 					From: newDate(2018, 1, 1),
 					To:   newDate(2020, 1, 1),
 				},
-				sortBy: sorting.Plastic,
+				sortBy: sorting.ByResource(eventsvc.Plastic),
 				amount: 150,
 				skip:   0,
 			},
@@ -233,7 +239,7 @@ func TestAggregatingRepo_Events(t *testing.T) {
 				filters: aggregating.EventFilters{
 					ResourcesAllowed: []eventsvc.Resource{eventsvc.Gadgets, eventsvc.Plastic, eventsvc.Paper},
 				},
-				sortBy: sorting.Plastic,
+				sortBy: sorting.ByResource(eventsvc.Plastic),
 				amount: 150,
 				skip:   0,
 			},
@@ -242,7 +248,7 @@ func TestAggregatingRepo_Events(t *testing.T) {
 		{
 			name: "offset > entries",
 			args: args{
-				sortBy: sorting.Plastic,
+				sortBy: sorting.ByResource(eventsvc.Plastic),
 				amount: 150,
 				skip:   999,
 			},
@@ -251,7 +257,8 @@ func TestAggregatingRepo_Events(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, _, err := r.Events(ctx, tt.args.filters, tt.args.sortBy, tt.args.amount, tt.args.skip)
+			_, _, _, err := r.Events(ctx, tt.args.filters, tt.args.sortBy,
+				paging.Page{Size: tt.args.amount, Skip: tt.args.skip})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Events() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -289,7 +296,7 @@ func TestAggregatingRepo_Pupils(t *testing.T) {
 					NameAndClass: "a",
 				},
 				pupilsSorting: sorting.NameDes,
-				eventsSorting: sorting.Gadgets,
+				eventsSorting: sorting.ByResource(eventsvc.Gadgets),
 				amount:        15,
 				skip:          0,
 			},
@@ -300,7 +307,33 @@ func TestAggregatingRepo_Pupils(t *testing.T) {
 			args: args{
 				filters:       aggregating.PupilFilters{},
 				pupilsSorting: sorting.NameDes,
-				eventsSorting: sorting.Gadgets,
+				eventsSorting: sorting.ByResource(eventsvc.Gadgets),
+				amount:        15,
+				skip:          0,
+			},
+			wantErr: false,
+		},
+		{
+			name: "restricted scope",
+			args: args{
+				filters: aggregating.PupilFilters{
+					Scope: eventsvc.RestrictedScope(eventsvc.Class{Letter: "b", DateFormed: newDate(2015, 9, 1)}),
+				},
+				pupilsSorting: sorting.NameDes,
+				eventsSorting: sorting.ByResource(eventsvc.Gadgets),
+				amount:        15,
+				skip:          0,
+			},
+			wantErr: false,
+		},
+		{
+			name: "linked pupils",
+			args: args{
+				filters: aggregating.PupilFilters{
+					Scope: eventsvc.PupilScope("somepupilid"),
+				},
+				pupilsSorting: sorting.NameDes,
+				eventsSorting: sorting.ByResource(eventsvc.Gadgets),
 				amount:        15,
 				skip:          0,
 			},
@@ -313,7 +346,7 @@ func TestAggregatingRepo_Pupils(t *testing.T) {
 					NameAndClass: "zzzzzzznopupils",
 				},
 				pupilsSorting: sorting.NameDes,
-				eventsSorting: sorting.Gadgets,
+				eventsSorting: sorting.ByResource(eventsvc.Gadgets),
 				amount:        15,
 				skip:          0,
 			},
@@ -328,7 +361,7 @@ func TestAggregatingRepo_Pupils(t *testing.T) {
 					},
 				},
 				pupilsSorting: sorting.NameDes,
-				eventsSorting: sorting.Gadgets,
+				eventsSorting: sorting.ByResource(eventsvc.Gadgets),
 				amount:        15,
 				skip:          0,
 			},
@@ -339,7 +372,7 @@ func TestAggregatingRepo_Pupils(t *testing.T) {
 			args: args{
 				filters:       aggregating.PupilFilters{},
 				pupilsSorting: sorting.NameDes,
-				eventsSorting: sorting.Gadgets,
+				eventsSorting: sorting.ByResource(eventsvc.Gadgets),
 				amount:        15,
 				skip:          999,
 			},
@@ -348,8 +381,8 @@ func TestAggregatingRepo_Pupils(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, _, err := r.Pupils(ctx, tt.args.filters, tt.args.pupilsSorting, tt.args.eventsSorting,
-				tt.args.amount, tt.args.skip)
+			_, _, _, err := r.Pupils(ctx, tt.args.filters, tt.args.pupilsSorting, tt.args.eventsSorting,
+				paging.Page{Size: tt.args.amount, Skip: tt.args.skip})
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Pupils() error = %v, wantErr %v", err, tt.wantErr)
@@ -359,6 +392,48 @@ func TestAggregatingRepo_Pupils(t *testing.T) {
 	}
 }
 
+func TestAggregatingRepo_StreamPupils(t *testing.T) {
+	r := postgres.NewAggregatingRepo(db)
+	ctx := context.Background()
+	filters := aggregating.PupilFilters{EventFilters: aggregating.EventFilters{From: newDate(2010, 1, 1)}}
+	pupilsSorting, eventsSorting := sorting.ByResource(eventsvc.Paper), sorting.DateDes
+
+	// the stream goes in the same order as the pages do
+	want, _, _, err := r.Pupils(ctx, filters, pupilsSorting, eventsSorting, paging.Page{Size: aggregating.MaxAmount})
+	if err != nil {
+		t.Fatalf("Pupils() error = %v", err)
+	}
+	var got []*aggregating.Pupil
+	err = r.StreamPupils(ctx, filters, pupilsSorting, eventsSorting, func(p *aggregating.Pupil) error {
+		got = append(got, p)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamPupils() error = %v", err)
+	}
+	if len(got) < len(want) {
+		t.Fatalf("StreamPupils() len = %d, want at least %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].ID != want[i].ID || len(got[i].Events) != len(want[i].Events) {
+			t.Fatalf("StreamPupils() [%d] = %s with %d events, want %s with %d events", i, got[i].ID,
+				len(got[i].Events), want[i].ID, len(want[i].Events))
+		}
+	}
+
+	t.Run("fn stops the stream", func(t *testing.T) {
+		errStop := errors.New("stop")
+		n := 0
+		err := r.StreamPupils(ctx, filters, pupilsSorting, eventsSorting, func(p *aggregating.Pupil) error {
+			n++
+			return errStop
+		})
+		if !errors.Is(err, errStop) || n != 1 {
+			t.Errorf("StreamPupils() error = %v, pupils = %d, want the error of fn after the first pupil", err, n)
+		}
+	})
+}
+
 func TestAggregatingRepo_Classes(t *testing.T) {
 	r := postgres.NewAggregatingRepo(db)
 	ctx := context.Background()
@@ -389,7 +464,7 @@ func TestAggregatingRepo_Classes(t *testing.T) {
 					Letter:     "a",
 				},
 				classesSorting: sorting.NameDes,
-				eventsSorting:  sorting.Gadgets,
+				eventsSorting:  sorting.ByResource(eventsvc.Gadgets),
 				amount:         15,
 				skip:           0,
 			},
@@ -400,7 +475,7 @@ func TestAggregatingRepo_Classes(t *testing.T) {
 			args: args{
 				filters:        aggregating.ClassFilters{},
 				classesSorting: sorting.NameDes,
-				eventsSorting:  sorting.Gadgets,
+				eventsSorting:  sorting.ByResource(eventsvc.Gadgets),
 				amount:         15,
 				skip:           0,
 			},
@@ -413,7 +488,7 @@ func TestAggregatingRepo_Classes(t *testing.T) {
 					DateFormed: time.Now().AddDate(50, 1, 1),
 				},
 				classesSorting: sorting.NameDes,
-				eventsSorting:  sorting.Gadgets,
+				eventsSorting:  sorting.ByResource(eventsvc.Gadgets),
 				amount:         15,
 				skip:           0,
 			},
@@ -428,7 +503,7 @@ func TestAggregatingRepo_Classes(t *testing.T) {
 					},
 				},
 				classesSorting: sorting.NameDes,
-				eventsSorting:  sorting.Gadgets,
+				eventsSorting:  sorting.ByResource(eventsvc.Gadgets),
 				amount:         15,
 				skip:           0,
 			},
@@ -439,7 +514,7 @@ func TestAggregatingRepo_Classes(t *testing.T) {
 			args: args{
 				filters:        aggregating.ClassFilters{},
 				classesSorting: sorting.NameDes,
-				eventsSorting:  sorting.Gadgets,
+				eventsSorting:  sorting.ByResource(eventsvc.Gadgets),
 				amount:         15,
 				skip:           999,
 			},
@@ -448,8 +523,8 @@ func TestAggregatingRepo_Classes(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, _, err := r.Classes(ctx, tt.args.filters, tt.args.classesSorting, tt.args.eventsSorting,
-				tt.args.amount, tt.args.skip)
+			_, _, _, err := r.Classes(ctx, tt.args.filters, tt.args.classesSorting, tt.args.eventsSorting,
+				paging.Page{Size: tt.args.amount, Skip: tt.args.skip})
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Classes() error = %v, wantErr %v", err, tt.wantErr)
@@ -459,6 +534,171 @@ func TestAggregatingRepo_Classes(t *testing.T) {
 	}
 }
 
+func TestAggregatingRepo_SortingByTotals(t *testing.T) {
+	r := postgres.NewAggregatingRepo(db)
+	eventingRepo := postgres.NewEventingRepo(db)
+	ctx := context.Background()
+
+	// a resource scoring 1 point per kilogram
+	weighted := &eventsvc.ResourceType{Name: "weighted", Unit: eventsvc.Kilograms, Points: 1}
+	if err := postgres.NewCataloguingRepo(db).StoreResourceType(ctx, weighted); err != nil {
+		t.Fatalf("prepare db: %v", err)
+	}
+	defer db.Exec(ctx, `delete from resource_types where name = $1`, weighted.Name.String())
+	eventID, deleteE := createEvent(t, &eventsvc.Event{ID: "totalsevent", Date: newDate(2100, 3, 1),
+		Name: "totals", ResourcesAllowed: []eventsvc.Resource{weighted.Name}, Status: eventsvc.Open})
+	defer deleteE()
+	// class a has one pupil who brings 3, class b has two pupils who bring 2 each and one who brings nothing
+	classA := eventsvc.Class{Letter: "a", DateFormed: newDate(2095, 9, 1)}
+	classB := eventsvc.Class{Letter: "b", DateFormed: newDate(2095, 9, 1)}
+	for _, p := range []struct {
+		id     string
+		class  eventsvc.Class
+		amount float32
+	}{
+		{"totalsa", classA, 3}, {"totalsb", classB, 2}, {"totalsc", classB, 2}, {"totalsd", classB, 0},
+	} {
+		pupilID, deleteP := createPupil(t, &eventsvc.Pupil{ID: p.id, FirstName: p.id, LastName: p.id}, p.class)
+		defer deleteP()
+		if p.amount == 0 {
+			continue
+		}
+		entry := newResourceEntry(t, eventID, pupilID, eventsvc.ResourceMap{weighted.Name: p.amount})
+		msg := mock.NewMessage(t, broker.TopicPupilResourcesAdded)
+		if err := eventingRepo.AddPupilResources(ctx, entry, msg); err != nil {
+			t.Fatalf("prepare db: %v", err)
+		}
+	}
+	filters := aggregating.ClassFilters{EventFilters: aggregating.EventFilters{From: newDate(2100, 3, 1),
+		To: newDate(2100, 3, 1)}, DateFormed: classA.DateFormed}
+
+	tests := []struct {
+		name   string
+		sortBy sorting.By
+		want   []float32
+	}{
+		{name: "total", sortBy: sorting.TotalDes.Then(sorting.NameAsc), want: []float32{4, 3}},
+		{name: "per pupil", sortBy: sorting.PerPupilDes.Then(sorting.NameAsc), want: []float32{3, 4}},
+		{name: "name then total", sortBy: sorting.NameDes.Then(sorting.TotalDes), want: []float32{4, 3}},
+		{name: "participation", sortBy: sorting.ParticipationAsc, want: []float32{4, 3}},
+		{name: "median", sortBy: sorting.MedianDes.Then(sorting.PerPupilAsc), want: []float32{3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			classes, _, _, err := r.Classes(ctx, filters, tt.sortBy, sorting.TotalDes.Then(sorting.DateDes),
+				paging.Page{Size: 10})
+			if err != nil {
+				t.Fatalf("Classes() error = %v", err)
+			}
+			eventClasses, _, _, err := eventingRepo.EventClasses(ctx, eventID, eventing.EventClassFilters{},
+				tt.sortBy, paging.Page{Size: 10})
+			if err != nil {
+				t.Fatalf("EventClasses() error = %v", err)
+			}
+			if len(classes) != len(tt.want) || len(eventClasses) != len(tt.want) {
+				t.Fatalf("Classes(), EventClasses() len = %d, %d, want %d", len(classes), len(eventClasses),
+					len(tt.want))
+			}
+			for i, want := range tt.want {
+				if got := classes[i].ResourcesBrought[weighted.Name]; got != want {
+					t.Errorf("Classes() [%d] brought = %v, want %v", i, got, want)
+				}
+				if got := eventClasses[i].ResourcesBrought[weighted.Name]; got != want {
+					t.Errorf("EventClasses() [%d] brought = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+	t.Run("stats", func(t *testing.T) {
+		classes, _, _, err := r.Classes(ctx, filters, sorting.NameDes, sorting.DateDes, paging.Page{Size: 10})
+		if err != nil {
+			t.Fatalf("Classes() error = %v", err)
+		}
+		eventClasses, _, _, err := eventingRepo.EventClasses(ctx, eventID, eventing.EventClassFilters{},
+			sorting.NameDes, paging.Page{Size: 10})
+		if err != nil {
+			t.Fatalf("EventClasses() error = %v", err)
+		}
+		if len(classes) != 2 || len(eventClasses) != 2 {
+			t.Fatalf("Classes(), EventClasses() len = %d, %d, want 2", len(classes), len(eventClasses))
+		}
+		// class b, which is sorted first
+		want := eventsvc.NewClassStats(eventsvc.ResourceMap{weighted.Name: 4}, 3, 2,
+			eventsvc.ResourceMap{weighted.Name: 2}, 2)
+		if got := classes[0].Stats; !reflect.DeepEqual(got, want) {
+			t.Errorf("Classes() stats = %+v, want %+v", got, want)
+		}
+		if got := eventClasses[0].Stats; !reflect.DeepEqual(got, want) {
+			t.Errorf("EventClasses() stats = %+v, want %+v", got, want)
+		}
+	})
+	t.Run("page token", func(t *testing.T) {
+		// the classes are paged through one by one. The last page is empty, since the one before it is full
+		var (
+			brought, eventBrought []float32
+			page                  = paging.Page{Size: 1, SkipTotal: true}
+			eventPage             = page
+		)
+		for i := 0; i < 3; i++ {
+			classes, total, token, err := r.Classes(ctx, filters, sorting.TotalDes, sorting.DateDes, page)
+			if err != nil {
+				t.Fatalf("Classes() error = %v", err)
+			}
+			eventClasses, _, eventToken, err := eventingRepo.EventClasses(ctx, eventID, eventing.EventClassFilters{},
+				sorting.TotalDes, eventPage)
+			if err != nil {
+				t.Fatalf("EventClasses() error = %v", err)
+			}
+			if total != 0 {
+				t.Errorf("Classes() total = %d, want 0", total)
+			}
+			if (token == "") != (len(classes) == 0) || (eventToken == "") != (len(eventClasses) == 0) {
+				t.Errorf("Classes(), EventClasses() tokens = %q, %q, want them on the full pages only", token,
+					eventToken)
+			}
+			for _, c := range classes {
+				brought = append(brought, c.ResourcesBrought[weighted.Name])
+			}
+			for _, c := range eventClasses {
+				eventBrought = append(eventBrought, c.ResourcesBrought[weighted.Name])
+			}
+			page.Token, eventPage.Token = token, eventToken
+		}
+		want := []float32{4, 3}
+		if !reflect.DeepEqual(brought, want) || !reflect.DeepEqual(eventBrought, want) {
+			t.Errorf("Classes(), EventClasses() brought = %v, %v, want %v", brought, eventBrought, want)
+		}
+		// the token is made for the sorting it was returned with
+		page.Token = ""
+		_, _, token, err := r.Classes(ctx, filters, sorting.TotalDes, sorting.DateDes, page)
+		if err != nil {
+			t.Fatalf("Classes() error = %v", err)
+		}
+		page.Token = token
+		if _, _, _, err := r.Classes(ctx, filters, sorting.TotalAsc, sorting.DateDes,
+			page); !errors.Is(err, paging.ErrInvalidToken) {
+			t.Errorf("Classes() error = %v, want %v", err, paging.ErrInvalidToken)
+		}
+	})
+	t.Run("pupils and events", func(t *testing.T) {
+		_, _, _, err := r.Pupils(ctx, aggregating.PupilFilters{}, sorting.TotalAsc.Then(sorting.NameDes),
+			sorting.TotalDes, paging.Page{Size: 10})
+		if err != nil {
+			t.Errorf("Pupils() error = %v", err)
+		}
+		_, _, _, err = r.Events(ctx, aggregating.EventFilters{}, sorting.TotalDes.Then(sorting.ByResource(weighted.Name),
+			sorting.NameAsc), paging.Page{Size: 10})
+		if err != nil {
+			t.Errorf("Events() error = %v", err)
+		}
+		_, _, _, err = eventingRepo.EventPupils(ctx, eventID, eventing.EventPupilFilters{}, sorting.TotalDes,
+			paging.Page{Size: 10})
+		if err != nil {
+			t.Errorf("EventPupils() error = %v", err)
+		}
+	})
+}
+
 func newDate(year int, month int, day int) time.Time {
 	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
 }
@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/internal/eventsvc/cataloguing"
+)
+
+// cataloguingRepo is a repository used by Cataloguing service
+type cataloguingRepo struct {
+	db *pgxpool.Pool
+}
+
+// NewCataloguingRepo returns an instance of cataloguingRepo
+func NewCataloguingRepo(db *pgxpool.Pool) cataloguing.Repository {
+	return &cataloguingRepo{db}
+}
+
+const resourceInUseQuery = `
+	select exists(select 1 from event where resources_allowed @> array [$1]::varchar[])
+	    or exists(select 1 from resource_entries where amounts ? $1);
+`
+
+// DeleteResourceType deletes the resource type which hasn't been allowed on the events or brought to them. The row of
+// the resource type is locked until the transaction ends, so that it can't be changed while it's being checked. The
+// events being stored hold the rows of the resource types they allow, so the deletion waits for them
+func (c *cataloguingRepo) DeleteResourceType(ctx context.Context, name eventsvc.Resource) error {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := lockResourceType(ctx, tx, name); err != nil {
+		return err
+	}
+	var inUse bool
+	if err := tx.QueryRow(ctx, resourceInUseQuery, name.String()).Scan(&inUse); err != nil {
+		return err
+	}
+	if inUse {
+		return eventsvc.ErrResourceInUse
+	}
+	if _, err := tx.Exec(ctx, `delete from resource_types where name = $1`, name.String()); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// ResourceTypes returns all the resource types sorted by their names
+func (c *cataloguingRepo) ResourceTypes(ctx context.Context) ([]*eventsvc.ResourceType, error) {
+	return resourceTypes(ctx, c.db)
+}
+
+const storeResourceTypeQuery = `
+	insert into resource_types (name, unit, points)
+	values ($1, $2, $3);
+`
+
+// StoreResourceType stores the new resource type
+func (c *cataloguingRepo) StoreResourceType(ctx context.Context, resourceType *eventsvc.ResourceType) error {
+	_, err := c.db.Exec(ctx, storeResourceTypeQuery, resourceType.Name.String(), resourceType.Unit.String(),
+		resourceType.Points)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			err = eventsvc.ErrResourceExists
+		}
+	}
+	return err
+}
+
+const updateResourceTypeQuery = `
+	update resource_types
+	set unit   = $2,
+	    points = $3
+	where name = $1;
+`
+
+// UpdateResourceType updates the unit and the points of the resource type. The unit can be changed only if the
+// resource hasn't been brought to the events, since the amounts brought are measured in it
+func (c *cataloguingRepo) UpdateResourceType(ctx context.Context, resourceType *eventsvc.ResourceType) error {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	unit, err := lockResourceType(ctx, tx, resourceType.Name)
+	if err != nil {
+		return err
+	}
+	if unit != resourceType.Unit {
+		var brought bool
+		err := tx.QueryRow(ctx, `select exists(select 1 from resource_entries where amounts ? $1)`,
+			resourceType.Name.String()).Scan(&brought)
+		if err != nil {
+			return err
+		}
+		if brought {
+			return eventsvc.ErrResourceInUse
+		}
+	}
+	_, err = tx.Exec(ctx, updateResourceTypeQuery, resourceType.Name.String(), resourceType.Unit.String(),
+		resourceType.Points)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// lockResourceType locks the row of the resource type until the transaction ends and returns its unit. It returns
+// eventsvc.ErrUnknownResourceType if there's no such resource type
+func lockResourceType(ctx context.Context, tx pgx.Tx, name eventsvc.Resource) (eventsvc.Unit, error) {
+	var unitStr string
+	err := tx.QueryRow(ctx, `select unit::text from resource_types where name = $1 for update`,
+		name.String()).Scan(&unitStr)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, eventsvc.ErrUnknownResourceType
+		}
+		return 0, err
+	}
+	return eventsvc.StringToUnit(unitStr)
+}
+
+const resourceTypesQuery = `
+	select name, unit::text, points
+	from resource_types
+	order by name;
+`
+
+// resourceTypes returns the catalogue of the resources sorted by their names. It's shared by the repositories of the
+// services which need the catalogue
+func resourceTypes(ctx context.Context, db *pgxpool.Pool) ([]*eventsvc.ResourceType, error) {
+	rows, err := db.Query(ctx, resourceTypesQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var resourceTypes []*eventsvc.ResourceType
+	for rows.Next() {
+		var (
+			resourceType = &eventsvc.ResourceType{}
+			unitStr      string
+		)
+		if err := rows.Scan(&resourceType.Name, &unitStr, &resourceType.Points); err != nil {
+			return nil, err
+		}
+		if resourceType.Unit, err = eventsvc.StringToUnit(unitStr); err != nil {
+			return nil, err
+		}
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return resourceTypes, nil
+}
@@ -0,0 +1,75 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/internal/eventsvc/postgres"
+)
+
+func TestCataloguingRepo(t *testing.T) {
+	r := postgres.NewCataloguingRepo(db)
+	ctx := context.Background()
+	glass := &eventsvc.ResourceType{Name: "glass", Unit: eventsvc.Kilograms, Points: 1.5}
+
+	if err := r.StoreResourceType(ctx, glass); err != nil {
+		t.Fatalf("StoreResourceType() error = %v", err)
+	}
+	defer db.Exec(ctx, `delete from resource_types where name = $1`, glass.Name.String())
+
+	t.Run("store existing", func(t *testing.T) {
+		if err := r.StoreResourceType(ctx, glass); !errors.Is(err, eventsvc.ErrResourceExists) {
+			t.Errorf("StoreResourceType() error = %v, want %v", err, eventsvc.ErrResourceExists)
+		}
+	})
+	t.Run("resource types", func(t *testing.T) {
+		resourceTypes, err := r.ResourceTypes(ctx)
+		if err != nil {
+			t.Fatalf("ResourceTypes() error = %v", err)
+		}
+		var found bool
+		for _, resourceType := range resourceTypes {
+			found = found || *resourceType == *glass
+		}
+		if !found {
+			t.Errorf("ResourceTypes() = %v, want to contain %v", resourceTypes, glass)
+		}
+	})
+	t.Run("update unknown", func(t *testing.T) {
+		err := r.UpdateResourceType(ctx, &eventsvc.ResourceType{Name: "unknown", Unit: eventsvc.Pieces})
+		if !errors.Is(err, eventsvc.ErrUnknownResourceType) {
+			t.Errorf("UpdateResourceType() error = %v, want %v", err, eventsvc.ErrUnknownResourceType)
+		}
+	})
+	t.Run("update unit of unused", func(t *testing.T) {
+		updated := &eventsvc.ResourceType{Name: glass.Name, Unit: eventsvc.Pieces, Points: 2}
+		if err := r.UpdateResourceType(ctx, updated); err != nil {
+			t.Errorf("UpdateResourceType() error = %v", err)
+		}
+	})
+	t.Run("delete in use", func(t *testing.T) {
+		_, deleteE := createEvent(t, &eventsvc.Event{
+			ID:               "glassevent",
+			Date:             time.Now(),
+			Name:             "glass event",
+			ResourcesAllowed: []eventsvc.Resource{glass.Name},
+			Status:           eventsvc.Open,
+		})
+		defer deleteE()
+		if err := r.DeleteResourceType(ctx, glass.Name); !errors.Is(err, eventsvc.ErrResourceInUse) {
+			t.Errorf("DeleteResourceType() error = %v, want %v", err, eventsvc.ErrResourceInUse)
+		}
+	})
+	t.Run("delete", func(t *testing.T) {
+		if err := r.DeleteResourceType(ctx, glass.Name); err != nil {
+			t.Fatalf("DeleteResourceType() error = %v", err)
+		}
+		err := r.DeleteResourceType(ctx, glass.Name)
+		if !errors.Is(err, eventsvc.ErrUnknownResourceType) {
+			t.Errorf("DeleteResourceType() error = %v, want %v", err, eventsvc.ErrUnknownResourceType)
+		}
+	})
+}
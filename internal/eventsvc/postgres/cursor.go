@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// cursorFetchSize is the number of the rows fetched from the db cursor at a time
+const cursorFetchSize = 100
+
+// walkCursor declares a db cursor for the query and calls fn with each of the rows the query returns, until fn
+// returns an error. The rows are fetched in batches, so that only one batch at a time is held in the memory. The next
+// batch isn't fetched until fn has been called with all the rows of the previous one, so a slow fn slows down the
+// reading of the rows instead of letting them pile up. The cursor lives in a read-only transaction, which sees the
+// rows as they were when it started
+func walkCursor(ctx context.Context, db *pgxpool.Pool, q string, args []interface{}, fn func(pgx.Rows) error) error {
+	tx, err := db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	// the transaction only reads, so it's rolled back in any case, which closes the cursor
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "declare walk no scroll cursor for "+q, args...); err != nil {
+		return err
+	}
+	fetch := fmt.Sprintf("fetch forward %d from walk", cursorFetchSize)
+	for {
+		n, err := fetchRows(ctx, tx, fetch, fn)
+		if err != nil {
+			return err
+		}
+		// the batch which isn't full is the last one
+		if n < cursorFetchSize {
+			return nil
+		}
+	}
+}
+
+// fetchRows fetches a batch of the rows from the cursor and calls fn with each of them. It returns the number of the
+// rows fetched
+func fetchRows(ctx context.Context, tx pgx.Tx, fetch string, fn func(pgx.Rows) error) (int, error) {
+	rows, err := tx.Query(ctx, fetch)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	n := 0
+	for rows.Next() {
+		n++
+		if err := fn(rows); err != nil {
+			return n, err
+		}
+	}
+	return n, rows.Err()
+}
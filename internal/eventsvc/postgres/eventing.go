@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgconn"
@@ -14,6 +15,8 @@ import (
 	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/eventing"
 	"github.com/shanvl/garbage/internal/eventsvc/sorting"
+	"github.com/shanvl/garbage/pkg/broker"
+	"github.com/shanvl/garbage/pkg/paging"
 )
 
 // eventingRepo is a repository used by Eventing service
@@ -26,40 +29,347 @@ func NewEventingRepo(db *pgxpool.Pool) eventing.Repository {
 	return &eventingRepo{db}
 }
 
-const changePupilResourcesQuery = `
-	insert into resources (pupil_id, event_id, gadgets, paper, plastic)
-	values ($1, $2, $3, $4, $5)
-	on conflict (pupil_id, event_id) do update
-		set (gadgets, paper, plastic) = ($3, $4, $5);
+const changeEventStatusQuery = `
+	update event
+	set status  = $3,
+	    version = version + 1
+	where id = $1
+	  and status = $2;
 `
 
-// ChangePupilResources adds/subtracts resources brought by a pupil to/from the event, updating `resources` table
-func (e *eventingRepo) ChangePupilResources(ctx context.Context, eventID string, pupilID string,
+// ChangeEventStatus changes the status of the event only if it's still the given one, so that the concurrent changes
+// can't skip a status. The message about the change is written to the outbox in the same transaction
+func (e *eventingRepo) ChangeEventStatus(ctx context.Context, eventID string, from, to eventsvc.EventStatus,
+	msg broker.Message) error {
+
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	res, err := tx.Exec(ctx, changeEventStatusQuery, eventID, from.String(), to.String())
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		// either there's no such event or its status has been changed in the meantime
+		var exists bool
+		if err := tx.QueryRow(ctx, `select exists(select 1 from event where id = $1)`, eventID).Scan(
+			&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return eventsvc.ErrUnknownEvent
+		}
+		return eventsvc.ErrInvalidStatusTransition
+	}
+	if err := storeOutboxMessages(ctx, tx, msg); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// AddPupilResources stores the entry in the ledger, adding its signed amounts to the resources brought by the pupil.
+// The weigh-ins of the pupil are serialized, so that the concurrent ones can neither overwrite each other nor make the
+// resources less than 0. The message about the entry is written to the outbox in the same transaction
+func (e *eventingRepo) AddPupilResources(ctx context.Context, entry *eventing.ResourceEntry, msg broker.Message) error {
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockPupilResources(ctx, tx, entry.EventID, entry.PupilID, entry.Resources); err != nil {
+		return err
+	}
+	if err := storeResourceEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+	if err := checkResourcesNotNegative(ctx, tx, entry.EventID, entry.PupilID); err != nil {
+		return err
+	}
+	if err := storeOutboxMessages(ctx, tx, msg); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// ChangePupilResources sets the amounts of the resources present in the entry, the other resources keep their
+// amounts. The ledger gets the entry holding the difference with the previous amounts. If the version isn't 0, the
+// resources are changed only if they are still of that version. The message about the change is written to the outbox
+// in the same transaction
+func (e *eventingRepo) ChangePupilResources(ctx context.Context, entry *eventing.ResourceEntry, version int,
+	msg broker.Message) error {
+
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockPupilResources(ctx, tx, entry.EventID, entry.PupilID, entry.Resources); err != nil {
+		return err
+	}
+	amounts, current, err := pupilResources(ctx, tx, entry.EventID, entry.PupilID)
+	if err != nil {
+		return err
+	}
+	if err := eventsvc.CheckVersion(version, current); err != nil {
+		return err
+	}
+	// the ledger stores the difference with the previous amounts
+	diffEntry := *entry
+	diffEntry.Resources = eventsvc.ResourceMap{}
+	for res, amount := range entry.Resources {
+		if diff := amount - amounts[res]; diff != 0 {
+			diffEntry.Resources[res] = diff
+		}
+	}
+	if len(diffEntry.Resources) > 0 {
+		if err := storeResourceEntry(ctx, tx, &diffEntry); err != nil {
+			return err
+		}
+	}
+	if err := storeOutboxMessages(ctx, tx, msg); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// lockPupilResources locks the event's row against the status and the allowed resources changes and the pupil's row
+// against the other changes of their resources until the transaction ends, so that the resources of a closed event
+// can't be changed and the changes of the pupil's resources are serialized. It returns eventsvc.ErrEventNotOpen if the
+// event isn't open and eventing.ErrResourceNotAllowed if any of the resources with non-zero amounts isn't allowed on
+// the event
+func lockPupilResources(ctx context.Context, tx pgx.Tx, eventID, pupilID string,
 	resources eventsvc.ResourceMap) error {
 
-	_, err := e.db.Exec(ctx, changePupilResourcesQuery, pupilID, eventID, resources[eventsvc.Gadgets],
-		resources[eventsvc.Paper], resources[eventsvc.Plastic])
+	var (
+		status  string
+		allowed []string
+	)
+	err := tx.QueryRow(ctx, `select status::text, resources_allowed::text[] from event where id = $1 for share`,
+		eventID).Scan(&status, &allowed)
+	if err != nil {
+		// the same as the violation of the foreign keys of the ledger
+		if errors.Is(err, pgx.ErrNoRows) {
+			return eventing.ErrNoEventPupil
+		}
+		return err
+	}
+	if status != eventsvc.Open.String() {
+		return fmt.Errorf("%w: the event is %s", eventsvc.ErrEventNotOpen, status)
+	}
+	// the allowed resources might have been changed since the service has checked the resources
+	event := eventsvc.Event{ResourcesAllowed: make([]eventsvc.Resource, len(allowed))}
+	for i, res := range allowed {
+		event.ResourcesAllowed[i] = eventsvc.Resource(res)
+	}
+	for res, amount := range resources {
+		if amount != 0 && !event.IsResourceAllowed(res) {
+			return fmt.Errorf("%w: %s", eventing.ErrResourceNotAllowed, res)
+		}
+	}
+	var id string
+	err = tx.QueryRow(ctx, `select id from pupil where id = $1 for no key update`, pupilID).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return eventing.ErrNoEventPupil
+	}
+	return err
+}
+
+const pupilResourcesQuery = `
+	select coalesce(r.amounts, '{}'),
+	       coalesce(r.version, 1)
+	from (values ($1::varchar, $2::varchar)) as k(event_id, pupil_id)
+	         left join resources r using (event_id, pupil_id);
+`
+
+// pupilResources returns the resources brought by the pupil to the event and their version
+func pupilResources(ctx context.Context, tx pgx.Tx, eventID, pupilID string) (eventsvc.ResourceMap, int, error) {
+	var (
+		amounts eventsvc.ResourceMap
+		version int
+	)
+	err := tx.QueryRow(ctx, pupilResourcesQuery, eventID, pupilID).Scan(&amounts, &version)
+	if err != nil {
+		return nil, 0, err
+	}
+	return amounts, version, nil
+}
 
-	// violation of the foreign key (pupil_id, event_id) means that there has been no pupil or event found
-	var pgErr *pgconn.PgError
-	if errors.As(err, &pgErr) {
-		if pgErr.Code == foreignKeyViolationCode {
+// checkResourcesNotNegative returns eventing.ErrNegativeResources if the resources brought by the pupil to the event
+// are less than 0
+func checkResourcesNotNegative(ctx context.Context, tx pgx.Tx, eventID, pupilID string) error {
+	amounts, _, err := pupilResources(ctx, tx, eventID, pupilID)
+	if err != nil {
+		return err
+	}
+	for res, amount := range amounts {
+		if amount < 0 {
+			return fmt.Errorf("%w: %s: %v", eventing.ErrNegativeResources, res, amount)
+		}
+	}
+	return nil
+}
+
+const storeResourceEntryQuery = `
+	insert into resource_entries (id, pupil_id, event_id, amounts, recorded_by, recorded_at, correction_of)
+	values ($1, $2, $3, $4, $5, $6, nullif($7, ''));
+`
+
+// storeResourceEntry stores the entry in the ledger
+func storeResourceEntry(ctx context.Context, tx pgx.Tx, entry *eventing.ResourceEntry) error {
+	_, err := tx.Exec(ctx, storeResourceEntryQuery, entry.ID, entry.PupilID, entry.EventID,
+		entry.Resources.ToStringMap(), entry.RecordedBy, entry.RecordedAt, entry.CorrectionOf)
+	if err != nil {
+		// violation of the foreign keys means that there has been no pupil or event found
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
 			err = eventing.ErrNoEventPupil
 		}
 	}
 	return err
 }
 
+const voidResourceEntryQuery = `
+	update resource_entries
+	set voided_by = $4,
+	    voided_at = $5
+	where id = $1
+	  and event_id = $2
+	  and pupil_id = $3
+	  and voided_at is null;
+`
+
+// voidResourceEntry voids the entry with the id, the event and the pupil of the given one
+func voidResourceEntry(ctx context.Context, tx pgx.Tx, entry *eventing.ResourceEntry) error {
+	res, err := tx.Exec(ctx, voidResourceEntryQuery, entry.ID, entry.EventID, entry.PupilID, entry.VoidedBy,
+		entry.VoidedAt)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		// either there's no such entry or it has already been voided
+		var exists bool
+		if err := tx.QueryRow(ctx, `select exists(select 1 from resource_entries where id = $1 and event_id = $2
+			and pupil_id = $3)`, entry.ID, entry.EventID, entry.PupilID).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return eventing.ErrUnknownEntry
+		}
+		return eventing.ErrEntryVoided
+	}
+	return nil
+}
+
+const classesWithResourcesQuery = `
+	select distinct p.class_letter,
+	                p.class_date_formed
+	from resources r
+	         inner join pupil p on p.id = r.pupil_id
+	where r.event_id = $1
+	  and exists(select 1 from jsonb_each_text(r.amounts) where value::float4 > 0)
+	order by p.class_date_formed desc, p.class_letter;
+`
+
+// ClassesWithResources returns the classes whose pupils have brought resources to the event
+func (e *eventingRepo) ClassesWithResources(ctx context.Context, eventID string) ([]eventsvc.Class, error) {
+	rows, err := e.db.Query(ctx, classesWithResourcesQuery, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var classes []eventsvc.Class
+	for rows.Next() {
+		var c eventsvc.Class
+		if err := rows.Scan(&c.Letter, &c.DateFormed); err != nil {
+			return nil, err
+		}
+		classes = append(classes, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return classes, nil
+}
+
+// CorrectResourceEntry voids the entry the correction is of on behalf of the correction's recorder and stores the
+// correction in the ledger. The message about it is written to the outbox in the same transaction
+func (e *eventingRepo) CorrectResourceEntry(ctx context.Context, correction *eventing.ResourceEntry,
+	msg broker.Message) error {
+
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockPupilResources(ctx, tx, correction.EventID, correction.PupilID, correction.Resources); err != nil {
+		return err
+	}
+	err = voidResourceEntry(ctx, tx, &eventing.ResourceEntry{
+		ID:       correction.CorrectionOf,
+		EventID:  correction.EventID,
+		PupilID:  correction.PupilID,
+		VoidedBy: correction.RecordedBy,
+		VoidedAt: correction.RecordedAt,
+	})
+	if err != nil {
+		return err
+	}
+	if err := storeResourceEntry(ctx, tx, correction); err != nil {
+		return err
+	}
+	if err := checkResourcesNotNegative(ctx, tx, correction.EventID, correction.PupilID); err != nil {
+		return err
+	}
+	if err := storeOutboxMessages(ctx, tx, msg); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
 const deleteEventQuery = `
 	delete
     from event e
 	where e.id = $1;
 `
 
-// DeleteEvent deletes an event with the id passed
+// DeleteEvent deletes an event with the id passed. The frozen events can't be deleted, since their resource entries
+// would be deleted too
 func (e *eventingRepo) DeleteEvent(ctx context.Context, eventID string) error {
-	_, err := e.db.Exec(ctx, deleteEventQuery, eventID)
-	return err
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// the event is locked, so that it can't be closed while it's being deleted
+	var statusStr string
+	err = tx.QueryRow(ctx, `select status::text from event where id = $1 for update`, eventID).Scan(&statusStr)
+	if err != nil {
+		// deleting an event which doesn't exist is a no-op
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	status, err := eventsvc.StringToEventStatus(statusStr)
+	if err != nil {
+		return err
+	}
+	if status.IsFrozen() {
+		return fmt.Errorf("%w: the event is %s", eventsvc.ErrEventFrozen, status)
+	}
+
+	if _, err := tx.Exec(ctx, deleteEventQuery, eventID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
 const eventByIDQuery = `
@@ -67,9 +377,10 @@ const eventByIDQuery = `
        e.name,
        e.date,
        e.resources_allowed::text[],
-       coalesce(sum(gadgets), 0) as gadgets,
-       coalesce(sum(r.paper), 0)   as paper,
-       coalesce(sum(plastic), 0) as plastic
+       e.status::text,
+       e.version,
+       e.multiplier,
+       resource_amounts_sum(r.amounts) as amounts
 	from event e
 			 left join resources r on e.id = r.event_id
 	where e.id = $1
@@ -82,13 +393,12 @@ func (e *eventingRepo) EventByID(ctx context.Context, eventID string) (*eventing
 	// need this one in order to scan resources_allowed into it
 	var (
 		resAllowedStr []string
-		gadgets       float32
-		paper         float32
-		plastic       float32
+		status        string
+		amounts       eventsvc.ResourceMap
 	)
 	// query db
 	err := e.db.QueryRow(ctx, eventByIDQuery, eventID).Scan(&ev.ID, &ev.Name, &ev.Date,
-		&resAllowedStr, &gadgets, &paper, &plastic)
+		&resAllowedStr, &status, &ev.Version, &ev.Multiplier, &amounts)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, eventsvc.ErrUnknownEvent
@@ -101,66 +411,109 @@ func (e *eventingRepo) EventByID(ctx context.Context, eventID string) (*eventing
 		return nil, err
 	}
 	ev.ResourcesAllowed = resAllowed
-	ev.ResourcesBrought = newResourceMap(resAllowed, gadgets, paper, plastic)
+	if ev.Status, err = eventsvc.StringToEventStatus(status); err != nil {
+		return nil, err
+	}
+	ev.ResourcesBrought = newResourceMap(resAllowed, amounts)
 	return ev, nil
 }
 
 const eventClassesQuery = `
-	with query as (
+	with pupils as (
 		select p.class_letter,
 			   p.class_date_formed,
 			   e.date,
-               e.resources_allowed::text[],
-			   coalesce(sum(gadgets), 0) as gadgets,
-			   coalesce(sum(paper), 0)   as paper,
-			   coalesce(sum(plastic), 0) as plastic
+			   e.resources_allowed,
+			   coalesce(r.amounts, '{}')                                                 as amounts,
+			   exists(select 1 from jsonb_each_text(r.amounts) where value::float4 > 0) as brought
 		from pupil p
 				 cross join event e
 				 left join resources r on r.pupil_id = p.id and r.event_id = e.id
 		where e.id = ? %s
 		  and e.date between symmetric p.class_date_formed and p.class_date_formed + (365.25 * 11)::integer
-		group by p.class_date_formed, p.class_letter, e.date, e.resources_allowed
+	), query as (
+		select class_letter,
+			   class_date_formed,
+			   date,
+			   resources_allowed::text[],
+			   resource_amounts_sum(amounts)                                                         as amounts,
+			   count(*)                                                                              as pupils,
+			   count(*) filter (where brought)                                                       as participants,
+			   percentile_cont(0.5) within group (order by resource_amounts_points(amounts))::float4 as median_points
+		from pupils
+		group by class_date_formed, class_letter, date, resources_allowed
 	),  pagination as (
-			 select *
+			 select *, %s as page_cursor
 			 from query
+			 where %s
 			 order by %s
 			 limit ? offset ?
-)
-	select *
+	), medians as (
+		-- the medians of the resources allowed are found for the paginated classes only
+		select class_letter, class_date_formed, jsonb_object_agg(res, median) as amounts_median
+		from (select pu.class_letter,
+					 pu.class_date_formed,
+					 res,
+					 percentile_cont(0.5) within group (order by coalesce((pu.amounts ->> res)::float4, 0)) as median
+			  from pupils pu
+					   inner join pagination pg using (class_letter, class_date_formed)
+					   cross join unnest(pu.resources_allowed) as res
+			  group by pu.class_letter, pu.class_date_formed, res) m
+		group by class_letter, class_date_formed
+	)
+	select class_letter,
+		   class_date_formed,
+		   date,
+		   resources_allowed,
+		   amounts,
+		   pupils,
+		   participants,
+		   median_points,
+		   coalesce(amounts_median, '{}'),
+		   page_cursor,
+		   total,
+		   event_id
 	from pagination
-			 right join (select count(*) FROM query) as c(total) on true
-             left join (select id from event where id = ?) as d(event_id) on true;
+			 left join medians using (class_letter, class_date_formed)
+			 right join (%s) as c(total) on true
+			 left join (select id from event where id = ?) as d(event_id) on true
+	order by %s;
 `
 
-// EventClasses returns a sorted and paginated list of classes that match the passed filters
+// EventClasses returns a sorted and paginated list of classes that match the passed filters. The page either skips a
+// number of the classes or follows the page token
 func (e *eventingRepo) EventClasses(ctx context.Context, eventID string,
-	filters eventing.EventClassFilters, sortBy sorting.By, amount int, skip int) (classes []*eventing.Class,
-	total int, err error) {
+	filters eventing.EventClassFilters, sortBy sorting.By, page paging.Page) (classes []*eventing.Class,
+	total int, nextToken string, err error) {
 
 	var q string
-	// derive the "order by" query part from the sortBy passed
-	orderBy := classOrderMap[sortBy]
+	// derive the paging and the "order by" query parts from the sortBy passed
+	pq, err := newPageQuery(page, sortBy, classOrdering.pageColumns(sortBy), "query")
+	if err != nil {
+		return nil, 0, "", err
+	}
 	// create a slice of the query arguments
 	args := []interface{}{eventID}
 	// if there're no filters passed, create a simple query. Otherwise, create a query w/ a conditional "where" part
 	if filters.Name == "" {
-		q = fmt.Sprintf(eventClassesQuery, "", orderBy)
-		args = append(args, amount, skip, eventID)
+		q = fmt.Sprintf(eventClassesQuery, "", pq.cursor, pq.after, pq.orderBy, pq.total, pq.orderBy)
+		args = append(args, pq.args...)
+		args = append(args, page.Size, page.Offset(), eventID)
 	} else {
 		// the event's date is needed when we create a eventsvc.Class from it's name.
 		// A class' name changes depending on the event date
 		var eDate time.Time
 		if err := e.db.QueryRow(ctx, `select date from event where id = $1`, eventID).Scan(&eDate); err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
-				return nil, 0, eventsvc.ErrUnknownEvent
+				return nil, 0, "", eventsvc.ErrUnknownEvent
 			}
-			return nil, 0, err
+			return nil, 0, "", err
 		}
 		// get the class' letter and date formed, using the event's date
 		letter, dateFormed, err := eventsvc.ParseClassName(filters.Name, eDate)
 		// if the letter or the date can't be derived from the class name, then simply return the total w/o any errors
 		if err != nil {
-			return nil, 0, nil
+			return nil, 0, "", nil
 		}
 		// create the "where" clause based on the presence of the letter and the dateFormed and append the
 		// arguments to the slice
@@ -174,16 +527,17 @@ func (e *eventingRepo) EventClasses(ctx context.Context, eventID string,
 			args = append(args, dateFormed)
 		}
 		// add other arguments
-		args = append(args, amount, skip, eventID)
+		args = append(args, pq.args...)
+		args = append(args, page.Size, page.Offset(), eventID)
 		// add the where clause to the query
-		q = fmt.Sprintf(eventClassesQuery, where, orderBy)
+		q = fmt.Sprintf(eventClassesQuery, where, pq.cursor, pq.after, pq.orderBy, pq.total, pq.orderBy)
 	}
 	// swap "?" for "$" in the query
 	q = sqlx.Rebind(sqlx.BindType("pgx"), q)
 	// exec the query
 	rows, err := e.db.Query(ctx, q, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 	defer rows.Close()
 
@@ -192,52 +546,63 @@ func (e *eventingRepo) EventClasses(ctx context.Context, eventID string,
 		classLetter pgtype.Varchar
 		classDate   pgtype.Date
 		eventDate   pgtype.Date
-		gadgets     pgtype.Float4
-		paper       pgtype.Float4
-		plastic     pgtype.Float4
+		cursor      []byte
 		eID         pgtype.Varchar
 	)
 	for rows.Next() {
-		var resAllowedStr []string
-		if err := rows.Scan(&classLetter, &classDate, &eventDate, &resAllowedStr, &gadgets, &paper, &plastic, &total,
-			&eID); err != nil {
-			return nil, 0, err
+		var (
+			resAllowedStr        []string
+			amounts, median      eventsvc.ResourceMap
+			pupils, participants pgtype.Int8
+			medianPoints         pgtype.Float4
+		)
+		if err := rows.Scan(&classLetter, &classDate, &eventDate, &resAllowedStr, &amounts, &pupils, &participants,
+			&medianPoints, &median, &cursor, &total, &eID); err != nil {
+			return nil, 0, "", err
 		}
 		// if the event hasn't been found, return an error
 		if eID.Status != pgtype.Present {
-			return nil, total, eventsvc.ErrUnknownEvent
+			return nil, total, "", eventsvc.ErrUnknownEvent
 		}
 		// next will happen if the offset >= total rows found or no classes with such class names have been found
 		// In that case we simply return the total w/o additional work
 		if classLetter.Status != pgtype.Present {
-			return nil, total, nil
+			return nil, total, "", nil
 		}
 		// derive a className from its letter, dateFormed and the event's date
 		c := eventsvc.Class{Letter: classLetter.String, DateFormed: classDate.Time}
 		className, err := c.NameOnDate(eventDate.Time)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, "", err
 		}
 		// convert []string to []eventsvc.Resource
 		resAllowed, err := eventsvc.StringSliceToResourceSlice(resAllowedStr)
-		// create a map of the resources brought to the event
-		resBrought := newResourceMap(resAllowed, gadgets.Float, paper.Float, plastic.Float)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, "", err
 		}
+		// create a map of the resources brought to the event
+		resBrought := newResourceMap(resAllowed, amounts)
 		// append the class to other classes
 		classes = append(classes, &eventing.Class{
 			Name:             className,
 			ResourcesBrought: resBrought,
+			Stats: eventsvc.NewClassStats(resBrought, int(pupils.Int), int(participants.Int), median,
+				medianPoints.Float),
 		})
 	}
 	if err := rows.Err(); err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+	nextToken, err = page.NextToken(string(sortBy), len(classes), cursor)
+	if err != nil {
+		return nil, 0, "", err
 	}
-	return classes, total, nil
+	return classes, total, nextToken, nil
 }
 
-const eventPupilsQuery = `
+// eventPupilsCTE selects the pupils who could have brought the resources to the event along with the resources
+// they brought ("query")
+const eventPupilsCTE = `
 	with query as (
     select p.id,
            p.first_name,
@@ -246,63 +611,56 @@ const eventPupilsQuery = `
            p.class_date_formed,
 		   e.date,
 		   e.resources_allowed::text[],
-           coalesce(r.gadgets, 0) as gadgets,
-           coalesce(r.paper, 0)   as paper,
-           coalesce(r.plastic, 0) as plastic
+           coalesce(r.amounts, '{}') as amounts
     from pupil p
              cross join event e
              left join resources r on r.pupil_id = p.id and r.event_id = e.id
     where e.id = ? %s
       and e.date between symmetric p.class_date_formed and p.class_date_formed + (365.25 * 11)::integer
-	),
+	)`
+
+const eventPupilsQuery = eventPupilsCTE + `,
 	pagination as (
-		select *
+		select *, %s as page_cursor
 		from query
+		where %s
 		order by %s
 		limit ? offset ?
 	)
 	select *
 	from pagination
-	right join (select count(*) from query) as c(total) on true
-	left join (select id from event where id = ?) as d(event_id) on true;
+	right join (%s) as c(total) on true
+	left join (select id from event where id = ?) as d(event_id) on true
+	order by %s;
 `
 
-// EventPupils returns a paginated and sorted list of the pupils who have participated in the specified event
+// EventPupils returns a paginated and sorted list of the pupils who have participated in the specified event. The page
+// either skips a number of the pupils or follows the page token
 func (e *eventingRepo) EventPupils(ctx context.Context, eventID string, filters eventing.EventPupilFilters,
-	sortBy sorting.By, amount int, skip int) (pupils []*eventing.Pupil, total int, err error) {
+	sortBy sorting.By, page paging.Page) (pupils []*eventing.Pupil, total int, nextToken string, err error) {
 
-	var q string
-	// derive the "order by" query part from the sortBy passed
-	orderBy := pupilOrderMap[sortBy]
-	// create a slice of the query arguments
-	args := []interface{}{eventID}
-	// if there're no filters passed, create a simple query. Otherwise, create a query w/ the text search
-	if filters.NameAndClass == "" {
-		q = fmt.Sprintf(eventPupilsQuery, "", orderBy)
-		args = append(args, amount, skip, eventID)
-	} else {
-		// we need to know the event's date in order to create a text search query. Every word,
-		// which resembles a class name, will be copied,
-		// processed and concatenated with itself so as to hit the table indices. The event's date is needed there.
-		// "3B" will become "3B:* | 2018B:*" if the event's date is 10.10.2020. Also,
-		// the event's date is needed when we create a class name from it's letter and the year it was formed in
-		var eDate time.Time
-		if err := e.db.QueryRow(ctx, `select date from event where id = $1`, eventID).Scan(&eDate); err != nil {
-			if errors.Is(err, pgx.ErrNoRows) {
-				return nil, 0, eventsvc.ErrUnknownEvent
-			}
-			return nil, 0, err
+	// derive the paging and the "order by" query parts from the sortBy passed
+	pq, err := newPageQuery(page, sortBy, pupilOrdering.pageColumns(sortBy), "query")
+	if err != nil {
+		return nil, 0, "", err
+	}
+	// if there're text filters passed, the event's date is needed to create the text search query
+	var eDate time.Time
+	if filters.NameAndClass != "" {
+		if eDate, err = e.eventDate(ctx, eventID); err != nil {
+			return nil, 0, "", err
 		}
-		// create the text search part of the query from the filters passed
-		textSearchQuery := prepareTextSearchClass(filters.NameAndClass, eDate)
-		q = fmt.Sprintf(eventPupilsQuery, " and p.text_search @@ to_tsquery('simple', ?)", orderBy)
-		args = append(args, textSearchQuery, amount, skip, eventID)
 	}
+	// the conditional "where" part of the query, which is built from the filters passed, and the query arguments
+	where, args := eventPupilsCondition(eventID, filters, eDate)
+	q := fmt.Sprintf(eventPupilsQuery, where, pq.cursor, pq.after, pq.orderBy, pq.total, pq.orderBy)
+	args = append(args, pq.args...)
+	args = append(args, page.Size, page.Offset(), eventID)
 	// change "?" to "$" in the query
 	q = sqlx.Rebind(sqlx.BindType("pgx"), q)
 	rows, err := e.db.Query(ctx, q, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 	defer rows.Close()
 	// "total" column will always be returned, so other columns might be null
@@ -313,34 +671,35 @@ func (e *eventingRepo) EventPupils(ctx context.Context, eventID string, filters
 		classLetter pgtype.Varchar
 		classDate   pgtype.Date
 		eventDate   pgtype.Date
-		gadgets     pgtype.Float4
-		paper       pgtype.Float4
-		plastic     pgtype.Float4
+		cursor      []byte
 		eID         pgtype.Varchar
 	)
 
 	for rows.Next() {
-		var resAllowedStr []string
-		err := rows.Scan(&id, &firstName, &lastName, &classLetter, &classDate, &eventDate, &resAllowedStr, &gadgets,
-			&paper, &plastic, &total, &eID)
+		var (
+			resAllowedStr []string
+			amounts       eventsvc.ResourceMap
+		)
+		err := rows.Scan(&id, &firstName, &lastName, &classLetter, &classDate, &eventDate, &resAllowedStr, &amounts,
+			&cursor, &total, &eID)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, "", err
 		}
 		if eID.Status != pgtype.Present {
-			return nil, total, eventsvc.ErrUnknownEvent
+			return nil, total, "", eventsvc.ErrUnknownEvent
 		}
 		// next will happen if the offset >= total rows found or no pupils with such names/classNames were found
 		// In that case we simply return the total w\o additional work
 		if id.Status != pgtype.Present {
-			return nil, total, nil
+			return nil, total, "", nil
 		}
 		// convert []string to []eventsvc.Resource
 		resAllowed, err := eventsvc.StringSliceToResourceSlice(resAllowedStr)
-		// create a map of the resources brought to the event
-		resBrought := newResourceMap(resAllowed, gadgets.Float, paper.Float, plastic.Float)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, "", err
 		}
+		// create a map of the resources brought to the event
+		resBrought := newResourceMap(resAllowed, amounts)
 		p := &eventing.Pupil{
 			Pupil: eventsvc.Pupil{
 				ID:        id.String,
@@ -353,7 +712,7 @@ func (e *eventingRepo) EventPupils(ctx context.Context, eventID string, filters
 		// derive a class name from its letter and a year it was formed in
 		className, err := c.NameOnDate(eventDate.Time)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, "", err
 		}
 		// append it to the pupil
 		p.Class = className
@@ -362,10 +721,96 @@ func (e *eventingRepo) EventPupils(ctx context.Context, eventID string, filters
 	}
 	err = rows.Err()
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+
+	nextToken, err = page.NextToken(string(sortBy), len(pupils), cursor)
+	if err != nil {
+		return nil, 0, "", err
 	}
+	return pupils, total, nextToken, nil
+}
 
-	return pupils, total, nil
+// eventDate returns the date of the event
+func (e *eventingRepo) eventDate(ctx context.Context, eventID string) (time.Time, error) {
+	var date time.Time
+	if err := e.db.QueryRow(ctx, `select date from event where id = $1`, eventID).Scan(&date); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, eventsvc.ErrUnknownEvent
+		}
+		return time.Time{}, err
+	}
+	return date, nil
+}
+
+// eventPupilsCondition returns the conditional "where" part of the event pupils query built from the filters, along
+// with its arguments, which start with the id of the event. The event's date is only needed for the text search
+func eventPupilsCondition(eventID string, filters eventing.EventPupilFilters, eventDate time.Time) (string,
+	[]interface{}) {
+
+	args := []interface{}{eventID}
+	var where strings.Builder
+	// if there're text filters passed, create the text search part of the query
+	if filters.NameAndClass != "" {
+		// every word, which resembles a class name, will be copied, processed and concatenated with itself so as to
+		// hit the table indices. The event's date is needed there.
+		// "3B" will become "3B:* | 2018B:*" if the event's date is 10.10.2020
+		textSearchQuery := prepareTextSearchClass(filters.NameAndClass, eventDate)
+		where.WriteString(" and p.text_search @@ to_tsquery('simple', ?)")
+		args = append(args, textSearchQuery)
+	}
+	// if the user is restricted to some classes or pupils, leave only those pupils
+	scopeCond, scopeArgs := scopeCondition(filters.Scope)
+	where.WriteString(scopeCond)
+	args = append(args, scopeArgs...)
+	return where.String(), args
+}
+
+const streamEventPupilsQuery = eventPupilsCTE + `
+	select id, first_name, last_name, class_letter, class_date_formed, resources_allowed, amounts
+	from query
+	order by %s;
+`
+
+// StreamEventPupils calls fn with each of the sorted pupils who have participated in the specified event, until fn
+// returns an error. The pupils are read with a db cursor, so that all of them can be walked through without being
+// held in the memory at once
+func (e *eventingRepo) StreamEventPupils(ctx context.Context, eventID string, filters eventing.EventPupilFilters,
+	sortBy sorting.By, fn func(*eventing.Pupil) error) error {
+
+	// the date is needed to name the classes of the pupils. Also, it tells whether the event exists
+	eDate, err := e.eventDate(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	where, args := eventPupilsCondition(eventID, filters, eDate)
+	// the pupils are sorted so that each of them has a place of its own
+	q := fmt.Sprintf(streamEventPupilsQuery, where, paging.OrderBy(pupilOrdering.pageColumns(sortBy)))
+	// change "?" to "$" in the query
+	q = sqlx.Rebind(sqlx.BindType("pgx"), q)
+
+	return walkCursor(ctx, e.db, q, args, func(rows pgx.Rows) error {
+		var (
+			c             eventsvc.Class
+			resAllowedStr []string
+			amounts       eventsvc.ResourceMap
+		)
+		p := &eventing.Pupil{}
+		if err := rows.Scan(&p.ID, &p.FirstName, &p.LastName, &c.Letter, &c.DateFormed, &resAllowedStr,
+			&amounts); err != nil {
+			return err
+		}
+		resAllowed, err := eventsvc.StringSliceToResourceSlice(resAllowedStr)
+		if err != nil {
+			return err
+		}
+		p.ResourcesBrought = newResourceMap(resAllowed, amounts)
+		// derive a class name from its letter and a year it was formed in
+		if p.Class, err = c.NameOnDate(eDate); err != nil {
+			return err
+		}
+		return fn(p)
+	})
 }
 
 // doing it via the left join so as to differ between the absence of the event or the pupil.
@@ -379,9 +824,9 @@ const evPupilByIDQuery = `
 		   p.last_name,
 		   p.class_letter,
 		   p.class_date_formed,
-		   coalesce(gadgets, 0) as gadgets,
-		   coalesce(paper, 0)   as paper,
-		   coalesce(plastic, 0) as plastic
+		   p.version,
+		   r.amounts,
+		   coalesce(r.version, 1)
 	from pupil p
 			 left join event e on e.id = $1
 			 left join resources r on e.id = r.event_id and p.id = r.pupil_id
@@ -399,16 +844,14 @@ func (e *eventingRepo) PupilByID(ctx context.Context, pupilID string,
 		// class name is always relative to the date of the event, not to the current date
 		eDate          pgtype.Date
 		eResAllowedStr []string
-		gadgets        float32
-		paper          float32
-		plastic        float32
+		amounts        eventsvc.ResourceMap
 	)
 
 	// class instance to derive a class name from
 	c := eventsvc.Class{}
 
 	err := e.db.QueryRow(ctx, evPupilByIDQuery, eventID, pupilID).Scan(&eID, &eDate, &eResAllowedStr, &p.ID,
-		&p.FirstName, &p.LastName, &c.Letter, &c.DateFormed, &gadgets, &paper, &plastic)
+		&p.FirstName, &p.LastName, &c.Letter, &c.DateFormed, &p.Version, &amounts, &p.ResourcesVersion)
 
 	if err != nil {
 		// if no rows have been returned, there's no such a pupil
@@ -435,25 +878,276 @@ func (e *eventingRepo) PupilByID(ctx context.Context, pupilID string,
 
 	// convert []string to []eventsvc.Resource
 	resAllowed, err := eventsvc.StringSliceToResourceSlice(eResAllowedStr)
-	// create a map of the resources brought to the event
-	resBrought := newResourceMap(resAllowed, gadgets, paper, plastic)
 	if err != nil {
 		return nil, err
 	}
-	p.ResourcesBrought = resBrought
+	// create a map of the resources brought to the event
+	p.ResourcesBrought = newResourceMap(resAllowed, amounts)
 
 	return p, nil
 }
 
-// ::text[]::resource[] is a workaround for pgx to save an enum array. It won't break or slow anything
+const pupilResourceEntriesQuery = `
+	select id,
+	       amounts,
+	       recorded_by,
+	       recorded_at,
+	       coalesce(correction_of, ''),
+	       coalesce(voided_by, ''),
+	       voided_at
+	from resource_entries
+	where event_id = $1
+	  and pupil_id = $2
+	order by recorded_at, id;
+`
+
+// PupilResourceEntries returns the entries of the ledger of the resources brought by the pupil to the event in the
+// order they were recorded, including the voided ones
+func (e *eventingRepo) PupilResourceEntries(ctx context.Context, eventID,
+	pupilID string) ([]*eventing.ResourceEntry, error) {
+
+	rows, err := e.db.Query(ctx, pupilResourceEntriesQuery, eventID, pupilID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*eventing.ResourceEntry
+	for rows.Next() {
+		entry := &eventing.ResourceEntry{EventID: eventID, PupilID: pupilID}
+		var voidedAt pgtype.Timestamptz
+		if err := rows.Scan(&entry.ID, &entry.Resources, &entry.RecordedBy, &entry.RecordedAt, &entry.CorrectionOf,
+			&entry.VoidedBy, &voidedAt); err != nil {
+			return nil, err
+		}
+		if voidedAt.Status == pgtype.Present {
+			entry.VoidedAt = voidedAt.Time
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
 const storeEventQuery = `
-	insert into event (id, name, date, resources_allowed)
-	values ($1, $2, $3, $4::text[]::resource[]);
+	insert into event (id, name, date, resources_allowed, status)
+	values ($1, $2, $3, $4, $5);
+`
+
+// ResourceTypes returns the catalogue of the resources which can be allowed on the events
+func (e *eventingRepo) ResourceTypes(ctx context.Context) ([]*eventsvc.ResourceType, error) {
+	return resourceTypes(ctx, e.db)
+}
+
+// StoreEvent stores event into the db. The resource types allowed on the event are locked until the transaction ends,
+// so that they can't be deleted from the catalogue meanwhile. The message about the change is written to the outbox
+// in the same transaction
+func (e *eventingRepo) StoreEvent(ctx context.Context, event *eventsvc.Event, msg broker.Message) error {
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockResourcesAllowed(ctx, tx, event.ResourcesAllowed); err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, storeEventQuery, event.ID, event.Name, event.Date,
+		eventsvc.ResourceSliceToStringSlice(event.ResourcesAllowed), event.Status.String())
+	if err != nil {
+		return err
+	}
+	if err := storeOutboxMessages(ctx, tx, msg); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// lockResourcesAllowed locks the rows of the resource types allowed on the event against the deletion until the
+// transaction ends. The service checks the allowed resources against the catalogue before the transaction starts, so
+// they are checked again here. It returns eventsvc.ErrUnknownResourceType if any of them isn't in the catalogue
+func lockResourcesAllowed(ctx context.Context, tx pgx.Tx, resources []eventsvc.Resource) error {
+	rows, err := tx.Query(ctx, `select name from resource_types where name = any($1) for share`,
+		eventsvc.ResourceSliceToStringSlice(resources))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	known := make(map[eventsvc.Resource]bool, len(resources))
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return err
+		}
+		known[eventsvc.Resource(name)] = true
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, res := range resources {
+		if !known[res] {
+			return fmt.Errorf("%w: %s", eventsvc.ErrUnknownResourceType, res)
+		}
+	}
+	return nil
+}
+
+const updateEventQuery = `
+	update event
+	set name              = $2,
+	    date              = $3,
+	    resources_allowed = $4,
+	    version           = version + 1
+	where id = $1;
 `
 
-// StoreEvent stores event into the db
-func (e *eventingRepo) StoreEvent(ctx context.Context, event *eventsvc.Event) error {
-	_, err := e.db.Exec(ctx, storeEventQuery, event.ID, event.Name, event.Date,
+// UpdateEvent updates the date, the name and the allowed resources of the event. The event's row is locked until the
+// transaction ends, so that no resources can be brought to the event while the allowed resources are being checked.
+// The allowed resource types are locked as well, so that they can't be deleted from the catalogue meanwhile.
+// If the event's version isn't 0, the event is updated only if it's still of that version. The message about the
+// change is written to the outbox in the same transaction
+func (e *eventingRepo) UpdateEvent(ctx context.Context, event *eventsvc.Event, msg broker.Message) error {
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		statusStr     string
+		date          time.Time
+		resAllowedStr []string
+		version       int
+	)
+	err = tx.QueryRow(ctx, `select status::text, date, resources_allowed::text[], version from event where id = $1
+		for update`, event.ID).Scan(&statusStr, &date, &resAllowedStr, &version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return eventsvc.ErrUnknownEvent
+		}
+		return err
+	}
+	if err := eventsvc.CheckVersion(event.Version, version); err != nil {
+		return err
+	}
+	status, err := eventsvc.StringToEventStatus(statusStr)
+	if err != nil {
+		return err
+	}
+	if status.IsFrozen() {
+		return fmt.Errorf("%w: the event is %s", eventsvc.ErrEventFrozen, status)
+	}
+	// the resources which have already been brought to the event can't be disallowed
+	resAllowed, err := eventsvc.StringSliceToResourceSlice(resAllowedStr)
+	if err != nil {
+		return err
+	}
+	var disallowed []eventsvc.Resource
+	for _, res := range resAllowed {
+		if !event.IsResourceAllowed(res) {
+			disallowed = append(disallowed, res)
+		}
+	}
+	pupils, err := pupilsWithResources(ctx, tx, event.ID, date, disallowed)
+	if err != nil {
+		return err
+	}
+	if len(pupils) > 0 {
+		return &eventing.ResourcesBroughtError{Pupils: pupils}
+	}
+	if err := lockResourcesAllowed(ctx, tx, event.ResourcesAllowed); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, updateEventQuery, event.ID, event.Name, event.Date,
 		eventsvc.ResourceSliceToStringSlice(event.ResourcesAllowed))
-	return err
+	if err != nil {
+		return err
+	}
+	if err := storeOutboxMessages(ctx, tx, msg); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// VoidResourceEntry voids the entry, so that it doesn't count towards the resources brought by the pupil anymore.
+// The message about it is written to the outbox in the same transaction
+func (e *eventingRepo) VoidResourceEntry(ctx context.Context, entry *eventing.ResourceEntry, msg broker.Message) error {
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockPupilResources(ctx, tx, entry.EventID, entry.PupilID, nil); err != nil {
+		return err
+	}
+	if err := voidResourceEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+	if err := checkResourcesNotNegative(ctx, tx, entry.EventID, entry.PupilID); err != nil {
+		return err
+	}
+	if err := storeOutboxMessages(ctx, tx, msg); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+const pupilsWithResourcesQuery = `
+	select p.id,
+	       p.first_name,
+	       p.last_name,
+	       p.class_letter,
+	       p.class_date_formed,
+	       r.amounts
+	from resources r
+	         inner join pupil p on p.id = r.pupil_id
+	where r.event_id = $1
+	  and exists(select 1 from jsonb_each_text(r.amounts) where key = any ($2) and value::float4 > 0)
+	order by p.last_name, p.first_name;
+`
+
+// pupilsWithResources returns the pupils who have brought any of the resources to the event along with the amounts
+// of those resources. Their classes are named as they were on the date of the event
+func pupilsWithResources(ctx context.Context, tx pgx.Tx, eventID string, eventDate time.Time,
+	resources []eventsvc.Resource) ([]*eventing.Pupil, error) {
+
+	if len(resources) == 0 {
+		return nil, nil
+	}
+	rows, err := tx.Query(ctx, pupilsWithResourcesQuery, eventID, eventsvc.ResourceSliceToStringSlice(resources))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pupils []*eventing.Pupil
+	for rows.Next() {
+		p := &eventing.Pupil{}
+		var (
+			c       eventsvc.Class
+			amounts eventsvc.ResourceMap
+		)
+		if err := rows.Scan(&p.ID, &p.FirstName, &p.LastName, &c.Letter, &c.DateFormed, &amounts); err != nil {
+			return nil, err
+		}
+		if p.Class, err = c.NameOnDate(eventDate); err != nil {
+			return nil, err
+		}
+		p.ResourcesBrought = eventsvc.ResourceMap{}
+		for _, res := range resources {
+			if amounts[res] > 0 {
+				p.ResourcesBrought[res] = amounts[res]
+			}
+		}
+		pupils = append(pupils, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return pupils, nil
 }
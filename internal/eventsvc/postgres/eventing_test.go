@@ -3,13 +3,18 @@ package postgres_test
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
+	gonanoid "github.com/matoous/go-nanoid"
 	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/eventing"
+	"github.com/shanvl/garbage/internal/eventsvc/mock"
 	"github.com/shanvl/garbage/internal/eventsvc/postgres"
 	"github.com/shanvl/garbage/internal/eventsvc/sorting"
+	"github.com/shanvl/garbage/pkg/broker"
+	"github.com/shanvl/garbage/pkg/paging"
 )
 
 func TestEventingRepo_ChangePupilResources(t *testing.T) {
@@ -61,8 +66,8 @@ func TestEventingRepo_ChangePupilResources(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := r.ChangePupilResources(ctx, tt.args.eventID, tt.args.pupilID,
-				tt.args.resources)
+			err := r.ChangePupilResources(ctx, newResourceEntry(t, tt.args.eventID, tt.args.pupilID,
+				tt.args.resources), 0, mock.NewMessage(t, broker.TopicPupilResourcesChanged))
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ChangePupilResources() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -72,41 +77,319 @@ func TestEventingRepo_ChangePupilResources(t *testing.T) {
 			}
 		})
 	}
+	t.Run("missing resources keep their amounts", func(t *testing.T) {
+		err := r.ChangePupilResources(ctx, newResourceEntry(t, eventID, pupilID, eventsvc.ResourceMap{
+			eventsvc.Plastic: 4,
+		}), 0, mock.NewMessage(t, broker.TopicPupilResourcesChanged))
+		if err != nil {
+			t.Fatalf("ChangePupilResources() error = %v", err)
+		}
+		want := eventsvc.ResourceMap{eventsvc.Gadgets: 0, eventsvc.Paper: 15, eventsvc.Plastic: 4}
+		if got := pupilResources(t, pupilID, eventID); !reflect.DeepEqual(got, want) {
+			t.Errorf("ChangePupilResources() resources = %v, want %v", got, want)
+		}
+	})
+	t.Run("version", func(t *testing.T) {
+		pupil, err := r.PupilByID(ctx, pupilID, eventID)
+		if err != nil {
+			t.Fatalf("PupilByID() error = %v", err)
+		}
+		version := pupil.ResourcesVersion
+		err = r.ChangePupilResources(ctx, newResourceEntry(t, eventID, pupilID, eventsvc.ResourceMap{
+			eventsvc.Plastic: 5,
+		}), version-1, mock.NewMessage(t, broker.TopicPupilResourcesChanged))
+		if !errors.Is(err, eventsvc.ErrVersionMismatch) {
+			t.Errorf("ChangePupilResources() of the previous version error = %v, want %v", err,
+				eventsvc.ErrVersionMismatch)
+		}
+		err = r.ChangePupilResources(ctx, newResourceEntry(t, eventID, pupilID, eventsvc.ResourceMap{
+			eventsvc.Plastic: 5,
+		}), version, mock.NewMessage(t, broker.TopicPupilResourcesChanged))
+		if err != nil {
+			t.Fatalf("ChangePupilResources() of the current version error = %v", err)
+		}
+		if pupil, err = r.PupilByID(ctx, pupilID, eventID); err != nil {
+			t.Fatalf("PupilByID() error = %v", err)
+		}
+		if pupil.ResourcesVersion != version+1 {
+			t.Errorf("ChangePupilResources() version = %v, want %v", pupil.ResourcesVersion, version+1)
+		}
+	})
+	t.Run("closed event", func(t *testing.T) {
+		closedID, deleteClosed := createEvent(t, &eventsvc.Event{
+			ID:               "closedevent",
+			Date:             time.Now().AddDate(0, 0, -5),
+			Name:             "closed event",
+			ResourcesAllowed: []eventsvc.Resource{eventsvc.Plastic, eventsvc.Paper},
+			Status:           eventsvc.Closed,
+		})
+		defer deleteClosed()
+		err := r.ChangePupilResources(ctx, newResourceEntry(t, closedID, pupilID, resources), 0,
+			mock.NewMessage(t, broker.TopicPupilResourcesChanged))
+		if !errors.Is(err, eventsvc.ErrEventNotOpen) {
+			t.Errorf("ChangePupilResources() error = %v, want %v", err, eventsvc.ErrEventNotOpen)
+		}
+	})
+	t.Run("resource not allowed", func(t *testing.T) {
+		// the allowed resources have been changed after the service has checked the resources
+		paperOnlyID, deletePaperOnly := createEvent(t, &eventsvc.Event{
+			ID:               "paperonlyevent",
+			Date:             time.Now().AddDate(0, 0, -5),
+			Name:             "paper only event",
+			ResourcesAllowed: []eventsvc.Resource{eventsvc.Paper},
+			Status:           eventsvc.Open,
+		})
+		defer deletePaperOnly()
+		err := r.ChangePupilResources(ctx, newResourceEntry(t, paperOnlyID, pupilID, resources), 0,
+			mock.NewMessage(t, broker.TopicPupilResourcesChanged))
+		if !errors.Is(err, eventing.ErrResourceNotAllowed) {
+			t.Errorf("ChangePupilResources() error = %v, want %v", err, eventing.ErrResourceNotAllowed)
+		}
+	})
 }
-func TestEventingRepo_DeleteEvent(t *testing.T) {
+
+func TestEventingRepo_AddPupilResources(t *testing.T) {
 	r := postgres.NewEventingRepo(db)
 	ctx := context.Background()
-	eventID := getEventID(t)
-	type args struct {
-		eventID string
-	}
+	pupilID, eventID := getPupilID(t), getEventID(t)
+	removePupilResources(t, pupilID, eventID)
+
 	tests := []struct {
-		name    string
-		args    args
-		wantErr bool
+		name      string
+		eventID   string
+		pupilID   string
+		resources eventsvc.ResourceMap
+		want      eventsvc.ResourceMap
+		wantErr   error
 	}{
 		{
-			name: "ok",
-			args: args{
-				eventID: eventID,
-			},
-			wantErr: false,
+			name: "insert resources", eventID: eventID, pupilID: pupilID,
+			resources: eventsvc.ResourceMap{eventsvc.Paper: 1.5},
+			want:      eventsvc.ResourceMap{eventsvc.Gadgets: 0, eventsvc.Paper: 1.5, eventsvc.Plastic: 0},
 		},
 		{
-			name: "ok2",
-			args: args{
-				eventID: eventID,
-			},
-			wantErr: false,
+			name: "add resources", eventID: eventID, pupilID: pupilID,
+			resources: eventsvc.ResourceMap{eventsvc.Paper: 2, eventsvc.Plastic: 3},
+			want:      eventsvc.ResourceMap{eventsvc.Gadgets: 0, eventsvc.Paper: 3.5, eventsvc.Plastic: 3},
+		},
+		{
+			name: "subtract resources", eventID: eventID, pupilID: pupilID,
+			resources: eventsvc.ResourceMap{eventsvc.Plastic: -1},
+			want:      eventsvc.ResourceMap{eventsvc.Gadgets: 0, eventsvc.Paper: 3.5, eventsvc.Plastic: 2},
+		},
+		{
+			name: "amounts become negative", eventID: eventID, pupilID: pupilID,
+			resources: eventsvc.ResourceMap{eventsvc.Plastic: -5},
+			want:      eventsvc.ResourceMap{eventsvc.Gadgets: 0, eventsvc.Paper: 3.5, eventsvc.Plastic: 2},
+			wantErr:   eventing.ErrNegativeResources,
+		},
+		{
+			name: "foreign key error", eventID: "some random id", pupilID: "some random id",
+			resources: eventsvc.ResourceMap{eventsvc.Plastic: 1},
+			wantErr:   eventing.ErrNoEventPupil,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := r.DeleteEvent(ctx, tt.args.eventID); (err != nil) != tt.wantErr {
-				t.Errorf("DeleteEvent() error = %v, wantErr %v", err, tt.wantErr)
+			err := r.AddPupilResources(ctx, newResourceEntry(t, tt.eventID, tt.pupilID, tt.resources),
+				mock.NewMessage(t, broker.TopicPupilResourcesAdded))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("AddPupilResources() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if tt.want == nil {
+				return
+			}
+			if got := pupilResources(t, tt.pupilID, tt.eventID); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AddPupilResources() resources = %v, want %v", got, tt.want)
 			}
 		})
 	}
+	t.Run("concurrent weigh-ins", func(t *testing.T) {
+		const n = 10
+		errs := make(chan error, n)
+		for i := 0; i < n; i++ {
+			go func() {
+				errs <- r.AddPupilResources(ctx, newResourceEntry(t, eventID, pupilID,
+					eventsvc.ResourceMap{eventsvc.Gadgets: 1}), mock.NewMessage(t, broker.TopicPupilResourcesAdded))
+			}()
+		}
+		for i := 0; i < n; i++ {
+			if err := <-errs; err != nil {
+				t.Fatalf("AddPupilResources() error = %v", err)
+			}
+		}
+		if got := pupilResources(t, pupilID, eventID)[eventsvc.Gadgets]; got != n {
+			t.Errorf("AddPupilResources() gadgets = %v, want %v", got, n)
+		}
+	})
+}
+
+func TestEventingRepo_ResourceEntries(t *testing.T) {
+	r := postgres.NewEventingRepo(db)
+	ctx := context.Background()
+	pupilID, eventID := getPupilID(t), getEventID(t)
+	removePupilResources(t, pupilID, eventID)
+
+	// the entries are created one by one, so that they are recorded at different times
+	added := make([]*eventing.ResourceEntry, 3)
+	for i, resources := range []eventsvc.ResourceMap{{eventsvc.Paper: 5}, {eventsvc.Plastic: 3},
+		{eventsvc.Plastic: -2}} {
+		added[i] = newResourceEntry(t, eventID, pupilID, resources)
+		if err := r.AddPupilResources(ctx, added[i], mock.NewMessage(t, broker.TopicPupilResourcesAdded)); err != nil {
+			t.Fatalf("prepare db: AddPupilResources error: %v", err)
+		}
+	}
+	paper, plastic, subtracted := added[0], added[1], added[2]
+	void := func(entry *eventing.ResourceEntry) error {
+		voided := *entry
+		voided.VoidedBy, voided.VoidedAt = "voider", time.Now().UTC()
+		return r.VoidResourceEntry(ctx, &voided, mock.NewMessage(t, broker.TopicResourceEntryVoided))
+	}
+
+	t.Run("entries", func(t *testing.T) {
+		entries, err := r.PupilResourceEntries(ctx, eventID, pupilID)
+		if err != nil {
+			t.Fatalf("PupilResourceEntries() error = %v", err)
+		}
+		if len(entries) != 3 || entries[0].ID != paper.ID || entries[2].ID != subtracted.ID {
+			t.Fatalf("PupilResourceEntries() = %v, want the entries in the order they were recorded", entries)
+		}
+		if !reflect.DeepEqual(entries[0].Resources, paper.Resources) || entries[0].RecordedBy != paper.RecordedBy {
+			t.Errorf("PupilResourceEntries() entry = %+v, want %+v", entries[0], paper)
+		}
+	})
+	t.Run("voiding makes resources negative", func(t *testing.T) {
+		if err := void(plastic); !errors.Is(err, eventing.ErrNegativeResources) {
+			t.Errorf("VoidResourceEntry() error = %v, want %v", err, eventing.ErrNegativeResources)
+		}
+	})
+	t.Run("void", func(t *testing.T) {
+		if err := void(subtracted); err != nil {
+			t.Fatalf("VoidResourceEntry() error = %v", err)
+		}
+		want := eventsvc.ResourceMap{eventsvc.Gadgets: 0, eventsvc.Paper: 5, eventsvc.Plastic: 3}
+		if got := pupilResources(t, pupilID, eventID); !reflect.DeepEqual(got, want) {
+			t.Errorf("VoidResourceEntry() resources = %v, want %v", got, want)
+		}
+		if err := void(subtracted); !errors.Is(err, eventing.ErrEntryVoided) {
+			t.Errorf("VoidResourceEntry() on the voided entry error = %v, want %v", err, eventing.ErrEntryVoided)
+		}
+		unknown := newResourceEntry(t, eventID, pupilID, nil)
+		if err := void(unknown); !errors.Is(err, eventing.ErrUnknownEntry) {
+			t.Errorf("VoidResourceEntry() on the unknown entry error = %v, want %v", err, eventing.ErrUnknownEntry)
+		}
+	})
+	t.Run("correct", func(t *testing.T) {
+		correction := newResourceEntry(t, eventID, pupilID, eventsvc.ResourceMap{eventsvc.Paper: 4.5})
+		correction.CorrectionOf = paper.ID
+		err := r.CorrectResourceEntry(ctx, correction, mock.NewMessage(t, broker.TopicResourceEntryCorrected))
+		if err != nil {
+			t.Fatalf("CorrectResourceEntry() error = %v", err)
+		}
+		want := eventsvc.ResourceMap{eventsvc.Gadgets: 0, eventsvc.Paper: 4.5, eventsvc.Plastic: 3}
+		if got := pupilResources(t, pupilID, eventID); !reflect.DeepEqual(got, want) {
+			t.Errorf("CorrectResourceEntry() resources = %v, want %v", got, want)
+		}
+		entries, err := r.PupilResourceEntries(ctx, eventID, pupilID)
+		if err != nil {
+			t.Fatalf("PupilResourceEntries() error = %v", err)
+		}
+		last := entries[len(entries)-1]
+		if last.ID != correction.ID || last.CorrectionOf != paper.ID || !entries[0].IsVoided() {
+			t.Errorf("CorrectResourceEntry() entries = %v, want the corrected entry voided", entries)
+		}
+		// the voided entry can't be corrected again
+		again := newResourceEntry(t, eventID, pupilID, eventsvc.ResourceMap{eventsvc.Paper: 1})
+		again.CorrectionOf = paper.ID
+		err = r.CorrectResourceEntry(ctx, again, mock.NewMessage(t, broker.TopicResourceEntryCorrected))
+		if !errors.Is(err, eventing.ErrEntryVoided) {
+			t.Errorf("CorrectResourceEntry() error = %v, want %v", err, eventing.ErrEntryVoided)
+		}
+	})
+}
+
+func TestEventingRepo_ChangeEventStatus(t *testing.T) {
+	r := postgres.NewEventingRepo(db)
+	ctx := context.Background()
+	eventID, deleteE := createEvent(t, &eventsvc.Event{
+		ID:               "statusevent",
+		Date:             time.Now().AddDate(0, 0, 5),
+		Name:             "status event",
+		ResourcesAllowed: []eventsvc.Resource{eventsvc.Gadgets},
+		Status:           eventsvc.Planned,
+	})
+	defer deleteE()
+
+	tests := []struct {
+		name    string
+		eventID string
+		from    eventsvc.EventStatus
+		to      eventsvc.EventStatus
+		wantErr error
+	}{
+		{name: "open", eventID: eventID, from: eventsvc.Planned, to: eventsvc.Open},
+		{name: "status has been changed", eventID: eventID, from: eventsvc.Planned, to: eventsvc.Open,
+			wantErr: eventsvc.ErrInvalidStatusTransition},
+		{name: "close", eventID: eventID, from: eventsvc.Open, to: eventsvc.Closed},
+		{name: "no event", eventID: "noeventid", from: eventsvc.Planned, to: eventsvc.Open,
+			wantErr: eventsvc.ErrUnknownEvent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := r.ChangeEventStatus(ctx, tt.eventID, tt.from, tt.to, mock.NewMessage(t, broker.TopicEventStatusChanged))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ChangeEventStatus() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+	event, err := r.EventByID(ctx, eventID)
+	if err != nil {
+		t.Fatalf("EventByID() error = %v", err)
+	}
+	if event.Status != eventsvc.Closed {
+		t.Errorf("EventByID() status = %v, want %v", event.Status, eventsvc.Closed)
+	}
+}
+func TestEventingRepo_DeleteEvent(t *testing.T) {
+	r := postgres.NewEventingRepo(db)
+	ctx := context.Background()
+	tests := []struct {
+		status  eventsvc.EventStatus
+		wantErr error
+	}{
+		{status: eventsvc.Planned, wantErr: nil},
+		{status: eventsvc.Open, wantErr: nil},
+		{status: eventsvc.Closed, wantErr: eventsvc.ErrEventFrozen},
+		{status: eventsvc.Archived, wantErr: eventsvc.ErrEventFrozen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			eventID, deleteEvent := createEvent(t, &eventsvc.Event{
+				ID:               "delete" + tt.status.String(),
+				Date:             time.Now().AddDate(0, 0, -5),
+				Name:             "delete event",
+				ResourcesAllowed: []eventsvc.Resource{eventsvc.Paper},
+				Status:           tt.status,
+			})
+			defer deleteEvent()
+			if err := r.DeleteEvent(ctx, eventID); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeleteEvent() error = %v, want %v", err, tt.wantErr)
+			}
+			_, err := r.EventByID(ctx, eventID)
+			if tt.wantErr == nil && !errors.Is(err, eventsvc.ErrUnknownEvent) {
+				t.Errorf("DeleteEvent() the event wasn't deleted, EventByID() error = %v", err)
+			}
+			if tt.wantErr != nil && err != nil {
+				t.Errorf("DeleteEvent() the frozen event was deleted, EventByID() error = %v", err)
+			}
+		})
+	}
+	t.Run("unknown event", func(t *testing.T) {
+		if err := r.DeleteEvent(ctx, "unknownevent"); err != nil {
+			t.Errorf("DeleteEvent() error = %v, want nil", err)
+		}
+	})
 }
 
 func TestEventingRepo_EventByID(t *testing.T) {
@@ -183,7 +466,8 @@ func TestEventingRepo_StoreEvent(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := r.StoreEvent(ctx, tt.args.event); (err != nil) != tt.wantErr {
+			err := r.StoreEvent(ctx, tt.args.event, mock.NewMessage(t, broker.TopicEventCreated))
+			if (err != nil) != tt.wantErr {
 				t.Errorf("StoreEvent() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -191,6 +475,123 @@ func TestEventingRepo_StoreEvent(t *testing.T) {
 	}
 }
 
+func TestEventingRepo_StoreEventUnknownResourceType(t *testing.T) {
+	r := postgres.NewEventingRepo(db)
+	// the resource type has been deleted from the catalogue after the service has checked the event
+	event := &eventsvc.Event{
+		ID:               "unknowntypeevent",
+		Date:             time.Now().AddDate(0, 0, 5),
+		Name:             "unknown type event",
+		ResourcesAllowed: []eventsvc.Resource{eventsvc.Paper, "deletedtype"},
+	}
+	err := r.StoreEvent(context.Background(), event, mock.NewMessage(t, broker.TopicEventCreated))
+	if !errors.Is(err, eventsvc.ErrUnknownResourceType) {
+		t.Errorf("StoreEvent() error = %v, want %v", err, eventsvc.ErrUnknownResourceType)
+	}
+}
+
+func TestEventingRepo_UpdateEvent(t *testing.T) {
+	r := postgres.NewEventingRepo(db)
+	ctx := context.Background()
+	event := &eventsvc.Event{
+		ID:               "updatedevent",
+		Date:             time.Now().AddDate(0, 1, 0),
+		Name:             "some name",
+		ResourcesAllowed: []eventsvc.Resource{eventsvc.Paper, eventsvc.Plastic},
+		Status:           eventsvc.Open,
+	}
+	eID, deleteE := createEvent(t, event)
+	defer deleteE()
+	class := eventsvc.Class{Letter: "u", DateFormed: time.Now().AddDate(-2, 0, 0)}
+	pID, deleteP := createPupil(t, &eventsvc.Pupil{ID: "updatedeventpupil", FirstName: "fn", LastName: "ln"}, class)
+	defer deleteP()
+	err := r.ChangePupilResources(ctx, newResourceEntry(t, eID, pID, eventsvc.ResourceMap{eventsvc.Paper: 2}), 0,
+		mock.NewMessage(t, broker.TopicPupilResourcesChanged))
+	if err != nil {
+		t.Fatalf("prepare db: ChangePupilResources error: %v", err)
+	}
+
+	t.Run("classes with resources", func(t *testing.T) {
+		classes, err := r.ClassesWithResources(ctx, eID)
+		if err != nil {
+			t.Fatalf("ClassesWithResources() error = %v", err)
+		}
+		if len(classes) != 1 || classes[0].Letter != class.Letter {
+			t.Errorf("ClassesWithResources() = %v, want the class of the pupil", classes)
+		}
+	})
+	t.Run("brought resource disallowed", func(t *testing.T) {
+		updated := *event
+		updated.ResourcesAllowed = []eventsvc.Resource{eventsvc.Plastic}
+		err := r.UpdateEvent(ctx, &updated, mock.NewMessage(t, broker.TopicEventUpdated))
+		var broughtErr *eventing.ResourcesBroughtError
+		if !errors.As(err, &broughtErr) {
+			t.Fatalf("UpdateEvent() error = %v, want *eventing.ResourcesBroughtError", err)
+		}
+		if len(broughtErr.Pupils) != 1 || broughtErr.Pupils[0].ID != pID ||
+			broughtErr.Pupils[0].ResourcesBrought[eventsvc.Paper] != 2 {
+			t.Errorf("UpdateEvent() pupils = %v, want the pupil who has brought paper", broughtErr.Pupils)
+		}
+	})
+	t.Run("ok", func(t *testing.T) {
+		updated := *event
+		updated.Name = "new name"
+		updated.ResourcesAllowed = []eventsvc.Resource{eventsvc.Paper}
+		if err := r.UpdateEvent(ctx, &updated, mock.NewMessage(t, broker.TopicEventUpdated)); err != nil {
+			t.Fatalf("UpdateEvent() error = %v", err)
+		}
+		got, err := r.EventByID(ctx, eID)
+		if err != nil {
+			t.Fatalf("EventByID() error = %v", err)
+		}
+		if got.Name != updated.Name || !reflect.DeepEqual(got.ResourcesAllowed, updated.ResourcesAllowed) {
+			t.Errorf("EventByID() = %v, want %v", got.Event, updated)
+		}
+	})
+	t.Run("version", func(t *testing.T) {
+		current, err := r.EventByID(ctx, eID)
+		if err != nil {
+			t.Fatalf("EventByID() error = %v", err)
+		}
+		updated := current.Event
+		updated.Name = "newer name"
+		updated.Version = current.Version - 1
+		err = r.UpdateEvent(ctx, &updated, mock.NewMessage(t, broker.TopicEventUpdated))
+		if !errors.Is(err, eventsvc.ErrVersionMismatch) {
+			t.Errorf("UpdateEvent() of the previous version error = %v, want %v", err, eventsvc.ErrVersionMismatch)
+		}
+		updated.Version = current.Version
+		if err := r.UpdateEvent(ctx, &updated, mock.NewMessage(t, broker.TopicEventUpdated)); err != nil {
+			t.Fatalf("UpdateEvent() of the current version error = %v", err)
+		}
+		got, err := r.EventByID(ctx, eID)
+		if err != nil {
+			t.Fatalf("EventByID() error = %v", err)
+		}
+		if got.Version != current.Version+1 {
+			t.Errorf("UpdateEvent() version = %v, want %v", got.Version, current.Version+1)
+		}
+	})
+	t.Run("no event", func(t *testing.T) {
+		updated := *event
+		updated.ID = "noeventid"
+		err := r.UpdateEvent(ctx, &updated, mock.NewMessage(t, broker.TopicEventUpdated))
+		if !errors.Is(err, eventsvc.ErrUnknownEvent) {
+			t.Errorf("UpdateEvent() error = %v, want %v", err, eventsvc.ErrUnknownEvent)
+		}
+	})
+	t.Run("closed event", func(t *testing.T) {
+		closed := &eventsvc.Event{ID: "closedupdatedevent", Date: time.Now().AddDate(0, 0, -1), Name: "closed",
+			ResourcesAllowed: []eventsvc.Resource{eventsvc.Paper}, Status: eventsvc.Closed}
+		_, deleteClosed := createEvent(t, closed)
+		defer deleteClosed()
+		err := r.UpdateEvent(ctx, closed, mock.NewMessage(t, broker.TopicEventUpdated))
+		if !errors.Is(err, eventsvc.ErrEventFrozen) {
+			t.Errorf("UpdateEvent() error = %v, want %v", err, eventsvc.ErrEventFrozen)
+		}
+	})
+}
+
 func TestEventingRepo_PupilByID(t *testing.T) {
 	r := postgres.NewEventingRepo(db)
 	ctx := context.Background()
@@ -309,7 +710,7 @@ func TestEventingRepo_EventPupils(t *testing.T) {
 				filters: eventing.EventPupilFilters{
 					NameAndClass: "",
 				},
-				sortBy: sorting.Plastic,
+				sortBy: sorting.ByResource(eventsvc.Plastic),
 				amount: 10,
 				skip:   0,
 			},
@@ -322,7 +723,7 @@ func TestEventingRepo_EventPupils(t *testing.T) {
 				filters: eventing.EventPupilFilters{
 					NameAndClass: "ro 7",
 				},
-				sortBy: sorting.Paper,
+				sortBy: sorting.ByResource(eventsvc.Paper),
 				amount: 50,
 				skip:   0,
 			},
@@ -335,7 +736,7 @@ func TestEventingRepo_EventPupils(t *testing.T) {
 				filters: eventing.EventPupilFilters{
 					NameAndClass: "ro&7",
 				},
-				sortBy: sorting.Paper,
+				sortBy: sorting.ByResource(eventsvc.Paper),
 				amount: 50,
 				skip:   0,
 			},
@@ -346,18 +747,35 @@ func TestEventingRepo_EventPupils(t *testing.T) {
 			args: args{
 				eventID: eID,
 				filters: eventing.EventPupilFilters{},
-				sortBy:  sorting.Plastic,
+				sortBy:  sorting.ByResource(eventsvc.Plastic),
 				amount:  150,
 				skip:    5000,
 			},
 			wantErr: false,
 		},
+		{
+			name: "restricted scope",
+			args: args{
+				eventID: eID,
+				filters: eventing.EventPupilFilters{
+					NameAndClass: "ro",
+					Scope: eventsvc.RestrictedScope(
+						eventsvc.Class{Letter: "b", DateFormed: time.Date(2015, 9, 1, 0, 0, 0, 0, time.UTC)},
+						eventsvc.Class{Letter: "a", DateFormed: time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC)},
+					),
+				},
+				sortBy: sorting.NameAsc,
+				amount: 50,
+				skip:   0,
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid event id",
 			args: args{
 				eventID: "wrongeventid",
 				filters: eventing.EventPupilFilters{},
-				sortBy:  sorting.Plastic,
+				sortBy:  sorting.ByResource(eventsvc.Plastic),
 				amount:  150,
 				skip:    0,
 			},
@@ -366,8 +784,8 @@ func TestEventingRepo_EventPupils(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, _, err := r.EventPupils(ctx, tt.args.eventID, tt.args.filters, tt.args.sortBy, tt.args.amount,
-				tt.args.skip)
+			_, _, _, err := r.EventPupils(ctx, tt.args.eventID, tt.args.filters, tt.args.sortBy,
+				paging.Page{Size: tt.args.amount, Skip: tt.args.skip})
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("EventPupils() error = %v, wantErr %v", err, tt.wantErr)
@@ -375,6 +793,65 @@ func TestEventingRepo_EventPupils(t *testing.T) {
 			}
 		})
 	}
+	t.Run("restricted scope without classes", func(t *testing.T) {
+		pupils, total, _, err := r.EventPupils(ctx, eID, eventing.EventPupilFilters{Scope: eventsvc.RestrictedScope()},
+			sorting.NameAsc, paging.Page{Size: 50})
+		if err != nil {
+			t.Fatalf("EventPupils() error = %v", err)
+		}
+		if total != 0 || len(pupils) != 0 {
+			t.Errorf("EventPupils() total = %d, pupils = %d, want no pupils", total, len(pupils))
+		}
+	})
+}
+
+func TestEventingRepo_StreamEventPupils(t *testing.T) {
+	r := postgres.NewEventingRepo(db)
+	ctx := context.Background()
+	eID := getEventID(t)
+	sortBy := sorting.ByResource(eventsvc.Plastic)
+
+	// the stream goes in the same order as the pages do
+	want, total, _, err := r.EventPupils(ctx, eID, eventing.EventPupilFilters{}, sortBy,
+		paging.Page{Size: eventing.MaxAmount})
+	if err != nil {
+		t.Fatalf("EventPupils() error = %v", err)
+	}
+	var got []*eventing.Pupil
+	err = r.StreamEventPupils(ctx, eID, eventing.EventPupilFilters{}, sortBy, func(p *eventing.Pupil) error {
+		got = append(got, p)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamEventPupils() error = %v", err)
+	}
+	if len(got) != total {
+		t.Fatalf("StreamEventPupils() len = %d, want %d", len(got), total)
+	}
+	for i := range want {
+		if !reflect.DeepEqual(got[i], want[i]) {
+			t.Fatalf("StreamEventPupils() [%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	t.Run("fn stops the stream", func(t *testing.T) {
+		errStop := errors.New("stop")
+		err := r.StreamEventPupils(ctx, eID, eventing.EventPupilFilters{}, sortBy, func(p *eventing.Pupil) error {
+			return errStop
+		})
+		if !errors.Is(err, errStop) {
+			t.Errorf("StreamEventPupils() error = %v, want the error of fn", err)
+		}
+	})
+	t.Run("unknown event", func(t *testing.T) {
+		err := r.StreamEventPupils(ctx, "wrongeventid", eventing.EventPupilFilters{}, sortBy,
+			func(p *eventing.Pupil) error {
+				return nil
+			})
+		if !errors.Is(err, eventsvc.ErrUnknownEvent) {
+			t.Errorf("StreamEventPupils() error = %v, want %v", err, eventsvc.ErrUnknownEvent)
+		}
+	})
 }
 
 func TestEventingRepo_EventClasses(t *testing.T) {
@@ -399,7 +876,7 @@ func TestEventingRepo_EventClasses(t *testing.T) {
 			args: args{
 				eventID: eID,
 				filters: eventing.EventClassFilters{},
-				sortBy:  sorting.Plastic,
+				sortBy:  sorting.ByResource(eventsvc.Plastic),
 				amount:  50,
 				skip:    0,
 			},
@@ -412,7 +889,7 @@ func TestEventingRepo_EventClasses(t *testing.T) {
 				filters: eventing.EventClassFilters{
 					Name: "3b",
 				},
-				sortBy: sorting.Plastic,
+				sortBy: sorting.ByResource(eventsvc.Plastic),
 				amount: 50,
 				skip:   0,
 			},
@@ -425,7 +902,7 @@ func TestEventingRepo_EventClasses(t *testing.T) {
 				filters: eventing.EventClassFilters{
 					Name: "3",
 				},
-				sortBy: sorting.Plastic,
+				sortBy: sorting.ByResource(eventsvc.Plastic),
 				amount: 50,
 				skip:   0,
 			},
@@ -436,7 +913,7 @@ func TestEventingRepo_EventClasses(t *testing.T) {
 			args: args{
 				eventID: eID,
 				filters: eventing.EventClassFilters{},
-				sortBy:  sorting.Plastic,
+				sortBy:  sorting.ByResource(eventsvc.Plastic),
 				amount:  50,
 				skip:    999,
 			},
@@ -449,7 +926,7 @@ func TestEventingRepo_EventClasses(t *testing.T) {
 				filters: eventing.EventClassFilters{
 					Name: "3b3",
 				},
-				sortBy: sorting.Plastic,
+				sortBy: sorting.ByResource(eventsvc.Plastic),
 				amount: 50,
 				skip:   0,
 			},
@@ -462,7 +939,7 @@ func TestEventingRepo_EventClasses(t *testing.T) {
 				filters: eventing.EventClassFilters{
 					Name: "",
 				},
-				sortBy: sorting.Plastic,
+				sortBy: sorting.ByResource(eventsvc.Plastic),
 				amount: 50,
 				skip:   0,
 			},
@@ -471,8 +948,8 @@ func TestEventingRepo_EventClasses(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, _, err := r.EventClasses(ctx, tt.args.eventID, tt.args.filters,
-				tt.args.sortBy, tt.args.amount, tt.args.skip)
+			_, _, _, err := r.EventClasses(ctx, tt.args.eventID, tt.args.filters,
+				tt.args.sortBy, paging.Page{Size: tt.args.amount, Skip: tt.args.skip})
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("EventClasses() error = %v, wantErr %v", err, tt.wantErr)
@@ -484,9 +961,12 @@ func TestEventingRepo_EventClasses(t *testing.T) {
 
 func createEvent(t *testing.T, event *eventsvc.Event) (string, func()) {
 	t.Helper()
-	q := "insert into event (id, name, date, resources_allowed)\n\tvalues ($1, $2, $3, $4::text[]::resource[]);"
+	q := `
+		insert into event (id, name, date, resources_allowed, status)
+		values ($1, $2, $3, $4, $5);
+	`
 	_, err := db.Exec(context.Background(), q, event.ID, event.Name, event.Date,
-		eventsvc.ResourceSliceToStringSlice(event.ResourcesAllowed))
+		eventsvc.ResourceSliceToStringSlice(event.ResourcesAllowed), event.Status.String())
 	if err != nil {
 		t.Fatalf("prepare db: %v", err)
 	}
@@ -536,7 +1016,9 @@ func getPupilID(t *testing.T) string {
 func getEventID(t *testing.T) string {
 	t.Helper()
 	var eventID string
-	if err := db.QueryRow(context.Background(), `select id from event`).Scan(&eventID); err != nil {
+	// the resources can be changed on the open events only
+	if err := db.QueryRow(context.Background(), `select id from event where status = 'open'`).Scan(
+		&eventID); err != nil {
 		t.Fatalf("prepare db: %v", err)
 	}
 	return eventID
@@ -544,8 +1026,36 @@ func getEventID(t *testing.T) string {
 
 func removePupilResources(t *testing.T, pupilID string, eventID string) {
 	t.Helper()
-	if _, err := db.Exec(context.Background(), `delete from resources where pupil_id = $1 and event_id = $2;`, pupilID,
-		eventID); err != nil {
+	if _, err := db.Exec(context.Background(), `delete from resource_entries where pupil_id = $1 and event_id = $2;`,
+		pupilID, eventID); err != nil {
 		t.Fatalf("prepare db: %v", err)
 	}
 }
+
+func newResourceEntry(t *testing.T, eventID, pupilID string, resources eventsvc.ResourceMap) *eventing.ResourceEntry {
+	t.Helper()
+	id, err := gonanoid.Nanoid()
+	if err != nil {
+		t.Fatalf("couldn't generate entry id: %v", err)
+	}
+	return &eventing.ResourceEntry{
+		ID:         id,
+		EventID:    eventID,
+		PupilID:    pupilID,
+		Resources:  resources,
+		RecordedBy: "recorder",
+		RecordedAt: time.Now().UTC(),
+	}
+}
+
+func pupilResources(t *testing.T, pupilID, eventID string) eventsvc.ResourceMap {
+	t.Helper()
+	var amounts eventsvc.ResourceMap
+	if err := db.QueryRow(context.Background(), `select amounts from resources where pupil_id = $1
+		and event_id = $2`, pupilID, eventID).Scan(&amounts); err != nil {
+		t.Fatalf("couldn't get the resources: %v", err)
+	}
+	// the resources which haven't been brought are 0
+	return eventsvc.ResourceMap{eventsvc.Gadgets: amounts[eventsvc.Gadgets], eventsvc.Paper: amounts[eventsvc.Paper],
+		eventsvc.Plastic: amounts[eventsvc.Plastic]}
+}
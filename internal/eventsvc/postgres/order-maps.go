@@ -1,45 +1,117 @@
 package postgres
 
-import "github.com/shanvl/garbage/internal/eventsvc/sorting"
-
-var classOrderMap = map[sorting.By]string{
-	sorting.NameAsc: "class_date_formed desc, class_letter asc",
-	sorting.NameDes: "class_date_formed asc, class_letter desc",
-	sorting.Gadgets: "gadgets desc",
-	sorting.Paper:   "paper desc",
-	sorting.Plastic: "plastic desc",
-}
-
-var eventOrderMap = map[sorting.By]string{
-	sorting.NameAsc: "name asc, date desc",
-	sorting.NameDes: "name desc, date desc",
-	sorting.DateAsc: "date, name",
-	sorting.DateDes: "date desc, name asc",
-	sorting.Gadgets: "gadgets desc, date desc",
-	sorting.Paper:   "paper desc, date desc",
-	sorting.Plastic: "plastic desc, date desc",
-}
-
-var pupilOrderMap = map[sorting.By]string{
-	sorting.NameAsc: "class_date_formed desc, class_letter asc, last_name asc, first_name asc",
-	sorting.NameDes: "class_date_formed asc, class_letter desc, last_name desc, first_name desc",
-	sorting.Gadgets: "gadgets desc",
-	sorting.Paper:   "paper desc",
-	sorting.Plastic: "plastic desc",
-}
-
-var pupilAggrOrderMap = map[sorting.By]string{
-	sorting.NameAsc: "class_date_formed desc, class_letter asc, last_name asc, first_name asc",
-	sorting.NameDes: "class_date_formed asc, class_letter desc, last_name desc, first_name desc",
-	sorting.Gadgets: "gadgets_aggr desc",
-	sorting.Paper:   "paper_aggr desc",
-	sorting.Plastic: "plastic_aggr desc",
-}
-
-var classAggrOrderMap = map[sorting.By]string{
-	sorting.NameAsc: "class_date_formed desc, class_letter asc",
-	sorting.NameDes: "class_date_formed asc, class_letter desc",
-	sorting.Gadgets: "gadgets_aggr desc",
-	sorting.Paper:   "paper_aggr desc",
-	sorting.Plastic: "plastic_aggr desc",
+import (
+	"fmt"
+
+	"github.com/shanvl/garbage/internal/eventsvc/sorting"
+	"github.com/shanvl/garbage/pkg/paging"
+)
+
+// ordering describes how the rows of a query can be sorted
+type ordering struct {
+	// "order by" parts of the sortings by names and dates
+	parts map[sorting.By]string
+	// the jsonb column holding the amounts of the resources, which the sortings by resources are made by
+	amounts string
+	// the columns holding the number of the pupils, the number of the pupils who brought anything and the median of
+	// the points they scored, which the sortings per pupil are made by
+	pupils, participants, median string
+	// the part which follows the sorting by the amounts of the resources, if any
+	then string
+	// the columns which identify a row. They follow the sorting when the rows are paged through
+	ids string
+}
+
+// orderBy returns the "order by" part of the query for the sorting
+func (o ordering) orderBy(sortBy sorting.By) string {
+	return paging.OrderBy(o.columns(sortBy))
+}
+
+// pageColumns returns the columns the pages of the rows are made by. They are the columns of the sorting followed by
+// the ones which identify a row, so that every row has a place of its own
+func (o ordering) pageColumns(sortBy sorting.By) []paging.Column {
+	return append(o.columns(sortBy), paging.ParseColumns(o.ids)...)
+}
+
+// columns returns the columns the rows are sorted by, each key of the sorting becoming a column or a few. The names of
+// the resources are valid, so they are safe to be put to the query as they are
+func (o ordering) columns(sortBy sorting.By) []paging.Column {
+	keys := sortBy.Keys()
+	var columns []paging.Column
+	for _, key := range keys {
+		columns = append(columns, o.keyColumns(key)...)
+	}
+	// the sortings by the amounts of the resources are followed by the "then" part, which makes them stable
+	if len(keys) > 0 && o.then != "" && !keys[len(keys)-1].IsName() && !keys[len(keys)-1].IsDate() {
+		columns = append(columns, paging.ParseColumns(o.then)...)
+	}
+	return columns
+}
+
+// keyColumns returns the columns the rows are sorted by for one key of the sorting
+func (o ordering) keyColumns(key sorting.By) []paging.Column {
+	points := fmt.Sprintf("resource_amounts_points(%s)", o.amounts)
+	switch {
+	case key.IsResources():
+		return []paging.Column{{Expr: fmt.Sprintf("coalesce((%s ->> '%s')::float4, 0)", o.amounts, key.Resource()),
+			Desc: true}}
+	case key.IsTotal():
+		return []paging.Column{{Expr: points, Desc: key == sorting.TotalDes}}
+	case key.IsPerPupil():
+		return []paging.Column{{Expr: fmt.Sprintf("%s / %s", points, o.pupils), Desc: key == sorting.PerPupilDes}}
+	case key.IsParticipation():
+		return []paging.Column{{Expr: fmt.Sprintf("%s::float4 / %s", o.participants, o.pupils),
+			Desc: key == sorting.ParticipationDes}}
+	case key.IsMedian():
+		return []paging.Column{{Expr: o.median, Desc: key == sorting.MedianDes}}
+	}
+	return paging.ParseColumns(o.parts[key])
+}
+
+var classOrdering = ordering{
+	parts: map[sorting.By]string{
+		sorting.NameAsc: "class_date_formed desc, class_letter asc",
+		sorting.NameDes: "class_date_formed asc, class_letter desc",
+	},
+	amounts:      "amounts",
+	pupils:       "pupils",
+	participants: "participants",
+	median:       "median_points",
+	ids:          "class_date_formed desc, class_letter",
+}
+
+var eventOrdering = ordering{
+	parts: map[sorting.By]string{
+		sorting.NameAsc: "name asc, date desc",
+		sorting.NameDes: "name desc, date desc",
+		sorting.DateAsc: "date, name",
+		sorting.DateDes: "date desc, name asc",
+	},
+	amounts: "amounts",
+	then:    "date desc",
+	ids:     "id",
+}
+
+var pupilOrdering = ordering{
+	parts: map[sorting.By]string{
+		sorting.NameAsc: "class_date_formed desc, class_letter asc, last_name asc, first_name asc",
+		sorting.NameDes: "class_date_formed asc, class_letter desc, last_name desc, first_name desc",
+	},
+	amounts: "amounts",
+	ids:     "id",
+}
+
+var pupilAggrOrdering = ordering{
+	parts:   pupilOrdering.parts,
+	amounts: "amounts_aggr",
+	ids:     "id",
+}
+
+var classAggrOrdering = ordering{
+	parts:        classOrdering.parts,
+	amounts:      "amounts_aggr",
+	pupils:       "class_pupils",
+	participants: "class_participants",
+	median:       "class_median_points",
+	ids:          "class_date_formed desc, class_letter",
 }
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/shanvl/garbage/pkg/broker"
+)
+
+// outbox stores the messages about the changes in the same transaction as the changes themselves, so that the
+// messages can't be lost if the process crashes before they are published
+type outbox struct {
+	db *pgxpool.Pool
+}
+
+// NewOutbox returns an outbox which the messages stored by the repos can be relayed from
+func NewOutbox(db *pgxpool.Pool) broker.Outbox {
+	return &outbox{db}
+}
+
+const outboxMessagesQuery = `
+	select id, topic, occurred_at, payload
+	from outbox
+	order by seq
+	limit $1 for update skip locked;
+`
+
+const deleteOutboxMessagesQuery = `
+	delete from outbox
+	where id = any ($1);
+`
+
+// Relay passes up to limit messages to the publish func in the order they were stored and removes them from the
+// outbox if the func returns no error. The rows are locked until then, so the relays can run concurrently
+func (o *outbox) Relay(ctx context.Context, limit int, publish func(ctx context.Context,
+	msgs []broker.Message) error) (int, error) {
+
+	tx, err := o.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, outboxMessagesQuery, limit)
+	if err != nil {
+		return 0, err
+	}
+	var (
+		msgs []broker.Message
+		ids  []string
+	)
+	for rows.Next() {
+		var msg broker.Message
+		if err := rows.Scan(&msg.ID, &msg.Topic, &msg.OccurredAt, &msg.Payload); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		msgs = append(msgs, msg)
+		ids = append(ids, msg.ID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if len(msgs) == 0 {
+		return 0, nil
+	}
+
+	if err := publish(ctx, msgs); err != nil {
+		return 0, err
+	}
+	// if the transaction fails from now on, the messages will be published again
+	if _, err := tx.Exec(ctx, deleteOutboxMessagesQuery, ids); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return len(msgs), nil
+}
+
+const storeOutboxMessagesQuery = `insert into outbox (id, topic, occurred_at, payload) values`
+
+// storeOutboxMessages writes the messages to the outbox within the transaction
+func storeOutboxMessages(ctx context.Context, tx pgx.Tx, msgs ...broker.Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	queryParams := make([]string, len(msgs))
+	queryValues := make([]interface{}, 0, len(msgs)*4)
+	for i, msg := range msgs {
+		n := i * 4
+		queryParams[i] = fmt.Sprintf("($%d, $%d, $%d, $%d)", n+1, n+2, n+3, n+4)
+		queryValues = append(queryValues, msg.ID, msg.Topic, msg.OccurredAt, string(msg.Payload))
+	}
+	q := fmt.Sprintf("%s %s;", storeOutboxMessagesQuery, strings.Join(queryParams, ","))
+	_, err := tx.Exec(ctx, q, queryValues...)
+	return err
+}
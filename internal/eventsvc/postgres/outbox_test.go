@@ -0,0 +1,77 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/internal/eventsvc/mock"
+	"github.com/shanvl/garbage/internal/eventsvc/postgres"
+	"github.com/shanvl/garbage/pkg/broker"
+)
+
+func TestOutbox_Relay(t *testing.T) {
+	o := postgres.NewOutbox(db)
+	r := postgres.NewEventingRepo(db)
+	ctx := context.Background()
+
+	event := &eventsvc.Event{
+		ID:               "outboxevent",
+		Date:             time.Now().AddDate(0, 0, 5),
+		Name:             "outbox event",
+		ResourcesAllowed: []eventsvc.Resource{eventsvc.Paper},
+	}
+	msg := mock.NewMessage(t, broker.TopicEventCreated)
+	if err := r.StoreEvent(ctx, event, msg); err != nil {
+		t.Fatalf("prepare db: StoreEvent error: %v", err)
+	}
+	defer deleteEvent(t, event.ID)
+
+	// the message must stay in the outbox if it couldn't be published
+	publishErr := errors.New("publish error")
+	_, err := o.Relay(ctx, 100, func(ctx context.Context, msgs []broker.Message) error {
+		return publishErr
+	})
+	if !errors.Is(err, publishErr) {
+		t.Errorf("Relay() error = %v, want %v", err, publishErr)
+	}
+	if !outboxContains(t, msg.ID) {
+		t.Fatalf("Relay() removed the message which hadn't been published")
+	}
+
+	// other tests may store messages concurrently, so the outbox is drained until the message is relayed
+	var relayed bool
+	for i := 0; i < 100 && !relayed; i++ {
+		n, err := o.Relay(ctx, 100, func(ctx context.Context, msgs []broker.Message) error {
+			for _, m := range msgs {
+				if m.ID == msg.ID {
+					relayed = m.Topic == msg.Topic
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Relay() error = %v", err)
+		}
+		if n == 0 {
+			break
+		}
+	}
+	if !relayed {
+		t.Errorf("Relay() didn't relay the message %v", msg)
+	}
+	if outboxContains(t, msg.ID) {
+		t.Errorf("Relay() didn't remove the published message from the outbox")
+	}
+}
+
+func outboxContains(t *testing.T, msgID string) bool {
+	t.Helper()
+	var n int
+	if err := db.QueryRow(context.Background(), "select count(*) from outbox where id = $1", msgID).Scan(&n); err != nil {
+		t.Fatalf("couldn't query the outbox: %v", err)
+	}
+	return n > 0
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/shanvl/garbage/internal/eventsvc/sorting"
+	"github.com/shanvl/garbage/pkg/paging"
+)
+
+// pageQuery holds the parts of the query which page through the sorted rows
+type pageQuery struct {
+	// cursor is the expression of the keys a row is sorted by, which the token of the next page is made of
+	cursor string
+	// after is the condition which leaves only the rows following the token of the page. args are its arguments
+	after string
+	args  []interface{}
+	// orderBy sorts the rows so that each of them has a place of its own
+	orderBy string
+	// total is the query returning the total number of the rows, which is 0 if the page skips the total
+	total string
+}
+
+// newPageQuery returns the parts of the query which page through the rows of the table sorted by the columns
+func newPageQuery(page paging.Page, sortBy sorting.By, columns []paging.Column, table string) (pageQuery, error) {
+	c, err := page.Cursor(string(sortBy))
+	if err != nil {
+		return pageQuery{}, err
+	}
+	after, args, err := paging.After(columns, c)
+	if err != nil {
+		return pageQuery{}, err
+	}
+	total := fmt.Sprintf("select count(*) from %s", table)
+	if page.SkipTotal {
+		total = "select 0"
+	}
+	return pageQuery{
+		cursor:  paging.Keys(columns),
+		after:   after,
+		args:    args,
+		orderBy: paging.OrderBy(columns),
+		total:   total,
+	}, nil
+}
@@ -12,6 +12,9 @@ import (
 // code which postgres returns on the violation of a foreign key
 const foreignKeyViolationCode = "23503"
 
+// code which postgres returns on the violation of a unique constraint
+const uniqueViolationCode = "23505"
+
 // Config allows to configure the db
 type Config struct {
 	Host, Database, User, Password string
@@ -11,6 +11,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/schooling"
+	"github.com/shanvl/garbage/pkg/broker"
 )
 
 type schoolingRepo struct {
@@ -22,7 +23,7 @@ func NewSchoolingRepo(db *pgxpool.Pool) schooling.Repository {
 }
 
 const pupilByIDQuery = `
-	select id, first_name, last_name, class_letter, class_date_formed
+	select id, first_name, last_name, class_letter, class_date_formed, version
 	from pupil
 	where pupil.id = $1;
 `
@@ -31,7 +32,7 @@ const pupilByIDQuery = `
 func (s *schoolingRepo) PupilByID(ctx context.Context, pupilID string) (*schooling.Pupil, error) {
 	p := &schooling.Pupil{}
 	err := s.db.QueryRow(ctx, pupilByIDQuery, pupilID).Scan(&p.ID, &p.FirstName, &p.LastName, &p.Class.Letter,
-		&p.Class.DateFormed)
+		&p.Class.DateFormed, &p.Version)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			err = eventsvc.ErrUnknownPupil
@@ -62,8 +63,8 @@ func (s *schoolingRepo) RemovePupils(ctx context.Context, pupilIDs []string) err
 
 const storePupilsQuery = `insert into pupil (id, first_name, last_name, class_letter, class_date_formed) values`
 
-// saves the given pupils
-func (s *schoolingRepo) StorePupils(ctx context.Context, pupils []*schooling.Pupil) error {
+// saves the given pupils. The message about the change is written to the outbox in the same transaction
+func (s *schoolingRepo) StorePupils(ctx context.Context, pupils []*schooling.Pupil, msg broker.Message) error {
 	pupilsLen := len(pupils)
 	// params placeholders to pass to the query
 	queryParams := make([]string, pupilsLen)
@@ -83,28 +84,46 @@ func (s *schoolingRepo) StorePupils(ctx context.Context, pupils []*schooling.Pup
 	}
 	// create and execute the query
 	q := fmt.Sprintf("%s %s;", storePupilsQuery, strings.Join(queryParams, ","))
-	_, err := s.db.Exec(ctx, q, queryValues...)
-	return err
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	if _, err := tx.Exec(ctx, q, queryValues...); err != nil {
+		return err
+	}
+	if err := storeOutboxMessages(ctx, tx, msg); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
 const updatePupilQuery = `
-	update pupil 
-	set (first_name, last_name, class_letter, class_date_formed) = ($2, $3, $4, $5)
+	update pupil
+	set (first_name, last_name, class_letter, class_date_formed, version) = ($2, $3, $4, $5, version + 1)
 	where id = $1
-	returning id;
+	  and ($6 = 0 or version = $6);
 `
 
-// updates the given pupil
+// updates the given pupil. If the pupil's version isn't 0, the pupil is updated only if it's still of that version
 func (s *schoolingRepo) UpdatePupil(ctx context.Context, pupil *schooling.Pupil) error {
-	var id string
-	err := s.db.QueryRow(ctx, updatePupilQuery, pupil.ID, pupil.FirstName, pupil.LastName, pupil.Class.Letter,
-		pupil.Class.DateFormed).Scan(&id)
+	res, err := s.db.Exec(ctx, updatePupilQuery, pupil.ID, pupil.FirstName, pupil.LastName, pupil.Class.Letter,
+		pupil.Class.DateFormed, pupil.Version)
 	if err != nil {
 		return err
 	}
-	// no pupil has been found
-	if id == "" {
+	if res.RowsAffected() > 0 {
+		return nil
+	}
+	// either there's no such pupil or it has been changed in the meantime
+	var exists bool
+	if err := s.db.QueryRow(ctx, `select exists(select 1 from pupil where id = $1)`, pupil.ID).Scan(
+		&exists); err != nil {
+		return err
+	}
+	if !exists {
 		return eventsvc.ErrUnknownPupil
 	}
-	return nil
+	return eventsvc.ErrVersionMismatch
 }
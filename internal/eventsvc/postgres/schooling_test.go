@@ -7,8 +7,10 @@ import (
 	"time"
 
 	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/internal/eventsvc/mock"
 	"github.com/shanvl/garbage/internal/eventsvc/postgres"
 	"github.com/shanvl/garbage/internal/eventsvc/schooling"
+	"github.com/shanvl/garbage/pkg/broker"
 )
 
 func TestSchoolingRepo_UpdatePupil(t *testing.T) {
@@ -40,6 +42,24 @@ func TestSchoolingRepo_UpdatePupil(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "pupil has been changed in the meantime",
+			args: args{
+				pupil: &schooling.Pupil{
+					Pupil: eventsvc.Pupil{
+						ID:        pupilID,
+						FirstName: "diffFName",
+						LastName:  "diffLName",
+						Version:   1000,
+					},
+					Class: eventsvc.Class{
+						Letter:     "A",
+						DateFormed: classDateFromYear(2015),
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "no pupil found",
 			args: args{
@@ -157,7 +177,7 @@ func TestSchoolingRepo_StorePupils(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := r.StorePupils(ctx, tt.pupils)
+			err := r.StorePupils(ctx, tt.pupils, mock.NewMessage(t, broker.TopicPupilsAdded))
 			if (err != nil) != tt.wantErr {
 				t.Errorf("StorePupils() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -209,6 +229,7 @@ func seedPupils(t *testing.T) ([]*schooling.Pupil, func()) {
 				ID:        "111",
 				FirstName: "fn1",
 				LastName:  "ln1",
+				Version:   1,
 			},
 			Class: eventsvc.Class{
 				Letter:     "a",
@@ -220,6 +241,7 @@ func seedPupils(t *testing.T) ([]*schooling.Pupil, func()) {
 				ID:        "222",
 				FirstName: "fn2",
 				LastName:  "ln2",
+				Version:   1,
 			},
 			Class: eventsvc.Class{
 				Letter:     "b",
@@ -231,6 +253,7 @@ func seedPupils(t *testing.T) ([]*schooling.Pupil, func()) {
 				ID:        "333",
 				FirstName: "fn3",
 				LastName:  "ln3",
+				Version:   1,
 			},
 			Class: eventsvc.Class{
 				Letter:     "c",
@@ -0,0 +1,24 @@
+package postgres
+
+import (
+	"github.com/shanvl/garbage/internal/eventsvc"
+)
+
+// scopeCondition returns the conditional "where" part of the query, which leaves only the pupils in the scope, along
+// with its arguments. The classes are matched by their letters and the years they were formed in.
+// The condition is empty if the scope isn't restricted. The pupils table must be aliased as "p"
+func scopeCondition(scope eventsvc.Scope) (string, []interface{}) {
+	if !scope.IsRestricted() {
+		return "", nil
+	}
+	classes := scope.Classes()
+	letters, years := make([]string, 0, len(classes)), make([]int32, 0, len(classes))
+	for _, c := range classes {
+		letters = append(letters, c.Letter)
+		years = append(years, int32(c.DateFormed.Year()))
+	}
+	pupilIDs := append(make([]string, 0, len(scope.PupilIDs())), scope.PupilIDs()...)
+	cond := ` and ((p.class_letter::text, extract(year from p.class_date_formed)::integer) in (
+			select * from unnest(?::text[], ?::integer[])) or p.id = any(?::text[]))`
+	return cond, []interface{}{letters, years, pupilIDs}
+}
@@ -0,0 +1,277 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/jmoiron/sqlx"
+	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/internal/eventsvc/scoring"
+)
+
+// scoringRepo is a repository used by Scoring service
+type scoringRepo struct {
+	db *pgxpool.Pool
+}
+
+// NewScoringRepo returns an instance of scoringRepo
+func NewScoringRepo(db *pgxpool.Pool) scoring.Repository {
+	return &scoringRepo{db}
+}
+
+// ChangeEventMultiplier changes the multiplier of the event which isn't frozen. The row of the event is locked until
+// the transaction ends, so that its status and version can't be changed while they're being checked
+func (s *scoringRepo) ChangeEventMultiplier(ctx context.Context, eventID string, multiplier float32,
+	version int) error {
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		statusStr      string
+		currentVersion int
+	)
+	err = tx.QueryRow(ctx, `select status::text, version from event where id = $1 for update`, eventID).Scan(
+		&statusStr, &currentVersion)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return eventsvc.ErrUnknownEvent
+		}
+		return err
+	}
+	if err := eventsvc.CheckVersion(version, currentVersion); err != nil {
+		return err
+	}
+	status, err := eventsvc.StringToEventStatus(statusStr)
+	if err != nil {
+		return err
+	}
+	if status.IsFrozen() {
+		return fmt.Errorf("%w: the event is %s", eventsvc.ErrEventFrozen, status)
+	}
+	_, err = tx.Exec(ctx, `update event set multiplier = $2, version = version + 1 where id = $1`, eventID,
+		multiplier)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// pupilScoresQuery is the first part of the leaderboard queries. It sums the points scored by the pupils in the
+// period and in the previous one, which ends the day before the period starts. A resource brought scores the points
+// of its type per unit, multiplied by the multiplier of the event
+const pupilScoresQuery = `
+	with pupil_scores as (
+		select r.pupil_id,
+			   e.date >= ?                                     as in_period,
+			   sum(a.amount::float4 * t.points * e.multiplier) as score
+		from resources r
+				 inner join event e on e.id = r.event_id
+				 cross join lateral jsonb_each_text(r.amounts) as a(name, amount)
+				 inner join resource_types t on t.name = a.name
+		where e.date between ? and ?
+		group by r.pupil_id, in_period
+	),
+`
+
+// the equal scores share the rank, which is compared with the rank in the previous period
+const classLeaderboardQuery = pupilScoresQuery + `
+		 class_scores as (
+			 select class_date_formed,
+					class_letter,
+					in_period,
+					sum(score)::float4 as score
+			 from pupil_scores s
+					  inner join pupil p on p.id = s.pupil_id
+			 group by class_date_formed, class_letter, in_period
+		 ),
+		 ranks as (
+			 select *,
+					rank() over (partition by in_period order by score desc) as rank
+			 from class_scores
+		 ),
+		 leaderboard as (
+			 select r.class_date_formed,
+					r.class_letter,
+					r.score,
+					r.rank,
+					coalesce(prev.rank, 0) as previous_rank
+			 from ranks r
+					  left join ranks prev on prev.class_date_formed = r.class_date_formed and
+											  prev.class_letter = r.class_letter and not prev.in_period
+			 where r.in_period
+		 ),
+		 pagination as (
+			 select *
+			 from leaderboard
+			 order by rank, class_date_formed desc, class_letter
+			 limit ? offset ?
+		 )
+	select class_date_formed,
+		   class_letter,
+		   score,
+		   rank,
+		   previous_rank,
+		   total
+	from pagination
+			 right join (select count(*) from leaderboard) as c(total) on true
+	order by rank, class_date_formed desc, class_letter;
+`
+
+// ClassLeaderboard returns the classes which scored in the period ranked by their scores
+func (s *scoringRepo) ClassLeaderboard(ctx context.Context, period, previous scoring.Period, amount,
+	skip int) (classes []*scoring.Class, total int, err error) {
+
+	q := sqlx.Rebind(sqlx.BindType("pgx"), classLeaderboardQuery)
+	rows, err := s.db.Query(ctx, q, period.From, previous.From, period.To, amount, skip)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	// "total" column will always be returned, so other columns might be null
+	var (
+		cDate        pgtype.Date
+		cLetter      pgtype.Varchar
+		score        pgtype.Float4
+		rank         pgtype.Int8
+		previousRank pgtype.Int8
+	)
+	for rows.Next() {
+		if err := rows.Scan(&cDate, &cLetter, &score, &rank, &previousRank, &total); err != nil {
+			return nil, 0, err
+		}
+		// next will happen if the offset >= total rows found or no classes have scored in the period
+		if cDate.Status != pgtype.Present {
+			return nil, total, nil
+		}
+		classes = append(classes, &scoring.Class{
+			Class: eventsvc.Class{
+				Letter:     cLetter.String,
+				DateFormed: cDate.Time,
+			},
+			Standing: scoring.Standing{
+				Score:        score.Float,
+				Rank:         int(rank.Int),
+				PreviousRank: int(previousRank.Int),
+			},
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return classes, total, nil
+}
+
+// the pupils out of the scope are ranked too, so that the ranks don't depend on the user
+const pupilLeaderboardQuery = pupilScoresQuery + `
+		 ranks as (
+			 select pupil_id,
+					in_period,
+					score::float4                                            as score,
+					rank() over (partition by in_period order by score desc) as rank
+			 from pupil_scores
+		 ),
+		 leaderboard as (
+			 select p.id,
+					p.first_name,
+					p.last_name,
+					p.class_date_formed,
+					p.class_letter,
+					r.score,
+					r.rank,
+					coalesce(prev.rank, 0) as previous_rank
+			 from ranks r
+					  inner join pupil p on p.id = r.pupil_id
+					  left join ranks prev on prev.pupil_id = r.pupil_id and not prev.in_period
+			 where r.in_period %s
+		 ),
+		 pagination as (
+			 select *
+			 from leaderboard
+			 order by rank, last_name, first_name, id
+			 limit ? offset ?
+		 )
+	select id,
+		   first_name,
+		   last_name,
+		   class_date_formed,
+		   class_letter,
+		   score,
+		   rank,
+		   previous_rank,
+		   total
+	from pagination
+			 right join (select count(*) from leaderboard) as c(total) on true
+	order by rank, last_name, first_name, id;
+`
+
+// PupilLeaderboard returns the pupils in the scope which scored in the period ranked by their scores
+func (s *scoringRepo) PupilLeaderboard(ctx context.Context, period, previous scoring.Period, scope eventsvc.Scope,
+	amount, skip int) (pupils []*scoring.Pupil, total int, err error) {
+
+	args := []interface{}{period.From, previous.From, period.To}
+	// if the user is restricted to some classes or pupils, leave only those pupils
+	scopeCond, scopeArgs := scopeCondition(scope)
+	args = append(args, scopeArgs...)
+	// add limit and offset to the query
+	args = append(args, amount, skip)
+	q := fmt.Sprintf(pupilLeaderboardQuery, scopeCond)
+	// change "?" to "$"
+	q = sqlx.Rebind(sqlx.BindType("pgx"), q)
+
+	rows, err := s.db.Query(ctx, q, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	// "total" column will always be returned, so other columns might be null
+	var (
+		id           pgtype.Varchar
+		firstName    pgtype.Varchar
+		lastName     pgtype.Varchar
+		cDate        pgtype.Date
+		cLetter      pgtype.Varchar
+		score        pgtype.Float4
+		rank         pgtype.Int8
+		previousRank pgtype.Int8
+	)
+	for rows.Next() {
+		if err := rows.Scan(&id, &firstName, &lastName, &cDate, &cLetter, &score, &rank, &previousRank,
+			&total); err != nil {
+			return nil, 0, err
+		}
+		// next will happen if the offset >= total rows found or no pupils have scored in the period
+		if id.Status != pgtype.Present {
+			return nil, total, nil
+		}
+		pupils = append(pupils, &scoring.Pupil{
+			Pupil: eventsvc.Pupil{
+				ID:        id.String,
+				FirstName: firstName.String,
+				LastName:  lastName.String,
+			},
+			Class: eventsvc.Class{
+				Letter:     cLetter.String,
+				DateFormed: cDate.Time,
+			},
+			Standing: scoring.Standing{
+				Score:        score.Float,
+				Rank:         int(rank.Int),
+				PreviousRank: int(previousRank.Int),
+			},
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return pupils, total, nil
+}
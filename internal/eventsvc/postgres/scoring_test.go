@@ -0,0 +1,209 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/internal/eventsvc/mock"
+	"github.com/shanvl/garbage/internal/eventsvc/postgres"
+	"github.com/shanvl/garbage/internal/eventsvc/scoring"
+	"github.com/shanvl/garbage/pkg/broker"
+)
+
+func TestScoringRepo_ChangeEventMultiplier(t *testing.T) {
+	r := postgres.NewScoringRepo(db)
+	ctx := context.Background()
+	eventID, deleteE := createEvent(t, &eventsvc.Event{ID: "multiplierevent", Date: newDate(2100, 3, 1),
+		Name: "multiplier", ResourcesAllowed: []eventsvc.Resource{eventsvc.Paper}, Status: eventsvc.Open})
+	defer deleteE()
+	closedEventID, deleteClosed := createEvent(t, &eventsvc.Event{ID: "multiplierclosed", Date: newDate(2100, 3, 1),
+		Name: "multiplier", ResourcesAllowed: []eventsvc.Resource{eventsvc.Paper}, Status: eventsvc.Closed})
+	defer deleteClosed()
+
+	tests := []struct {
+		name    string
+		eventID string
+		version int
+		wantErr error
+	}{
+		{name: "unknown event", eventID: "unknown", wantErr: eventsvc.ErrUnknownEvent},
+		{name: "closed event", eventID: closedEventID, wantErr: eventsvc.ErrEventFrozen},
+		{name: "version mismatch", eventID: eventID, version: 5, wantErr: eventsvc.ErrVersionMismatch},
+		{name: "version matches", eventID: eventID, version: 1},
+		{name: "no version", eventID: eventID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := r.ChangeEventMultiplier(ctx, tt.eventID, 2.5, tt.version)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ChangeEventMultiplier() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+	var (
+		multiplier float32
+		version    int
+	)
+	err := db.QueryRow(ctx, `select multiplier, version from event where id = $1`, eventID).Scan(&multiplier,
+		&version)
+	if err != nil {
+		t.Fatalf("couldn't get the event: %v", err)
+	}
+	if multiplier != 2.5 || version != 3 {
+		t.Errorf("ChangeEventMultiplier() multiplier, version = %v, %v, want 2.5, 3", multiplier, version)
+	}
+}
+
+func TestScoringRepo_Leaderboards(t *testing.T) {
+	r := postgres.NewScoringRepo(db)
+	eventingRepo := postgres.NewEventingRepo(db)
+	ctx := context.Background()
+
+	// a resource scoring 2 points per kilogram
+	scored := &eventsvc.ResourceType{Name: "scored", Unit: eventsvc.Kilograms, Points: 2}
+	if err := postgres.NewCataloguingRepo(db).StoreResourceType(ctx, scored); err != nil {
+		t.Fatalf("prepare db: %v", err)
+	}
+	defer db.Exec(ctx, `delete from resource_types where name = $1`, scored.Name.String())
+	// the events are far in the future, so that the other events don't get into the periods
+	prevEventID, deleteE := createEvent(t, &eventsvc.Event{ID: "scoringprev", Date: newDate(2100, 1, 10),
+		Name: "previous", ResourcesAllowed: []eventsvc.Resource{scored.Name}, Status: eventsvc.Open})
+	defer deleteE()
+	eventID, deleteE := createEvent(t, &eventsvc.Event{ID: "scoringcur", Date: newDate(2100, 2, 10),
+		Name: "current", ResourcesAllowed: []eventsvc.Resource{scored.Name}, Status: eventsvc.Open})
+	defer deleteE()
+	if err := r.ChangeEventMultiplier(ctx, eventID, 2, 0); err != nil {
+		t.Fatalf("prepare db: %v", err)
+	}
+	classA := eventsvc.Class{Letter: "a", DateFormed: newDate(2095, 9, 1)}
+	classB := eventsvc.Class{Letter: "b", DateFormed: newDate(2095, 9, 1)}
+	pupilA, deleteP := createPupil(t, &eventsvc.Pupil{ID: "scoringa", FirstName: "a", LastName: "a"}, classA)
+	defer deleteP()
+	pupilB, deleteP := createPupil(t, &eventsvc.Pupil{ID: "scoringb", FirstName: "b", LastName: "b"}, classB)
+	defer deleteP()
+	pupilC, deleteP := createPupil(t, &eventsvc.Pupil{ID: "scoringc", FirstName: "c", LastName: "c"}, classB)
+	defer deleteP()
+	// previous period: a scores 2, b scores 1
+	// current period, doubled by the multiplier: a scores 4, b scores 8, c scores 4
+	for _, e := range []struct {
+		eventID, pupilID string
+		amount           float32
+	}{
+		{prevEventID, pupilA, 1}, {prevEventID, pupilB, 0.5},
+		{eventID, pupilA, 1}, {eventID, pupilB, 2}, {eventID, pupilC, 1},
+	} {
+		entry := newResourceEntry(t, e.eventID, e.pupilID, eventsvc.ResourceMap{scored.Name: e.amount})
+		msg := mock.NewMessage(t, broker.TopicPupilResourcesAdded)
+		if err := eventingRepo.AddPupilResources(ctx, entry, msg); err != nil {
+			t.Fatalf("prepare db: %v", err)
+		}
+	}
+	period := scoring.Period{From: newDate(2100, 2, 1), To: newDate(2100, 2, 28)}
+
+	t.Run("classes", func(t *testing.T) {
+		classes, total, err := r.ClassLeaderboard(ctx, period, period.Previous(), 10, 0)
+		if err != nil {
+			t.Fatalf("ClassLeaderboard() error = %v", err)
+		}
+		want := []scoring.Standing{{Score: 12, Rank: 1, PreviousRank: 2}, {Score: 4, Rank: 2, PreviousRank: 1}}
+		if total != len(want) || len(classes) != len(want) {
+			t.Fatalf("ClassLeaderboard() total, len = %d, %d, want %d", total, len(classes), len(want))
+		}
+		for i, class := range classes {
+			if class.Standing != want[i] {
+				t.Errorf("ClassLeaderboard() %s standing = %+v, want %+v", class.Letter, class.Standing, want[i])
+			}
+		}
+		if classes[0].Letter != classB.Letter {
+			t.Errorf("ClassLeaderboard() first class = %s, want %s", classes[0].Letter, classB.Letter)
+		}
+	})
+	t.Run("pupils", func(t *testing.T) {
+		pupils, total, err := r.PupilLeaderboard(ctx, period, period.Previous(), eventsvc.Scope{}, 10, 0)
+		if err != nil {
+			t.Fatalf("PupilLeaderboard() error = %v", err)
+		}
+		// a and c share the second place
+		want := map[string]scoring.Standing{
+			pupilB: {Score: 8, Rank: 1, PreviousRank: 2},
+			pupilA: {Score: 4, Rank: 2, PreviousRank: 1},
+			pupilC: {Score: 4, Rank: 2},
+		}
+		if total != len(want) || len(pupils) != len(want) {
+			t.Fatalf("PupilLeaderboard() total, len = %d, %d, want %d", total, len(pupils), len(want))
+		}
+		for _, pupil := range pupils {
+			if pupil.Standing != want[pupil.ID] {
+				t.Errorf("PupilLeaderboard() %s standing = %+v, want %+v", pupil.ID, pupil.Standing, want[pupil.ID])
+			}
+		}
+	})
+	t.Run("pupils in scope", func(t *testing.T) {
+		scope := eventsvc.RestrictedScope(classA)
+		pupils, total, err := r.PupilLeaderboard(ctx, period, period.Previous(), scope, 10, 0)
+		if err != nil {
+			t.Fatalf("PupilLeaderboard() error = %v", err)
+		}
+		// the pupil is ranked among all the pupils
+		if total != 1 || len(pupils) != 1 || pupils[0].ID != pupilA || pupils[0].Rank != 2 {
+			t.Errorf("PupilLeaderboard() = %v, %d, want only %s of rank 2", pupils, total, pupilA)
+		}
+	})
+	t.Run("skip all", func(t *testing.T) {
+		classes, total, err := r.ClassLeaderboard(ctx, period, period.Previous(), 10, 10)
+		if err != nil || len(classes) != 0 || total != 2 {
+			t.Errorf("ClassLeaderboard() = %v, %d, %v, want no classes of 2", classes, total, err)
+		}
+	})
+}
+
+func TestScoringRepo_LeaderboardsSeededTypes(t *testing.T) {
+	r := postgres.NewScoringRepo(db)
+	eventingRepo := postgres.NewEventingRepo(db)
+	ctx := context.Background()
+
+	// the seeded resources are worth a point per unit, so the pupils are ranked by the raw amounts
+	eventID, deleteE := createEvent(t, &eventsvc.Event{ID: "scoringseeded", Date: newDate(2101, 2, 10),
+		Name: "seeded", ResourcesAllowed: []eventsvc.Resource{eventsvc.Gadgets, eventsvc.Paper, eventsvc.Plastic},
+		Status: eventsvc.Open})
+	defer deleteE()
+	class := eventsvc.Class{Letter: "a", DateFormed: newDate(2096, 9, 1)}
+	pupilA, deleteP := createPupil(t, &eventsvc.Pupil{ID: "seededa", FirstName: "a", LastName: "a"}, class)
+	defer deleteP()
+	pupilB, deleteP := createPupil(t, &eventsvc.Pupil{ID: "seededb", FirstName: "b", LastName: "b"}, class)
+	defer deleteP()
+	pupilC, deleteP := createPupil(t, &eventsvc.Pupil{ID: "seededc", FirstName: "c", LastName: "c"}, class)
+	defer deleteP()
+	for pupilID, resources := range map[string]eventsvc.ResourceMap{
+		pupilA: {eventsvc.Paper: 3},
+		pupilB: {eventsvc.Gadgets: 5},
+		pupilC: {eventsvc.Paper: 1, eventsvc.Plastic: 1},
+	} {
+		entry := newResourceEntry(t, eventID, pupilID, resources)
+		msg := mock.NewMessage(t, broker.TopicPupilResourcesAdded)
+		if err := eventingRepo.AddPupilResources(ctx, entry, msg); err != nil {
+			t.Fatalf("prepare db: %v", err)
+		}
+	}
+	period := scoring.Period{From: newDate(2101, 2, 1), To: newDate(2101, 2, 28)}
+
+	pupils, total, err := r.PupilLeaderboard(ctx, period, period.Previous(), eventsvc.Scope{}, 10, 0)
+	if err != nil {
+		t.Fatalf("PupilLeaderboard() error = %v", err)
+	}
+	want := []struct {
+		id    string
+		score float32
+	}{{pupilB, 5}, {pupilA, 3}, {pupilC, 2}}
+	if total != len(want) || len(pupils) != len(want) {
+		t.Fatalf("PupilLeaderboard() total, len = %d, %d, want %d", total, len(pupils), len(want))
+	}
+	for i, pupil := range pupils {
+		if pupil.ID != want[i].id || pupil.Score != want[i].score || pupil.Rank != i+1 {
+			t.Errorf("PupilLeaderboard() %d: %s of score %v and rank %d, want %s of score %v and rank %d", i,
+				pupil.ID, pupil.Score, pupil.Rank, want[i].id, want[i].score, i+1)
+		}
+	}
+}
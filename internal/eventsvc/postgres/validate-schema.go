@@ -9,12 +9,45 @@ import (
 // a query to create tables and indices if they don't already exist.
 // Can be extracted into a separate sql file and used in conjunction with migration tools
 const schema = `
--- create resource enum type
+-- create resource unit enum type
 do
 $$
     begin
-        if not exists(select 1 from pg_type where typname = 'resource') then
-            create type resource as enum ('paper', 'plastic', 'gadgets');
+        if not exists(select 1 from pg_type where typname = 'resource_unit') then
+            create type resource_unit as enum ('kilograms', 'pieces');
+        end if;
+    end
+$$;
+
+-- create resource_types table. It's the catalogue of the resources which can be allowed on the events. The new
+-- catalogue is seeded with the resources which were hard-coded before the catalogue was introduced, so that the
+-- resources of the existing events stay in it. They are worth a point per unit, so that they are ranked by the raw
+-- amounts as they were before the points were introduced. The seeded resources can be deleted later, so they aren't
+-- restored
+do
+$$
+    begin
+        if not exists(select 1 from pg_class where relname = 'resource_types' and relkind = 'r') then
+            create table resource_types
+            (
+                name   varchar(25)   primary key,
+                unit   resource_unit not null,
+                points float4        not null default 0 check (points >= 0)
+            );
+            insert into resource_types (name, unit, points)
+            values ('gadgets', 'pieces', 1),
+                   ('paper', 'kilograms', 1),
+                   ('plastic', 'kilograms', 1);
+        end if;
+    end
+$$;
+
+-- create event status enum type
+do
+$$
+    begin
+        if not exists(select 1 from pg_type where typname = 'event_status') then
+            create type event_status as enum ('planned', 'open', 'closed', 'archived');
         end if;
     end
 $$;
@@ -22,12 +55,44 @@ $$;
 -- create event table
 create table if not exists event
 (
-    id                varchar(25) primary key,
-    date              date        not null,
-    name              varchar(25) not null,
-    resources_allowed resource[]  not null check (cardinality(resources_allowed) >= 1)
+    id                varchar(25)   primary key,
+    date              date          not null,
+    name              varchar(25)   not null,
+    resources_allowed varchar(25)[] not null check (cardinality(resources_allowed) >= 1),
+    -- the events created before the statuses were introduced stay open
+    status            event_status  not null default 'open',
+    -- version is incremented on every change of the event, so that the event can be changed on condition it hasn't
+    -- been changed by someone else in the meantime
+    version           integer       not null default 1,
+    -- points scored for the resources brought to the event are multiplied by it
+    multiplier        float4        not null default 1 check (multiplier > 0)
 );
 
+-- add the status to the event table created before the statuses were introduced
+alter table event
+    add column if not exists status event_status not null default 'open';
+
+-- add the version to the event table created before the versions were introduced
+alter table event
+    add column if not exists version integer not null default 1;
+
+-- add the multiplier to the event table created before the scoring was introduced
+alter table event
+    add column if not exists multiplier float4 not null default 1 check (multiplier > 0);
+
+-- the resources allowed on the event table created before the catalogue was introduced are of the resource enum type
+do
+$$
+    begin
+        if exists(select 1 from pg_type where typname = 'resource') then
+            drop index if exists event_resources_allowed;
+            alter table event
+                alter column resources_allowed type varchar(25)[] using resources_allowed::varchar(25)[];
+            drop type resource;
+        end if;
+    end
+$$;
+
 create index if not exists event_resources_allowed on event using gin (resources_allowed);
 create index if not exists event_id_date_name_idx on event (id, date, name);
 create index if not exists event_id_name_date_idx on event (id, name, date);
@@ -46,21 +111,32 @@ create table if not exists pupil
                                                                           ' ' ||
                                                                           class_letter || ' ' ||
                                                                           extract(year from class_date_formed)::text))
-                          stored
+                          stored,
+    -- version is incremented on every change of the pupil
+    version           integer     not null default 1
 );
 
+-- add the version to the pupil table created before the versions were introduced
+alter table pupil
+    add column if not exists version integer not null default 1;
+
 create index if not exists pupil_text_search_idx on pupil using gin (text_search);
 create index if not exists pupil_class_name_idx on pupil (class_date_formed, class_letter, last_name, first_name);
 
--- create resources table
-create table if not exists resources
+-- create resource_entries table. It's the ledger of the weigh-ins, each entry holds the signed amounts added to the
+-- resources brought by a pupil, keyed by the names of the resources. A mistaken entry is voided rather than deleted,
+-- a corrected one is voided and replaced by the entry which points to it
+create table if not exists resource_entries
 (
-    pupil_id varchar(25) not null,
-    event_id varchar(25) not null,
-    paper    float4      not null default 0,
-    plastic  float4      not null default 0,
-    gadgets  float4      not null default 0,
-    primary key (pupil_id, event_id),
+    id            varchar(25) not null primary key,
+    pupil_id      varchar(25) not null,
+    event_id      varchar(25) not null,
+    amounts       jsonb       not null default '{}',
+    recorded_by   varchar(50) not null,
+    recorded_at   timestamptz not null,
+    correction_of varchar(25) references resource_entries (id),
+    voided_by     varchar(50),
+    voided_at     timestamptz,
     foreign key (event_id) references event (id)
         on delete cascade
         on update cascade,
@@ -69,9 +145,142 @@ create table if not exists resources
         on update cascade
 );
 
-create index if not exists resources_event_id_gadgets_idx on resources (event_id, gadgets desc nulls last);
-create index if not exists resources_event_id_paper_idx on resources (event_id, paper desc nulls last);
-create index if not exists resources_event_id_plastic_idx on resources (event_id, plastic desc nulls last);
+-- add the corrections to the ledger created before they were introduced
+alter table resource_entries
+    add column if not exists correction_of varchar(25) references resource_entries (id),
+    add column if not exists voided_by     varchar(50),
+    add column if not exists voided_at     timestamptz;
+
+create index if not exists resource_entries_event_id_pupil_id_recorded_at_idx on resource_entries (event_id,
+                                                                                                   pupil_id,
+                                                                                                   recorded_at);
+
+-- add the amounts to the ledger created before the catalogue was introduced
+alter table resource_entries
+    add column if not exists amounts jsonb not null default '{}';
+
+-- the totals of the resources view are summed from the amounts of the entries of the pupils of the event, so the index
+-- covers them. It replaces the per-resource indexes of the resources table, so it's created before the table is dropped
+create index if not exists resource_entries_event_id_pupil_id_idx on resource_entries (event_id, pupil_id)
+    include (amounts, voided_at);
+
+-- the resources table created before the totals were derived from the ledger is moved to the ledger as the opening
+-- entries, which hold the totals. The ledger is created along with the amounts in the same run, so it has no other
+-- entries yet
+do
+$$
+    begin
+        if exists(select 1 from pg_class where relname = 'resources' and relkind = 'r') then
+            insert into resource_entries (id, pupil_id, event_id, amounts, recorded_by, recorded_at)
+            select left(md5(pupil_id || event_id), 25),
+                   pupil_id,
+                   event_id,
+                   jsonb_strip_nulls(jsonb_build_object('paper', nullif(paper, 0), 'plastic', nullif(plastic, 0),
+                                                        'gadgets', nullif(gadgets, 0))),
+                   '',
+                   now()
+            from resources
+            where paper <> 0
+               or plastic <> 0
+               or gadgets <> 0;
+            drop table resources;
+        end if;
+    end
+$$;
+
+-- the amounts held by the columns of the ledger created before the catalogue was introduced are moved to the amounts
+do
+$$
+    begin
+        if exists(select 1
+                  from information_schema.columns
+                  where table_name = 'resource_entries'
+                    and column_name = 'paper') then
+            drop view if exists resources;
+            update resource_entries
+            set amounts = jsonb_strip_nulls(jsonb_build_object('paper', nullif(paper, 0), 'plastic', nullif(plastic, 0),
+                                                               'gadgets', nullif(gadgets, 0)));
+            alter table resource_entries
+                drop column paper,
+                drop column plastic,
+                drop column gadgets;
+        end if;
+    end
+$$;
+
+-- resource_amounts_add adds the amounts of the resources to each other
+create or replace function resource_amounts_add(a jsonb, b jsonb) returns jsonb
+    language sql
+    immutable
+as
+$$
+select coalesce(jsonb_object_agg(key, amount), '{}')
+from (select key, sum(value::float4) as amount
+      from (select *
+            from jsonb_each_text(coalesce(a, '{}'))
+            union all
+            select *
+            from jsonb_each_text(coalesce(b, '{}'))) kv
+      group by key) s;
+$$;
+
+-- resource_amounts_sum sums the amounts of the resources
+create or replace aggregate resource_amounts_sum(jsonb) (
+    sfunc = resource_amounts_add,
+    stype = jsonb,
+    initcond = '{}'
+    );
+
+-- resource_amounts_points returns the points scored for the amounts of the resources. Each resource is worth the points
+-- of its type, so the resources which aren't scored don't count
+create or replace function resource_amounts_points(amounts jsonb) returns float4
+    language sql
+    stable
+as
+$$
+select coalesce(sum(a.value::float4 * t.points), 0)::float4
+from jsonb_each_text(coalesce(amounts, '{}')) a
+         join resource_types t on t.name = a.key;
+$$;
+
+-- create resources view. It holds the totals of the resources brought by the pupils to the events. The version of the
+-- totals is 1 before anything has been brought and is incremented by every entry recorded or voided
+create or replace view resources as
+select pupil_id,
+       event_id,
+       resource_amounts_sum(amounts) filter (where voided_at is null) as amounts,
+       1 + count(*) + count(voided_at)                                as version
+from resource_entries
+group by pupil_id, event_id;
+
+-- create outbox table. Messages about the changes are written to it in the same transaction as the changes and are
+-- removed after they have been published
+create table if not exists outbox
+(
+    seq         bigserial primary key,
+    id          varchar(25) not null unique,
+    topic       varchar(50) not null,
+    occurred_at timestamptz not null,
+    payload     jsonb       not null
+);
+
+-- create audit entries table. An entry records a change made by a user. The entries are kept even if the user or the
+-- changed entities are deleted
+create table if not exists audit_entries
+(
+    id          varchar(25)   primary key,
+    actor_id    varchar(50)   not null,
+    client_id   varchar(50)   not null,
+    method      varchar(200)  not null,
+    target_ids  varchar(50)[] not null,
+    before      jsonb,
+    after       jsonb,
+    occurred_at timestamptz   not null
+);
+
+create index if not exists audit_entries_occurred_at_idx on audit_entries (occurred_at desc);
+create index if not exists audit_entries_actor_id_idx on audit_entries (actor_id);
+create index if not exists audit_entries_target_ids_idx on audit_entries using gin (target_ids);
 `
 
 // ValidateSchema creates tables and indices if they don't already exist
@@ -14,3 +14,20 @@ func TestValidateSchema(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateSchema_ResourcesIndex(t *testing.T) {
+	ctx := context.Background()
+	if err := postgres.ValidateSchema(ctx, db); err != nil {
+		t.Fatalf("ValidateSchema() error = %v", err)
+	}
+	// the totals of the resources view are summed using the index covering the amounts of the entries
+	var exists bool
+	err := db.QueryRow(ctx, `select exists(select 1 from pg_indexes where tablename = 'resource_entries'
+		and indexname = 'resource_entries_event_id_pupil_id_idx')`).Scan(&exists)
+	if err != nil {
+		t.Fatalf("couldn't find the index: %v", err)
+	}
+	if !exists {
+		t.Errorf("ValidateSchema() didn't create the index covering the amounts of the resource entries")
+	}
+}
@@ -11,4 +11,6 @@ type Pupil struct {
 	ID        string
 	FirstName string
 	LastName  string
+	// Version is incremented on every change of the pupil
+	Version int
 }
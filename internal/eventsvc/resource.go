@@ -3,32 +3,171 @@ package eventsvc
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 )
 
-// Resource is a concrete type of recyclables brought by pupils to events
-type Resource int
+// Resource is a concrete type of recyclables brought by pupils to events. It's the name of the resource type in the
+// catalogue of the resources
+type Resource string
 
+// The resources the catalogue is seeded with
 const (
-	Gadgets Resource = iota
-	Paper
-	Plastic
+	Gadgets Resource = "gadgets"
+	Paper   Resource = "paper"
+	Plastic Resource = "plastic"
 )
 
-var stringValues = [...]string{"gadgets", "paper", "plastic"}
-
 // String returns the string value of the resource
 func (r Resource) String() string {
-	if r < 0 || int(r) >= len(stringValues) {
+	return string(r)
+}
+
+// the names of the resources are lowercase words, which can be used as the keys of the maps as they are
+var resourceNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]{0,24}$`)
+
+// IsValid reports whether the resource's name can be used as the name of a resource type
+func (r Resource) IsValid() bool {
+	return resourceNameRegexp.MatchString(string(r))
+}
+
+var (
+	ErrUnknownResource = errors.New("unknown resource")
+	// ErrUnknownResourceType is used when the resource type isn't in the catalogue
+	ErrUnknownResourceType = errors.New("unknown resource type")
+	ErrUnknownUnit         = errors.New("unknown unit")
+	// ErrIncompatibleUnits is used when an amount is converted between a unit of mass and a countable unit
+	ErrIncompatibleUnits = errors.New("incompatible units")
+	// ErrResourceExists is used when a resource type with the same name is already in the catalogue
+	ErrResourceExists = errors.New("the resource already exists")
+	// ErrResourceInUse is used when a resource type which has been allowed on the events or brought to them is
+	// deleted or its unit is changed
+	ErrResourceInUse = errors.New("the resource is in use")
+)
+
+// Unit is a unit the amounts of a resource are measured in. The resource types are measured in the base units, the
+// amounts given in the other ones are converted to them
+type Unit int
+
+const (
+	Kilograms Unit = iota
+	Pieces
+	Grams
+	Pounds
+)
+
+var unitStringValues = [...]string{"kilograms", "pieces", "grams", "pounds"}
+
+// kilograms in one of each unit of mass
+var unitKilograms = map[Unit]float64{
+	Kilograms: 1,
+	Grams:     0.001,
+	Pounds:    0.45359237,
+}
+
+// String returns the string value of the unit
+func (u Unit) String() string {
+	if !u.IsValid() {
 		return "unknown"
 	}
-	return stringValues[r]
+	return unitStringValues[u]
+}
+
+// IsValid reports whether the unit is known
+func (u Unit) IsValid() bool {
+	return u >= 0 && int(u) < len(unitStringValues)
+}
+
+// IsBase reports whether the resource types can be measured in the unit
+func (u Unit) IsBase() bool {
+	return u == Kilograms || u == Pieces
+}
+
+// IsCountable reports whether the unit counts the things rather than measures their mass. Only the whole amounts can
+// be measured in it
+func (u Unit) IsCountable() bool {
+	return u == Pieces
+}
+
+// Convert converts the amount measured in the unit to the given unit
+func (u Unit) Convert(amount float32, to Unit) (float32, error) {
+	if u == to {
+		return amount, nil
+	}
+	from, ok := unitKilograms[u]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s to %s", ErrIncompatibleUnits, u, to)
+	}
+	kilograms, ok := unitKilograms[to]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s to %s", ErrIncompatibleUnits, u, to)
+	}
+	return float32(float64(amount) * from / kilograms), nil
+}
+
+// StringToUnit converts the string value of the unit to Unit
+func StringToUnit(s string) (Unit, error) {
+	for i, v := range unitStringValues {
+		if v == strings.ToLower(s) {
+			return Unit(i), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", ErrUnknownUnit, s)
 }
 
-// ResourceMap is a map of resources in kg
+// ResourceType is an entry of the catalogue of the resources which can be brought to the events
+type ResourceType struct {
+	Name Resource
+	// Unit the amounts of the resource are measured in
+	Unit Unit
+	// Points are given for a unit of the resource brought. 0 means the resource isn't scored
+	Points float32
+}
+
+// ResourceMap is a map of the amounts of resources, each of which is measured in the unit of its type
 type ResourceMap map[Resource]float32
 
-var ErrUnknownResource = errors.New("unknown resource")
+// ToStringMap converts the map to a map with the string values of the resources as keys
+func (m ResourceMap) ToStringMap() map[string]float32 {
+	sm := make(map[string]float32, len(m))
+	for r, amount := range m {
+		sm[r.String()] = amount
+	}
+	return sm
+}
+
+// IsZero reports whether all the amounts in the map are 0
+func (m ResourceMap) IsZero() bool {
+	for _, amount := range m {
+		if amount != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// UnitMap is a map of the units the amounts of resources are measured in
+type UnitMap map[Resource]Unit
+
+// NewUnitMap returns the units the resource types are measured in
+func NewUnitMap(resourceTypes []*ResourceType) UnitMap {
+	m := make(UnitMap, len(resourceTypes))
+	for _, resourceType := range resourceTypes {
+		m[resourceType.Name] = resourceType.Unit
+	}
+	return m
+}
+
+// Of returns the units of the resources in the resource map
+func (m UnitMap) Of(resources ResourceMap) UnitMap {
+	units := make(UnitMap, len(resources))
+	for res := range resources {
+		if unit, ok := m[res]; ok {
+			units[res] = unit
+		}
+	}
+	return units
+}
 
 // ResourceSliceToStringSlice converts a slice of resources to a slice of strings
 func ResourceSliceToStringSlice(rr []Resource) []string {
@@ -39,21 +178,16 @@ func ResourceSliceToStringSlice(rr []Resource) []string {
 	return ss
 }
 
-var stringToValue = map[string]Resource{
-	"gadgets": Gadgets,
-	"paper":   Paper,
-	"plastic": Plastic,
-}
-
-// StringSliceToResourceSlice converts a slice of strings to a slice of resources
+// StringSliceToResourceSlice converts a slice of strings to a slice of resources. It doesn't check that the resources
+// are in the catalogue, only that they can be
 func StringSliceToResourceSlice(ss []string) ([]Resource, error) {
 	rr := make([]Resource, len(ss))
 	for i, s := range ss {
-		value, ok := stringToValue[strings.ToLower(s)]
-		if !ok {
+		r := Resource(strings.ToLower(s))
+		if !r.IsValid() {
 			return nil, fmt.Errorf("%w: %s", ErrUnknownResource, ss[i])
 		}
-		rr[i] = value
+		rr[i] = r
 	}
 	return rr, nil
 }
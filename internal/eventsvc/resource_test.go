@@ -81,3 +81,140 @@ func TestResourceSliceToStringSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceMap_ToStringMap(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		resources ResourceMap
+		want      map[string]float32
+	}{
+		{
+			name:      "no values",
+			resources: ResourceMap{},
+			want:      map[string]float32{},
+		},
+		{
+			name:      "ok case",
+			resources: ResourceMap{Plastic: 1, Gadgets: 2.5},
+			want:      map[string]float32{"plastic": 1, "gadgets": 2.5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.resources.ToStringMap(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToStringMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResourceMap_IsZero(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		m    ResourceMap
+		want bool
+	}{
+		{name: "nil", m: nil, want: true},
+		{name: "zero amounts", m: ResourceMap{Paper: 0, Plastic: 0}, want: true},
+		{name: "negative amount", m: ResourceMap{Paper: 0, Plastic: -1}, want: false},
+		{name: "positive amount", m: ResourceMap{Gadgets: 2}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.IsZero(); got != tt.want {
+				t.Errorf("IsZero() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResource_IsValid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		r    Resource
+		want bool
+	}{
+		{name: "empty", r: "", want: false},
+		{name: "upper case", r: "Glass", want: false},
+		{name: "starts with a digit", r: "1glass", want: false},
+		{name: "quote", r: "glass'", want: false},
+		{name: "too long", r: "batteries_and_accumulators", want: false},
+		{name: "ok", r: "glass", want: true},
+		{name: "ok with underscore", r: "bottle_caps", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.IsValid(); got != tt.want {
+				t.Errorf("IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringToUnit(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		s       string
+		want    Unit
+		wantErr bool
+	}{
+		{name: "unknown", s: "liters", wantErr: true},
+		{name: "in upper case", s: "PIECES", want: Pieces},
+		{name: "ok", s: "kilograms", want: Kilograms},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToUnit(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StringToUnit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("StringToUnit() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnit_Convert(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		from    Unit
+		amount  float32
+		to      Unit
+		want    float32
+		wantErr bool
+	}{
+		{name: "same unit", from: Pieces, amount: 3, to: Pieces, want: 3},
+		{name: "grams to kilograms", from: Grams, amount: 1500, to: Kilograms, want: 1.5},
+		{name: "pounds to kilograms", from: Pounds, amount: 10, to: Kilograms, want: 4.5359235},
+		{name: "kilograms to pieces", from: Kilograms, amount: 1, to: Pieces, wantErr: true},
+		{name: "pieces to kilograms", from: Pieces, amount: 1, to: Kilograms, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.from.Convert(tt.amount, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Convert() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Convert() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnitMap_Of(t *testing.T) {
+	t.Parallel()
+	units := NewUnitMap([]*ResourceType{{Name: Gadgets, Unit: Pieces}, {Name: Paper, Unit: Kilograms}})
+	got := units.Of(ResourceMap{Paper: 1, "unknown": 2})
+	if len(got) != 1 || got[Paper] != Kilograms {
+		t.Errorf("Of() got = %v, want the unit of paper only", got)
+	}
+}
@@ -0,0 +1,24 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
+	"github.com/shanvl/garbage/pkg/etag"
+	"google.golang.org/protobuf/proto"
+)
+
+// etagResponseOption sets the ETag header of the responses holding a single versioned entity. The tag can then be
+// sent back in the If-Match header to change the entity on condition that it hasn't been changed in the meantime
+func etagResponseOption(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+	switch resp := resp.(type) {
+	case *eventsv1pb.FindEventByIDResponse:
+		etag.SetHeader(w, resp.GetEvent().GetVersion())
+	case *eventsv1pb.FindEventPupilByIDResponse:
+		etag.SetHeader(w, resp.GetPupil().GetResourcesVersion())
+	case *eventsv1pb.FindPupilByIDResponse:
+		etag.SetHeader(w, resp.GetPupil().GetVersion())
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package rest
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ndjsonContentType is the content type of the newline-delimited JSON
+const ndjsonContentType = "application/x-ndjson"
+
+// ndjsonMarshaler is the default marshaler of the gateway, which tells that the responses of the streaming rpcs are
+// newline-delimited JSON. The gateway writes each message of a stream on a line of its own, so the body of such a
+// response can be read line by line while the stream goes on
+type ndjsonMarshaler struct {
+	runtime.Marshaler
+}
+
+// newNDJSONMarshaler returns an instance of ndjsonMarshaler, which marshals the messages the same way the default
+// marshaler does
+func newNDJSONMarshaler() *ndjsonMarshaler {
+	return &ndjsonMarshaler{&runtime.HTTPBodyMarshaler{
+		Marshaler: &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		},
+	}}
+}
+
+// ContentType returns the content type of the newline-delimited JSON if v is a message of a stream
+func (m *ndjsonMarshaler) ContentType(v interface{}) string {
+	switch v.(type) {
+	case *eventsv1pb.StreamEventPupilsResponse, *eventsv1pb.StreamPupilsResponse:
+		return ndjsonContentType
+	}
+	return m.Marshaler.ContentType(v)
+}
+
+// Delimiter returns the separator of the messages of a stream
+func (m *ndjsonMarshaler) Delimiter() []byte {
+	return []byte("\n")
+}
@@ -29,9 +29,14 @@ func (s *Server) Run(port int, grpcAddress string) error {
 
 	// add custom error handler
 	customErrorsOption := runtime.WithErrorHandler(customHTTPError)
+	// set the ETag header of the versioned entities
+	etagOption := runtime.WithForwardResponseOption(etagResponseOption)
+
+	// the streaming rpcs respond with newline-delimited JSON
+	marshalerOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, newNDJSONMarshaler())
 
 	// create new mux
-	mux := runtime.NewServeMux(customErrorsOption)
+	mux := runtime.NewServeMux(customErrorsOption, etagOption, marshalerOption)
 
 	// no tls
 	dialOptions := []grpc.DialOption{grpc.WithInsecure()}
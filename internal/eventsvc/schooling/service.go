@@ -9,6 +9,7 @@ import (
 
 	gonanoid "github.com/matoous/go-nanoid"
 	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/pkg/broker"
 	"github.com/shanvl/garbage/pkg/valid"
 )
 
@@ -16,8 +17,9 @@ import (
 type Service interface {
 	// AddPupils adds the given pupils and returns ids of the added
 	AddPupils(ctx context.Context, pupilInfo []PupilBio) ([]string, error)
-	// ChangePupilClass changes the class of the pupil if such a class exists
-	ChangePupilClass(ctx context.Context, pupilID string, className string) error
+	// ChangePupilClass changes the class of the pupil if such a class exists. If the version isn't 0, the class is
+	// changed only if the pupil is still of that version
+	ChangePupilClass(ctx context.Context, pupilID string, className string, version int) error
 	// RemovePupils removes pupils with provided IDs
 	RemovePupils(ctx context.Context, pupilIDs []string) error
 }
@@ -25,7 +27,10 @@ type Service interface {
 type Repository interface {
 	PupilByID(ctx context.Context, pupilID string) (*Pupil, error)
 	RemovePupils(ctx context.Context, pupilIDs []string) error
-	StorePupils(ctx context.Context, pupils []*Pupil) error
+	// StorePupils stores the pupils and writes the message about them to the outbox atomically
+	StorePupils(ctx context.Context, pupils []*Pupil, msg broker.Message) error
+	// UpdatePupil updates the pupil and increments its version. If the pupil's version isn't 0, the pupil is updated
+	// only if it's still of that version, otherwise eventsvc.ErrVersionMismatch is returned
 	UpdatePupil(ctx context.Context, pupil *Pupil) error
 }
 
@@ -107,16 +112,22 @@ func (s *service) AddPupils(ctx context.Context, pupilsBio []PupilBio) ([]string
 		return nil, errVld
 	}
 
+	// create the message about the new pupils
+	msg, err := broker.NewMessage(broker.TopicPupilsAdded, broker.PupilsAdded{PupilIDs: pupilIDs})
+	if err != nil {
+		return nil, err
+	}
 	// save the pupils
-	err := s.repo.StorePupils(ctx, pupils)
+	err = s.repo.StorePupils(ctx, pupils, msg)
 	if err != nil {
 		return nil, err
 	}
 	return pupilIDs, nil
 }
 
-// ChangePupilClass changes the class of the pupil
-func (s *service) ChangePupilClass(ctx context.Context, pupilID string, className string) error {
+// ChangePupilClass changes the class of the pupil. The pupil is updated only if it hasn't been changed since it was
+// read, so that the concurrent changes aren't lost
+func (s *service) ChangePupilClass(ctx context.Context, pupilID string, className string, version int) error {
 
 	// validate args
 	errVld := valid.EmptyError()
@@ -135,6 +146,9 @@ func (s *service) ChangePupilClass(ctx context.Context, pupilID string, classNam
 	if err != nil {
 		return err
 	}
+	if err := eventsvc.CheckVersion(version, pupil.Version); err != nil {
+		return err
+	}
 	// parse className
 	class, err := eventsvc.ClassFromClassName(className, time.Now())
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/shanvl/garbage/internal/eventsvc"
 	"github.com/shanvl/garbage/internal/eventsvc/mock"
 	"github.com/shanvl/garbage/internal/eventsvc/schooling"
+	"github.com/shanvl/garbage/pkg/broker"
 )
 
 func Test_service_RemovePupils(t *testing.T) {
@@ -85,7 +86,10 @@ func Test_service_AddPupils(t *testing.T) {
 	ctx := context.Background()
 
 	var repo mock.SchoolingRepository
-	repo.StorePupilsFn = func(ctx context.Context, pupils []*schooling.Pupil) error {
+	repo.StorePupilsFn = func(ctx context.Context, pupils []*schooling.Pupil, msg broker.Message) error {
+		if msg.Topic != broker.TopicPupilsAdded {
+			t.Errorf("StorePupils() msg.Topic = %v, want %v", msg.Topic, broker.TopicPupilsAdded)
+		}
 		if len(pupils) > 0 && pupils[0].FirstName == "error" {
 			return errors.New("repo's error")
 		}
@@ -232,6 +236,7 @@ func Test_service_ChangePupilClass(t *testing.T) {
 				ID:        pupilID,
 				FirstName: "FN",
 				LastName:  "LN",
+				Version:   2,
 			},
 			Class: eventsvc.Class{
 				Letter:     "Y",
@@ -244,6 +249,7 @@ func Test_service_ChangePupilClass(t *testing.T) {
 	type args struct {
 		pupilID   string
 		className string
+		version   int
 	}
 	tests := []struct {
 		name    string
@@ -306,6 +312,15 @@ func Test_service_ChangePupilClass(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "pupil has been changed in the meantime",
+			args: args{
+				pupilID:   "pupilID",
+				className: "10Y",
+				version:   1,
+			},
+			wantErr: true,
+		},
 		{
 			name: "swap a pupil's class for the found one",
 			args: args{
@@ -314,10 +329,19 @@ func Test_service_ChangePupilClass(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "swap a pupil's class of the given version",
+			args: args{
+				pupilID:   "pupilID",
+				className: "10Y",
+				version:   2,
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := s.ChangePupilClass(ctx, tt.args.pupilID, tt.args.className)
+			err := s.ChangePupilClass(ctx, tt.args.pupilID, tt.args.className, tt.args.version)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ChangePupilClass() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -0,0 +1,94 @@
+package eventsvc
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrOutOfScope is used when the user isn't allowed to see or change the pupil
+var ErrOutOfScope = errors.New("the pupil is out of the user's scope")
+
+// Scope restricts the pupils the user can see and change. A restricted scope consists of the classes the user is
+// assigned to, e.g. the ones of a class teacher, or of the pupils the user is linked to, e.g. the children of a parent.
+// The zero value doesn't restrict anything
+type Scope struct {
+	restricted bool
+	classes    []Class
+	pupilIDs   []string
+}
+
+// RestrictedScope returns a scope consisting of the given classes only. Without classes it allows nothing
+func RestrictedScope(classes ...Class) Scope {
+	return Scope{restricted: true, classes: classes}
+}
+
+// PupilScope returns a scope consisting of the pupils with the given ids only. Without ids it allows nothing
+func PupilScope(pupilIDs ...string) Scope {
+	return Scope{restricted: true, pupilIDs: pupilIDs}
+}
+
+// IsRestricted tells whether the scope restricts the user to some classes
+func (s Scope) IsRestricted() bool {
+	return s.restricted
+}
+
+// Classes returns the classes of the restricted scope
+func (s Scope) Classes() []Class {
+	return s.classes
+}
+
+// PupilIDs returns the ids of the pupils of the restricted scope
+func (s Scope) PupilIDs() []string {
+	return s.pupilIDs
+}
+
+// Allows tells whether the pupils of the class are in the scope. The classes are compared by their letters and
+// the years they were formed in
+func (s Scope) Allows(c Class) bool {
+	if !s.restricted {
+		return true
+	}
+	for _, sc := range s.classes {
+		if sc.Letter == c.Letter && sc.DateFormed.Year() == c.DateFormed.Year() {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsPupil tells whether the pupil with the given id, who is in the class c, is in the scope
+func (s Scope) AllowsPupil(pupilID string, c Class) bool {
+	for _, id := range s.pupilIDs {
+		if id == pupilID {
+			return true
+		}
+	}
+	return s.Allows(c)
+}
+
+type scopeCtxKey struct{}
+
+// ContextWithScope returns a copy of ctx carrying the scope of the user who has made the request
+func ContextWithScope(ctx context.Context, scope Scope) context.Context {
+	return context.WithValue(ctx, scopeCtxKey{}, scope)
+}
+
+// ScopeFromContext returns the scope of the user who has made the request. If ctx doesn't carry one,
+// the scope isn't restricted
+func ScopeFromContext(ctx context.Context) Scope {
+	scope, _ := ctx.Value(scopeCtxKey{}).(Scope)
+	return scope
+}
+
+type userIDCtxKey struct{}
+
+// ContextWithUserID returns a copy of ctx carrying the id of the user who has made the request
+func ContextWithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDCtxKey{}, userID)
+}
+
+// UserIDFromContext returns the id of the user who has made the request. It's empty if ctx doesn't carry one
+func UserIDFromContext(ctx context.Context) string {
+	userID, _ := ctx.Value(userIDCtxKey{}).(string)
+	return userID
+}
@@ -0,0 +1,127 @@
+package eventsvc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestScope_Allows(t *testing.T) {
+	t.Parallel()
+	class := Class{Letter: "b", DateFormed: newDate(2018, 9, 1, 0)}
+	tests := []struct {
+		name  string
+		scope Scope
+		class Class
+		want  bool
+	}{
+		{
+			name:  "unrestricted",
+			scope: Scope{},
+			class: class,
+			want:  true,
+		},
+		{
+			name:  "restricted, no classes",
+			scope: RestrictedScope(),
+			class: class,
+			want:  false,
+		},
+		{
+			name:  "another letter",
+			scope: RestrictedScope(Class{Letter: "a", DateFormed: newDate(2018, 9, 1, 0)}),
+			class: class,
+			want:  false,
+		},
+		{
+			name:  "another year",
+			scope: RestrictedScope(Class{Letter: "b", DateFormed: newDate(2017, 9, 1, 0)}),
+			class: class,
+			want:  false,
+		},
+		{
+			name: "assigned class",
+			scope: RestrictedScope(Class{Letter: "a", DateFormed: newDate(2018, 9, 1, 0)},
+				Class{Letter: "b", DateFormed: newDate(2018, 9, 1, 0)}),
+			class: class,
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.scope.Allows(tt.class); got != tt.want {
+				t.Errorf("Allows() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScope_AllowsPupil(t *testing.T) {
+	t.Parallel()
+	class := Class{Letter: "b", DateFormed: newDate(2018, 9, 1, 0)}
+	tests := []struct {
+		name    string
+		scope   Scope
+		pupilID string
+		want    bool
+	}{
+		{
+			name:    "unrestricted",
+			scope:   Scope{},
+			pupilID: "1",
+			want:    true,
+		},
+		{
+			name:    "restricted, no pupils",
+			scope:   PupilScope(),
+			pupilID: "1",
+			want:    false,
+		},
+		{
+			name:    "another pupil",
+			scope:   PupilScope("2", "3"),
+			pupilID: "1",
+			want:    false,
+		},
+		{
+			name:    "linked pupil",
+			scope:   PupilScope("2", "1"),
+			pupilID: "1",
+			want:    true,
+		},
+		{
+			name:    "pupil of the assigned class",
+			scope:   RestrictedScope(class),
+			pupilID: "1",
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.scope.AllowsPupil(tt.pupilID, class); got != tt.want {
+				t.Errorf("AllowsPupil() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScopeFromContext(t *testing.T) {
+	t.Parallel()
+	if ScopeFromContext(context.Background()).IsRestricted() {
+		t.Errorf("ScopeFromContext() the scope of an empty ctx is restricted")
+	}
+	ctx := ContextWithScope(context.Background(), RestrictedScope())
+	if !ScopeFromContext(ctx).IsRestricted() {
+		t.Errorf("ScopeFromContext() the scope put into ctx has been lost")
+	}
+}
+
+func TestUserIDFromContext(t *testing.T) {
+	t.Parallel()
+	if id := UserIDFromContext(context.Background()); id != "" {
+		t.Errorf("UserIDFromContext() the user id of an empty ctx = %q, want an empty one", id)
+	}
+	ctx := ContextWithUserID(context.Background(), "user")
+	if id := UserIDFromContext(ctx); id != "user" {
+		t.Errorf("UserIDFromContext() = %q, want %q", id, "user")
+	}
+}
@@ -0,0 +1,190 @@
+// Package scoring is responsible for the points scored by the classes and the pupils for the resources they brought
+// to the events and for the leaderboards made of them
+package scoring
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/pkg/valid"
+)
+
+// Service is an interface providing methods to rank the classes and the pupils by the points they scored.
+// A resource brought scores the points of its type per unit, multiplied by the multiplier of the event
+type Service interface {
+	// ClassLeaderboard returns the classes ranked by the points they scored at the events of the period. Each class
+	// has its rank in the previous period of the same length as well
+	ClassLeaderboard(ctx context.Context, period Period, amount, skip int) (classes []*Class, total int, err error)
+	// PupilLeaderboard returns the pupils ranked by the points they scored at the events of the period. Each pupil
+	// has their rank in the previous period of the same length as well. The pupils are ranked among all the pupils, but
+	// only the ones in the scope of the user are returned
+	PupilLeaderboard(ctx context.Context, period Period, amount, skip int) (pupils []*Pupil, total int, err error)
+	// SetEventMultiplier sets the multiplier of the points scored at the event which isn't closed yet. If the version
+	// isn't 0, the multiplier is set only if the event is still of that version
+	SetEventMultiplier(ctx context.Context, eventID string, multiplier float32, version int) error
+}
+
+// Repository provides methods to work with the scores persistence
+type Repository interface {
+	// ClassLeaderboard returns the classes which scored in the period ranked by their scores, along with their ranks
+	// in the previous period and the total number of the classes ranked
+	ClassLeaderboard(ctx context.Context, period, previous Period, amount, skip int) (classes []*Class, total int,
+		err error)
+	// PupilLeaderboard returns the pupils which scored in the period ranked by their scores, along with their ranks
+	// in the previous period. Only the pupils in the scope are returned and counted in the total, but all of them
+	// are ranked
+	PupilLeaderboard(ctx context.Context, period, previous Period, scope eventsvc.Scope, amount,
+		skip int) (pupils []*Pupil, total int, err error)
+	// ChangeEventMultiplier changes the multiplier of the event and increments its version. If there's no such event,
+	// eventsvc.ErrUnknownEvent is returned. If the event is frozen, eventsvc.ErrEventFrozen is returned. If the
+	// version isn't 0 and differs from the one of the event, eventsvc.ErrVersionMismatch is returned
+	ChangeEventMultiplier(ctx context.Context, eventID string, multiplier float32, version int) error
+}
+
+type service struct {
+	repo Repository
+}
+
+const (
+	DefaultAmount = 50
+	DefaultSkip   = 0
+	MaxAmount     = 1000
+	// MaxMultiplier is the largest multiplier of the points scored at an event
+	MaxMultiplier = 10
+)
+
+// NewService returns an instance of Service with all its dependencies
+func NewService(repo Repository) Service {
+	return &service{repo}
+}
+
+// ClassLeaderboard returns the classes ranked by the points they scored at the events of the period
+func (s *service) ClassLeaderboard(ctx context.Context, period Period, amount, skip int) (classes []*Class,
+	total int, err error) {
+
+	period, err = validatePeriod(period)
+	if err != nil {
+		return nil, 0, err
+	}
+	// if provided values are incorrect, use default ones instead
+	amount, skip = validateAmountSkip(amount, skip)
+
+	return s.repo.ClassLeaderboard(ctx, period, period.Previous(), amount, skip)
+}
+
+// PupilLeaderboard returns the pupils in the scope of the user ranked by the points they scored at the events of
+// the period
+func (s *service) PupilLeaderboard(ctx context.Context, period Period, amount, skip int) (pupils []*Pupil,
+	total int, err error) {
+
+	period, err = validatePeriod(period)
+	if err != nil {
+		return nil, 0, err
+	}
+	// if provided values are incorrect, use default ones instead
+	amount, skip = validateAmountSkip(amount, skip)
+	// the scope always comes from the user, so that it can't be widened by the caller
+	scope := eventsvc.ScopeFromContext(ctx)
+
+	return s.repo.PupilLeaderboard(ctx, period, period.Previous(), scope, amount, skip)
+}
+
+// SetEventMultiplier sets the multiplier of the points scored at the event
+func (s *service) SetEventMultiplier(ctx context.Context, eventID string, multiplier float32, version int) error {
+	errVld := valid.EmptyError()
+	if len(eventID) == 0 {
+		errVld.Add("eventID", "eventID must be provided")
+	}
+	if multiplier <= 0 || multiplier > MaxMultiplier {
+		errVld.Add("multiplier", fmt.Sprintf("multiplier must be greater than 0 and not greater than %d",
+			MaxMultiplier))
+	}
+	if !errVld.IsEmpty() {
+		return errVld
+	}
+	return s.repo.ChangeEventMultiplier(ctx, eventID, multiplier, version)
+}
+
+// validatePeriod checks that both dates of the period are set and that the period doesn't end before it starts.
+// The dates are truncated to the days, since the events are stored with their dates only
+func validatePeriod(period Period) (Period, error) {
+	errVld := valid.EmptyError()
+	if period.From.IsZero() {
+		errVld.Add("from", "start of the period must be provided")
+	}
+	if period.To.IsZero() {
+		errVld.Add("to", "end of the period must be provided")
+	}
+	if !errVld.IsEmpty() {
+		return Period{}, errVld
+	}
+	period = Period{From: truncateToDay(period.From), To: truncateToDay(period.To)}
+	if period.To.Before(period.From) {
+		return Period{}, valid.NewError("to", "period can't end before it starts")
+	}
+	return period, nil
+}
+
+// ensures that amount and skip are valid
+func validateAmountSkip(a, s int) (int, int) {
+	if a <= 0 || a > MaxAmount {
+		a = DefaultAmount
+	}
+	if s < 0 {
+		s = DefaultSkip
+	}
+	return a, s
+}
+
+// truncateToDay returns the start of the day of the date in UTC
+func truncateToDay(date time.Time) time.Time {
+	year, month, day := date.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Period is a range of the dates of the events which are scored. Both dates are included
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+// Previous returns the period of the same length which ends the day before the period starts
+func (p Period) Previous() Period {
+	days := int(p.To.Sub(p.From).Hours()/24) + 1
+	return Period{From: p.From.AddDate(0, 0, -days), To: p.From.AddDate(0, 0, -1)}
+}
+
+// Standing is a place on the leaderboard
+type Standing struct {
+	// Score is the sum of the points scored at the events of the period
+	Score float32
+	// Rank is the place by the score, starting from 1. The equal scores share the rank and the next rank skips the
+	// shared places, e.g. 1, 2, 2, 4
+	Rank int
+	// PreviousRank is the rank in the previous period. It's 0 if nothing was scored in that period
+	PreviousRank int
+}
+
+// RankDelta returns the number of the places gained since the previous period, negative if the places were lost.
+// It's 0 if nothing was scored in the previous period
+func (s Standing) RankDelta() int {
+	if s.PreviousRank == 0 {
+		return 0
+	}
+	return s.PreviousRank - s.Rank
+}
+
+// Class is a model of the class, adapted for this use case
+type Class struct {
+	eventsvc.Class
+	Standing
+}
+
+// Pupil is a model of the pupil, adapted for this use case
+type Pupil struct {
+	eventsvc.Pupil
+	eventsvc.Class
+	Standing
+}
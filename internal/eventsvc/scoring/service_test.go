@@ -0,0 +1,217 @@
+package scoring_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shanvl/garbage/internal/eventsvc"
+	"github.com/shanvl/garbage/internal/eventsvc/mock"
+	"github.com/shanvl/garbage/internal/eventsvc/scoring"
+	"github.com/shanvl/garbage/pkg/valid"
+)
+
+func Test_service_ClassLeaderboard(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	classes := []*scoring.Class{{Standing: scoring.Standing{Score: 10, Rank: 1}}}
+
+	var repo mock.ScoringRepository
+	repo.ClassLeaderboardFn = func(ctx context.Context, period, previous scoring.Period, amount,
+		skip int) ([]*scoring.Class, int, error) {
+
+		if amount != scoring.DefaultAmount || skip != scoring.DefaultSkip {
+			t.Errorf("ClassLeaderboard() amount, skip = %d, %d, want the default ones", amount, skip)
+		}
+		if previous != period.Previous() {
+			t.Errorf("ClassLeaderboard() previous = %v, want %v", previous, period.Previous())
+		}
+		return classes, len(classes), nil
+	}
+	s := scoring.NewService(&repo)
+
+	tests := []struct {
+		name         string
+		period       scoring.Period
+		wantPeriod   scoring.Period
+		wantValidErr bool
+	}{
+		{
+			name:         "no from",
+			period:       scoring.Period{To: date(2020, 5, 31)},
+			wantValidErr: true,
+		},
+		{
+			name:         "no to",
+			period:       scoring.Period{From: date(2020, 5, 1)},
+			wantValidErr: true,
+		},
+		{
+			name:         "ends before it starts",
+			period:       scoring.Period{From: date(2020, 5, 31), To: date(2020, 5, 1)},
+			wantValidErr: true,
+		},
+		{
+			name:       "dates are truncated",
+			period:     scoring.Period{From: date(2020, 5, 1).Add(13 * time.Hour), To: date(2020, 5, 31).Add(time.Hour)},
+			wantPeriod: scoring.Period{From: date(2020, 5, 1), To: date(2020, 5, 31)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo.ClassLeaderboardInvoked = false
+			got, total, err := s.ClassLeaderboard(ctx, tt.period, 0, -1)
+			var validErr *valid.ErrValidation
+			if errors.As(err, &validErr) != tt.wantValidErr {
+				t.Errorf("ClassLeaderboard() error = %v, wantValidErr %v", err, tt.wantValidErr)
+				return
+			}
+			if tt.wantValidErr {
+				if repo.ClassLeaderboardInvoked {
+					t.Errorf("ClassLeaderboard() repo was invoked on the invalid period")
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, classes) || total != len(classes) {
+				t.Errorf("ClassLeaderboard() = %v, %d, want %v, %d", got, total, classes, len(classes))
+			}
+		})
+	}
+}
+
+func Test_service_PupilLeaderboard(t *testing.T) {
+	t.Parallel()
+	scope := eventsvc.RestrictedScope(eventsvc.Class{Letter: "b", DateFormed: date(2018, 9, 1)})
+	ctx := eventsvc.ContextWithScope(context.Background(), scope)
+	period := scoring.Period{From: date(2020, 5, 1), To: date(2020, 5, 31)}
+
+	var repo mock.ScoringRepository
+	repo.PupilLeaderboardFn = func(ctx context.Context, gotPeriod, previous scoring.Period, gotScope eventsvc.Scope,
+		amount, skip int) ([]*scoring.Pupil, int, error) {
+
+		if gotPeriod != period || previous != period.Previous() {
+			t.Errorf("PupilLeaderboard() periods = %v, %v, want %v, %v", gotPeriod, previous, period,
+				period.Previous())
+		}
+		if !reflect.DeepEqual(gotScope, scope) {
+			t.Errorf("PupilLeaderboard() scope = %v, want the scope from ctx", gotScope)
+		}
+		if amount != 10 || skip != 20 {
+			t.Errorf("PupilLeaderboard() amount, skip = %d, %d, want 10, 20", amount, skip)
+		}
+		return nil, 0, nil
+	}
+	s := scoring.NewService(&repo)
+
+	if _, _, err := s.PupilLeaderboard(ctx, period, 10, 20); err != nil {
+		t.Errorf("PupilLeaderboard() error = %v", err)
+	}
+	var validErr *valid.ErrValidation
+	if _, _, err := s.PupilLeaderboard(ctx, scoring.Period{}, 10, 20); !errors.As(err, &validErr) {
+		t.Errorf("PupilLeaderboard() error = %v, want a validation error", err)
+	}
+}
+
+func Test_service_SetEventMultiplier(t *testing.T) {
+	t.Parallel()
+	const (
+		eventID       = "event"
+		frozenEventID = "frozen"
+	)
+	ctx := context.Background()
+
+	var repo mock.ScoringRepository
+	repo.ChangeEventMultiplierFn = func(ctx context.Context, id string, multiplier float32, version int) error {
+		if id == frozenEventID {
+			return eventsvc.ErrEventFrozen
+		}
+		// the event is of version 3
+		return eventsvc.CheckVersion(version, 3)
+	}
+	s := scoring.NewService(&repo)
+
+	tests := []struct {
+		name         string
+		eventID      string
+		multiplier   float32
+		version      int
+		wantValidErr bool
+		wantErr      error
+	}{
+		{name: "no eventID", multiplier: 2, wantValidErr: true},
+		{name: "zero multiplier", eventID: eventID, wantValidErr: true},
+		{name: "negative multiplier", eventID: eventID, multiplier: -1, wantValidErr: true},
+		{name: "too large multiplier", eventID: eventID, multiplier: scoring.MaxMultiplier + 1, wantValidErr: true},
+		{name: "event is frozen", eventID: frozenEventID, multiplier: 2, wantErr: eventsvc.ErrEventFrozen},
+		{name: "version mismatch", eventID: eventID, multiplier: 2, version: 2, wantErr: eventsvc.ErrVersionMismatch},
+		{name: "fractional multiplier", eventID: eventID, multiplier: 1.5},
+		{name: "version matches", eventID: eventID, multiplier: 2, version: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.SetEventMultiplier(ctx, tt.eventID, tt.multiplier, tt.version)
+			var validErr *valid.ErrValidation
+			if errors.As(err, &validErr) != tt.wantValidErr {
+				t.Errorf("SetEventMultiplier() error = %v, wantValidErr %v", err, tt.wantValidErr)
+				return
+			}
+			if !tt.wantValidErr && !errors.Is(err, tt.wantErr) {
+				t.Errorf("SetEventMultiplier() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPeriod_Previous(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		period scoring.Period
+		want   scoring.Period
+	}{
+		{
+			name:   "one day",
+			period: scoring.Period{From: date(2020, 5, 1), To: date(2020, 5, 1)},
+			want:   scoring.Period{From: date(2020, 4, 30), To: date(2020, 4, 30)},
+		},
+		{
+			name:   "month",
+			period: scoring.Period{From: date(2020, 3, 1), To: date(2020, 3, 31)},
+			want:   scoring.Period{From: date(2020, 1, 30), To: date(2020, 2, 29)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.Previous(); got != tt.want {
+				t.Errorf("Previous() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStanding_RankDelta(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		standing scoring.Standing
+		want     int
+	}{
+		{name: "climbed", standing: scoring.Standing{Rank: 2, PreviousRank: 5}, want: 3},
+		{name: "fell", standing: scoring.Standing{Rank: 4, PreviousRank: 1}, want: -3},
+		{name: "kept the place", standing: scoring.Standing{Rank: 1, PreviousRank: 1}, want: 0},
+		{name: "no previous rank", standing: scoring.Standing{Rank: 3}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.standing.RankDelta(); got != tt.want {
+				t.Errorf("RankDelta() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -1,20 +1,102 @@
 // Package sorting specifies how various entities can be sorted
 package sorting
 
+import (
+	"strings"
+
+	"github.com/shanvl/garbage/internal/eventsvc"
+)
+
 // By is a type indicating how things can be sorted
-type By int
+type By string
 
 const (
-	DateAsc By = iota
-	DateDes
-	Gadgets
-	NameAsc
-	NameDes
-	Paper
-	Plastic
-	Unspecified
+	DateAsc     By = "date_asc"
+	DateDes     By = "date_desc"
+	NameAsc     By = "name_asc"
+	NameDes     By = "name_desc"
+	Unspecified By = ""
+	// TotalAsc and TotalDes sort by the points scored for all the resources brought
+	TotalAsc By = "total_asc"
+	TotalDes By = "total_desc"
+	// PerPupilAsc and PerPupilDes sort classes by the points scored for all the resources brought divided by the
+	// number of the pupils in the class
+	PerPupilAsc By = "per_pupil_asc"
+	PerPupilDes By = "per_pupil_desc"
+	// ParticipationAsc and ParticipationDes sort classes by the share of their pupils who brought anything
+	ParticipationAsc By = "participation_asc"
+	ParticipationDes By = "participation_desc"
+	// MedianAsc and MedianDes sort classes by the median of the points scored by their pupils
+	MedianAsc By = "median_asc"
+	MedianDes By = "median_desc"
 )
 
+// MaxKeys is the max number of the keys a sorting can consist of
+const MaxKeys = 3
+
+// the sortings by resources consist of the prefix and the name of the resource
+const resourcePrefix = "resource:"
+
+// the keys of a combined sorting are separated by it
+const keySeparator = ","
+
+// ByResource returns the sorting by the amount of the resource in descending order
+func ByResource(r eventsvc.Resource) By {
+	return By(resourcePrefix + r.String())
+}
+
+// Then returns the sorting which sorts by b and then sorts the things equal by b using the next sortings in turn.
+// Unspecified sortings are left out
+func (b By) Then(next ...By) By {
+	keys := make([]string, 0, len(next)+1)
+	for _, s := range append([]By{b}, next...) {
+		if s != Unspecified {
+			keys = append(keys, string(s))
+		}
+	}
+	return By(strings.Join(keys, keySeparator))
+}
+
+// Keys returns the sortings the sorting consists of in the order they are applied
+func (b By) Keys() []By {
+	if b == Unspecified {
+		return nil
+	}
+	parts := strings.Split(string(b), keySeparator)
+	keys := make([]By, len(parts))
+	for i, part := range parts {
+		keys[i] = By(part)
+	}
+	return keys
+}
+
+// ConsistsOf checks if every key of the sorting passes one of the checks, e.g. sorting.By.IsName. The sorting can't
+// be unspecified, have more than MaxKeys keys or have the same key twice
+func (b By) ConsistsOf(checks ...func(By) bool) bool {
+	keys := b.Keys()
+	if len(keys) == 0 || len(keys) > MaxKeys {
+		return false
+	}
+	seen := make(map[By]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] || !key.passes(checks) {
+			return false
+		}
+		seen[key] = true
+	}
+	return true
+}
+
+// passes checks if the key passes one of the checks
+func (b By) passes(checks []func(By) bool) bool {
+	for _, check := range checks {
+		if check(b) {
+			return true
+		}
+	}
+	return false
+}
+
 // IsDate checks if a provided string can be used as a sorting by date
 func (b By) IsDate() bool {
 	switch b {
@@ -33,11 +115,51 @@ func (b By) IsName() bool {
 	return false
 }
 
-// IsResources check if a provided string can be used as a sorting by resources
-func (b By) IsResources() bool {
+// IsTotal checks if a provided string can be used as a sorting by the points scored for all the resources
+func (b By) IsTotal() bool {
 	switch b {
-	case Gadgets, Paper, Plastic:
+	case TotalAsc, TotalDes:
 		return true
 	}
 	return false
 }
+
+// IsPerPupil checks if a provided string can be used as a sorting by the points scored per pupil
+func (b By) IsPerPupil() bool {
+	switch b {
+	case PerPupilAsc, PerPupilDes:
+		return true
+	}
+	return false
+}
+
+// IsParticipation checks if a provided string can be used as a sorting by the share of the pupils who brought anything
+func (b By) IsParticipation() bool {
+	switch b {
+	case ParticipationAsc, ParticipationDes:
+		return true
+	}
+	return false
+}
+
+// IsMedian checks if a provided string can be used as a sorting by the median of the points scored by the pupils
+func (b By) IsMedian() bool {
+	switch b {
+	case MedianAsc, MedianDes:
+		return true
+	}
+	return false
+}
+
+// IsResources check if a provided string can be used as a sorting by resources
+func (b By) IsResources() bool {
+	return strings.HasPrefix(string(b), resourcePrefix) && b.Resource().IsValid()
+}
+
+// Resource returns the resource the things are sorted by the amount of. It's empty if it's not a sorting by resources
+func (b By) Resource() eventsvc.Resource {
+	if !strings.HasPrefix(string(b), resourcePrefix) {
+		return ""
+	}
+	return eventsvc.Resource(strings.TrimPrefix(string(b), resourcePrefix))
+}
@@ -2,6 +2,8 @@ package sorting
 
 import (
 	"testing"
+
+	"github.com/shanvl/garbage/internal/eventsvc"
 )
 
 func TestSort_IsDate(t *testing.T) {
@@ -17,7 +19,7 @@ func TestSort_IsDate(t *testing.T) {
 		},
 		{
 			name: "by plastic",
-			s:    Plastic,
+			s:    ByResource(eventsvc.Plastic),
 			want: false,
 		},
 		{
@@ -48,7 +50,7 @@ func TestSort_IsName(t *testing.T) {
 		},
 		{
 			name: "by plastic",
-			s:    Plastic,
+			s:    ByResource(eventsvc.Plastic),
 			want: false,
 		},
 		{
@@ -74,7 +76,7 @@ func TestSort_IsResources(t *testing.T) {
 	}{
 		{
 			name: "by gadgets",
-			s:    Gadgets,
+			s:    ByResource(eventsvc.Gadgets),
 			want: true,
 		},
 		{
@@ -96,3 +98,115 @@ func TestSort_IsResources(t *testing.T) {
 		})
 	}
 }
+
+func TestBy_Resource(t *testing.T) {
+	tests := []struct {
+		name string
+		s    By
+		want eventsvc.Resource
+	}{
+		{
+			name: "by glass",
+			s:    ByResource("glass"),
+			want: "glass",
+		},
+		{
+			name: "by name",
+			s:    NameAsc,
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Resource(); got != tt.want {
+				t.Errorf("Resource() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBy_Then(t *testing.T) {
+	tests := []struct {
+		name string
+		s    By
+		next []By
+		want By
+	}{
+		{
+			name: "total then name",
+			s:    TotalDes,
+			next: []By{NameAsc},
+			want: "total_desc,name_asc",
+		},
+		{
+			name: "unspecified are left out",
+			s:    Unspecified,
+			next: []By{ByResource("paper"), Unspecified, DateDes},
+			want: "resource:paper,date_desc",
+		},
+		{
+			name: "nothing next",
+			s:    NameDes,
+			want: NameDes,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Then(tt.next...); got != tt.want {
+				t.Errorf("Then() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBy_ConsistsOf(t *testing.T) {
+	checks := []func(By) bool{By.IsName, By.IsResources, By.IsTotal}
+	tests := []struct {
+		name string
+		s    By
+		want bool
+	}{
+		{
+			name: "single key",
+			s:    TotalAsc,
+			want: true,
+		},
+		{
+			name: "total then resource then name",
+			s:    TotalDes.Then(ByResource("paper"), NameAsc),
+			want: true,
+		},
+		{
+			name: "unspecified",
+			s:    Unspecified,
+			want: false,
+		},
+		{
+			name: "key not allowed",
+			s:    TotalDes.Then(PerPupilDes),
+			want: false,
+		},
+		{
+			name: "same key twice",
+			s:    NameAsc.Then(NameAsc),
+			want: false,
+		},
+		{
+			name: "too many keys",
+			s:    TotalDes.Then(ByResource("paper"), ByResource("glass"), NameAsc),
+			want: false,
+		},
+		{
+			name: "empty key",
+			s:    "total_desc,,name_asc",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.ConsistsOf(checks...); got != tt.want {
+				t.Errorf("ConsistsOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package eventsvc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrVersionMismatch is used when an entity is changed on condition that it's of the version it isn't of anymore,
+// i.e. someone else has changed it in the meantime
+var ErrVersionMismatch = errors.New("the entity has been changed in the meantime")
+
+// CheckVersion returns ErrVersionMismatch if the entity of the current version can't be changed on condition that
+// it's of the expected version. The expected version 0 means there's no condition
+func CheckVersion(expected, current int) error {
+	if expected != 0 && expected != current {
+		return fmt.Errorf("%w: the version is %d, not %d", ErrVersionMismatch, current, expected)
+	}
+	return nil
+}
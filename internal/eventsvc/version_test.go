@@ -0,0 +1,42 @@
+package eventsvc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckVersion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		expected int
+		current  int
+		wantErr  error
+	}{
+		{
+			name:     "no condition",
+			expected: 0,
+			current:  3,
+			wantErr:  nil,
+		},
+		{
+			name:     "same version",
+			expected: 3,
+			current:  3,
+			wantErr:  nil,
+		},
+		{
+			name:     "changed in the meantime",
+			expected: 2,
+			current:  3,
+			wantErr:  ErrVersionMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckVersion(tt.expected, tt.current); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
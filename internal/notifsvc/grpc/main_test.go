@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/shanvl/garbage/internal/notifsvc"
+	"github.com/shanvl/garbage/internal/notifsvc/mock"
+	"github.com/shanvl/garbage/internal/notifsvc/notifying"
+	"github.com/shanvl/garbage/pkg/broker"
+	"go.uber.org/zap"
+)
+
+const testServiceToken = "test service token"
+
+var (
+	server    *Server
+	repo      *testRepo
+	logSender *mock.Sender
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testMain(m))
+}
+
+// testMain initializes gRPC server with an in-memory repo and an in-process broker
+func testMain(m *testing.M) int {
+	repo = newTestRepo()
+	logSender = &mock.Sender{SendFn: func(ctx context.Context, n *notifsvc.Notification) error { return nil }}
+	notifyingService := notifying.NewService(repo, map[notifsvc.Channel]notifying.Sender{
+		notifsvc.Log:     logSender,
+		notifsvc.Webhook: &mock.Sender{SendFn: func(ctx context.Context, n *notifsvc.Notification) error { return nil }},
+	})
+	// subscribe the service to all the topics
+	b := broker.NewInProc()
+	for _, topic := range broker.Topics() {
+		if err := b.Subscribe(topic, notifyingService.HandleMessage); err != nil {
+			log.Print(err)
+			return 1
+		}
+	}
+	// logger
+	logger, err := zap.NewProduction()
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	// create gRPC server
+	server = NewServer(testAuthService{}, notifyingService, b, testServiceToken, logger)
+	return m.Run()
+}
+
+// testAuthService allows access to all the RPCs
+type testAuthService struct{}
+
+func (testAuthService) Authorize(_ context.Context, _, _ string) (*AuthClaims, error) {
+	return &AuthClaims{}, nil
+}
+
+// testRepo is an in-memory notifying.Repository
+type testRepo struct {
+	mock.NotifyingRepository
+	subs          map[string]*notifsvc.Subscription
+	notifications map[string]*notifsvc.Notification
+}
+
+func newTestRepo() *testRepo {
+	r := &testRepo{
+		subs:          map[string]*notifsvc.Subscription{},
+		notifications: map[string]*notifsvc.Notification{},
+	}
+	r.DeleteSubscriptionFn = func(_ context.Context, id string) error {
+		if _, ok := r.subs[id]; !ok {
+			return notifsvc.ErrUnknownSubscription
+		}
+		delete(r.subs, id)
+		return nil
+	}
+	r.NotificationFn = func(_ context.Context, messageID, subscriptionID string) (*notifsvc.Notification, error) {
+		for _, n := range r.notifications {
+			if n.MessageID == messageID && n.SubscriptionID == subscriptionID {
+				return n, nil
+			}
+		}
+		return nil, notifsvc.ErrUnknownNotification
+	}
+	r.NotificationsFn = func(_ context.Context, filters notifying.NotificationFilters, amount,
+		skip int) ([]*notifsvc.Notification, int, error) {
+
+		var ns []*notifsvc.Notification
+		for _, n := range r.notifications {
+			if filters.Topic == "" || n.Topic == filters.Topic {
+				ns = append(ns, n)
+			}
+		}
+		return ns, len(ns), nil
+	}
+	r.StoreNotificationFn = func(_ context.Context, n *notifsvc.Notification) error {
+		r.notifications[n.ID] = n
+		return nil
+	}
+	r.StoreSubscriptionFn = func(_ context.Context, sub *notifsvc.Subscription) error {
+		r.subs[sub.ID] = sub
+		return nil
+	}
+	r.SubscriptionsFn = func(_ context.Context, topic string) ([]*notifsvc.Subscription, error) {
+		var subs []*notifsvc.Subscription
+		for _, s := range r.subs {
+			if topic == "" || s.Topic == topic {
+				subs = append(subs, s)
+			}
+		}
+		return subs, nil
+	}
+	return r
+}
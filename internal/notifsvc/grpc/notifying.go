@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	notificationsv1pb "github.com/shanvl/garbage/api/notifications/v1/pb"
+	"github.com/shanvl/garbage/internal/notifsvc/notifying"
+	"github.com/shanvl/garbage/pkg/broker"
+	"github.com/shanvl/garbage/pkg/valid"
+)
+
+// CreateSubscription subscribes the target to the messages of the topic
+func (s *Server) CreateSubscription(ctx context.Context,
+	req *notificationsv1pb.CreateSubscriptionRequest) (*notificationsv1pb.CreateSubscriptionResponse, error) {
+
+	channel, err := protoToChannel(req.GetChannel())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	id, err := s.notifSvc.CreateSubscription(ctx, req.GetTopic(), channel, req.GetTarget())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	return &notificationsv1pb.CreateSubscriptionResponse{Id: id}, nil
+}
+
+// DeleteSubscription deletes the subscription
+func (s *Server) DeleteSubscription(ctx context.Context, req *notificationsv1pb.DeleteSubscriptionRequest) (*empty.Empty,
+	error) {
+
+	if err := s.notifSvc.DeleteSubscription(ctx, req.GetId()); err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// FindNotifications returns a list of the notifications sorted by the date of creation, the newest first
+func (s *Server) FindNotifications(ctx context.Context,
+	req *notificationsv1pb.FindNotificationsRequest) (*notificationsv1pb.FindNotificationsResponse, error) {
+
+	channels, err := protoToChannels(req.GetChannels())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	statuses, err := protoToStatuses(req.GetStatuses())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	filters := notifying.NotificationFilters{
+		Topic:    req.GetTopic(),
+		Channels: channels,
+		Statuses: statuses,
+	}
+	notifications, total, err := s.notifSvc.Notifications(ctx, filters, int(req.GetAmount()), int(req.GetSkip()))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	// convert []*notifsvc.Notification to []*notificationsv1pb.Notification
+	notificationsProto := make([]*notificationsv1pb.Notification, len(notifications))
+	for i, n := range notifications {
+		notificationsProto[i], err = notificationToProto(n)
+		if err != nil {
+			return nil, s.handleError(err)
+		}
+	}
+	return &notificationsv1pb.FindNotificationsResponse{Notifications: notificationsProto, Total: uint32(total)}, nil
+}
+
+// FindSubscriptions returns a list of the subscriptions
+func (s *Server) FindSubscriptions(ctx context.Context,
+	req *notificationsv1pb.FindSubscriptionsRequest) (*notificationsv1pb.FindSubscriptionsResponse, error) {
+
+	subs, err := s.notifSvc.Subscriptions(ctx, req.GetTopic())
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+	// convert []*notifsvc.Subscription to []*notificationsv1pb.Subscription
+	subsProto := make([]*notificationsv1pb.Subscription, len(subs))
+	for i, sub := range subs {
+		subsProto[i] = subscriptionToProto(sub)
+	}
+	return &notificationsv1pb.FindSubscriptionsResponse{Subscriptions: subsProto}, nil
+}
+
+// Publish passes the messages published by the other services to the broker which dispatches them to the handlers.
+// If an error is returned, the caller is expected to publish the messages again
+func (s *Server) Publish(ctx context.Context, req *notificationsv1pb.PublishRequest) (*empty.Empty, error) {
+	// validate the messages
+	errVld := valid.EmptyError()
+	msgs := make([]broker.Message, len(req.GetMessages()))
+	for i, m := range req.GetMessages() {
+		msg, err := protoToMessage(m)
+		if err != nil {
+			return nil, s.handleError(err)
+		}
+		if msg.ID == "" {
+			errVld.Add(fmt.Sprintf("messages[%d].id", i), "id is required")
+		}
+		if !broker.IsKnownTopic(msg.Topic) {
+			errVld.Add(fmt.Sprintf("messages[%d].topic", i), fmt.Sprintf("unknown topic %q", msg.Topic))
+		}
+		msgs[i] = msg
+	}
+	if !errVld.IsEmpty() {
+		return nil, s.handleError(errVld)
+	}
+
+	if err := s.broker.Publish(ctx, msgs...); err != nil {
+		return nil, s.handleError(err)
+	}
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,160 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	notificationsv1pb "github.com/shanvl/garbage/api/notifications/v1/pb"
+	"github.com/shanvl/garbage/pkg/broker"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_CreateSubscription(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		req  *notificationsv1pb.CreateSubscriptionRequest
+		code codes.Code
+	}{
+		{
+			name: "unknown channel",
+			req: &notificationsv1pb.CreateSubscriptionRequest{Topic: broker.TopicUserCreated,
+				Channel: notificationsv1pb.Channel_CHANNEL_UNKNOWN, Target: "log"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unsupported channel",
+			req: &notificationsv1pb.CreateSubscriptionRequest{Topic: broker.TopicUserCreated,
+				Channel: notificationsv1pb.Channel_CHANNEL_EMAIL, Target: "a@b.c"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unknown topic",
+			req: &notificationsv1pb.CreateSubscriptionRequest{Topic: "unknown",
+				Channel: notificationsv1pb.Channel_CHANNEL_LOG, Target: "log"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "ok",
+			req: &notificationsv1pb.CreateSubscriptionRequest{Topic: broker.TopicUserCreated,
+				Channel: notificationsv1pb.Channel_CHANNEL_WEBHOOK, Target: "http://host/hook"},
+			code: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.CreateSubscription(ctx, tt.req)
+			require.Equal(t, tt.code, status.Code(err))
+			if tt.code == codes.OK {
+				require.NotEmpty(t, resp.GetId())
+				_, err := server.DeleteSubscription(ctx, &notificationsv1pb.DeleteSubscriptionRequest{Id: resp.GetId()})
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServer_DeleteSubscription(t *testing.T) {
+	ctx := context.Background()
+	_, err := server.DeleteSubscription(ctx, &notificationsv1pb.DeleteSubscriptionRequest{Id: ""})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.DeleteSubscription(ctx, &notificationsv1pb.DeleteSubscriptionRequest{Id: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServer_Publish(t *testing.T) {
+	ctx := context.Background()
+	sub, err := server.CreateSubscription(ctx, &notificationsv1pb.CreateSubscriptionRequest{
+		Topic:   broker.TopicEventCreated,
+		Channel: notificationsv1pb.Channel_CHANNEL_LOG,
+		Target:  "log",
+	})
+	require.NoError(t, err)
+	defer server.DeleteSubscription(ctx, &notificationsv1pb.DeleteSubscriptionRequest{Id: sub.GetId()})
+
+	msg, err := broker.NewMessage(broker.TopicEventCreated, broker.EventCreated{EventID: "id", Name: "name",
+		ResourcesAllowed: []string{"paper"}})
+	require.NoError(t, err)
+	occurredAt, err := ptypes.TimestampProto(msg.OccurredAt)
+	require.NoError(t, err)
+	msgProto := &notificationsv1pb.Message{Id: msg.ID, Topic: msg.Topic, OccurredAt: occurredAt,
+		Payload: msg.Payload}
+
+	t.Run("invalid messages", func(t *testing.T) {
+		_, err := server.Publish(ctx, &notificationsv1pb.PublishRequest{Messages: []*notificationsv1pb.Message{
+			{Id: "", Topic: broker.TopicEventCreated},
+			{Id: "id", Topic: "unknown"},
+		}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("message is delivered once", func(t *testing.T) {
+		sent := len(logSender.Sent)
+		// publish the same message twice as it might happen on redelivery
+		for i := 0; i < 2; i++ {
+			_, err := server.Publish(ctx, &notificationsv1pb.PublishRequest{
+				Messages: []*notificationsv1pb.Message{msgProto},
+			})
+			require.NoError(t, err)
+		}
+		require.Equal(t, sent+1, len(logSender.Sent))
+
+		resp, err := server.FindNotifications(ctx, &notificationsv1pb.FindNotificationsRequest{
+			Topic: broker.TopicEventCreated,
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), resp.GetTotal())
+		n := resp.GetNotifications()[0]
+		require.Equal(t, msg.ID, n.GetMessageId())
+		require.Equal(t, notificationsv1pb.NotificationStatus_NOTIFICATION_STATUS_SENT, n.GetStatus())
+		require.NotNil(t, n.GetSentAt())
+	})
+}
+
+func TestServer_PublishServiceToken(t *testing.T) {
+	interceptor := server.authUnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/shanvl.garbage.notifications.v1.NotificationsService/Publish"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	tests := []struct {
+		name string
+		md   metadata.MD
+		code codes.Code
+	}{
+		{"anonymous", nil, codes.Unauthenticated},
+		{"access token", metadata.Pairs("authorization", "Bearer token"), codes.Unauthenticated},
+		{"wrong service token", metadata.Pairs("x-service-token", "wrong"), codes.Unauthenticated},
+		{"service token", metadata.Pairs("x-service-token", testServiceToken), codes.OK},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			_, err := interceptor(ctx, &notificationsv1pb.PublishRequest{}, info, handler)
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestServer_FindSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	sub, err := server.CreateSubscription(ctx, &notificationsv1pb.CreateSubscriptionRequest{
+		Topic:   broker.TopicPupilsAdded,
+		Channel: notificationsv1pb.Channel_CHANNEL_LOG,
+		Target:  "log",
+	})
+	require.NoError(t, err)
+	defer server.DeleteSubscription(ctx, &notificationsv1pb.DeleteSubscriptionRequest{Id: sub.GetId()})
+
+	resp, err := server.FindSubscriptions(ctx, &notificationsv1pb.FindSubscriptionsRequest{
+		Topic: broker.TopicPupilsAdded,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetSubscriptions(), 1)
+	require.Equal(t, sub.GetId(), resp.GetSubscriptions()[0].GetId())
+	require.Equal(t, notificationsv1pb.Channel_CHANNEL_LOG, resp.GetSubscriptions()[0].GetChannel())
+}
@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	notificationsv1pb "github.com/shanvl/garbage/api/notifications/v1/pb"
+	"github.com/shanvl/garbage/internal/notifsvc"
+	"github.com/shanvl/garbage/pkg/broker"
+)
+
+var channelProtoMap = map[notifsvc.Channel]notificationsv1pb.Channel{
+	notifsvc.Email:   notificationsv1pb.Channel_CHANNEL_EMAIL,
+	notifsvc.Log:     notificationsv1pb.Channel_CHANNEL_LOG,
+	notifsvc.Webhook: notificationsv1pb.Channel_CHANNEL_WEBHOOK,
+}
+
+var protoChannelMap = map[notificationsv1pb.Channel]notifsvc.Channel{
+	notificationsv1pb.Channel_CHANNEL_EMAIL:   notifsvc.Email,
+	notificationsv1pb.Channel_CHANNEL_LOG:     notifsvc.Log,
+	notificationsv1pb.Channel_CHANNEL_WEBHOOK: notifsvc.Webhook,
+}
+
+var statusProtoMap = map[notifsvc.Status]notificationsv1pb.NotificationStatus{
+	notifsvc.Failed:  notificationsv1pb.NotificationStatus_NOTIFICATION_STATUS_FAILED,
+	notifsvc.Pending: notificationsv1pb.NotificationStatus_NOTIFICATION_STATUS_PENDING,
+	notifsvc.Sent:    notificationsv1pb.NotificationStatus_NOTIFICATION_STATUS_SENT,
+}
+
+var protoStatusMap = map[notificationsv1pb.NotificationStatus]notifsvc.Status{
+	notificationsv1pb.NotificationStatus_NOTIFICATION_STATUS_FAILED:  notifsvc.Failed,
+	notificationsv1pb.NotificationStatus_NOTIFICATION_STATUS_PENDING: notifsvc.Pending,
+	notificationsv1pb.NotificationStatus_NOTIFICATION_STATUS_SENT:    notifsvc.Sent,
+}
+
+// protoToChannel converts notificationsv1pb.Channel to notifsvc.Channel
+func protoToChannel(proto notificationsv1pb.Channel) (notifsvc.Channel, error) {
+	c, ok := protoChannelMap[proto]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", notifsvc.ErrUnknownChannel, proto)
+	}
+	return c, nil
+}
+
+// protoToChannels converts []notificationsv1pb.Channel to []notifsvc.Channel
+func protoToChannels(proto []notificationsv1pb.Channel) ([]notifsvc.Channel, error) {
+	channels := make([]notifsvc.Channel, len(proto))
+	for i, p := range proto {
+		c, err := protoToChannel(p)
+		if err != nil {
+			return nil, err
+		}
+		channels[i] = c
+	}
+	return channels, nil
+}
+
+// protoToStatuses converts []notificationsv1pb.NotificationStatus to []notifsvc.Status
+func protoToStatuses(proto []notificationsv1pb.NotificationStatus) ([]notifsvc.Status, error) {
+	statuses := make([]notifsvc.Status, len(proto))
+	for i, p := range proto {
+		s, ok := protoStatusMap[p]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", notifsvc.ErrUnknownStatus, p)
+		}
+		statuses[i] = s
+	}
+	return statuses, nil
+}
+
+// protoToMessage converts *notificationsv1pb.Message to broker.Message
+func protoToMessage(proto *notificationsv1pb.Message) (broker.Message, error) {
+	occurredAt, err := protoTimeToTimestamp(proto.GetOccurredAt())
+	if err != nil {
+		return broker.Message{}, err
+	}
+	return broker.Message{
+		ID:         proto.GetId(),
+		Topic:      proto.GetTopic(),
+		OccurredAt: occurredAt,
+		Payload:    proto.GetPayload(),
+	}, nil
+}
+
+// notificationToProto converts *notifsvc.Notification to *notificationsv1pb.Notification
+func notificationToProto(n *notifsvc.Notification) (*notificationsv1pb.Notification, error) {
+	createdAt, err := ptypes.TimestampProto(n.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("notification created at: %w", ErrInvalidTimestamp)
+	}
+	var sentAt *timestamp.Timestamp
+	if !n.SentAt.IsZero() {
+		sentAt, err = ptypes.TimestampProto(n.SentAt)
+		if err != nil {
+			return nil, fmt.Errorf("notification sent at: %w", ErrInvalidTimestamp)
+		}
+	}
+	return &notificationsv1pb.Notification{
+		Id:             n.ID,
+		MessageId:      n.MessageID,
+		SubscriptionId: n.SubscriptionID,
+		Topic:          n.Topic,
+		Channel:        channelProtoMap[n.Channel],
+		Target:         n.Target,
+		Subject:        n.Subject,
+		Body:           n.Body,
+		Status:         statusProtoMap[n.Status],
+		Error:          n.Error,
+		CreatedAt:      createdAt,
+		SentAt:         sentAt,
+	}, nil
+}
+
+// subscriptionToProto converts *notifsvc.Subscription to *notificationsv1pb.Subscription
+func subscriptionToProto(sub *notifsvc.Subscription) *notificationsv1pb.Subscription {
+	return &notificationsv1pb.Subscription{
+		Id:      sub.ID,
+		Topic:   sub.Topic,
+		Channel: channelProtoMap[sub.Channel],
+		Target:  sub.Target,
+	}
+}
+
+// protoTimeToTimestamp transforms *timestamp.Timestamp to time.Time
+func protoTimeToTimestamp(proto *timestamp.Timestamp) (time.Time, error) {
+	if proto == nil {
+		return time.Time{}, nil
+	}
+	ts, err := ptypes.Timestamp(proto)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", ErrInvalidTimestamp, err)
+	}
+	return ts, nil
+}
@@ -0,0 +1,81 @@
+package mock
+
+import (
+	"context"
+	"sync"
+
+	"github.com/shanvl/garbage/internal/notifsvc"
+	"github.com/shanvl/garbage/internal/notifsvc/notifying"
+)
+
+// NotifyingRepository is a mock repository for notifying use case
+type NotifyingRepository struct {
+	DeleteSubscriptionFn      func(ctx context.Context, id string) error
+	DeleteSubscriptionInvoked bool
+
+	NotificationFn func(ctx context.Context, messageID, subscriptionID string) (*notifsvc.Notification,
+		error)
+	NotificationInvoked bool
+
+	NotificationsFn func(ctx context.Context, filters notifying.NotificationFilters, amount,
+		skip int) ([]*notifsvc.Notification, int, error)
+	NotificationsInvoked bool
+
+	StoreNotificationFn      func(ctx context.Context, n *notifsvc.Notification) error
+	StoreNotificationInvoked bool
+
+	StoreSubscriptionFn      func(ctx context.Context, sub *notifsvc.Subscription) error
+	StoreSubscriptionInvoked bool
+
+	SubscriptionsFn      func(ctx context.Context, topic string) ([]*notifsvc.Subscription, error)
+	SubscriptionsInvoked bool
+}
+
+func (r *NotifyingRepository) DeleteSubscription(ctx context.Context, id string) error {
+	r.DeleteSubscriptionInvoked = true
+	return r.DeleteSubscriptionFn(ctx, id)
+}
+
+func (r *NotifyingRepository) Notification(ctx context.Context, messageID,
+	subscriptionID string) (*notifsvc.Notification, error) {
+
+	r.NotificationInvoked = true
+	return r.NotificationFn(ctx, messageID, subscriptionID)
+}
+
+func (r *NotifyingRepository) Notifications(ctx context.Context, filters notifying.NotificationFilters, amount,
+	skip int) ([]*notifsvc.Notification, int, error) {
+
+	r.NotificationsInvoked = true
+	return r.NotificationsFn(ctx, filters, amount, skip)
+}
+
+func (r *NotifyingRepository) StoreNotification(ctx context.Context, n *notifsvc.Notification) error {
+	r.StoreNotificationInvoked = true
+	return r.StoreNotificationFn(ctx, n)
+}
+
+func (r *NotifyingRepository) StoreSubscription(ctx context.Context, sub *notifsvc.Subscription) error {
+	r.StoreSubscriptionInvoked = true
+	return r.StoreSubscriptionFn(ctx, sub)
+}
+
+func (r *NotifyingRepository) Subscriptions(ctx context.Context, topic string) ([]*notifsvc.Subscription, error) {
+	r.SubscriptionsInvoked = true
+	return r.SubscriptionsFn(ctx, topic)
+}
+
+// Sender is a mock sender of notifications. It remembers the notifications it has been asked to send
+type Sender struct {
+	SendFn func(ctx context.Context, n *notifsvc.Notification) error
+
+	mu   sync.Mutex
+	Sent []*notifsvc.Notification
+}
+
+func (s *Sender) Send(ctx context.Context, n *notifsvc.Notification) error {
+	s.mu.Lock()
+	s.Sent = append(s.Sent, n)
+	s.mu.Unlock()
+	return s.SendFn(ctx, n)
+}
@@ -0,0 +1,140 @@
+// Package notifsvc contains the entities of the notification service. The service consumes the domain events
+// published by the other services and sends them out to the subscribers through the pluggable channels
+package notifsvc
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrUnknownChannel      = errors.New("unknown channel")
+	ErrUnknownNotification = errors.New("unknown notification")
+	ErrUnknownStatus       = errors.New("unknown status")
+	ErrUnknownSubscription = errors.New("unknown subscription")
+)
+
+// Channel is a way a notification is sent out
+type Channel int
+
+const (
+	Email Channel = iota
+	Log
+	Webhook
+)
+
+var channelStringValues = []string{"email", "log", "webhook"}
+
+// String returns the string value of a channel
+func (c Channel) String() string {
+	if c < 0 || int(c) >= len(channelStringValues) {
+		return "unknown"
+	}
+	return channelStringValues[c]
+}
+
+var stringToChannelMap = map[string]Channel{
+	"email":   Email,
+	"log":     Log,
+	"webhook": Webhook,
+}
+
+// StringToChannel converts a string to a channel
+func StringToChannel(s string) (Channel, error) {
+	c, ok := stringToChannelMap[s]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownChannel, s)
+	}
+	return c, nil
+}
+
+// Status shows whether the notification has been sent out
+type Status int
+
+const (
+	Failed Status = iota
+	Pending
+	Sent
+)
+
+var statusStringValues = []string{"failed", "pending", "sent"}
+
+// String returns the string value of a status
+func (s Status) String() string {
+	if s < 0 || int(s) >= len(statusStringValues) {
+		return "unknown"
+	}
+	return statusStringValues[s]
+}
+
+var stringToStatusMap = map[string]Status{
+	"failed":  Failed,
+	"pending": Pending,
+	"sent":    Sent,
+}
+
+// StringToStatus converts a string to a status
+func StringToStatus(s string) (Status, error) {
+	status, ok := stringToStatusMap[s]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownStatus, s)
+	}
+	return status, nil
+}
+
+// Subscription subscribes a target to the messages of the topic. The target is the address of the recipient which
+// depends on the channel: email for Email, url for Webhook, etc.
+type Subscription struct {
+	ID      string
+	Topic   string
+	Channel Channel
+	Target  string
+}
+
+// Notification is a message sent out to a subscriber through one of the channels.
+// There can be only one notification per message and subscription
+type Notification struct {
+	ID             string
+	MessageID      string
+	SubscriptionID string
+	Topic          string
+	Channel        Channel
+	Target         string
+	Subject        string
+	Body           string
+	Status         Status
+	// Error is the error which occurred on the last sending attempt
+	Error     string
+	CreatedAt time.Time
+	SentAt    time.Time
+}
+
+// NewNotification creates a pending notification of the message for the subscription
+func NewNotification(id, messageID, subject, body string, sub *Subscription) *Notification {
+	return &Notification{
+		ID:             id,
+		MessageID:      messageID,
+		SubscriptionID: sub.ID,
+		Topic:          sub.Topic,
+		Channel:        sub.Channel,
+		Target:         sub.Target,
+		Subject:        subject,
+		Body:           body,
+		Status:         Pending,
+		CreatedAt:      time.Now().UTC(),
+	}
+}
+
+// MarkFailed changes the status of the notification to failed and remembers the reason
+func (n *Notification) MarkFailed(err error) {
+	n.Status = Failed
+	n.Error = err.Error()
+}
+
+// MarkSent changes the status of the notification to sent
+func (n *Notification) MarkSent() {
+	n.Status = Sent
+	n.Error = ""
+	n.SentAt = time.Now().UTC()
+}
@@ -0,0 +1,84 @@
+package notifsvc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStringToChannel(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		s       string
+		want    Channel
+		wantErr bool
+	}{
+		{name: "email", s: "email", want: Email},
+		{name: "log", s: "log", want: Log},
+		{name: "webhook", s: "webhook", want: Webhook},
+		{name: "unknown", s: "sms", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToChannel(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StringToChannel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("StringToChannel() got = %v, want %v", got, tt.want)
+			}
+			if err == nil && got.String() != tt.s {
+				t.Errorf("String() got = %v, want %v", got.String(), tt.s)
+			}
+		})
+	}
+}
+
+func TestStringToStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		s       string
+		want    Status
+		wantErr bool
+	}{
+		{name: "failed", s: "failed", want: Failed},
+		{name: "pending", s: "pending", want: Pending},
+		{name: "sent", s: "sent", want: Sent},
+		{name: "unknown", s: "lost", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringToStatus(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StringToStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("StringToStatus() got = %v, want %v", got, tt.want)
+			}
+			if err == nil && got.String() != tt.s {
+				t.Errorf("String() got = %v, want %v", got.String(), tt.s)
+			}
+		})
+	}
+}
+
+func TestNotification_Mark(t *testing.T) {
+	t.Parallel()
+	sub := &Subscription{ID: "sub", Topic: "topic", Channel: Webhook, Target: "http://localhost"}
+	n := NewNotification("id", "msg", "subject", "body", sub)
+	if n.Status != Pending || n.SubscriptionID != sub.ID || n.Target != sub.Target || n.Channel != sub.Channel {
+		t.Fatalf("NewNotification() got = %+v, want pending notification for the subscription", n)
+	}
+	n.MarkFailed(errors.New("error"))
+	if n.Status != Failed || n.Error != "error" {
+		t.Errorf("MarkFailed() status = %v, error = %q, want failed, \"error\"", n.Status, n.Error)
+	}
+	n.MarkSent()
+	if n.Status != Sent || n.Error != "" || n.SentAt.IsZero() {
+		t.Errorf("MarkSent() status = %v, error = %q, sentAt = %v, want sent, \"\", non-zero", n.Status, n.Error,
+			n.SentAt)
+	}
+}
@@ -0,0 +1,97 @@
+package notifying
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	"github.com/shanvl/garbage/pkg/broker"
+)
+
+// messageTemplate is used to render a notification about a message of a specific topic
+type messageTemplate struct {
+	subject string
+	body    *template.Template
+	// payload returns a value the json payload of the message is decoded into
+	payload func() interface{}
+}
+
+var messageTemplates = map[string]messageTemplate{
+	broker.TopicEventCreated: {
+		subject: "New event",
+		body: template.Must(template.New(broker.TopicEventCreated).Parse(
+			`Event "{{.Name}}" will take place on {{.Date.Format "2006-01-02"}}. ` +
+				`Resources allowed: {{range $i, $r := .ResourcesAllowed}}{{if $i}}, {{end}}{{$r}}{{end}}.`)),
+		payload: func() interface{} { return &broker.EventCreated{} },
+	},
+	broker.TopicEventStatusChanged: {
+		subject: "Event status changed",
+		body: template.Must(template.New(broker.TopicEventStatusChanged).Parse(
+			`Event {{.EventID}} is {{.Status}} now.`)),
+		payload: func() interface{} { return &broker.EventStatusChanged{} },
+	},
+	broker.TopicEventUpdated: {
+		subject: "Event changed",
+		body: template.Must(template.New(broker.TopicEventUpdated).Parse(
+			`Event "{{.Name}}" has been changed. It will take place on {{.Date.Format "2006-01-02"}}. ` +
+				`Resources allowed: {{range $i, $r := .ResourcesAllowed}}{{if $i}}, {{end}}{{$r}}{{end}}.`)),
+		payload: func() interface{} { return &broker.EventUpdated{} },
+	},
+	broker.TopicPupilResourcesAdded: {
+		subject: "Pupil's resources weighed in",
+		body: template.Must(template.New(broker.TopicPupilResourcesAdded).Parse(
+			`Resources brought by pupil {{.PupilID}} to event {{.EventID}} have been weighed in by {{.RecordedBy}}: ` +
+				`{{range $r, $a := .Resources}}{{$r}}: {{$a}}; {{end}}`)),
+		payload: func() interface{} { return &broker.PupilResourcesAdded{} },
+	},
+	broker.TopicPupilResourcesChanged: {
+		subject: "Pupil's resources changed",
+		body: template.Must(template.New(broker.TopicPupilResourcesChanged).Parse(
+			`Resources brought by pupil {{.PupilID}} to event {{.EventID}} have been changed to: ` +
+				`{{range $r, $a := .Resources}}{{$r}}: {{$a}}; {{end}}`)),
+		payload: func() interface{} { return &broker.PupilResourcesChanged{} },
+	},
+	broker.TopicPupilsAdded: {
+		subject: "New pupils",
+		body: template.Must(template.New(broker.TopicPupilsAdded).Parse(
+			`{{len .PupilIDs}} pupil(s) have been added.`)),
+		payload: func() interface{} { return &broker.PupilsAdded{} },
+	},
+	broker.TopicResourceEntryCorrected: {
+		subject: "Pupil's resources corrected",
+		body: template.Must(template.New(broker.TopicResourceEntryCorrected).Parse(
+			`Weigh-in {{.EntryID}} of pupil {{.PupilID}} at event {{.EventID}} has been corrected by {{.RecordedBy}} ` +
+				`to: {{range $r, $a := .Resources}}{{$r}}: {{$a}}; {{end}}`)),
+		payload: func() interface{} { return &broker.ResourceEntryCorrected{} },
+	},
+	broker.TopicResourceEntryVoided: {
+		subject: "Pupil's resources voided",
+		body: template.Must(template.New(broker.TopicResourceEntryVoided).Parse(
+			`Weigh-in {{.EntryID}} of pupil {{.PupilID}} at event {{.EventID}} has been voided by {{.VoidedBy}}.`)),
+		payload: func() interface{} { return &broker.ResourceEntryVoided{} },
+	},
+	broker.TopicUserCreated: {
+		subject: "New user",
+		body: template.Must(template.New(broker.TopicUserCreated).Parse(
+			`User {{.Email}} has been created.`)),
+		payload: func() interface{} { return &broker.UserCreated{} },
+	},
+}
+
+// render returns the subject and the body of a notification about the message
+func render(msg broker.Message) (subject, body string, err error) {
+	t, ok := messageTemplates[msg.Topic]
+	if !ok {
+		return "", "", fmt.Errorf("%w: %s", broker.ErrUnknownTopic, msg.Topic)
+	}
+	payload := t.payload()
+	if err := json.Unmarshal(msg.Payload, payload); err != nil {
+		return "", "", fmt.Errorf("decode payload of message %s: %w", msg.ID, err)
+	}
+	var b bytes.Buffer
+	if err := t.body.Execute(&b, payload); err != nil {
+		return "", "", fmt.Errorf("render message %s: %w", msg.ID, err)
+	}
+	return t.subject, b.String(), nil
+}
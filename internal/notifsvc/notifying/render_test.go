@@ -0,0 +1,95 @@
+package notifying
+
+import (
+	"testing"
+
+	"github.com/shanvl/garbage/pkg/broker"
+)
+
+func Test_render(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		msg      broker.Message
+		wantBody string
+		wantErr  bool
+	}{
+		{
+			name: "event created",
+			msg: broker.Message{ID: "1", Topic: broker.TopicEventCreated,
+				Payload: []byte(`{"name":"Spring","date":"2020-04-01T00:00:00Z","resourcesAllowed":["paper","gadgets"]}`)},
+			wantBody: `Event "Spring" will take place on 2020-04-01. Resources allowed: paper, gadgets.`,
+		},
+		{
+			name: "event status changed",
+			msg: broker.Message{ID: "7", Topic: broker.TopicEventStatusChanged,
+				Payload: []byte(`{"eventId":"e","status":"open"}`)},
+			wantBody: `Event e is open now.`,
+		},
+		{
+			name: "event updated",
+			msg: broker.Message{ID: "8", Topic: broker.TopicEventUpdated,
+				Payload: []byte(`{"name":"Autumn","date":"2020-10-01T00:00:00Z","resourcesAllowed":["plastic"]}`)},
+			wantBody: `Event "Autumn" has been changed. It will take place on 2020-10-01. Resources allowed: plastic.`,
+		},
+		{
+			name: "pupil resources added",
+			msg: broker.Message{ID: "2", Topic: broker.TopicPupilResourcesAdded,
+				Payload: []byte(`{"eventId":"e","pupilId":"p","resources":{"paper":-0.5},"recordedBy":"u"}`)},
+			wantBody: `Resources brought by pupil p to event e have been weighed in by u: paper: -0.5; `,
+		},
+		{
+			name: "pupil resources changed",
+			msg: broker.Message{ID: "2", Topic: broker.TopicPupilResourcesChanged,
+				Payload: []byte(`{"eventId":"e","pupilId":"p","resources":{"paper":1.5}}`)},
+			wantBody: `Resources brought by pupil p to event e have been changed to: paper: 1.5; `,
+		},
+		{
+			name: "resource entry corrected",
+			msg: broker.Message{ID: "2", Topic: broker.TopicResourceEntryCorrected, Payload: []byte(
+				`{"eventId":"e","pupilId":"p","entryId":"1","correctionId":"2","resources":{"paper":3},"recordedBy":"u"}`)},
+			wantBody: `Weigh-in 1 of pupil p at event e has been corrected by u to: paper: 3; `,
+		},
+		{
+			name: "resource entry voided",
+			msg: broker.Message{ID: "2", Topic: broker.TopicResourceEntryVoided,
+				Payload: []byte(`{"eventId":"e","pupilId":"p","entryId":"1","voidedBy":"u"}`)},
+			wantBody: `Weigh-in 1 of pupil p at event e has been voided by u.`,
+		},
+		{
+			name:     "pupils added",
+			msg:      broker.Message{ID: "3", Topic: broker.TopicPupilsAdded, Payload: []byte(`{"pupilIds":["1","2"]}`)},
+			wantBody: `2 pupil(s) have been added.`,
+		},
+		{
+			name:     "user created",
+			msg:      broker.Message{ID: "4", Topic: broker.TopicUserCreated, Payload: []byte(`{"email":"a@b.c"}`)},
+			wantBody: `User a@b.c has been created.`,
+		},
+		{
+			name:    "unknown topic",
+			msg:     broker.Message{ID: "5", Topic: "unknown", Payload: []byte(`{}`)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid payload",
+			msg:     broker.Message{ID: "6", Topic: broker.TopicUserCreated, Payload: []byte(`{`)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subject, body, err := render(tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("render() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && subject == "" {
+				t.Errorf("render() subject is empty")
+			}
+			if body != tt.wantBody {
+				t.Errorf("render() body = %q, want %q", body, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Package notifying is responsible for sending out notifications about the domain events to the subscribers
+package notifying
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	gonanoid "github.com/matoous/go-nanoid"
+	"github.com/shanvl/garbage/internal/notifsvc"
+	"github.com/shanvl/garbage/pkg/broker"
+	"github.com/shanvl/garbage/pkg/valid"
+)
+
+// Service manages subscriptions and sends out notifications
+type Service interface {
+	// CreateSubscription subscribes the target to the messages of the topic
+	CreateSubscription(ctx context.Context, topic string, channel notifsvc.Channel, target string) (string, error)
+	// DeleteSubscription deletes the subscription
+	DeleteSubscription(ctx context.Context, id string) error
+	// HandleMessage sends out a notification about the message to every subscriber of its topic.
+	// Messages may be delivered more than once, so the notifications that have already been sent are skipped
+	HandleMessage(ctx context.Context, msg broker.Message) error
+	// Notifications returns a list of the notifications sorted by the date of creation, the newest first
+	Notifications(ctx context.Context, filters NotificationFilters, amount, skip int) (
+		notifications []*notifsvc.Notification, total int, err error)
+	// Subscriptions returns the subscriptions to the topic or all the subscriptions if the topic is empty
+	Subscriptions(ctx context.Context, topic string) ([]*notifsvc.Subscription, error)
+}
+
+// Repository provides methods to work with the persistence of subscriptions and notifications
+type Repository interface {
+	DeleteSubscription(ctx context.Context, id string) error
+	// Notification returns the notification of the message for the subscription
+	Notification(ctx context.Context, messageID, subscriptionID string) (*notifsvc.Notification, error)
+	Notifications(ctx context.Context, filters NotificationFilters, amount, skip int) (
+		notifications []*notifsvc.Notification, total int, err error)
+	StoreNotification(ctx context.Context, n *notifsvc.Notification) error
+	StoreSubscription(ctx context.Context, sub *notifsvc.Subscription) error
+	Subscriptions(ctx context.Context, topic string) ([]*notifsvc.Subscription, error)
+}
+
+// Sender sends out a notification through one of the channels
+type Sender interface {
+	Send(ctx context.Context, n *notifsvc.Notification) error
+}
+
+const (
+	DefaultAmount = 50
+	MaxAmount     = 1000
+)
+
+type service struct {
+	repo    Repository
+	senders map[notifsvc.Channel]Sender
+}
+
+// NewService returns an instance of Service with all its dependencies. Subscriptions can be created only for the
+// channels which have a sender
+func NewService(repo Repository, senders map[notifsvc.Channel]Sender) Service {
+	return &service{repo, senders}
+}
+
+// CreateSubscription subscribes the target to the messages of the topic
+func (s *service) CreateSubscription(ctx context.Context, topic string, channel notifsvc.Channel,
+	target string) (string, error) {
+
+	// validate the arguments
+	errVld := valid.EmptyError()
+	if !broker.IsKnownTopic(topic) {
+		errVld.Add("topic", fmt.Sprintf("topic must be one of: %s", strings.Join(broker.Topics(), ", ")))
+	}
+	if _, ok := s.senders[channel]; !ok {
+		errVld.Add("channel", "channel is not supported")
+	}
+	if len(target) > 255 {
+		errVld.Add("target", "length of the target can't be more than 255")
+	}
+	switch channel {
+	case notifsvc.Email:
+		if !strings.Contains(target, "@") {
+			errVld.Add("target", "target must be a valid email")
+		}
+	case notifsvc.Webhook:
+		if u, err := url.Parse(target); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errVld.Add("target", "target must be a valid http(s) url")
+		}
+	}
+	if !errVld.IsEmpty() {
+		return "", errVld
+	}
+
+	id, err := gonanoid.Nanoid()
+	if err != nil {
+		return "", err
+	}
+	sub := &notifsvc.Subscription{ID: id, Topic: topic, Channel: channel, Target: target}
+	if err := s.repo.StoreSubscription(ctx, sub); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// DeleteSubscription deletes the subscription
+func (s *service) DeleteSubscription(ctx context.Context, id string) error {
+	if id == "" {
+		return valid.NewError("id", "id is required")
+	}
+	return s.repo.DeleteSubscription(ctx, id)
+}
+
+// HandleMessage sends out a notification about the message to every subscriber of its topic.
+// Messages may be delivered more than once, so the notifications that have already been sent are skipped.
+// An error is returned if at least one of the notifications couldn't be sent, so that the message can be redelivered
+func (s *service) HandleMessage(ctx context.Context, msg broker.Message) error {
+	if msg.ID == "" {
+		return valid.NewError("id", "message id is required")
+	}
+	subs, err := s.repo.Subscriptions(ctx, msg.Topic)
+	if err != nil {
+		return err
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+	subject, body, err := render(msg)
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	for _, sub := range subs {
+		// skip the subscriptions that have already been notified about the message
+		n, err := s.repo.Notification(ctx, msg.ID, sub.ID)
+		switch {
+		case err == nil && n.Status == notifsvc.Sent:
+			continue
+		case err == nil:
+		case errors.Is(err, notifsvc.ErrUnknownNotification):
+			id, err := gonanoid.Nanoid()
+			if err != nil {
+				return err
+			}
+			n = notifsvc.NewNotification(id, msg.ID, subject, body, sub)
+		default:
+			return err
+		}
+
+		// send the notification and store the result of the attempt
+		if err := s.send(ctx, n); err != nil {
+			n.MarkFailed(err)
+			failed++
+		} else {
+			n.MarkSent()
+		}
+		if err := s.repo.StoreNotification(ctx, n); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d notifications about message %s weren't sent", failed, len(subs), msg.ID)
+	}
+	return nil
+}
+
+// send sends out the notification using the sender of its channel
+func (s *service) send(ctx context.Context, n *notifsvc.Notification) error {
+	sender, ok := s.senders[n.Channel]
+	if !ok {
+		return fmt.Errorf("%w: %s", notifsvc.ErrUnknownChannel, n.Channel)
+	}
+	return sender.Send(ctx, n)
+}
+
+// Notifications returns a list of the notifications sorted by the date of creation, the newest first
+func (s *service) Notifications(ctx context.Context, filters NotificationFilters, amount,
+	skip int) ([]*notifsvc.Notification, int, error) {
+
+	// validate amount and skip
+	if amount <= 0 || amount > MaxAmount {
+		amount = DefaultAmount
+	}
+	if skip < 0 {
+		skip = 0
+	}
+	return s.repo.Notifications(ctx, filters, amount, skip)
+}
+
+// Subscriptions returns the subscriptions to the topic or all the subscriptions if the topic is empty
+func (s *service) Subscriptions(ctx context.Context, topic string) ([]*notifsvc.Subscription, error) {
+	return s.repo.Subscriptions(ctx, topic)
+}
+
+// NotificationFilters are used to filter the notifications
+type NotificationFilters struct {
+	// Topic of the message which caused the notification
+	Topic string
+	// Channels the notifications were sent through
+	Channels []notifsvc.Channel
+	// Statuses of the notifications
+	Statuses []notifsvc.Status
+}
@@ -0,0 +1,234 @@
+package notifying_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shanvl/garbage/internal/notifsvc"
+	"github.com/shanvl/garbage/internal/notifsvc/mock"
+	"github.com/shanvl/garbage/internal/notifsvc/notifying"
+	"github.com/shanvl/garbage/pkg/broker"
+)
+
+func Test_service_CreateSubscription(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := &mock.NotifyingRepository{}
+	repo.StoreSubscriptionFn = func(ctx context.Context, sub *notifsvc.Subscription) error {
+		return nil
+	}
+	senders := map[notifsvc.Channel]notifying.Sender{
+		notifsvc.Email:   &mock.Sender{},
+		notifsvc.Webhook: &mock.Sender{},
+	}
+	s := notifying.NewService(repo, senders)
+	type args struct {
+		topic   string
+		channel notifsvc.Channel
+		target  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "unknown topic",
+			args:    args{topic: "unknown", channel: notifsvc.Email, target: "a@b.c"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported channel",
+			args:    args{topic: broker.TopicEventCreated, channel: notifsvc.Log, target: "log"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid email",
+			args:    args{topic: broker.TopicEventCreated, channel: notifsvc.Email, target: "email"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid url",
+			args:    args{topic: broker.TopicEventCreated, channel: notifsvc.Webhook, target: "ftp://host"},
+			wantErr: true,
+		},
+		{
+			name:    "email",
+			args:    args{topic: broker.TopicEventCreated, channel: notifsvc.Email, target: "a@b.c"},
+			wantErr: false,
+		},
+		{
+			name:    "webhook",
+			args:    args{topic: broker.TopicUserCreated, channel: notifsvc.Webhook, target: "https://host/hook"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := s.CreateSubscription(ctx, tt.args.topic, tt.args.channel, tt.args.target)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateSubscription() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && id == "" {
+				t.Errorf("CreateSubscription() err == nil, len(id) == 0")
+			}
+		})
+	}
+}
+
+func Test_service_DeleteSubscription(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := &mock.NotifyingRepository{}
+	repo.DeleteSubscriptionFn = func(ctx context.Context, id string) error {
+		return nil
+	}
+	s := notifying.NewService(repo, nil)
+	if err := s.DeleteSubscription(ctx, ""); err == nil {
+		t.Errorf("DeleteSubscription() error == nil, wantErr true")
+	}
+	if err := s.DeleteSubscription(ctx, "id"); err != nil {
+		t.Errorf("DeleteSubscription() error = %v, wantErr false", err)
+	}
+}
+
+func Test_service_HandleMessage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	msg, err := broker.NewMessage(broker.TopicUserCreated, broker.UserCreated{UserID: "id", Email: "a@b.c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	subs := []*notifsvc.Subscription{
+		{ID: "sent", Topic: msg.Topic, Channel: notifsvc.Email, Target: "sent@b.c"},
+		{ID: "failed", Topic: msg.Topic, Channel: notifsvc.Email, Target: "failed@b.c"},
+		{ID: "new", Topic: msg.Topic, Channel: notifsvc.Log, Target: "log"},
+	}
+
+	t.Run("notifications are sent only once", func(t *testing.T) {
+		repo := &mock.NotifyingRepository{}
+		repo.SubscriptionsFn = func(ctx context.Context, topic string) ([]*notifsvc.Subscription, error) {
+			return subs, nil
+		}
+		repo.NotificationFn = func(ctx context.Context, messageID, subID string) (*notifsvc.Notification, error) {
+			switch subID {
+			case "sent":
+				return &notifsvc.Notification{ID: "1", SubscriptionID: subID, Channel: notifsvc.Email,
+					Status: notifsvc.Sent}, nil
+			case "failed":
+				return &notifsvc.Notification{ID: "2", SubscriptionID: subID, Channel: notifsvc.Email,
+					Status: notifsvc.Failed}, nil
+			}
+			return nil, notifsvc.ErrUnknownNotification
+		}
+		stored := map[string]*notifsvc.Notification{}
+		repo.StoreNotificationFn = func(ctx context.Context, n *notifsvc.Notification) error {
+			stored[n.SubscriptionID] = n
+			return nil
+		}
+		email := &mock.Sender{SendFn: func(ctx context.Context, n *notifsvc.Notification) error { return nil }}
+		log := &mock.Sender{SendFn: func(ctx context.Context, n *notifsvc.Notification) error { return nil }}
+		s := notifying.NewService(repo, map[notifsvc.Channel]notifying.Sender{notifsvc.Email: email, notifsvc.Log: log})
+
+		if err := s.HandleMessage(ctx, msg); err != nil {
+			t.Fatalf("HandleMessage() error = %v, wantErr false", err)
+		}
+		if len(email.Sent) != 1 || email.Sent[0].SubscriptionID != "failed" {
+			t.Errorf("HandleMessage() email notifications sent = %d, want 1 for the failed one", len(email.Sent))
+		}
+		if len(log.Sent) != 1 || log.Sent[0].SubscriptionID != "new" || log.Sent[0].MessageID != msg.ID {
+			t.Errorf("HandleMessage() log notifications sent = %d, want 1 for the new one", len(log.Sent))
+		}
+		if _, ok := stored["sent"]; ok || len(stored) != 2 {
+			t.Errorf("HandleMessage() stored %d notifications, want 2", len(stored))
+		}
+		for id, n := range stored {
+			if n.Status != notifsvc.Sent {
+				t.Errorf("HandleMessage() notification for %s status = %v, want sent", id, n.Status)
+			}
+		}
+	})
+
+	t.Run("sender's error", func(t *testing.T) {
+		repo := &mock.NotifyingRepository{}
+		repo.SubscriptionsFn = func(ctx context.Context, topic string) ([]*notifsvc.Subscription, error) {
+			return subs[2:], nil
+		}
+		repo.NotificationFn = func(ctx context.Context, messageID, subID string) (*notifsvc.Notification, error) {
+			return nil, notifsvc.ErrUnknownNotification
+		}
+		var stored *notifsvc.Notification
+		repo.StoreNotificationFn = func(ctx context.Context, n *notifsvc.Notification) error {
+			stored = n
+			return nil
+		}
+		log := &mock.Sender{SendFn: func(ctx context.Context, n *notifsvc.Notification) error {
+			return errors.New("sender error")
+		}}
+		s := notifying.NewService(repo, map[notifsvc.Channel]notifying.Sender{notifsvc.Log: log})
+
+		if err := s.HandleMessage(ctx, msg); err == nil {
+			t.Errorf("HandleMessage() error == nil, wantErr true")
+		}
+		if stored == nil || stored.Status != notifsvc.Failed || stored.Error != "sender error" {
+			t.Errorf("HandleMessage() stored notification = %+v, want failed with the sender's error", stored)
+		}
+	})
+
+	t.Run("no subscriptions", func(t *testing.T) {
+		repo := &mock.NotifyingRepository{}
+		repo.SubscriptionsFn = func(ctx context.Context, topic string) ([]*notifsvc.Subscription, error) {
+			return nil, nil
+		}
+		s := notifying.NewService(repo, nil)
+		if err := s.HandleMessage(ctx, msg); err != nil {
+			t.Errorf("HandleMessage() error = %v, wantErr false", err)
+		}
+		if repo.NotificationInvoked {
+			t.Errorf("HandleMessage() notification lookup invoked with no subscriptions")
+		}
+	})
+
+	t.Run("no message id", func(t *testing.T) {
+		s := notifying.NewService(&mock.NotifyingRepository{}, nil)
+		if err := s.HandleMessage(ctx, broker.Message{Topic: broker.TopicUserCreated}); err == nil {
+			t.Errorf("HandleMessage() error == nil, wantErr true")
+		}
+	})
+}
+
+func Test_service_Notifications(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := &mock.NotifyingRepository{}
+	var gotAmount, gotSkip int
+	repo.NotificationsFn = func(ctx context.Context, filters notifying.NotificationFilters, amount,
+		skip int) ([]*notifsvc.Notification, int, error) {
+		gotAmount, gotSkip = amount, skip
+		return nil, 0, nil
+	}
+	s := notifying.NewService(repo, nil)
+	tests := []struct {
+		name       string
+		amount     int
+		skip       int
+		wantAmount int
+		wantSkip   int
+	}{
+		{name: "defaults", amount: 0, skip: -1, wantAmount: notifying.DefaultAmount, wantSkip: 0},
+		{name: "too many", amount: notifying.MaxAmount + 1, skip: 0, wantAmount: notifying.DefaultAmount},
+		{name: "ok", amount: 10, skip: 5, wantAmount: 10, wantSkip: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := s.Notifications(ctx, notifying.NotificationFilters{}, tt.amount, tt.skip); err != nil {
+				t.Errorf("Notifications() error = %v, wantErr false", err)
+			}
+			if gotAmount != tt.wantAmount || gotSkip != tt.wantSkip {
+				t.Errorf("Notifications() amount, skip = %d, %d, want %d, %d", gotAmount, gotSkip, tt.wantAmount,
+					tt.wantSkip)
+			}
+		})
+	}
+}
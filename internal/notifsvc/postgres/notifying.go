@@ -0,0 +1,247 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/jmoiron/sqlx"
+	"github.com/shanvl/garbage/internal/notifsvc"
+	"github.com/shanvl/garbage/internal/notifsvc/notifying"
+)
+
+type notifyingRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewNotifyingRepo(db *pgxpool.Pool) notifying.Repository {
+	return &notifyingRepo{db}
+}
+
+const deleteSubscriptionQuery = `
+	delete from subscription
+	where id = $1;
+`
+
+// DeleteSubscription deletes the subscription and all its notifications
+func (r *notifyingRepo) DeleteSubscription(ctx context.Context, id string) error {
+	tag, err := r.db.Exec(ctx, deleteSubscriptionQuery, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return notifsvc.ErrUnknownSubscription
+	}
+	return nil
+}
+
+const notificationQuery = `
+	select id, message_id, subscription_id, topic, channel, target, subject, body, status, error, created_at, sent_at
+	from notification
+	where message_id = $1
+	  and subscription_id = $2;
+`
+
+// Notification returns the notification of the message for the subscription
+func (r *notifyingRepo) Notification(ctx context.Context, messageID,
+	subscriptionID string) (*notifsvc.Notification, error) {
+
+	n, err := scanNotification(r.db.QueryRow(ctx, notificationQuery, messageID, subscriptionID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, notifsvc.ErrUnknownNotification
+		}
+		return nil, err
+	}
+	return n, nil
+}
+
+const notificationsQuery = `
+	with query as (
+		select id, message_id, subscription_id, topic, channel, target, subject, body, status, error, created_at,
+			   sent_at
+		from notification
+		where 1 = 1 %s
+	), pagination as (
+		select *
+		from query
+		order by created_at desc, id
+		limit ? offset ?
+	)
+	select *
+	from pagination
+			 right join (select count(*) from query) as c(total) on true;
+`
+
+// Notifications returns a list of the notifications sorted by the date of creation, the newest first
+func (r *notifyingRepo) Notifications(ctx context.Context, filters notifying.NotificationFilters, amount,
+	skip int) ([]*notifsvc.Notification, int, error) {
+
+	// create the "where" part of the query
+	where := strings.Builder{}
+	var args []interface{}
+	if filters.Topic != "" {
+		where.WriteString("and topic = ? ")
+		args = append(args, filters.Topic)
+	}
+	if len(filters.Channels) > 0 {
+		channels := make([]string, len(filters.Channels))
+		for i, c := range filters.Channels {
+			channels[i] = c.String()
+		}
+		where.WriteString("and channel = any (?::text[]::channel[]) ")
+		args = append(args, channels)
+	}
+	if len(filters.Statuses) > 0 {
+		statuses := make([]string, len(filters.Statuses))
+		for i, s := range filters.Statuses {
+			statuses[i] = s.String()
+		}
+		where.WriteString("and status = any (?::text[]::notification_status[]) ")
+		args = append(args, statuses)
+	}
+	// add limit and offset
+	args = append(args, amount, skip)
+	// embed the "where" part to the query and change "?" to "$"
+	q := sqlx.Rebind(sqlx.BindType("pgx"), fmt.Sprintf(notificationsQuery, where.String()))
+
+	rows, err := r.db.Query(ctx, q, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	// "total" column will always be returned, so other columns might be null
+	var (
+		id, msgID, subID, topic, channel, target, subject, body, status, nErr pgtype.Text
+		createdAt, sentAt                                                     pgtype.Timestamptz
+		total                                                                 int
+	)
+	notifications := []*notifsvc.Notification{}
+	for rows.Next() {
+		err := rows.Scan(&id, &msgID, &subID, &topic, &channel, &target, &subject, &body, &status, &nErr,
+			&createdAt, &sentAt, &total)
+		if err != nil {
+			return nil, 0, err
+		}
+		// next will happen if the offset >= total rows found or no notifications matching the provided criteria have
+		// been found. In that case we simply return total w/o additional work
+		if id.Status != pgtype.Present {
+			return notifications, total, nil
+		}
+		n := &notifsvc.Notification{
+			ID:             id.String,
+			MessageID:      msgID.String,
+			SubscriptionID: subID.String,
+			Topic:          topic.String,
+			Target:         target.String,
+			Subject:        subject.String,
+			Body:           body.String,
+			Error:          nErr.String,
+			CreatedAt:      createdAt.Time,
+			SentAt:         sentAt.Time,
+		}
+		if n.Channel, err = notifsvc.StringToChannel(channel.String); err != nil {
+			return nil, 0, err
+		}
+		if n.Status, err = notifsvc.StringToStatus(status.String); err != nil {
+			return nil, 0, err
+		}
+		notifications = append(notifications, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return notifications, total, nil
+}
+
+const storeNotificationQuery = `
+	insert into notification (id, message_id, subscription_id, topic, channel, target, subject, body, status, error,
+							  created_at, sent_at)
+	values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	on conflict (id) do update
+		set (status, error, sent_at) = ($9, $10, $12);
+`
+
+// StoreNotification upserts the notification. Only the result of the sending attempt is updated on conflict
+func (r *notifyingRepo) StoreNotification(ctx context.Context, n *notifsvc.Notification) error {
+	sentAt := pgtype.Timestamptz{Status: pgtype.Null}
+	if !n.SentAt.IsZero() {
+		sentAt = pgtype.Timestamptz{Time: n.SentAt, Status: pgtype.Present}
+	}
+	_, err := r.db.Exec(ctx, storeNotificationQuery, n.ID, n.MessageID, n.SubscriptionID, n.Topic, n.Channel.String(),
+		n.Target, n.Subject, n.Body, n.Status.String(), n.Error, n.CreatedAt, sentAt)
+	return err
+}
+
+const storeSubscriptionQuery = `
+	insert into subscription (id, topic, channel, target)
+	values ($1, $2, $3, $4)
+	on conflict (id) do update
+		set (topic, channel, target) = ($2, $3, $4);
+`
+
+// StoreSubscription upserts the subscription
+func (r *notifyingRepo) StoreSubscription(ctx context.Context, sub *notifsvc.Subscription) error {
+	_, err := r.db.Exec(ctx, storeSubscriptionQuery, sub.ID, sub.Topic, sub.Channel.String(), sub.Target)
+	return err
+}
+
+const subscriptionsQuery = `
+	select id, topic, channel, target
+	from subscription
+	where $1 = '' or topic = $1
+	order by topic, channel, target;
+`
+
+// Subscriptions returns the subscriptions to the topic or all the subscriptions if the topic is empty
+func (r *notifyingRepo) Subscriptions(ctx context.Context, topic string) ([]*notifsvc.Subscription, error) {
+	rows, err := r.db.Query(ctx, subscriptionsQuery, topic)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	subs := []*notifsvc.Subscription{}
+	for rows.Next() {
+		var (
+			sub     = &notifsvc.Subscription{}
+			channel string
+		)
+		if err := rows.Scan(&sub.ID, &sub.Topic, &channel, &sub.Target); err != nil {
+			return nil, err
+		}
+		if sub.Channel, err = notifsvc.StringToChannel(channel); err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
+
+// scanNotification scans a single notification row
+func scanNotification(row pgx.Row) (*notifsvc.Notification, error) {
+	var (
+		n               = &notifsvc.Notification{}
+		channel, status string
+		sentAt          pgtype.Timestamptz
+	)
+	err := row.Scan(&n.ID, &n.MessageID, &n.SubscriptionID, &n.Topic, &channel, &n.Target, &n.Subject, &n.Body,
+		&status, &n.Error, &n.CreatedAt, &sentAt)
+	if err != nil {
+		return nil, err
+	}
+	if n.Channel, err = notifsvc.StringToChannel(channel); err != nil {
+		return nil, err
+	}
+	if n.Status, err = notifsvc.StringToStatus(status); err != nil {
+		return nil, err
+	}
+	n.SentAt = sentAt.Time
+	return n, nil
+}
@@ -0,0 +1,135 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shanvl/garbage/internal/notifsvc"
+	"github.com/shanvl/garbage/internal/notifsvc/notifying"
+	"github.com/shanvl/garbage/internal/notifsvc/postgres"
+	"github.com/shanvl/garbage/pkg/broker"
+)
+
+func TestNotifyingRepo_Subscriptions(t *testing.T) {
+	r := postgres.NewNotifyingRepo(db)
+	ctx := context.Background()
+	subs := []*notifsvc.Subscription{
+		{ID: "sub_test_1", Topic: broker.TopicEventCreated, Channel: notifsvc.Email, Target: "a@b.c"},
+		{ID: "sub_test_2", Topic: broker.TopicUserCreated, Channel: notifsvc.Webhook, Target: "http://host"},
+	}
+	for _, sub := range subs {
+		if err := r.StoreSubscription(ctx, sub); err != nil {
+			t.Fatalf("StoreSubscription() error = %v", err)
+		}
+		defer r.DeleteSubscription(ctx, sub.ID)
+	}
+	t.Run("by topic", func(t *testing.T) {
+		got, err := r.Subscriptions(ctx, broker.TopicEventCreated)
+		if err != nil {
+			t.Fatalf("Subscriptions() error = %v", err)
+		}
+		if !containsSubscription(got, subs[0]) || containsSubscription(got, subs[1]) {
+			t.Errorf("Subscriptions() = %v, want only the subscriptions to %s", got, broker.TopicEventCreated)
+		}
+	})
+	t.Run("all", func(t *testing.T) {
+		got, err := r.Subscriptions(ctx, "")
+		if err != nil {
+			t.Fatalf("Subscriptions() error = %v", err)
+		}
+		if !containsSubscription(got, subs[0]) || !containsSubscription(got, subs[1]) {
+			t.Errorf("Subscriptions() = %v, want all the subscriptions", got)
+		}
+	})
+	t.Run("deleted", func(t *testing.T) {
+		if err := r.DeleteSubscription(ctx, subs[1].ID); err != nil {
+			t.Fatalf("DeleteSubscription() error = %v", err)
+		}
+		got, err := r.Subscriptions(ctx, broker.TopicUserCreated)
+		if err != nil {
+			t.Fatalf("Subscriptions() error = %v", err)
+		}
+		if containsSubscription(got, subs[1]) {
+			t.Errorf("Subscriptions() = %v, deleted subscription is returned", got)
+		}
+	})
+}
+
+func TestNotifyingRepo_Notifications(t *testing.T) {
+	r := postgres.NewNotifyingRepo(db)
+	ctx := context.Background()
+	sub := &notifsvc.Subscription{ID: "sub_test_3", Topic: broker.TopicPupilsAdded, Channel: notifsvc.Log,
+		Target: "log"}
+	if err := r.StoreSubscription(ctx, sub); err != nil {
+		t.Fatalf("StoreSubscription() error = %v", err)
+	}
+	defer r.DeleteSubscription(ctx, sub.ID)
+
+	n := notifsvc.NewNotification("notif_test_1", "msg_test_1", "subject", "body", sub)
+	if err := r.StoreNotification(ctx, n); err != nil {
+		t.Fatalf("StoreNotification() error = %v", err)
+	}
+	t.Run("unknown notification", func(t *testing.T) {
+		if _, err := r.Notification(ctx, "unknown", sub.ID); !errors.Is(err, notifsvc.ErrUnknownNotification) {
+			t.Errorf("Notification() error = %v, want ErrUnknownNotification", err)
+		}
+	})
+	t.Run("pending notification", func(t *testing.T) {
+		got, err := r.Notification(ctx, n.MessageID, sub.ID)
+		if err != nil {
+			t.Fatalf("Notification() error = %v", err)
+		}
+		if got.ID != n.ID || got.Status != notifsvc.Pending || !got.SentAt.IsZero() {
+			t.Errorf("Notification() = %+v, want %+v", got, n)
+		}
+	})
+	t.Run("sent notification", func(t *testing.T) {
+		n.MarkSent()
+		if err := r.StoreNotification(ctx, n); err != nil {
+			t.Fatalf("StoreNotification() error = %v", err)
+		}
+		got, err := r.Notification(ctx, n.MessageID, sub.ID)
+		if err != nil {
+			t.Fatalf("Notification() error = %v", err)
+		}
+		if got.Status != notifsvc.Sent || got.SentAt.Sub(n.SentAt) > time.Millisecond {
+			t.Errorf("Notification() = %+v, want sent", got)
+		}
+	})
+	t.Run("filters", func(t *testing.T) {
+		got, total, err := r.Notifications(ctx, notifying.NotificationFilters{
+			Topic:    broker.TopicPupilsAdded,
+			Channels: []notifsvc.Channel{notifsvc.Log},
+			Statuses: []notifsvc.Status{notifsvc.Sent},
+		}, 10, 0)
+		if err != nil {
+			t.Fatalf("Notifications() error = %v", err)
+		}
+		if total < 1 || len(got) < 1 {
+			t.Errorf("Notifications() total = %d, len = %d, want at least 1", total, len(got))
+		}
+		got, total, err = r.Notifications(ctx, notifying.NotificationFilters{
+			Topic:    broker.TopicPupilsAdded,
+			Statuses: []notifsvc.Status{notifsvc.Failed},
+		}, 10, 0)
+		if err != nil {
+			t.Fatalf("Notifications() error = %v", err)
+		}
+		for _, n := range got {
+			if n.Status != notifsvc.Failed {
+				t.Errorf("Notifications() returned notification with status %s, want failed", n.Status)
+			}
+		}
+	})
+}
+
+func containsSubscription(subs []*notifsvc.Subscription, sub *notifsvc.Subscription) bool {
+	for _, s := range subs {
+		if *s == *sub {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// a query to create tables and indices if they don't already exist.
+// Can be extracted into a separate sql file and used in conjunction with migration tools
+const schema = `
+-- create channel enum type
+do
+$$
+    begin
+        if not exists(select 1 from pg_type where typname = 'channel') then
+            create type channel as enum ('email', 'log', 'webhook');
+        end if;
+    end
+$$;
+
+-- create notification_status enum type
+do
+$$
+    begin
+        if not exists(select 1 from pg_type where typname = 'notification_status') then
+            create type notification_status as enum ('failed', 'pending', 'sent');
+        end if;
+    end
+$$;
+
+-- create subscription table
+create table if not exists subscription
+(
+    id      varchar(25)  primary key,
+    topic   varchar(50)  not null,
+    channel channel      not null,
+    target  varchar(255) not null
+);
+
+create index if not exists subscription_topic_idx on subscription (topic);
+
+-- create notification table. There can be only one notification per message and subscription
+create table if not exists notification
+(
+    id              varchar(25)         primary key,
+    message_id      varchar(25)         not null,
+    subscription_id varchar(25)         not null,
+    topic           varchar(50)         not null,
+    channel         channel             not null,
+    target          varchar(255)        not null,
+    subject         text                not null,
+    body            text                not null,
+    status          notification_status not null,
+    error           text                not null default '',
+    created_at      timestamptz         not null,
+    sent_at         timestamptz,
+    unique (message_id, subscription_id),
+    foreign key (subscription_id) references subscription (id)
+        on delete cascade
+        on update cascade
+);
+
+create index if not exists notification_created_at_idx on notification (created_at desc);
+create index if not exists notification_topic_created_at_idx on notification (topic, created_at desc);
+`
+
+// ValidateSchema creates tables and indices if they don't already exist
+func ValidateSchema(ctx context.Context, db *pgxpool.Pool) error {
+	if _, err := db.Exec(ctx, schema); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Package sender contains the implementations of notifying.Sender for every channel
+package sender
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/shanvl/garbage/internal/notifsvc"
+	"github.com/shanvl/garbage/internal/notifsvc/notifying"
+)
+
+// EmailConfig allows to configure the smtp server used to send emails
+type EmailConfig struct {
+	Host, User, Password string
+	Port                 int
+	// From is the address the emails are sent from
+	From string
+}
+
+type email struct {
+	conf EmailConfig
+}
+
+// NewEmail returns a sender which sends the notifications as emails through the smtp server
+func NewEmail(conf EmailConfig) notifying.Sender {
+	return &email{conf}
+}
+
+// Send sends the notification to the email address of its target
+func (e *email) Send(_ context.Context, n *notifsvc.Notification) error {
+	var auth smtp.Auth
+	if e.conf.User != "" {
+		auth = smtp.PlainAuth("", e.conf.User, e.conf.Password, e.conf.Host)
+	}
+	addr := net.JoinHostPort(e.conf.Host, fmt.Sprint(e.conf.Port))
+	err := smtp.SendMail(addr, auth, e.conf.From, []string{n.Target}, emailMessage(e.conf.From, n))
+	if err != nil {
+		return fmt.Errorf("send email to %s: %w", n.Target, err)
+	}
+	return nil
+}
+
+// emailMessage builds an RFC 822 message from the notification
+func emailMessage(from string, n *notifsvc.Notification) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + n.Target + "\r\n")
+	b.WriteString("Subject: " + n.Subject + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(n.Body + "\r\n")
+	return []byte(b.String())
+}
@@ -0,0 +1,31 @@
+package sender
+
+import (
+	"context"
+
+	"github.com/shanvl/garbage/internal/notifsvc"
+	"github.com/shanvl/garbage/internal/notifsvc/notifying"
+	"go.uber.org/zap"
+)
+
+type log struct {
+	log *zap.Logger
+}
+
+// NewLog returns a sender which writes the notifications to the log. It's useful for debugging
+func NewLog(logger *zap.Logger) notifying.Sender {
+	return &log{logger}
+}
+
+// Send writes the notification to the log
+func (l *log) Send(_ context.Context, n *notifsvc.Notification) error {
+	l.log.Info("notification",
+		zap.String("id", n.ID),
+		zap.String("message_id", n.MessageID),
+		zap.String("topic", n.Topic),
+		zap.String("target", n.Target),
+		zap.String("subject", n.Subject),
+		zap.String("body", n.Body),
+	)
+	return nil
+}
@@ -0,0 +1,72 @@
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shanvl/garbage/internal/notifsvc"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func Test_webhook_Send(t *testing.T) {
+	var got webhookBody
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+	s := NewWebhook(time.Second)
+	tests := []struct {
+		name    string
+		target  string
+		wantErr bool
+	}{
+		{name: "ok", target: srv.URL + "/ok", wantErr: false},
+		{name: "non 2xx status", target: srv.URL + "/fail", wantErr: true},
+		{name: "invalid url", target: "http://[::1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := &notifsvc.Notification{ID: "id", MessageID: "msg", Topic: "topic", Target: tt.target, Body: "body"}
+			err := s.Send(context.Background(), n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (got.ID != n.ID || got.MessageID != n.MessageID || got.Body != n.Body) {
+				t.Errorf("Send() posted = %+v, want the notification", got)
+			}
+		})
+	}
+}
+
+func Test_log_Send(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	s := NewLog(zap.New(core))
+	if err := s.Send(context.Background(), &notifsvc.Notification{ID: "id", Body: "body"}); err != nil {
+		t.Errorf("Send() error = %v, wantErr false", err)
+	}
+	if logs.Len() != 1 || logs.All()[0].ContextMap()["body"] != "body" {
+		t.Errorf("Send() logged %d entries, want 1 with the body of the notification", logs.Len())
+	}
+}
+
+func Test_emailMessage(t *testing.T) {
+	n := &notifsvc.Notification{Target: "to@b.c", Subject: "subject", Body: "body"}
+	msg := string(emailMessage("from@b.c", n))
+	for _, want := range []string{"From: from@b.c\r\n", "To: to@b.c\r\n", "Subject: subject\r\n", "\r\n\r\nbody\r\n"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("emailMessage() = %q, want it to contain %q", msg, want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/shanvl/garbage/internal/notifsvc"
+	"github.com/shanvl/garbage/internal/notifsvc/notifying"
+)
+
+type webhook struct {
+	client *http.Client
+}
+
+// NewWebhook returns a sender which posts the notifications as json to the urls of their targets
+func NewWebhook(timeout time.Duration) notifying.Sender {
+	return &webhook{&http.Client{Timeout: timeout}}
+}
+
+// webhookBody is the json body of the request sent to a webhook
+type webhookBody struct {
+	ID        string    `json:"id"`
+	MessageID string    `json:"messageId"`
+	Topic     string    `json:"topic"`
+	Subject   string    `json:"subject"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Send posts the notification to the url of its target. Any response with a non 2xx status is considered an error
+func (w *webhook) Send(ctx context.Context, n *notifsvc.Notification) error {
+	body, err := json.Marshal(webhookBody{
+		ID:        n.ID,
+		MessageID: n.MessageID,
+		Topic:     n.Topic,
+		Subject:   n.Subject,
+		Body:      n.Body,
+		CreatedAt: n.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.Target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post webhook %s: %w", n.Target, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("post webhook %s: unexpected status %d", n.Target, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+// Package audit records who has changed what in the services. Every successful call of a mutating RPC produces an
+// entry holding the user who has made the call, the ids of the changed entities and their states before and after
+// the call
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	gonanoid "github.com/matoous/go-nanoid"
+	"github.com/shanvl/garbage/pkg/valid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultAmount = 50
+	MaxAmount     = 1000
+	// redacted replaces the values of the secrets in the recorded states
+	redacted = "[redacted]"
+)
+
+// Entry is a record of a single change
+type Entry struct {
+	ID string
+	// ActorID is the id of the user who has made the change. It's empty if the RPC doesn't require authentication
+	ActorID string
+	// ClientID is the id of the client the change has been made from
+	ClientID string
+	// Method is the full name of the RPC, e.g. "/shanvl.garbage.events.v1.EventsService/DeleteEvent"
+	Method string
+	// TargetIDs are the ids of the changed entities
+	TargetIDs []string
+	// Before and After are the json encoded states of the targets before and after the change. Before is nil if the
+	// change has created the targets or their previous state is unknown. After is nil if the change has deleted them
+	Before     []byte
+	After      []byte
+	OccurredAt time.Time
+}
+
+// NewEntry creates an entry with a unique id. The states are encoded to json with the secrets, such as passwords and
+// tokens, redacted. The states may be proto messages
+func NewEntry(actorID, clientID, method string, targetIDs []string, before, after interface{}) (Entry, error) {
+	id, err := gonanoid.Nanoid()
+	if err != nil {
+		return Entry{}, err
+	}
+	b, err := encodeState(before)
+	if err != nil {
+		return Entry{}, fmt.Errorf("encode state before: %w", err)
+	}
+	a, err := encodeState(after)
+	if err != nil {
+		return Entry{}, fmt.Errorf("encode state after: %w", err)
+	}
+	return Entry{
+		ID:         id,
+		ActorID:    actorID,
+		ClientID:   clientID,
+		Method:     method,
+		TargetIDs:  targetIDs,
+		Before:     b,
+		After:      a,
+		OccurredAt: time.Now().UTC(),
+	}, nil
+}
+
+// encodeState encodes the state to json and redacts the secrets in it
+func encodeState(state interface{}) ([]byte, error) {
+	if state == nil {
+		return nil, nil
+	}
+	var (
+		b   []byte
+		err error
+	)
+	if m, ok := state.(proto.Message); ok {
+		b, err = protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	} else {
+		b, err = json.Marshal(state)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(redact(v))
+}
+
+// redact replaces the values of the object keys which look like they hold secrets
+func redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			key := strings.ToLower(k)
+			if strings.Contains(key, "password") || strings.Contains(key, "token") {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redact(val)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redact(val)
+		}
+	}
+	return v
+}
+
+// Filters are used to filter the entries
+type Filters struct {
+	ActorID string
+	Method  string
+	// TargetID filters the entries which have changed the entity with the given id
+	TargetID string
+	// From and To limit the time the entries have occurred at. Zero values mean no limit
+	From time.Time
+	To   time.Time
+}
+
+// Repository is a repo required by Service
+type Repository interface {
+	// Entries returns the entries passing the filters, the latest first, and the total number of such entries
+	Entries(ctx context.Context, filters Filters, amount, skip int) ([]Entry, int, error)
+	StoreEntry(ctx context.Context, entry Entry) error
+}
+
+// Service records the changes and lets find them
+type Service interface {
+	// Entries returns the entries passing the filters, the latest first, and the total number of such entries
+	Entries(ctx context.Context, filters Filters, amount, skip int) (entries []Entry, total int, err error)
+	// Record stores the entry
+	Record(ctx context.Context, entry Entry) error
+}
+
+type service struct {
+	repo Repository
+}
+
+// NewService returns an instance of Service with all its dependencies
+func NewService(repo Repository) Service {
+	return &service{repo}
+}
+
+// Entries returns the entries passing the filters, the latest first, and the total number of such entries
+func (s *service) Entries(ctx context.Context, filters Filters, amount, skip int) ([]Entry, int, error) {
+	if !filters.From.IsZero() && !filters.To.IsZero() && filters.From.After(filters.To) {
+		return nil, 0, valid.NewError("from", "from can't be after to")
+	}
+	// validate amount and skip
+	if amount <= 0 || amount > MaxAmount {
+		amount = DefaultAmount
+	}
+	if skip < 0 {
+		skip = 0
+	}
+	return s.repo.Entries(ctx, filters, amount, skip)
+}
+
+// Record stores the entry
+func (s *service) Record(ctx context.Context, entry Entry) error {
+	validErr := valid.EmptyError()
+	if entry.ID == "" {
+		validErr.Add("id", "id is required")
+	}
+	if entry.Method == "" {
+		validErr.Add("method", "method is required")
+	}
+	if entry.OccurredAt.IsZero() {
+		validErr.Add("occurredAt", "occurredAt is required")
+	}
+	if !validErr.IsEmpty() {
+		return validErr
+	}
+	return s.repo.StoreEntry(ctx, entry)
+}
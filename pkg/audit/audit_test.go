@@ -0,0 +1,214 @@
+package audit_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
+	"github.com/shanvl/garbage/pkg/audit"
+	"github.com/shanvl/garbage/pkg/valid"
+)
+
+// testRepo is an in-memory audit.Repository
+type testRepo struct {
+	entries       []audit.Entry
+	amount, skip  int
+	filters       audit.Filters
+	entriesCalled bool
+}
+
+func (r *testRepo) Entries(_ context.Context, filters audit.Filters, amount, skip int) ([]audit.Entry, int, error) {
+	r.entriesCalled = true
+	r.filters, r.amount, r.skip = filters, amount, skip
+	return r.entries, len(r.entries), nil
+}
+
+func (r *testRepo) StoreEntry(_ context.Context, entry audit.Entry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func TestNewEntry(t *testing.T) {
+	t.Parallel()
+	type profile struct {
+		Name         string
+		PasswordHash string
+		Sessions     []struct{ RefreshToken string }
+	}
+	tests := []struct {
+		name       string
+		before     interface{}
+		after      interface{}
+		wantBefore map[string]interface{}
+		wantAfter  map[string]interface{}
+	}{
+		{
+			name:      "created",
+			before:    nil,
+			after:     profile{Name: "name"},
+			wantAfter: map[string]interface{}{"Name": "name", "PasswordHash": "[redacted]", "Sessions": nil},
+		},
+		{
+			name:   "nested secrets",
+			before: profile{Name: "old", Sessions: []struct{ RefreshToken string }{{"token"}}},
+			after:  nil,
+			wantBefore: map[string]interface{}{
+				"Name":         "old",
+				"PasswordHash": "[redacted]",
+				"Sessions": []interface{}{
+					map[string]interface{}{"RefreshToken": "[redacted]"},
+				},
+			},
+		},
+		{
+			name:       "proto message",
+			before:     nil,
+			after:      &authv1pb.ResetPasswordRequest{ResetToken: "token", Password: "password"},
+			wantBefore: nil,
+			wantAfter:  map[string]interface{}{"reset_token": "[redacted]", "password": "[redacted]"},
+		},
+		{
+			name:      "proto field names",
+			after:     &authv1pb.SetUserPupilsRequest{Id: "id", PupilIds: []string{"p1"}},
+			wantAfter: map[string]interface{}{"id": "id", "pupil_ids": []interface{}{"p1"}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := audit.NewEntry("actor", "client", "/pkg.Service/Method", []string{"target"}, tt.before,
+				tt.after)
+			if err != nil {
+				t.Fatalf("NewEntry() error = %v", err)
+			}
+			if got.ID == "" || got.OccurredAt.IsZero() {
+				t.Errorf("NewEntry() id = %q, occurredAt = %v, want them set", got.ID, got.OccurredAt)
+			}
+			if gotBefore := decode(t, got.Before); !reflect.DeepEqual(gotBefore, tt.wantBefore) {
+				t.Errorf("NewEntry() before = %v, want %v", gotBefore, tt.wantBefore)
+			}
+			if gotAfter := decode(t, got.After); !reflect.DeepEqual(gotAfter, tt.wantAfter) {
+				t.Errorf("NewEntry() after = %v, want %v", gotAfter, tt.wantAfter)
+			}
+		})
+	}
+}
+
+func Test_service_Entries(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	tests := []struct {
+		name       string
+		filters    audit.Filters
+		amount     int
+		skip       int
+		wantAmount int
+		wantSkip   int
+		wantErr    bool
+	}{
+		{
+			name:       "ok",
+			filters:    audit.Filters{ActorID: "actor", From: now.Add(-time.Hour), To: now},
+			amount:     10,
+			skip:       5,
+			wantAmount: 10,
+			wantSkip:   5,
+		},
+		{
+			name:       "defaults",
+			amount:     0,
+			skip:       -1,
+			wantAmount: audit.DefaultAmount,
+			wantSkip:   0,
+		},
+		{
+			name:       "too many",
+			amount:     audit.MaxAmount + 1,
+			wantAmount: audit.DefaultAmount,
+		},
+		{
+			name:    "from after to",
+			filters: audit.Filters{From: now, To: now.Add(-time.Hour)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := &testRepo{}
+			_, _, err := audit.NewService(repo).Entries(context.Background(), tt.filters, tt.amount, tt.skip)
+			if tt.wantErr {
+				var validErr *valid.ErrValidation
+				if !errors.As(err, &validErr) {
+					t.Errorf("Entries() error = %v, want a validation error", err)
+				}
+				if repo.entriesCalled {
+					t.Error("Entries() repo has been called on invalid filters")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Entries() error = %v", err)
+			}
+			if repo.amount != tt.wantAmount || repo.skip != tt.wantSkip {
+				t.Errorf("Entries() amount, skip = %d, %d, want %d, %d", repo.amount, repo.skip, tt.wantAmount,
+					tt.wantSkip)
+			}
+			if !reflect.DeepEqual(repo.filters, tt.filters) {
+				t.Errorf("Entries() filters = %+v, want %+v", repo.filters, tt.filters)
+			}
+		})
+	}
+}
+
+func Test_service_Record(t *testing.T) {
+	t.Parallel()
+	entry, err := audit.NewEntry("actor", "client", "/pkg.Service/Method", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewEntry() error = %v", err)
+	}
+	noMethod := entry
+	noMethod.Method = ""
+	tests := []struct {
+		name    string
+		entry   audit.Entry
+		wantErr bool
+	}{
+		{name: "ok", entry: entry},
+		{name: "no method", entry: noMethod, wantErr: true},
+		{name: "empty", entry: audit.Entry{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := &testRepo{}
+			err := audit.NewService(repo).Record(context.Background(), tt.entry)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Record() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if stored := len(repo.entries) == 1; stored == tt.wantErr {
+				t.Errorf("Record() stored = %v, want %v", stored, !tt.wantErr)
+			}
+		})
+	}
+}
+
+// decode decodes the json state, which is nil if there's no state
+func decode(t *testing.T, b []byte) map[string]interface{} {
+	t.Helper()
+	if b == nil {
+		return nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatalf("couldn't decode the state %s: %v", b, err)
+	}
+	return m
+}
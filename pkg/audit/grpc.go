@@ -0,0 +1,109 @@
+package audit
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Spec describes how the calls of a mutating RPC are audited
+type Spec struct {
+	// Snapshot returns the state of the targets of the call. It's taken before and after the call, so that the entry
+	// shows what has changed. If it's nil, the request itself is recorded as the state after the call
+	Snapshot func(ctx context.Context, req interface{}) (interface{}, error)
+	// Targets returns the ids of the entities targeted by the call. If it's nil, the ids are found by TargetIDs
+	Targets func(ctx context.Context, req, resp interface{}) []string
+}
+
+// ActorFunc returns the ids of the user and the client who have made the call
+type ActorFunc func(ctx context.Context) (userID, clientID string)
+
+// UnaryServerInterceptor records the successful calls of the RPCs described by the specs. It must be chained after
+// the interceptor which puts the user's claims into the context, so that the actor and their scope are known.
+// A failure to record the call is logged, but doesn't fail the call, since the change has already been made
+func UnaryServerInterceptor(svc Service, specs map[string]Spec, actor ActorFunc,
+	log *zap.Logger) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		spec, ok := specs[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		var before interface{}
+		if spec.Snapshot != nil {
+			// the handler will most likely fail if the state can't be taken
+			before = snapshot(ctx, spec, req)
+		}
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		after := req
+		if spec.Snapshot != nil {
+			// the targets might have been deleted, in that case there's no state after the call
+			after = snapshot(ctx, spec, req)
+		}
+		var targetIDs []string
+		if spec.Targets != nil {
+			targetIDs = spec.Targets(ctx, req, resp)
+		} else {
+			targetIDs = TargetIDs(req, resp)
+		}
+		userID, clientID := actor(ctx)
+		entry, rErr := NewEntry(userID, clientID, info.FullMethod, targetIDs, before, after)
+		if rErr == nil {
+			rErr = svc.Record(ctx, entry)
+		}
+		if rErr != nil {
+			log.Error("couldn't record the audit entry", zap.String("method", info.FullMethod), zap.Error(rErr))
+		}
+		return resp, nil
+	}
+}
+
+// snapshot takes the snapshot of the call's targets. It returns nil if the snapshot can't be taken, which means the
+// state is unknown
+func snapshot(ctx context.Context, spec Spec, req interface{}) interface{} {
+	state, err := spec.Snapshot(ctx, req)
+	if err != nil {
+		return nil
+	}
+	return state
+}
+
+// TargetIDs returns the ids of the entities targeted by the call. The ids are taken from the request, or from the
+// response if the request has none, e.g. when an entity is created
+func TargetIDs(req, resp interface{}) []string {
+	if ids := messageIDs(req); len(ids) > 0 {
+		return ids
+	}
+	return messageIDs(resp)
+}
+
+// messageIDs returns the ids held by the message's id fields. The single ids take precedence over the lists of ids,
+// since the latter are the payload of the calls which have a single target, e.g. the pupils linked to a user
+func messageIDs(m interface{}) []string {
+	var ids []string
+	if m, ok := m.(interface{ GetId() string }); ok && m.GetId() != "" {
+		ids = append(ids, m.GetId())
+	}
+	if m, ok := m.(interface{ GetEventId() string }); ok && m.GetEventId() != "" {
+		ids = append(ids, m.GetEventId())
+	}
+	if m, ok := m.(interface{ GetPupilId() string }); ok && m.GetPupilId() != "" {
+		ids = append(ids, m.GetPupilId())
+	}
+	if m, ok := m.(interface{ GetUserId() string }); ok && m.GetUserId() != "" {
+		ids = append(ids, m.GetUserId())
+	}
+	if len(ids) > 0 {
+		return ids
+	}
+	if m, ok := m.(interface{ GetPupilIds() []string }); ok {
+		ids = append(ids, m.GetPupilIds()...)
+	}
+	return ids
+}
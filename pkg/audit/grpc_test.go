@@ -0,0 +1,189 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	authv1pb "github.com/shanvl/garbage/api/auth/v1/pb"
+	eventsv1pb "github.com/shanvl/garbage/api/events/v1/pb"
+	"github.com/shanvl/garbage/pkg/audit"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+	const (
+		roleMethod   = "/shanvl.garbage.auth.v1.AuthService/ChangeUserRole"
+		deleteMethod = "/shanvl.garbage.auth.v1.AuthService/DeleteUser"
+		createMethod = "/shanvl.garbage.auth.v1.AuthService/CreateUser"
+		findMethod   = "/shanvl.garbage.auth.v1.AuthService/FindUser"
+	)
+	// role is the state of the user changed by the handlers
+	role := "ROLE_MEMBER"
+	deleted := false
+	snapshot := func(_ context.Context, req interface{}) (interface{}, error) {
+		if deleted {
+			return nil, errors.New("unknown user")
+		}
+		return map[string]string{"id": req.(*authv1pb.ChangeUserRoleRequest).GetId(), "role": role}, nil
+	}
+	specs := map[string]audit.Spec{
+		roleMethod:   {Snapshot: snapshot},
+		deleteMethod: {Snapshot: snapshot},
+		createMethod: {},
+	}
+	actor := func(_ context.Context) (string, string) { return "actorid", "clientid" }
+	tests := []struct {
+		name       string
+		method     string
+		req        interface{}
+		handler    grpc.UnaryHandler
+		wantErr    bool
+		wantEntry  bool
+		wantTarget []string
+		wantBefore map[string]interface{}
+		wantAfter  map[string]interface{}
+	}{
+		{
+			name:   "changed",
+			method: roleMethod,
+			req:    &authv1pb.ChangeUserRoleRequest{Id: "userid", Role: authv1pb.Role_ROLE_ADMIN},
+			handler: func(_ context.Context, _ interface{}) (interface{}, error) {
+				role = "ROLE_ADMIN"
+				return nil, nil
+			},
+			wantEntry:  true,
+			wantTarget: []string{"userid"},
+			wantBefore: map[string]interface{}{"id": "userid", "role": "ROLE_MEMBER"},
+			wantAfter:  map[string]interface{}{"id": "userid", "role": "ROLE_ADMIN"},
+		},
+		{
+			name:   "deleted",
+			method: deleteMethod,
+			req:    &authv1pb.ChangeUserRoleRequest{Id: "userid"},
+			handler: func(_ context.Context, _ interface{}) (interface{}, error) {
+				deleted = true
+				return nil, nil
+			},
+			wantEntry:  true,
+			wantTarget: []string{"userid"},
+			wantBefore: map[string]interface{}{"id": "userid", "role": "ROLE_ADMIN"},
+		},
+		{
+			name:   "created, no snapshot",
+			method: createMethod,
+			req:    &authv1pb.CreateUserRequest{Email: "email@email.com"},
+			handler: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return &authv1pb.CreateUserResponse{Id: "newid", ActivationToken: "token"}, nil
+			},
+			wantEntry:  true,
+			wantTarget: []string{"newid"},
+			wantAfter:  map[string]interface{}{"email": "email@email.com"},
+		},
+		{
+			name:   "failed",
+			method: createMethod,
+			req:    &authv1pb.CreateUserRequest{},
+			handler: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, errors.New("handler error")
+			},
+			wantErr: true,
+		},
+		{
+			name:   "not audited",
+			method: findMethod,
+			req:    &authv1pb.FindUserRequest{Id: "userid"},
+			handler: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return &authv1pb.FindUserResponse{}, nil
+			},
+		},
+	}
+	// the cases depend on each other, so they are run sequentially
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &testRepo{}
+			interceptor := audit.UnaryServerInterceptor(audit.NewService(repo), specs, actor, zap.NewNop())
+			_, err := interceptor(context.Background(), tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				tt.handler)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("interceptor error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantEntry {
+				if len(repo.entries) != 0 {
+					t.Errorf("interceptor recorded %+v, want nothing recorded", repo.entries)
+				}
+				return
+			}
+			if len(repo.entries) != 1 {
+				t.Fatalf("interceptor recorded %d entries, want 1", len(repo.entries))
+			}
+			got := repo.entries[0]
+			if got.ActorID != "actorid" || got.ClientID != "clientid" || got.Method != tt.method {
+				t.Errorf("interceptor entry = %+v, want actorid, clientid and %s", got, tt.method)
+			}
+			if !reflect.DeepEqual(got.TargetIDs, tt.wantTarget) {
+				t.Errorf("interceptor targets = %v, want %v", got.TargetIDs, tt.wantTarget)
+			}
+			if gotBefore := decode(t, got.Before); !reflect.DeepEqual(gotBefore, tt.wantBefore) {
+				t.Errorf("interceptor before = %v, want %v", gotBefore, tt.wantBefore)
+			}
+			if gotAfter := decode(t, got.After); !reflect.DeepEqual(gotAfter, tt.wantAfter) {
+				t.Errorf("interceptor after = %v, want %v", gotAfter, tt.wantAfter)
+			}
+		})
+	}
+}
+
+func TestTargetIDs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		req  interface{}
+		resp interface{}
+		want []string
+	}{
+		{
+			name: "id",
+			req:  &eventsv1pb.DeleteEventRequest{Id: "eventid"},
+			want: []string{"eventid"},
+		},
+		{
+			name: "event and pupil",
+			req:  &eventsv1pb.ChangePupilResourcesRequest{EventId: "eventid", PupilId: "pupilid"},
+			want: []string{"eventid", "pupilid"},
+		},
+		{
+			name: "pupil ids",
+			req:  &eventsv1pb.RemovePupilsRequest{PupilIds: []string{"p1", "p2"}},
+			want: []string{"p1", "p2"},
+		},
+		{
+			name: "single id takes precedence",
+			req:  &authv1pb.SetUserPupilsRequest{Id: "userid", PupilIds: []string{"p1"}},
+			want: []string{"userid"},
+		},
+		{
+			name: "from response",
+			req:  &eventsv1pb.AddPupilsRequest{},
+			resp: &eventsv1pb.AddPupilsResponse{PupilIds: []string{"p1"}},
+			want: []string{"p1"},
+		},
+		{
+			name: "none",
+			req:  &authv1pb.ResetPasswordRequest{ResetToken: "token"},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := audit.TargetIDs(tt.req, tt.resp); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TargetIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/jmoiron/sqlx"
+)
+
+// postgresRepo is a Repository storing the entries in the audit_entries table of the service's db
+type postgresRepo struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresRepo returns a Repository storing the entries in the db of the service
+func NewPostgresRepo(db *pgxpool.Pool) Repository {
+	return &postgresRepo{db}
+}
+
+const auditEntriesQuery = `
+	with query as (
+		select id, actor_id, client_id, method, target_ids, before, after, occurred_at
+		from audit_entries
+		where 1=1 %s
+	), pagination as (
+		select *
+		from query
+		order by occurred_at desc, id
+		limit ? offset ?
+	)
+	select *
+	from pagination
+			 right join (select count(*) from query) as c(total) on true;
+`
+
+// Entries returns the entries passing the filters, the latest first, and the total number of such entries
+func (p *postgresRepo) Entries(ctx context.Context, filters Filters, amount, skip int) ([]Entry, int,
+	error) {
+
+	q, args := auditEntriesQueryArgs(filters, amount, skip)
+	rows, err := p.db.Query(ctx, q, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	// "total" column will always be returned, so other columns might be null
+	var (
+		id         pgtype.Varchar
+		actorID    pgtype.Varchar
+		clientID   pgtype.Varchar
+		method     pgtype.Varchar
+		targetIDs  []string
+		before     pgtype.JSONB
+		after      pgtype.JSONB
+		occurredAt pgtype.Timestamptz
+		total      int
+	)
+	entries := []Entry{}
+	for rows.Next() {
+		err := rows.Scan(&id, &actorID, &clientID, &method, &targetIDs, &before, &after, &occurredAt, &total)
+		if err != nil {
+			return nil, 0, err
+		}
+		// next will happen if the offset >= total rows found or no entries matching the filters have been found
+		if id.Status != pgtype.Present {
+			return entries, total, nil
+		}
+		entries = append(entries, Entry{
+			ID:         id.String,
+			ActorID:    actorID.String,
+			ClientID:   clientID.String,
+			Method:     method.String,
+			TargetIDs:  targetIDs,
+			Before:     jsonBytes(before),
+			After:      jsonBytes(after),
+			OccurredAt: occurredAt.Time,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
+
+// auditEntriesQueryArgs builds the entries query along with its args
+func auditEntriesQueryArgs(filters Filters, amount, skip int) (string, []interface{}) {
+	where := strings.Builder{}
+	var args []interface{}
+	if filters.ActorID != "" {
+		where.WriteString("and actor_id = ? ")
+		args = append(args, filters.ActorID)
+	}
+	if filters.Method != "" {
+		where.WriteString("and method = ? ")
+		args = append(args, filters.Method)
+	}
+	if filters.TargetID != "" {
+		where.WriteString("and target_ids @> array [?::varchar] ")
+		args = append(args, filters.TargetID)
+	}
+	if !filters.From.IsZero() {
+		where.WriteString("and occurred_at >= ? ")
+		args = append(args, filters.From)
+	}
+	if !filters.To.IsZero() {
+		where.WriteString("and occurred_at <= ? ")
+		args = append(args, filters.To)
+	}
+	args = append(args, amount, skip)
+	q := fmt.Sprintf(auditEntriesQuery, where.String())
+	// change "?" to "$" in the query
+	return sqlx.Rebind(sqlx.BindType("pgx"), q), args
+}
+
+const storeAuditEntryQuery = `
+	insert into audit_entries (id, actor_id, client_id, method, target_ids, before, after, occurred_at)
+	values ($1, $2, $3, $4, $5, $6, $7, $8);
+`
+
+// StoreEntry stores the entry
+func (p *postgresRepo) StoreEntry(ctx context.Context, entry Entry) error {
+	targetIDs := entry.TargetIDs
+	if targetIDs == nil {
+		targetIDs = []string{}
+	}
+	_, err := p.db.Exec(ctx, storeAuditEntryQuery, entry.ID, entry.ActorID, entry.ClientID, entry.Method, targetIDs,
+		jsonb(entry.Before), jsonb(entry.After), entry.OccurredAt)
+	return err
+}
+
+// jsonBytes returns the json held by the jsonb, which is nil if the jsonb is null
+func jsonBytes(j pgtype.JSONB) []byte {
+	if j.Status != pgtype.Present {
+		return nil
+	}
+	return j.Bytes
+}
+
+// jsonb converts the json to the jsonb type, which is null if there's no json
+func jsonb(b []byte) pgtype.JSONB {
+	if b == nil {
+		return pgtype.JSONB{Status: pgtype.Null}
+	}
+	return pgtype.JSONB{Bytes: b, Status: pgtype.Present}
+}
@@ -0,0 +1,68 @@
+// Package broker describes the way the services exchange domain events. A message published by one service is
+// delivered to every handler subscribed to its topic
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	gonanoid "github.com/matoous/go-nanoid"
+)
+
+var ErrUnknownTopic = errors.New("unknown topic")
+
+// Message is a domain event which has occurred in one of the services
+type Message struct {
+	// ID is a unique id of the message. Messages may be delivered more than once, so consumers can use the id to
+	// deduplicate them
+	ID         string
+	Topic      string
+	OccurredAt time.Time
+	// Payload is a json encoded body of the message. Its structure depends on the topic
+	Payload []byte
+}
+
+// NewMessage creates a message with a unique id and the payload encoded to json
+func NewMessage(topic string, payload interface{}) (Message, error) {
+	if !IsKnownTopic(topic) {
+		return Message{}, fmt.Errorf("%w: %s", ErrUnknownTopic, topic)
+	}
+	id, err := gonanoid.Nanoid()
+	if err != nil {
+		return Message{}, err
+	}
+	p, err := json.Marshal(payload)
+	if err != nil {
+		return Message{}, fmt.Errorf("encode payload: %w", err)
+	}
+	return Message{ID: id, Topic: topic, OccurredAt: time.Now().UTC(), Payload: p}, nil
+}
+
+// Publisher publishes messages
+type Publisher interface {
+	Publish(ctx context.Context, msgs ...Message) error
+}
+
+// Handler processes a message. If it returns an error, the message is considered undelivered
+type Handler func(ctx context.Context, msg Message) error
+
+// Subscriber registers handlers for the topics
+type Subscriber interface {
+	Subscribe(topic string, h Handler) error
+}
+
+// nopPublisher is a publisher which drops all the messages
+type nopPublisher struct{}
+
+// NewNopPublisher returns a publisher which drops all the messages. It is used when there's no one to listen to them
+func NewNopPublisher() Publisher {
+	return nopPublisher{}
+}
+
+// Publish drops the messages
+func (nopPublisher) Publish(_ context.Context, _ ...Message) error {
+	return nil
+}
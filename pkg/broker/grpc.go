@@ -0,0 +1,49 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	notificationsv1pb "github.com/shanvl/garbage/api/notifications/v1/pb"
+)
+
+// grpcPublisher is an implementation of Publisher which sends the messages to the notification service
+type grpcPublisher struct {
+	client notificationsv1pb.NotificationsServiceClient
+	// time to wait for the notification svc response
+	timeout time.Duration
+}
+
+// NewGRPCPublisher returns a publisher which sends the messages to the notification service using its gRPC client
+func NewGRPCPublisher(client notificationsv1pb.NotificationsServiceClient, timeout time.Duration) Publisher {
+	return &grpcPublisher{client, timeout}
+}
+
+// Publish sends the messages to the notification service
+func (p *grpcPublisher) Publish(ctx context.Context, msgs ...Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	req := &notificationsv1pb.PublishRequest{Messages: make([]*notificationsv1pb.Message, len(msgs))}
+	for i, msg := range msgs {
+		occurredAt, err := ptypes.TimestampProto(msg.OccurredAt)
+		if err != nil {
+			return fmt.Errorf("message %s: %w", msg.ID, err)
+		}
+		req.Messages[i] = &notificationsv1pb.Message{
+			Id:         msg.ID,
+			Topic:      msg.Topic,
+			OccurredAt: occurredAt,
+			Payload:    msg.Payload,
+		}
+	}
+
+	ctxWithDeadline, cancel := context.WithDeadline(ctx, time.Now().Add(p.timeout))
+	defer cancel()
+	if _, err := p.client.Publish(ctxWithDeadline, req); err != nil {
+		return fmt.Errorf("publish messages: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package broker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	notificationsv1pb "github.com/shanvl/garbage/api/notifications/v1/pb"
+	"github.com/shanvl/garbage/pkg/broker"
+	"google.golang.org/grpc"
+)
+
+// testNotifClient records the published messages. Only Publish is implemented
+type testNotifClient struct {
+	notificationsv1pb.NotificationsServiceClient
+	err  error
+	msgs []*notificationsv1pb.Message
+}
+
+func (c *testNotifClient) Publish(ctx context.Context, req *notificationsv1pb.PublishRequest,
+	_ ...grpc.CallOption) (*empty.Empty, error) {
+
+	if c.err != nil {
+		return nil, c.err
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, errors.New("no deadline")
+	}
+	c.msgs = append(c.msgs, req.GetMessages()...)
+	return &empty.Empty{}, nil
+}
+
+func TestGRPCPublisher_Publish(t *testing.T) {
+	ctx := context.Background()
+	msg, err := broker.NewMessage(broker.TopicUserCreated, broker.UserCreated{UserID: "id", Email: "email"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("ok", func(t *testing.T) {
+		c := &testNotifClient{}
+		p := broker.NewGRPCPublisher(c, time.Second)
+		if err := p.Publish(ctx, msg); err != nil {
+			t.Fatalf("Publish() error = %v, wantErr false", err)
+		}
+		if len(c.msgs) != 1 || c.msgs[0].GetId() != msg.ID || c.msgs[0].GetTopic() != msg.Topic ||
+			string(c.msgs[0].GetPayload()) != string(msg.Payload) {
+			t.Errorf("Publish() sent = %v, want %v", c.msgs, msg)
+		}
+	})
+	t.Run("client's error", func(t *testing.T) {
+		p := broker.NewGRPCPublisher(&testNotifClient{err: errors.New("error")}, time.Second)
+		if err := p.Publish(ctx, msg); err == nil {
+			t.Errorf("Publish() error == nil, wantErr true")
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// InProc is an in-process broker which synchronously delivers the published messages to the handlers subscribed to
+// their topics. It's used by the notification service to dispatch the messages it receives as well as in the tests
+type InProc struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// NewInProc returns an in-process broker
+func NewInProc() *InProc {
+	return &InProc{handlers: map[string][]Handler{}}
+}
+
+// Publish delivers the messages to the handlers subscribed to their topics. It stops on the first error returned by
+// a handler and returns that error
+func (b *InProc) Publish(ctx context.Context, msgs ...Message) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, msg := range msgs {
+		for _, h := range b.handlers[msg.Topic] {
+			if err := h(ctx, msg); err != nil {
+				return fmt.Errorf("handle message %s: %w", msg.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Subscribe registers a handler for the topic. The topic must be one of the known topics
+func (b *InProc) Subscribe(topic string, h Handler) error {
+	if !IsKnownTopic(topic) {
+		return fmt.Errorf("%w: %s", ErrUnknownTopic, topic)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[topic] = append(b.handlers[topic], h)
+	return nil
+}
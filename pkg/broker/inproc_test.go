@@ -0,0 +1,117 @@
+package broker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shanvl/garbage/pkg/broker"
+)
+
+func TestInProc_Subscribe(t *testing.T) {
+	b := broker.NewInProc()
+	tests := []struct {
+		name    string
+		topic   string
+		wantErr bool
+	}{
+		{
+			name:    "known topic",
+			topic:   broker.TopicEventCreated,
+			wantErr: false,
+		},
+		{
+			name:    "unknown topic",
+			topic:   "unknown",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := b.Subscribe(tt.topic, func(ctx context.Context, msg broker.Message) error { return nil })
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Subscribe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInProc_Publish(t *testing.T) {
+	ctx := context.Background()
+	b := broker.NewInProc()
+	var eventCreated, userCreated []string
+	b.Subscribe(broker.TopicEventCreated, func(ctx context.Context, msg broker.Message) error {
+		eventCreated = append(eventCreated, msg.ID)
+		return nil
+	})
+	b.Subscribe(broker.TopicUserCreated, func(ctx context.Context, msg broker.Message) error {
+		userCreated = append(userCreated, msg.ID)
+		return nil
+	})
+	b.Subscribe(broker.TopicPupilsAdded, func(ctx context.Context, msg broker.Message) error {
+		return errors.New("handler error")
+	})
+
+	t.Run("messages are delivered to the subscribers of their topics", func(t *testing.T) {
+		err := b.Publish(ctx,
+			broker.Message{ID: "1", Topic: broker.TopicEventCreated},
+			broker.Message{ID: "2", Topic: broker.TopicUserCreated},
+			broker.Message{ID: "3", Topic: broker.TopicEventCreated},
+			broker.Message{ID: "4", Topic: broker.TopicPupilResourcesChanged},
+		)
+		if err != nil {
+			t.Fatalf("Publish() error = %v, wantErr false", err)
+		}
+		if len(eventCreated) != 2 || eventCreated[0] != "1" || eventCreated[1] != "3" {
+			t.Errorf("Publish() %s messages = %v, want [1 3]", broker.TopicEventCreated, eventCreated)
+		}
+		if len(userCreated) != 1 || userCreated[0] != "2" {
+			t.Errorf("Publish() %s messages = %v, want [2]", broker.TopicUserCreated, userCreated)
+		}
+	})
+	t.Run("handler's error", func(t *testing.T) {
+		err := b.Publish(ctx, broker.Message{ID: "5", Topic: broker.TopicPupilsAdded})
+		if err == nil {
+			t.Errorf("Publish() error = nil, wantErr true")
+		}
+	})
+}
+
+func TestNewMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		topic   string
+		payload interface{}
+		wantErr bool
+	}{
+		{
+			name:    "ok",
+			topic:   broker.TopicUserCreated,
+			payload: broker.UserCreated{UserID: "id", Email: "email"},
+			wantErr: false,
+		},
+		{
+			name:    "unknown topic",
+			topic:   "unknown",
+			payload: broker.UserCreated{UserID: "id", Email: "email"},
+			wantErr: true,
+		},
+		{
+			name:    "payload can't be encoded",
+			topic:   broker.TopicUserCreated,
+			payload: func() {},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := broker.NewMessage(tt.topic, tt.payload)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (msg.ID == "" || msg.Topic != tt.topic || len(msg.Payload) == 0) {
+				t.Errorf("NewMessage() = %+v, want message with id, topic and payload", msg)
+			}
+		})
+	}
+}
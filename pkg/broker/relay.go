@@ -0,0 +1,59 @@
+package broker
+
+import (
+	"context"
+	"time"
+)
+
+// Outbox stores the messages written in the same transaction as the changes they describe until they are published
+type Outbox interface {
+	// Relay passes up to limit messages to the publish func in the order they were stored and removes them from
+	// the outbox if the func returns no error. It returns the number of messages relayed
+	Relay(ctx context.Context, limit int, publish func(ctx context.Context, msgs []Message) error) (int, error)
+}
+
+// Relay periodically moves the messages from the outbox to the publisher. A message is removed from the outbox only
+// after it has been published, so every message is published at least once
+type Relay struct {
+	outbox    Outbox
+	publisher Publisher
+	// time to wait before checking the outbox again after it has been emptied
+	interval time.Duration
+	// max number of messages published at once
+	batch int
+}
+
+// NewRelay returns a relay of the messages from the outbox to the publisher
+func NewRelay(outbox Outbox, publisher Publisher, interval time.Duration, batch int) *Relay {
+	if batch <= 0 {
+		batch = 100
+	}
+	return &Relay{outbox, publisher, interval, batch}
+}
+
+// Run relays the messages until the ctx is done. Errors are passed to onErr and the messages are retried after the
+// interval
+func (r *Relay) Run(ctx context.Context, onErr func(error)) {
+	for {
+		n, err := r.RelayOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			onErr(err)
+		}
+		// if the batch was full, there may be more messages waiting
+		if err == nil && n == r.batch {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.interval):
+		}
+	}
+}
+
+// RelayOnce relays one batch of the messages and returns the number of messages relayed
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	return r.outbox.Relay(ctx, r.batch, func(ctx context.Context, msgs []Message) error {
+		return r.publisher.Publish(ctx, msgs...)
+	})
+}
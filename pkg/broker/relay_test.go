@@ -0,0 +1,109 @@
+package broker_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shanvl/garbage/pkg/broker"
+)
+
+// testOutbox is an in-memory outbox
+type testOutbox struct {
+	mu   sync.Mutex
+	msgs []broker.Message
+}
+
+func (o *testOutbox) len() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.msgs)
+}
+
+func (o *testOutbox) Relay(ctx context.Context, limit int, publish func(ctx context.Context,
+	msgs []broker.Message) error) (int, error) {
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	n := limit
+	if n > len(o.msgs) {
+		n = len(o.msgs)
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	if err := publish(ctx, o.msgs[:n]); err != nil {
+		return 0, err
+	}
+	o.msgs = o.msgs[n:]
+	return n, nil
+}
+
+// testPublisher fails the first "fail" attempts to publish
+type testPublisher struct {
+	fail      int
+	published []broker.Message
+}
+
+func (p *testPublisher) Publish(_ context.Context, msgs ...broker.Message) error {
+	if p.fail > 0 {
+		p.fail--
+		return errors.New("publisher error")
+	}
+	p.published = append(p.published, msgs...)
+	return nil
+}
+
+func TestRelay_RelayOnce(t *testing.T) {
+	ctx := context.Background()
+	outbox := &testOutbox{msgs: []broker.Message{{ID: "1"}, {ID: "2"}, {ID: "3"}}}
+	pub := &testPublisher{fail: 1}
+	r := broker.NewRelay(outbox, pub, time.Millisecond, 2)
+
+	if _, err := r.RelayOnce(ctx); err == nil {
+		t.Fatalf("RelayOnce() error == nil, wantErr true")
+	}
+	if len(outbox.msgs) != 3 {
+		t.Fatalf("RelayOnce() messages removed from the outbox on publisher's error")
+	}
+	for _, want := range []int{2, 1, 0} {
+		n, err := r.RelayOnce(ctx)
+		if err != nil {
+			t.Fatalf("RelayOnce() error = %v, wantErr false", err)
+		}
+		if n != want {
+			t.Errorf("RelayOnce() relayed = %d, want %d", n, want)
+		}
+	}
+	if len(pub.published) != 3 || pub.published[0].ID != "1" || pub.published[2].ID != "3" {
+		t.Errorf("RelayOnce() published = %v, want messages 1, 2, 3 in order", pub.published)
+	}
+}
+
+func TestRelay_Run(t *testing.T) {
+	outbox := &testOutbox{msgs: []broker.Message{{ID: "1"}, {ID: "2"}, {ID: "3"}}}
+	pub := &testPublisher{fail: 2}
+	r := broker.NewRelay(outbox, pub, time.Millisecond, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var errs int
+	done := make(chan struct{})
+	go func() {
+		r.Run(ctx, func(err error) { errs++ })
+		close(done)
+	}()
+	for outbox.len() > 0 && ctx.Err() == nil {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	<-done
+	if len(outbox.msgs) != 0 || len(pub.published) != 3 {
+		t.Errorf("Run() published = %d, left in the outbox = %d, want 3, 0", len(pub.published), len(outbox.msgs))
+	}
+	if errs != 2 {
+		t.Errorf("Run() errors = %d, want 2", errs)
+	}
+}
@@ -0,0 +1,127 @@
+package broker
+
+import "time"
+
+// Topics of the messages the services publish
+const (
+	// TopicEventCreated is published by eventsvc when a new event is created. Payload: EventCreated
+	TopicEventCreated = "events.event.created"
+	// TopicEventStatusChanged is published by eventsvc when an event is opened, closed or archived.
+	// Payload: EventStatusChanged
+	TopicEventStatusChanged = "events.event.status_changed"
+	// TopicEventUpdated is published by eventsvc when the date, the name or the allowed resources of an event are
+	// changed. Payload: EventUpdated
+	TopicEventUpdated = "events.event.updated"
+	// TopicPupilResourcesAdded is published by eventsvc when the resources brought by a pupil to an event are
+	// weighed in. Payload: PupilResourcesAdded
+	TopicPupilResourcesAdded = "events.pupil.resources_added"
+	// TopicPupilResourcesChanged is published by eventsvc when the resources brought by a pupil to an event are
+	// changed. Payload: PupilResourcesChanged
+	TopicPupilResourcesChanged = "events.pupil.resources_changed"
+	// TopicPupilsAdded is published by eventsvc when new pupils are added. Payload: PupilsAdded
+	TopicPupilsAdded = "events.pupils.added"
+	// TopicResourceEntryCorrected is published by eventsvc when an entry of the ledger of the resources brought by a
+	// pupil to an event is corrected. Payload: ResourceEntryCorrected
+	TopicResourceEntryCorrected = "events.resource_entry.corrected"
+	// TopicResourceEntryVoided is published by eventsvc when an entry of the ledger of the resources brought by a
+	// pupil to an event is voided. Payload: ResourceEntryVoided
+	TopicResourceEntryVoided = "events.resource_entry.voided"
+	// TopicUserCreated is published by authsvc when a new user is created. Payload: UserCreated
+	TopicUserCreated = "auth.user.created"
+)
+
+var knownTopics = map[string]bool{
+	TopicEventCreated:           true,
+	TopicEventStatusChanged:     true,
+	TopicEventUpdated:           true,
+	TopicPupilResourcesAdded:    true,
+	TopicPupilResourcesChanged:  true,
+	TopicPupilsAdded:            true,
+	TopicResourceEntryCorrected: true,
+	TopicResourceEntryVoided:    true,
+	TopicUserCreated:            true,
+}
+
+// IsKnownTopic reports whether the topic is one of the topics the services publish
+func IsKnownTopic(topic string) bool {
+	return knownTopics[topic]
+}
+
+// Topics returns all the known topics
+func Topics() []string {
+	return []string{TopicEventCreated, TopicEventStatusChanged, TopicEventUpdated, TopicPupilResourcesAdded,
+		TopicPupilResourcesChanged, TopicPupilsAdded, TopicResourceEntryCorrected, TopicResourceEntryVoided,
+		TopicUserCreated}
+}
+
+// EventCreated is the payload of TopicEventCreated
+type EventCreated struct {
+	EventID          string    `json:"eventId"`
+	Date             time.Time `json:"date"`
+	Name             string    `json:"name"`
+	ResourcesAllowed []string  `json:"resourcesAllowed"`
+}
+
+// EventStatusChanged is the payload of TopicEventStatusChanged
+type EventStatusChanged struct {
+	EventID string `json:"eventId"`
+	// Status is the new status of the event, e.g. "open"
+	Status string `json:"status"`
+}
+
+// EventUpdated is the payload of TopicEventUpdated
+type EventUpdated struct {
+	EventID          string    `json:"eventId"`
+	Date             time.Time `json:"date"`
+	Name             string    `json:"name"`
+	ResourcesAllowed []string  `json:"resourcesAllowed"`
+}
+
+// PupilResourcesAdded is the payload of TopicPupilResourcesAdded
+type PupilResourcesAdded struct {
+	EventID string `json:"eventId"`
+	PupilID string `json:"pupilId"`
+	// Resources are the signed amounts added to the resources brought by the pupil
+	Resources map[string]float32 `json:"resources"`
+	// RecordedBy is the id of the user who has weighed the resources in
+	RecordedBy string `json:"recordedBy"`
+}
+
+// PupilResourcesChanged is the payload of TopicPupilResourcesChanged
+type PupilResourcesChanged struct {
+	EventID   string             `json:"eventId"`
+	PupilID   string             `json:"pupilId"`
+	Resources map[string]float32 `json:"resources"`
+}
+
+// PupilsAdded is the payload of TopicPupilsAdded
+type PupilsAdded struct {
+	PupilIDs []string `json:"pupilIds"`
+}
+
+// ResourceEntryCorrected is the payload of TopicResourceEntryCorrected
+type ResourceEntryCorrected struct {
+	EventID string `json:"eventId"`
+	PupilID string `json:"pupilId"`
+	// EntryID is the id of the corrected entry, which has been voided
+	EntryID string `json:"entryId"`
+	// CorrectionID is the id of the entry recorded instead
+	CorrectionID string `json:"correctionId"`
+	// Resources are the corrected signed amounts
+	Resources  map[string]float32 `json:"resources"`
+	RecordedBy string             `json:"recordedBy"`
+}
+
+// ResourceEntryVoided is the payload of TopicResourceEntryVoided
+type ResourceEntryVoided struct {
+	EventID  string `json:"eventId"`
+	PupilID  string `json:"pupilId"`
+	EntryID  string `json:"entryId"`
+	VoidedBy string `json:"voidedBy"`
+}
+
+// UserCreated is the payload of TopicUserCreated
+type UserCreated struct {
+	UserID string `json:"userId"`
+	Email  string `json:"email"`
+}
@@ -0,0 +1,72 @@
+// Package etag converts the versions of the entities to the entity tags of HTTP and back, so that the clients of the
+// REST gateways can read the versions from the ETag header and make their changes conditional with the If-Match one
+package etag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ErrInvalid is used when the entity tag isn't made of a version
+var ErrInvalid = errors.New("invalid entity tag")
+
+// ifMatchKey is the metadata key the REST gateway forwards the If-Match header with
+const ifMatchKey = "grpcgateway-if-match"
+
+// Format returns the entity tag of the version, which is the version in quotes
+func Format(version uint32) string {
+	return strconv.Quote(strconv.FormatUint(uint64(version), 10))
+}
+
+// Parse returns the version the entity tag is made of. The weak tags are accepted as well, since the version changes
+// on every change of the entity anyway. The "*" tag matches any version, so it's parsed as 0
+func Parse(tag string) (uint32, error) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+	if tag == "*" {
+		return 0, nil
+	}
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, fmt.Errorf("%w: %s", ErrInvalid, tag)
+	}
+	version, err := strconv.ParseUint(tag[1:len(tag)-1], 10, 32)
+	if err != nil || version == 0 {
+		return 0, fmt.Errorf("%w: %s", ErrInvalid, tag)
+	}
+	return uint32(version), nil
+}
+
+// SetHeader sets the ETag header to the entity tag of the version. The version 0 means there's no versioned entity,
+// so the header isn't set
+func SetHeader(w http.ResponseWriter, version uint32) {
+	if version != 0 {
+		w.Header().Set("ETag", Format(version))
+	}
+}
+
+// Precondition returns the version the call is conditional on. It's the given version or, if it's 0, the version
+// from the If-Match header forwarded by the REST gateway. The version 0 means the call isn't conditional
+func Precondition(ctx context.Context, version uint32) (int, error) {
+	if version != 0 {
+		return int(version), nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	tags := md.Get(ifMatchKey)
+	if len(tags) == 0 {
+		return 0, nil
+	}
+	// a single entity can't be of several versions at once
+	if len(tags) > 1 || strings.Contains(tags[0], ",") {
+		return 0, fmt.Errorf("%w: only one entity tag is allowed", ErrInvalid)
+	}
+	v, err := Parse(tags[0])
+	return int(v), err
+}
@@ -0,0 +1,144 @@
+package etag
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		tag     string
+		want    uint32
+		wantErr error
+	}{
+		{
+			name: "formatted version",
+			tag:  Format(3),
+			want: 3,
+		},
+		{
+			name: "weak tag",
+			tag:  `W/"12"`,
+			want: 12,
+		},
+		{
+			name: "any version",
+			tag:  "*",
+			want: 0,
+		},
+		{
+			name:    "no quotes",
+			tag:     "3",
+			wantErr: ErrInvalid,
+		},
+		{
+			name:    "not a version",
+			tag:     `"abc"`,
+			wantErr: ErrInvalid,
+		},
+		{
+			name:    "zero version",
+			tag:     `"0"`,
+			wantErr: ErrInvalid,
+		},
+		{
+			name:    "empty",
+			tag:     "",
+			wantErr: ErrInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.tag)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetHeader(t *testing.T) {
+	t.Parallel()
+	w := httptest.NewRecorder()
+	SetHeader(w, 0)
+	if got := w.Header().Get("ETag"); got != "" {
+		t.Errorf("SetHeader() of version 0 ETag = %v, want none", got)
+	}
+	SetHeader(w, 5)
+	if got := w.Header().Get("ETag"); got != `"5"` {
+		t.Errorf("SetHeader() ETag = %v, want %v", got, `"5"`)
+	}
+}
+
+func TestPrecondition(t *testing.T) {
+	t.Parallel()
+	withIfMatch := func(tags ...string) context.Context {
+		md := metadata.MD{}
+		for _, tag := range tags {
+			md.Append(ifMatchKey, tag)
+		}
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		version uint32
+		want    int
+		wantErr error
+	}{
+		{
+			name: "no condition",
+			ctx:  context.Background(),
+			want: 0,
+		},
+		{
+			name:    "version of the request",
+			ctx:     context.Background(),
+			version: 4,
+			want:    4,
+		},
+		{
+			name:    "version of the request takes precedence over the header",
+			ctx:     withIfMatch(`"2"`),
+			version: 4,
+			want:    4,
+		},
+		{
+			name: "version of the header",
+			ctx:  withIfMatch(`"2"`),
+			want: 2,
+		},
+		{
+			name:    "invalid header",
+			ctx:     withIfMatch("2"),
+			wantErr: ErrInvalid,
+		},
+		{
+			name:    "several tags",
+			ctx:     withIfMatch(`"2", "3"`),
+			wantErr: ErrInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Precondition(tt.ctx, tt.version)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Precondition() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Precondition() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
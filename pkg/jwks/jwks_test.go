@@ -0,0 +1,115 @@
+package jwks_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shanvl/garbage/pkg/jwks"
+)
+
+func TestKey_RSAPublicKey(t *testing.T) {
+	t.Parallel()
+	private, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("couldn't generate a key: %v", err)
+	}
+	tests := []struct {
+		name    string
+		key     jwks.Key
+		wantErr bool
+	}{
+		{
+			name:    "ok",
+			key:     jwks.NewRSAKey("kid", &private.PublicKey),
+			wantErr: false,
+		},
+		{
+			name:    "not rsa",
+			key:     jwks.Key{Kty: "EC", Kid: "kid"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid modulus",
+			key:     jwks.Key{Kty: "RSA", Kid: "kid", N: "!", E: "AQAB"},
+			wantErr: true,
+		},
+		{
+			name:    "no exponent",
+			key:     jwks.Key{Kty: "RSA", Kid: "kid", N: "AQAB", E: ""},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.key.RSAPublicKey()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RSAPublicKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !equalKeys(&private.PublicKey, got) {
+				t.Errorf("RSAPublicKey() decoded key doesn't match the encoded one")
+			}
+		})
+	}
+}
+
+func TestSet_RSAPublicKeys(t *testing.T) {
+	t.Parallel()
+	private, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("couldn't generate a key: %v", err)
+	}
+	encKey := jwks.NewRSAKey("enc", &private.PublicKey)
+	encKey.Use = "enc"
+	set := jwks.Set{Keys: []jwks.Key{
+		jwks.NewRSAKey("sig", &private.PublicKey),
+		encKey,
+		{Kty: "EC", Kid: "ec"},
+	}}
+	keys, err := set.RSAPublicKeys()
+	if err != nil {
+		t.Fatalf("RSAPublicKeys() error = %v", err)
+	}
+	if len(keys) != 1 || !equalKeys(&private.PublicKey, keys["sig"]) {
+		t.Errorf("RSAPublicKeys() got = %v, want only the signature key", keys)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+	private, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("couldn't generate a key: %v", err)
+	}
+	want := jwks.Set{Keys: []jwks.Key{jwks.NewRSAKey("kid", &private.PublicKey)}}
+	h := jwks.Handler(func() jwks.Set { return want })
+
+	t.Run("get", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Handler() code = %d, want %d", rec.Code, http.StatusOK)
+		}
+		var got jwks.Set
+		if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+			t.Fatalf("Handler() couldn't decode the body: %v", err)
+		}
+		if len(got.Keys) != 1 || got.Keys[0] != want.Keys[0] {
+			t.Errorf("Handler() got = %+v, want %+v", got, want)
+		}
+	})
+	t.Run("post", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/.well-known/jwks.json", nil))
+		if rec.Code != http.StatusMethodNotAllowed {
+			t.Errorf("Handler() code = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func equalKeys(a, b *rsa.PublicKey) bool {
+	return a != nil && b != nil && a.N.Cmp(b.N) == 0 && a.E == b.E
+}
@@ -0,0 +1,106 @@
+package jwks
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Fetch downloads the key set from the url
+func Fetch(ctx context.Context, client *http.Client, url string) (Set, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return Set{}, err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return Set{}, fmt.Errorf("couldn't fetch the key set: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Set{}, fmt.Errorf("couldn't fetch the key set: unexpected status %d", resp.StatusCode)
+	}
+	var set Set
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return Set{}, fmt.Errorf("couldn't decode the key set: %w", err)
+	}
+	return set, nil
+}
+
+// RemoteSet is a key set fetched from the url and kept in memory. The set is fetched again when it gets older than
+// maxAge, so that the removed keys stop working, and when an unknown key is requested, so that the new keys start
+// working right away. The latter happens at most once per minRefreshInterval
+type RemoteSet struct {
+	url                string
+	client             *http.Client
+	minRefreshInterval time.Duration
+	maxAge             time.Duration
+
+	// refreshMu makes sure only one request fetches the set at a time
+	refreshMu sync.Mutex
+	mu        sync.RWMutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+// NewRemoteSet returns a key set which is fetched from the url on demand
+func NewRemoteSet(url string, client *http.Client, minRefreshInterval, maxAge time.Duration) *RemoteSet {
+	return &RemoteSet{
+		url:                url,
+		client:             client,
+		minRefreshInterval: minRefreshInterval,
+		maxAge:             maxAge,
+	}
+}
+
+// PublicKey returns the rsa key with the given id
+func (r *RemoteSet) PublicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	if key, ok, fresh := r.cached(kid); ok && fresh {
+		return key, nil
+	}
+
+	r.refreshMu.Lock()
+	defer r.refreshMu.Unlock()
+	// the set might have been fetched while waiting for the lock
+	key, ok, fresh := r.cached(kid)
+	if ok && fresh {
+		return key, nil
+	}
+	r.mu.RLock()
+	fetchedAt := r.fetchedAt
+	r.mu.RUnlock()
+	if fresh && time.Since(fetchedAt) < r.minRefreshInterval {
+		return nil, fmt.Errorf("unknown key id: %q", kid)
+	}
+
+	set, err := Fetch(ctx, r.client, r.url)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := set.RSAPublicKeys()
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.keys, r.fetchedAt = keys, time.Now()
+	r.mu.Unlock()
+
+	key, ok = keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id: %q", kid)
+	}
+	return key, nil
+}
+
+// cached returns the key from the fetched set and tells whether the set is younger than maxAge
+func (r *RemoteSet) cached(kid string) (key *rsa.PublicKey, ok bool, fresh bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	key, ok = r.keys[kid]
+	fresh = !r.fetchedAt.IsZero() && time.Since(r.fetchedAt) < r.maxAge
+	return key, ok, fresh
+}
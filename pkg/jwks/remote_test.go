@@ -0,0 +1,88 @@
+package jwks_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shanvl/garbage/pkg/jwks"
+)
+
+func TestRemoteSet_PublicKey(t *testing.T) {
+	t.Parallel()
+	oldKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("couldn't generate a key: %v", err)
+	}
+	newKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("couldn't generate a key: %v", err)
+	}
+	var mu sync.Mutex
+	set := jwks.Set{Keys: []jwks.Key{jwks.NewRSAKey("old", &oldKey.PublicKey)}}
+	fetches := 0
+	srv := httptest.NewServer(jwks.Handler(func() jwks.Set {
+		mu.Lock()
+		defer mu.Unlock()
+		fetches++
+		return set
+	}))
+	defer srv.Close()
+
+	const minRefreshInterval = 50 * time.Millisecond
+	r := jwks.NewRemoteSet(srv.URL, srv.Client(), minRefreshInterval, time.Hour)
+	ctx := context.Background()
+	fetchCount := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return fetches
+	}
+
+	t.Run("known key is fetched once", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			key, err := r.PublicKey(ctx, "old")
+			if err != nil {
+				t.Fatalf("PublicKey() error = %v", err)
+			}
+			if !equalKeys(&oldKey.PublicKey, key) {
+				t.Errorf("PublicKey() returned the wrong key")
+			}
+		}
+		if got := fetchCount(); got != 1 {
+			t.Errorf("PublicKey() fetched the set %d times, want 1", got)
+		}
+	})
+	t.Run("unknown key is refetched no more than once per interval", func(t *testing.T) {
+		mu.Lock()
+		set.Keys = append(set.Keys, jwks.NewRSAKey("new", &newKey.PublicKey))
+		mu.Unlock()
+		time.Sleep(minRefreshInterval)
+		key, err := r.PublicKey(ctx, "new")
+		if err != nil {
+			t.Fatalf("PublicKey() error = %v", err)
+		}
+		if !equalKeys(&newKey.PublicKey, key) {
+			t.Errorf("PublicKey() returned the wrong key")
+		}
+		before := fetchCount()
+		if _, err := r.PublicKey(ctx, "unknown"); err == nil {
+			t.Errorf("PublicKey() unknown key, error = nil")
+		}
+		if got := fetchCount(); got != before {
+			t.Errorf("PublicKey() refetched the set too soon")
+		}
+	})
+	t.Run("fetch error", func(t *testing.T) {
+		srvNotFound := httptest.NewServer(http.NotFoundHandler())
+		defer srvNotFound.Close()
+		broken := jwks.NewRemoteSet(srvNotFound.URL, srvNotFound.Client(), minRefreshInterval, time.Hour)
+		if _, err := broken.PublicKey(ctx, "old"); err == nil {
+			t.Errorf("PublicKey() fetch error, error = nil")
+		}
+	})
+}
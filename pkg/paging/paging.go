@@ -0,0 +1,125 @@
+// Package paging pages through the sorted lists. A page either skips a number of the items, or follows the opaque
+// page token which points at the last item of the previous page. The pages made with the tokens stay stable while the
+// items are added or removed, and the db doesn't have to go through the items of the previous pages to make them
+package paging
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidToken is used when the page token can't be decoded or was made for the list sorted in another way
+var ErrInvalidToken = errors.New("invalid page token")
+
+// Page describes which part of a sorted list is returned
+type Page struct {
+	// Size is the max number of the items returned
+	Size int
+	// Skip is the number of the items skipped. It's ignored if the token is set
+	Skip int
+	// Token is the token of the previous page
+	Token string
+	// SkipTotal allows not to count all the items of the list, which is only needed for the total. The total is 0 then
+	SkipTotal bool
+}
+
+// Request is a request of the api which pages through a sorted list. The page size is used instead of the amount if
+// it's set
+type Request interface {
+	GetAmount() uint32
+	GetSkip() uint32
+	GetPageSize() uint32
+	GetPageToken() string
+	GetSkipTotal() bool
+}
+
+// FromRequest returns the page the request asks for
+func FromRequest(req Request) Page {
+	size := req.GetAmount()
+	if req.GetPageSize() != 0 {
+		size = req.GetPageSize()
+	}
+	return Page{Size: int(size), Skip: int(req.GetSkip()), Token: req.GetPageToken(), SkipTotal: req.GetSkipTotal()}
+}
+
+// Normalize returns the page, the size and the skip of which are replaced with the defaults if they're out of range
+func (p Page) Normalize(defaultSize, maxSize int) Page {
+	if p.Size <= 0 || p.Size > maxSize {
+		p.Size = defaultSize
+	}
+	if p.Skip < 0 {
+		p.Skip = 0
+	}
+	return p
+}
+
+// Cursor returns the cursor the token of the page was made of, or nil if the page has no token. The token must have
+// been made for the list sorted by the sorting
+func (p Page) Cursor(sorting string) (*Cursor, error) {
+	if p.Token == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(p.Token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil || len(c.Keys) == 0 {
+		return nil, ErrInvalidToken
+	}
+	if c.Sorting != sorting {
+		return nil, fmt.Errorf("%w: it was made for another sorting", ErrInvalidToken)
+	}
+	return &c, nil
+}
+
+// Offset returns the number of the items to skip. The items before the cursor are left out by the query, so nothing
+// is skipped if the page has a token
+func (p Page) Offset() int {
+	if p.Token != "" {
+		return 0
+	}
+	return p.Skip
+}
+
+// NextToken returns the token of the page following this one, which holds n items, the last of which has the keys. If
+// the page isn't full, it's the last one and there's no token
+func (p Page) NextToken(sorting string, n int, keys []byte) (string, error) {
+	if n < p.Size || keys == nil {
+		return "", nil
+	}
+	c, err := NewCursor(sorting, keys)
+	if err != nil {
+		return "", err
+	}
+	return c.Token()
+}
+
+// Cursor is the position of an item in the sorted list
+type Cursor struct {
+	// Sorting is the sorting of the list the item is in
+	Sorting string `json:"s"`
+	// Keys are the values the item is sorted by, the last of which identify the item
+	Keys []json.RawMessage `json:"k"`
+}
+
+// NewCursor returns the cursor of the item in the list sorted by the sorting. The keys are the json array of the
+// values the item is sorted by
+func NewCursor(sorting string, keys []byte) (*Cursor, error) {
+	c := &Cursor{Sorting: sorting}
+	if err := json.Unmarshal(keys, &c.Keys); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Token returns the page token which points at the item. The next page starts after it
+func (c *Cursor) Token() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
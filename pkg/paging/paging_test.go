@@ -0,0 +1,146 @@
+package paging
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPage_Normalize(t *testing.T) {
+	tests := []struct {
+		name string
+		page Page
+		want Page
+	}{
+		{
+			name: "ok",
+			page: Page{Size: 10, Skip: 5},
+			want: Page{Size: 10, Skip: 5},
+		},
+		{
+			name: "no size",
+			page: Page{Skip: 5},
+			want: Page{Size: 25, Skip: 5},
+		},
+		{
+			name: "too large size and negative skip",
+			page: Page{Size: 101, Skip: -1},
+			want: Page{Size: 25},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.page.Normalize(25, 100); got != tt.want {
+				t.Errorf("Normalize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPage_Cursor(t *testing.T) {
+	c, err := NewCursor("name_asc", []byte(`["Smith", "2020-09-01", 1.5, "id"]`))
+	if err != nil {
+		t.Fatalf("NewCursor() error = %v", err)
+	}
+	token, err := c.Token()
+	if err != nil {
+		t.Fatalf("Token() error = %v", err)
+	}
+	tests := []struct {
+		name    string
+		page    Page
+		sorting string
+		want    *Cursor
+		wantErr error
+	}{
+		{
+			name:    "no token",
+			page:    Page{Skip: 10},
+			sorting: "name_asc",
+		},
+		{
+			name:    "ok",
+			page:    Page{Token: token},
+			sorting: "name_asc",
+			want:    c,
+		},
+		{
+			name:    "another sorting",
+			page:    Page{Token: token},
+			sorting: "name_desc",
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "not a token",
+			page:    Page{Token: "not a token"},
+			sorting: "name_asc",
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "no keys",
+			page:    Page{Token: "eyJzIjoibmFtZV9hc2MifQ"},
+			sorting: "name_asc",
+			wantErr: ErrInvalidToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.page.Cursor(tt.sorting)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Cursor() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Cursor() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPage_Offset(t *testing.T) {
+	if got := (Page{Skip: 10}).Offset(); got != 10 {
+		t.Errorf("Offset() = %d, want 10", got)
+	}
+	if got := (Page{Skip: 10, Token: "token"}).Offset(); got != 0 {
+		t.Errorf("Offset() with the token = %d, want 0", got)
+	}
+}
+
+type request struct {
+	amount, skip, pageSize uint32
+	pageToken              string
+	skipTotal              bool
+}
+
+func (r request) GetAmount() uint32    { return r.amount }
+func (r request) GetSkip() uint32      { return r.skip }
+func (r request) GetPageSize() uint32  { return r.pageSize }
+func (r request) GetPageToken() string { return r.pageToken }
+func (r request) GetSkipTotal() bool   { return r.skipTotal }
+
+func TestFromRequest(t *testing.T) {
+	if got := FromRequest(request{amount: 10, skip: 5}); got != (Page{Size: 10, Skip: 5}) {
+		t.Errorf("FromRequest() = %+v, want the amount and the skip", got)
+	}
+	got := FromRequest(request{amount: 10, pageSize: 20, pageToken: "token", skipTotal: true})
+	if want := (Page{Size: 20, Token: "token", SkipTotal: true}); got != want {
+		t.Errorf("FromRequest() = %+v, want %+v", got, want)
+	}
+}
+
+func TestPage_NextToken(t *testing.T) {
+	page := Page{Size: 2}
+	token, err := page.NextToken("name_asc", 2, []byte(`["Smith", "id"]`))
+	if err != nil || token == "" {
+		t.Fatalf("NextToken() = %q, %v, want the token", token, err)
+	}
+	c, err := Page{Token: token}.Cursor("name_asc")
+	if err != nil {
+		t.Fatalf("Cursor() error = %v", err)
+	}
+	if keys := string(c.Keys[0]) + string(c.Keys[1]); keys != `"Smith""id"` {
+		t.Errorf("Cursor() keys = %s", keys)
+	}
+	if token, err := page.NextToken("name_asc", 1, []byte(`["Smith", "id"]`)); err != nil || token != "" {
+		t.Errorf("NextToken() of the last page = %q, %v, want no token", token, err)
+	}
+}
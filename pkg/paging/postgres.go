@@ -0,0 +1,89 @@
+package paging
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Column is a column of the "order by" part of a postgres query. It can be an expression as well
+type Column struct {
+	Expr string
+	Desc bool
+}
+
+// ParseColumns returns the columns of the "order by" part of the query, e.g. "date desc, name". The columns are
+// separated by commas, so they can't contain commas themselves
+func ParseColumns(orderBy string) []Column {
+	var columns []Column
+	for _, part := range strings.Split(orderBy, ",") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			continue
+		}
+		c := Column{Expr: strings.Join(fields, " ")}
+		if n := len(fields); n > 1 {
+			switch strings.ToLower(fields[n-1]) {
+			case "asc":
+				c.Expr = strings.Join(fields[:n-1], " ")
+			case "desc":
+				c = Column{Expr: strings.Join(fields[:n-1], " "), Desc: true}
+			}
+		}
+		columns = append(columns, c)
+	}
+	return columns
+}
+
+// OrderBy returns the "order by" part of the query which sorts by the columns
+func OrderBy(columns []Column) string {
+	parts := make([]string, len(columns))
+	for i, c := range columns {
+		parts[i] = c.Expr + " asc"
+		if c.Desc {
+			parts[i] = c.Expr + " desc"
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Keys returns the expression which makes the jsonb array of the values of the columns. The array of the last row of
+// the page is used to create its cursor
+func Keys(columns []Column) string {
+	exprs := make([]string, len(columns))
+	for i, c := range columns {
+		exprs[i] = c.Expr
+	}
+	return fmt.Sprintf("jsonb_build_array(%s)", strings.Join(exprs, ", "))
+}
+
+// After returns the condition which leaves only the rows which follow the cursor in the list sorted by the columns,
+// and its arguments. The values are compared as jsonb, which keeps the order of the numbers, the strings and the
+// dates. If the cursor is nil, the condition leaves all the rows
+func After(columns []Column, c *Cursor) (string, []interface{}, error) {
+	if c == nil {
+		return "true", nil, nil
+	}
+	if len(c.Keys) != len(columns) {
+		return "", nil, ErrInvalidToken
+	}
+	var (
+		or   []string
+		args []interface{}
+	)
+	// (a > ?) or (a = ? and b > ?) or ...
+	for i, column := range columns {
+		and := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			and = append(and, fmt.Sprintf("to_jsonb(%s) = ?::jsonb", columns[j].Expr))
+			args = append(args, string(c.Keys[j]))
+		}
+		op := ">"
+		if column.Desc {
+			op = "<"
+		}
+		and = append(and, fmt.Sprintf("to_jsonb(%s) %s ?::jsonb", column.Expr, op))
+		args = append(args, string(c.Keys[i]))
+		or = append(or, "("+strings.Join(and, " and ")+")")
+	}
+	return "(" + strings.Join(or, " or ") + ")", args, nil
+}
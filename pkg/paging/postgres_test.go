@@ -0,0 +1,65 @@
+package paging
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseColumns(t *testing.T) {
+	want := []Column{
+		{Expr: "class_date_formed", Desc: true},
+		{Expr: "class_letter"},
+		{Expr: "name"},
+	}
+	got := ParseColumns("class_date_formed desc, class_letter asc, name")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseColumns() = %+v, want %+v", got, want)
+	}
+	if orderBy := OrderBy(got); orderBy != "class_date_formed desc, class_letter asc, name asc" {
+		t.Errorf("OrderBy() = %s", orderBy)
+	}
+	if keys := Keys(got); keys != "jsonb_build_array(class_date_formed, class_letter, name)" {
+		t.Errorf("Keys() = %s", keys)
+	}
+}
+
+func TestAfter(t *testing.T) {
+	columns := []Column{{Expr: "date", Desc: true}, {Expr: "id"}}
+	c := &Cursor{Keys: []json.RawMessage{json.RawMessage(`"2020-01-01"`), json.RawMessage(`"id"`)}}
+	tests := []struct {
+		name     string
+		cursor   *Cursor
+		wantCond string
+		wantArgs []interface{}
+		wantErr  error
+	}{
+		{
+			name:     "no cursor",
+			wantCond: "true",
+		},
+		{
+			name:     "ok",
+			cursor:   c,
+			wantCond: "((to_jsonb(date) < ?::jsonb) or (to_jsonb(date) = ?::jsonb and to_jsonb(id) > ?::jsonb))",
+			wantArgs: []interface{}{`"2020-01-01"`, `"2020-01-01"`, `"id"`},
+		},
+		{
+			name:    "keys don't match the columns",
+			cursor:  &Cursor{Keys: c.Keys[:1]},
+			wantErr: ErrInvalidToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cond, args, err := After(columns, tt.cursor)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("After() error = %v, want %v", err, tt.wantErr)
+			}
+			if cond != tt.wantCond || !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("After() = %s, %v, want %s, %v", cond, args, tt.wantCond, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package svcauth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shanvl/garbage/pkg/svcauth"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+	const token = "service token"
+	// the incoming metadata as the server gets it from the credentials of the client
+	md, err := svcauth.NewCredentials(token).GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("GetRequestMetadata() error = %v", err)
+	}
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		token string
+		want  bool
+	}{
+		{
+			name:  "no metadata",
+			ctx:   context.Background(),
+			token: token,
+			want:  false,
+		},
+		{
+			name:  "no token",
+			ctx:   metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer x")),
+			token: token,
+			want:  false,
+		},
+		{
+			name:  "wrong token",
+			ctx:   metadata.NewIncomingContext(context.Background(), metadata.New(md)),
+			token: "another token",
+			want:  false,
+		},
+		{
+			name:  "token isn't configured",
+			ctx:   metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-service-token", "")),
+			token: "",
+			want:  false,
+		},
+		{
+			name:  "ok",
+			ctx:   metadata.NewIncomingContext(context.Background(), metadata.New(md)),
+			token: token,
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := svcauth.Verify(tt.ctx, tt.token); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}